@@ -19,6 +19,7 @@ import (
 func NewListCommand() *cobra.Command {
 	var (
 		selector  string
+		query     string
 		output    string
 		chunkSize int64
 	)
@@ -30,7 +31,7 @@ func NewListCommand() *cobra.Command {
 			serviceClient, err := apiClient.NewArchivedWorkflowServiceClient()
 			errors.CheckError(err)
 			namespace := client.Namespace()
-			workflows, err := listArchivedWorkflows(ctx, serviceClient, "metadata.namespace="+namespace, selector, chunkSize)
+			workflows, err := listArchivedWorkflows(ctx, serviceClient, "metadata.namespace="+namespace, selector, query, chunkSize)
 			errors.CheckError(err)
 			err = printer.PrintWorkflows(workflows, os.Stdout, printer.PrintOpts{Output: output, Namespace: true, UID: true})
 			errors.CheckError(err)
@@ -38,11 +39,12 @@ func NewListCommand() *cobra.Command {
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Selector (label query) to filter on, not including uninitialized ones, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	command.Flags().StringVarP(&query, "query", "q", "", "Query to search on, e.g. 'phase=Failed message~\"out of memory\" parameters.env=prod'. Supports '=', '!=' and '~' (contains, ignoring case) on the fields phase, message, workflowTemplate, clusterWorkflowTemplate, cronWorkflow and parameters.<name>. A term without a field matches the name or any field.")
 	command.Flags().Int64VarP(&chunkSize, "chunk-size", "", 0, "Return large lists in chunks rather than all at once. Pass 0 to disable.")
 	return command
 }
 
-func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, fieldSelector string, labelSelector string, chunkSize int64) (wfv1.Workflows, error) {
+func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg.ArchivedWorkflowServiceClient, fieldSelector string, labelSelector string, query string, chunkSize int64) (wfv1.Workflows, error) {
 	listOpts := &metav1.ListOptions{
 		FieldSelector: fieldSelector,
 		LabelSelector: labelSelector,
@@ -51,7 +53,7 @@ func listArchivedWorkflows(ctx context.Context, serviceClient workflowarchivepkg
 	var workflows wfv1.Workflows
 	for {
 		log.WithField("listOpts", listOpts).Debug()
-		resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: listOpts})
+		resp, err := serviceClient.ListArchivedWorkflows(ctx, &workflowarchivepkg.ListArchivedWorkflowsRequest{ListOptions: listOpts, Query: query})
 		if err != nil {
 			return nil, err
 		}
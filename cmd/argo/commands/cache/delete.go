@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	var keyRegex string
+	command := &cobra.Command{
+		Use:   "delete CACHE [KEY...]",
+		Short: "delete memoization cache entries, so the steps that created them run again",
+		Example: `# Delete entries by key:
+  argo cache delete my-cache my-key-1 my-key-2
+
+# Delete the entries whose key matches a regular expression:
+  argo cache delete my-cache --regex '^my-key-.*'
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 || (len(args) == 1 && keyRegex == "") {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			errors.CheckError(err)
+			list, err := serviceClient.DeleteMemoizationCacheEntries(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				CacheType: cacheType(cmd),
+				CacheName: args[0],
+				Keys:      args[1:],
+				KeyRegex:  keyRegex,
+			})
+			errors.CheckError(err)
+			for _, entry := range list.Items {
+				fmt.Printf("Cache entry '%s' deleted from %s '%s'\n", entry.Key, entry.CacheType, entry.CacheName)
+			}
+		},
+	}
+	command.Flags().StringVar(&keyRegex, "regex", "", "Delete the entries whose key matches this regular expression")
+	return command
+}
@@ -0,0 +1,33 @@
+package cache
+
+import (
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewGetCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "get CACHE KEY",
+		Short: "display the details of a memoization cache entry",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			errors.CheckError(err)
+			entry, err := serviceClient.GetMemoizationCacheEntry(ctx, &memoizationcachepkg.GetMemoizationCacheEntryRequest{
+				Namespace: client.Namespace(),
+				CacheType: cacheType(cmd),
+				CacheName: args[0],
+				Key:       args[1],
+			})
+			errors.CheckError(err)
+			printEntry(entry, output)
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
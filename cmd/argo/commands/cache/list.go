@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewListCommand() *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list [CACHE]",
+		Short: "list the entries of memoization caches",
+		Example: `# List the entries of every cache:
+  argo cache list
+
+# List the entries of one cache:
+  argo cache list my-cache
+`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			errors.CheckError(err)
+			req := &memoizationcachepkg.ListMemoizationCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				CacheType: cacheType(cmd),
+			}
+			if len(args) == 1 {
+				req.CacheName = args[0]
+			}
+			list, err := serviceClient.ListMemoizationCacheEntries(ctx, req)
+			errors.CheckError(err)
+			printEntries(list.Items, output)
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide|name")
+	return command
+}
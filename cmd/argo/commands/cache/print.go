@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func cacheType(cmd *cobra.Command) string {
+	t, _ := cmd.Flags().GetString("type")
+	return t
+}
+
+func printEntries(entries []*memoizationcachepkg.MemoizationCacheEntry, output string) {
+	switch output {
+	case "json":
+		printJSON(entries)
+	case "yaml":
+		printYAML(entries)
+	case "name":
+		for _, entry := range entries {
+			fmt.Println(entry.Key)
+		}
+	case "", "wide":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		_, _ = fmt.Fprint(w, "TYPE\tCACHE\tKEY\tNODE ID\tAGE\tLAST HIT")
+		if output == "wide" {
+			_, _ = fmt.Fprint(w, "\tOUTPUTS")
+		}
+		_, _ = fmt.Fprint(w, "\n")
+		for _, entry := range entries {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s", entry.CacheType, entry.CacheName, entry.Key, entry.NodeID, since(entry.CreationTimestamp), since(entry.LastHitTimestamp))
+			if output == "wide" {
+				_, _ = fmt.Fprintf(w, "\t%s", strings.Join(outputNames(entry), ","))
+			}
+			_, _ = fmt.Fprint(w, "\n")
+		}
+		_ = w.Flush()
+	default:
+		log.Fatalf("Unknown output mode: %s", output)
+	}
+}
+
+func printEntry(entry *memoizationcachepkg.MemoizationCacheEntry, output string) {
+	switch output {
+	case "json":
+		printJSON(entry)
+	case "yaml":
+		printYAML(entry)
+	case "":
+		const fmtStr = "%-20s %v\n"
+		fmt.Printf(fmtStr, "Type:", entry.CacheType)
+		fmt.Printf(fmtStr, "Cache:", entry.CacheName)
+		fmt.Printf(fmtStr, "Key:", entry.Key)
+		fmt.Printf(fmtStr, "Node ID:", entry.NodeID)
+		if entry.CreationTimestamp != nil {
+			fmt.Printf(fmtStr, "Created:", humanize.Timestamp(entry.CreationTimestamp.Time))
+		}
+		if entry.LastHitTimestamp != nil {
+			fmt.Printf(fmtStr, "Last Hit:", humanize.Timestamp(entry.LastHitTimestamp.Time))
+		}
+		outputs := entry.Outputs
+		if outputs == nil {
+			return
+		}
+		if len(outputs.Parameters) > 0 {
+			fmt.Println("Output Parameters:")
+			for _, p := range outputs.Parameters {
+				if p.Value != nil {
+					fmt.Printf("  %s: %s\n", p.Name, p.Value.String())
+				}
+			}
+		}
+		if len(outputs.Artifacts) > 0 {
+			fmt.Println("Output Artifacts:")
+			for _, a := range outputs.Artifacts {
+				key, _ := a.GetKey()
+				fmt.Printf("  %s: %s\n", a.Name, key)
+			}
+		}
+		if outputs.Result != nil {
+			fmt.Printf(fmtStr, "Result:", *outputs.Result)
+		}
+		if outputs.ExitCode != nil {
+			fmt.Printf(fmtStr, "Exit Code:", *outputs.ExitCode)
+		}
+	default:
+		log.Fatalf("Unknown output mode: %s", output)
+	}
+}
+
+func outputNames(entry *memoizationcachepkg.MemoizationCacheEntry) []string {
+	var names []string
+	if entry.Outputs == nil {
+		return names
+	}
+	for _, p := range entry.Outputs.Parameters {
+		names = append(names, p.Name)
+	}
+	for _, a := range entry.Outputs.Artifacts {
+		names = append(names, a.Name)
+	}
+	return names
+}
+
+func since(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return "N/A"
+	}
+	return humanize.RelativeDurationShort(t.Time, time.Now())
+}
+
+func printJSON(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(data))
+}
+
+func printYAML(v interface{}) {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(string(data))
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+func NewPruneCommand() *cobra.Command {
+	var olderThan string
+	command := &cobra.Command{
+		Use:   "prune [CACHE]",
+		Short: "delete memoization cache entries created longer ago than a duration",
+		Example: `# Delete the entries of every cache that were created more than a week ago:
+  argo cache prune --older-than 168h
+
+# Only prune one cache:
+  argo cache prune my-cache --older-than 24h
+`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewMemoizationCacheServiceClient()
+			errors.CheckError(err)
+			req := &memoizationcachepkg.PruneMemoizationCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				CacheType: cacheType(cmd),
+				OlderThan: olderThan,
+			}
+			if len(args) == 1 {
+				req.CacheName = args[0]
+			}
+			list, err := serviceClient.PruneMemoizationCacheEntries(ctx, req)
+			errors.CheckError(err)
+			for _, entry := range list.Items {
+				fmt.Printf("Cache entry '%s' deleted from %s '%s'\n", entry.Key, entry.CacheType, entry.CacheName)
+			}
+		},
+	}
+	command.Flags().StringVar(&olderThan, "older-than", "", "Delete entries created longer ago than this duration, e.g. 24h")
+	errors.CheckError(command.MarkFlagRequired("older-than"))
+	return command
+}
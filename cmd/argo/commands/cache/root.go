@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewCacheCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cache",
+		Short: "manage memoization caches",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+
+	command.AddCommand(NewListCommand())
+	command.AddCommand(NewGetCommand())
+	command.AddCommand(NewDeleteCommand())
+	command.AddCommand(NewPruneCommand())
+	command.PersistentFlags().String("type", "", "Only use caches of this type. One of: ConfigMapCache|SQLCache|ArtifactCache")
+	return command
+}
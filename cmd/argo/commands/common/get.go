@@ -93,6 +93,14 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 	if !wf.Status.ResourcesDuration.IsZero() {
 		out += fmt.Sprintf(fmtStr, "ResourcesDuration:", wf.Status.ResourcesDuration)
 	}
+	if wf.Status.Synchronization != nil && wf.Status.Synchronization.Semaphore != nil && len(wf.Status.Synchronization.Semaphore.Holding) > 0 {
+		out += fmt.Sprintf(fmtStr, "Semaphores:", "")
+		for _, holding := range wf.Status.Synchronization.Semaphore.Holding {
+			if len(holding.Holders) > 0 {
+				out += fmt.Sprintf(fmtStr, "  "+holding.Semaphore+":", semaphoreHoldersString(wf, holding))
+			}
+		}
+	}
 	if len(wf.GetExecSpec().Arguments.Parameters) > 0 {
 		out += fmt.Sprintf(fmtStr, "Parameters:", "")
 		for _, param := range wf.GetExecSpec().Arguments.Parameters {
@@ -169,6 +177,22 @@ func PrintWorkflowHelper(wf *wfv1.Workflow, getArgs GetFlags) string {
 	return out
 }
 
+// semaphoreHoldersString returns the holders of a semaphore, which are the workflow or its nodes, and their permits
+func semaphoreHoldersString(wf *wfv1.Workflow, holding wfv1.SemaphoreHolding) string {
+	var holders []string
+	for _, holder := range holding.Holders {
+		name := holder
+		if node, ok := wf.Status.Nodes[holder]; ok {
+			name = node.DisplayName
+		}
+		if permits := holding.GetPermits(holder); permits > 1 {
+			name = fmt.Sprintf("%s (%d permits)", name, permits)
+		}
+		holders = append(holders, name)
+	}
+	return strings.Join(holders, ", ")
+}
+
 type nodeInfoInterface interface {
 	getID() string
 	getNodeStatus(wf *wfv1.Workflow) wfv1.NodeStatus
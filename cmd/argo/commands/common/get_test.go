@@ -132,6 +132,27 @@ status:
 		output := PrintWorkflowHelper(&wf, GetFlags{})
 		assert.Regexp(t, `EstimatedDuration: *1 second`, output)
 	})
+	t.Run("Semaphores", func(t *testing.T) {
+		var wf wfv1.Workflow
+		wfv1.MustUnmarshal(`
+metadata:
+  name: my-wf
+status:
+  nodes:
+    my-wf-123:
+      displayName: train
+  phase: Running
+  synchronization:
+    semaphore:
+      holding:
+      - semaphore: default/ConfigMap/my-config/gpu
+        holders: [my-wf, my-wf-123]
+        permits:
+          my-wf-123: 4
+`, &wf)
+		output := PrintWorkflowHelper(&wf, GetFlags{})
+		assert.Regexp(t, `default/ConfigMap/my-config/gpu: *my-wf, train \(4 permits\)`, output)
+	})
 	t.Run("IndexOrdering", func(t *testing.T) {
 		var wf wfv1.Workflow
 		wfv1.MustUnmarshal(`apiVersion: argoproj.io/v1alpha1
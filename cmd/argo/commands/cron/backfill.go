@@ -0,0 +1,67 @@
+package cron
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/cmd/argo/commands/client"
+	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+)
+
+// NewBackfillCommand returns a new instance of an `argo cron backfill` command
+func NewBackfillCommand() *cobra.Command {
+	var (
+		from        string
+		to          string
+		parallelism int32
+	)
+	command := &cobra.Command{
+		Use:   "backfill CRON_WORKFLOW",
+		Short: "run the schedules of a cron workflow from one time to another",
+		Example: `# Run the schedules of a cron workflow from the start of 2024:
+
+  argo cron backfill my-cron-wf --from 2024-01-01T00:00:00Z
+
+# Run the schedules of January 2024, two workflows at a time:
+
+  argo cron backfill my-cron-wf --from 2024-01-01T00:00:00Z --to 2024-01-31T23:59:59Z --parallelism 2
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			fromTime, err := time.Parse(time.RFC3339, from)
+			errors.CheckError(err)
+			// the server defaults the end of the backfill to its time, as that of the client may be ahead of it
+			var toTime *metav1.Time
+			if to != "" {
+				t, err := time.Parse(time.RFC3339, to)
+				errors.CheckError(err)
+				toTime = &metav1.Time{Time: t}
+			}
+
+			ctx, apiClient := client.NewAPIClient(cmd.Context())
+			serviceClient, err := apiClient.NewCronWorkflowServiceClient()
+			errors.CheckError(err)
+			name := args[0]
+			cronWf, err := serviceClient.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{
+				Name:        name,
+				Namespace:   client.Namespace(),
+				From:        &metav1.Time{Time: fromTime},
+				To:          toTime,
+				Parallelism: parallelism,
+			})
+			errors.CheckError(err)
+			if b := cronWf.Status.Backfill; b != nil {
+				fmt.Printf("CronWorkflow '%s' backfilling from %s to %s\n", name, b.From.Format(time.RFC3339), b.To.Format(time.RFC3339))
+			}
+		},
+	}
+	command.Flags().StringVar(&from, "from", "", "The time of the first schedule to run, in RFC3339 format, inclusive")
+	command.Flags().StringVar(&to, "to", "", "The time of the last schedule to run, in RFC3339 format, inclusive. Defaults to the time of the server")
+	command.Flags().Int32Var(&parallelism, "parallelism", 1, "The number of backfilled workflows that may run at the same time")
+	errors.CheckError(command.MarkFlagRequired("from"))
+	return command
+}
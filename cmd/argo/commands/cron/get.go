@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
 	"github.com/argoproj/pkg/humanize"
@@ -73,7 +74,7 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 	out += fmt.Sprintf(fmtStr, "Name:", cwf.ObjectMeta.Name)
 	out += fmt.Sprintf(fmtStr, "Namespace:", cwf.ObjectMeta.Namespace)
 	out += fmt.Sprintf(fmtStr, "Created:", humanize.Timestamp(cwf.ObjectMeta.CreationTimestamp.Time))
-	out += fmt.Sprintf(fmtStr, "Schedule:", cwf.Spec.Schedule)
+	out += fmt.Sprintf(fmtStr, "Schedule:", strings.Join(cwf.Spec.GetSchedules(), ", "))
 	out += fmt.Sprintf(fmtStr, "Suspended:", cwf.Spec.Suspend)
 	if cwf.Spec.Timezone != "" {
 		out += fmt.Sprintf(fmtStr, "Timezone:", cwf.Spec.Timezone)
@@ -84,15 +85,28 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 	if cwf.Spec.ConcurrencyPolicy != "" {
 		out += fmt.Sprintf(fmtStr, "ConcurrencyPolicy:", cwf.Spec.ConcurrencyPolicy)
 	}
+	if x := cwf.Spec.Exclusions; x != nil {
+		exclusions := x.Dates
+		if x.ConfigMapKeyRef != nil {
+			exclusions = append(exclusions, fmt.Sprintf("configmap %s/%s", x.ConfigMapKeyRef.Name, x.ConfigMapKeyRef.Key))
+		}
+		out += fmt.Sprintf(fmtStr, "Exclusions:", strings.Join(exclusions, ", "))
+	}
 	if cwf.Status.LastScheduledTime != nil {
 		out += fmt.Sprintf(fmtStr, "LastScheduledTime:", humanize.Timestamp(cwf.Status.LastScheduledTime.Time))
 	}
+	if s := cwf.Status.LastSkipped; s != nil {
+		out += fmt.Sprintf(fmtStr, "LastSkipped:", humanize.Timestamp(s.ScheduledTime.Time)+" ("+s.Reason+")")
+	}
 
 	next, err := GetNextRuntime(cwf)
 	if err == nil {
 		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next)+" (assumes workflow-controller is in UTC)")
 	}
 
+	if cwf.Status.Succeeded > 0 || cwf.Status.Failed > 0 {
+		out += fmt.Sprintf(fmtStr, "Completed Workflows:", fmt.Sprintf("%d succeeded, %d failed (%d consecutively)", cwf.Status.Succeeded, cwf.Status.Failed, cwf.Status.ConsecutiveFailed))
+	}
 	if len(cwf.Status.Active) > 0 {
 		var activeWfNames []string
 		for _, activeWf := range cwf.Status.Active {
@@ -100,6 +114,15 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 		}
 		out += fmt.Sprintf(fmtStr, "Active Workflows:", strings.Join(activeWfNames, ", "))
 	}
+	if b := cwf.Status.Backfill; b != nil {
+		backfill := fmt.Sprintf("%s to %s, %d submitted", b.From.Format(time.RFC3339), b.To.Format(time.RFC3339), b.Submitted)
+		if b.Running() {
+			backfill += ", running"
+		} else {
+			backfill += ", finished " + humanize.Timestamp(b.FinishedAt.Time)
+		}
+		out += fmt.Sprintf(fmtStr, "Backfill:", backfill)
+	}
 	if len(cwf.Status.Conditions) > 0 {
 		out += cwf.Status.Conditions.DisplayString(fmtStr, map[wfv1.ConditionType]string{wfv1.ConditionTypeSubmissionError: "✖"})
 	}
@@ -1,6 +1,7 @@
 package cron
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/robfig/cron/v3"
@@ -8,12 +9,21 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
-// GetNextRuntime returns the next time the workflow should run in local time. It assumes the workflow-controller is in
-// UTC, but nevertheless returns the time in the local timezone.
+// GetNextRuntime returns the next time the workflow should run in local time, i.e. the earliest next time of its
+// schedules. It assumes the workflow-controller is in UTC, but nevertheless returns the time in the local timezone.
 func GetNextRuntime(cwf *v1alpha1.CronWorkflow) (time.Time, error) {
-	cronSchedule, err := cron.ParseStandard(cwf.Spec.GetScheduleString())
-	if err != nil {
-		return time.Time{}, err
+	var next time.Time
+	for _, schedule := range cwf.Spec.GetSchedulesWithTimezone() {
+		cronSchedule, err := cron.ParseStandard(schedule)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if n := cronSchedule.Next(time.Now().UTC()); next.IsZero() || n.Before(next) {
+			next = n
+		}
 	}
-	return cronSchedule.Next(time.Now().UTC()).Local(), nil
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron workflow has no schedule")
+	}
+	return next.Local(), nil
 }
@@ -1,7 +1,6 @@
 package commands
 
 import (
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -30,6 +29,7 @@ var (
 	varRunArgo          = common.VarRunArgoPath
 	containerName       = os.Getenv(common.EnvVarContainerName)
 	includeScriptOutput = os.Getenv(common.EnvVarIncludeScriptOutput) == "true" // capture stdout/combined
+	logSinkConfigured   = os.Getenv(common.EnvVarLogSink) != ""                 // capture combined, for the wait container to stream
 	template            = &wfv1.Template{}
 	logger              = log.WithField("argo", true)
 )
@@ -202,7 +202,7 @@ func NewEmissaryCommand() *cobra.Command {
 				}
 				for _, x := range template.Outputs.Artifacts {
 					if x.Path != "" {
-						if err := saveArtifact(x.Path); err != nil {
+						if err := saveArtifact(x.Path, x.Archive); err != nil {
 							return err
 						}
 					}
@@ -228,7 +228,7 @@ func createCommand(name string, args []string, template *wfv1.Template) (*exec.C
 	var combined *os.File
 	var err error
 	// this may not be that important an optimisation, except for very long logs we don't want to capture
-	if includeScriptOutput || template.SaveLogsAsArtifact() {
+	if includeScriptOutput || template.SaveLogsAsArtifact() || logSinkConfigured {
 		logger.Info("capturing logs")
 		stdout, err = os.OpenFile(varRunArgo+"/ctr/"+containerName+"/stdout", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 		if err != nil {
@@ -244,7 +244,7 @@ func createCommand(name string, args []string, template *wfv1.Template) (*exec.C
 	return command, stdout, combined, nil
 }
 
-func saveArtifact(srcPath string) error {
+func saveArtifact(srcPath string, strategy *wfv1.ArchiveStrategy) error {
 	if common.FindOverlappingVolume(template, srcPath) != nil {
 		logger.Infof("no need to save artifact - on overlapping volume: %s", srcPath)
 		return nil
@@ -264,7 +264,8 @@ func saveArtifact(srcPath string) error {
 		return fmt.Errorf("failed to create destination %s: %w", dstPath, err)
 	}
 	defer func() { _ = dst.Close() }()
-	if err = archive.TarGzToWriter(srcPath, gzip.DefaultCompression, dst); err != nil {
+	compression, level := archive.StrategyCompression(strategy)
+	if err = archive.TarToWriter(srcPath, compression, level, dst); err != nil {
 		return fmt.Errorf("failed to tarball the output %s to %s: %w", srcPath, dstPath, err)
 	}
 	if err = dst.Close(); err != nil {
@@ -2,11 +2,18 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
+	"os"
 	"time"
 
 	"github.com/argoproj/pkg/stats"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor"
 )
 
 func NewWaitCommand() *cobra.Command {
@@ -30,11 +37,13 @@ func waitContainer(ctx context.Context) error {
 	defer stats.LogStats()
 	stats.StartStatsTicker(5 * time.Minute)
 
+	stopStreamingLogs := streamLogs(ctx, wfExecutor)
 	// Wait for main container to complete
 	err := wfExecutor.Wait(ctx)
 	if err != nil {
 		wfExecutor.AddError(err)
 	}
+	stopStreamingLogs()
 	// Capture output script result
 	err = wfExecutor.CaptureScriptResult(ctx)
 	if err != nil {
@@ -63,3 +72,23 @@ func waitContainer(ctx context.Context) error {
 
 	return wfExecutor.HasError()
 }
+
+// streamLogs starts streaming the logs of the main containers to the log sink, if one is configured. It returns a
+// function that stops streaming.
+func streamLogs(ctx context.Context, wfExecutor *executor.WorkflowExecutor) (stop func()) {
+	value, ok := os.LookupEnv(common.EnvVarLogSink)
+	if !ok {
+		return func() {}
+	}
+	cfg := config.LogSinkConfig{}
+	if err := json.Unmarshal([]byte(value), &cfg); err != nil {
+		log.WithError(err).Warn("invalid log sink, not streaming logs")
+		return func() {}
+	}
+	logSink, err := sink.New(cfg, os.Getenv(common.EnvVarLogSinkAuthorization))
+	if err != nil {
+		log.WithError(err).Warn("invalid log sink, not streaming logs")
+		return func() {}
+	}
+	return wfExecutor.StreamLogs(ctx, logSink, cfg.GetFlushInterval())
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ArtifactTransferConfig configures how the init and wait containers transfer artifacts to and from artifact
+// repositories
+type ArtifactTransferConfig struct {
+	// Parallelism is the number of files of a directory artifact, or parts of a large file, that are transferred at the
+	// same time. Defaults to 1.
+	Parallelism int `json:"parallelism,omitempty"`
+	// PartSize is the size of the parts that large files are transferred in, e.g. 64Mi. Each part is retried on its own.
+	// Defaults to the artifact driver's default.
+	PartSize *resource.Quantity `json:"partSize,omitempty"`
+	// PartialDownloads is a volume that partially downloaded files are kept on, e.g. a host path or a persistent volume
+	// claim, so that the retries of a node, which run in new pods, resume them rather than start again. It is added to
+	// pods and mounted on the init container, with a sub-path for each workflow and node. By default, partial downloads
+	// are kept in the pod, so only the executor's own retries resume them.
+	PartialDownloads *apiv1.Volume `json:"partialDownloads,omitempty"`
+}
@@ -67,6 +67,18 @@ type Config struct {
 	// Persistence contains the workflow persistence DB configuration
 	Persistence *PersistConfig `json:"persistence,omitempty"`
 
+	// Synchronization configures where the state of semaphores and mutexes is kept
+	Synchronization *SyncConfig `json:"synchronization,omitempty"`
+
+	// LogSink streams the logs of main containers to a sink while they run, so they can be read after pods are deleted
+	LogSink *LogSinkConfig `json:"logSink,omitempty"`
+
+	// SharedArtifactCache lets steps read the output artifacts of previous steps from a volume rather than download them
+	SharedArtifactCache *SharedArtifactCacheConfig `json:"sharedArtifactCache,omitempty"`
+
+	// ArtifactTransfer configures the parallelism and part size of artifact transfers
+	ArtifactTransfer *ArtifactTransferConfig `json:"artifactTransfer,omitempty"`
+
 	// Links to related apps.
 	Links []*wfv1.Link `json:"links,omitempty"`
 
@@ -164,6 +176,13 @@ type PersistConfig struct {
 	PostgreSQL     *PostgreSQLConfig `json:"postgresql,omitempty"`
 	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
 	SkipMigration  bool              `json:"skipMigration,omitempty"`
+	// ObjectStorage stores persisted data as objects in the artifact repository, rather than in a database
+	ObjectStorage *ObjectStorageConfig `json:"objectStorage,omitempty"`
+}
+
+// HasDatabase returns whether a PostgreSQL or MySQL database is configured
+func (c PersistConfig) HasDatabase() bool {
+	return c.PostgreSQL != nil || c.MySQL != nil
 }
 
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
@@ -180,6 +199,19 @@ func (c PersistConfig) GetClusterName() string {
 	return "default"
 }
 
+// ObjectStorageConfig configures how persisted data is stored in the artifact repository
+type ObjectStorageConfig struct {
+	// KeyPrefix is the prefix of the keys of the objects, defaults to "argo-persistence"
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+func (c *ObjectStorageConfig) GetKeyPrefix() string {
+	if c == nil || c.KeyPrefix == "" {
+		return "argo-persistence"
+	}
+	return c.KeyPrefix
+}
+
 type ConnectionPool struct {
 	MaxIdleConns    int `json:"maxIdleConns,omitempty"`
 	MaxOpenConns    int `json:"maxOpenConns,omitempty"`
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogSinkConfig configures where the wait container streams the logs of the main containers to while they run, so
+// that they can be read after their pods are deleted. Exactly one sink must be configured.
+type LogSinkConfig struct {
+	// HTTP pushes logs to Loki or Elasticsearch
+	HTTP *HTTPLogSinkConfig `json:"http,omitempty"`
+	// File writes logs to files in a volume, which must also be mounted on the Argo Server to read them
+	File *FileLogSinkConfig `json:"file,omitempty"`
+	// FlushInterval is how often logs are written to the sink, default 5s
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+}
+
+func (c LogSinkConfig) GetFlushInterval() time.Duration {
+	if c.FlushInterval == nil {
+		return 5 * time.Second
+	}
+	return c.FlushInterval.Duration
+}
+
+type HTTPLogSinkFormat string
+
+const (
+	HTTPLogSinkLoki          HTTPLogSinkFormat = "loki"
+	HTTPLogSinkElasticsearch HTTPLogSinkFormat = "elasticsearch"
+)
+
+type HTTPLogSinkConfig struct {
+	// Format is the API of the endpoint, "loki" or "elasticsearch"
+	Format HTTPLogSinkFormat `json:"format"`
+	// URL is the base URL of the endpoint, e.g. "http://loki:3100" or "http://elasticsearch:9200"
+	URL string `json:"url"`
+	// Index is the Elasticsearch index logs are written to, default "argo-workflows-logs"
+	Index string `json:"index,omitempty"`
+	// AuthorizationSecret is the secret that contains the value of the Authorization header, e.g. "Bearer <token>".
+	// The wait container gets it from the workflow's namespace, and the Argo Server from its own namespace.
+	AuthorizationSecret *apiv1.SecretKeySelector `json:"authorizationSecret,omitempty"`
+}
+
+func (c HTTPLogSinkConfig) GetIndex() string {
+	if c.Index == "" {
+		return "argo-workflows-logs"
+	}
+	return c.Index
+}
+
+type FileLogSinkConfig struct {
+	// Path of the directory logs are written to, as "<path>/<namespace>/<workflow>/<pod>.<container>.log"
+	Path string `json:"path"`
+	// Volume is added to pods and mounted at the path on the wait container, e.g. a persistent volume claim that
+	// can be written to by many pods
+	Volume *apiv1.Volume `json:"volume,omitempty"`
+	// MaxSizeBytes is the size a file is rotated at, default 10MiB
+	MaxSizeBytes int64 `json:"maxSizeBytes,omitempty"`
+	// MaxFiles is the number of rotated files kept for each container, default 5
+	MaxFiles int `json:"maxFiles,omitempty"`
+}
+
+func (c FileLogSinkConfig) GetMaxSizeBytes() int64 {
+	if c.MaxSizeBytes <= 0 {
+		return 10 * 1024 * 1024
+	}
+	return c.MaxSizeBytes
+}
+
+func (c FileLogSinkConfig) GetMaxFiles() int {
+	if c.MaxFiles <= 0 {
+		return 5
+	}
+	return c.MaxFiles
+}
@@ -0,0 +1,15 @@
+package config
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// SharedArtifactCacheConfig configures a volume that output artifacts are copied to after they are saved, so that the
+// steps that take them as inputs can read them from the volume rather than download them from the artifact repository.
+// Artifacts are keyed by their namespace and key. When a step cannot find an artifact in the volume, e.g. because the
+// volume is a host path and the steps ran on different nodes, it downloads the artifact from the repository.
+type SharedArtifactCacheConfig struct {
+	// Volume is added to pods and mounted on the init and wait containers, e.g. a host path for a cache on each node, or a
+	// persistent volume claim with the ReadWriteMany access mode for a cache shared by all nodes
+	Volume apiv1.Volume `json:"volume"`
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncConfig configures semaphores and mutexes. By default, their state is kept in the controller's memory and rebuilt
+// from the status of workflows when the controller starts.
+type SyncConfig struct {
+	// Redis keeps the state of semaphores and mutexes in Redis, so they are shared by all the controllers that use it
+	Redis *RedisConfig `json:"redis,omitempty"`
+	// LimitEndpoints are the URLs of the HTTP endpoints that return semaphore limits, by name. Workflows refer to
+	// them by name, so the controller only requests the URLs declared here.
+	LimitEndpoints map[string]string `json:"limitEndpoints,omitempty"`
+}
+
+// GetLimitEndpoint returns the URL of the limit endpoint with the given name, if it is declared
+func (c *SyncConfig) GetLimitEndpoint(name string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	url, ok := c.LimitEndpoints[name]
+	return url, ok
+}
+
+type RedisConfig struct {
+	// Address of the Redis server, e.g. "redis:6379"
+	Address string `json:"address"`
+	// DB is the Redis database to use, default 0
+	DB int `json:"db,omitempty"`
+	// PasswordSecret is the secret that contains the password, it must be in the same namespace as the controller
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+	// TLS connects to Redis using TLS
+	TLS bool `json:"tls,omitempty"`
+	// KeyPrefix is the prefix of the keys locks are stored in, default "argo-workflows:sync"
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+	// ClusterName of the cluster the controller runs in. It must be unique for each controller that shares the
+	// Redis, as it distinguishes the lock holders of this controller from those of the others. Default "default".
+	ClusterName string `json:"clusterName,omitempty"`
+	// LeaseDuration is how long a lock is held, or a place in the queue for a lock kept, after the controller stops
+	// renewing it, e.g. because it crashed, default 1m
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+}
+
+func (c RedisConfig) GetKeyPrefix() string {
+	if c.KeyPrefix != "" {
+		return c.KeyPrefix
+	}
+	return "argo-workflows:sync"
+}
+
+func (c RedisConfig) GetClusterName() string {
+	if c.ClusterName != "" {
+		return c.ClusterName
+	}
+	return "default"
+}
+
+func (c RedisConfig) GetLeaseDuration() time.Duration {
+	if c.LeaseDuration == nil {
+		return time.Minute
+	}
+	return c.LeaseDuration.Duration
+}
@@ -0,0 +1,225 @@
+package objectstorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+)
+
+// offloadedNodesPrefix is the prefix of offloaded nodes, which are stored as
+// "<prefix>/<uid>/<namespace>/<unix time saved at>-<version>.json.gz"
+const offloadedNodesPrefix = "offloaded-nodes"
+
+// NewOffloadNodeStatusRepo returns a repo that offloads node statuses to the artifact repository. The kube client and
+// namespace are used to get the artifact repository's secrets.
+func NewOffloadNodeStatusRepo(kubeClient kubernetes.Interface, namespace string, location *wfv1.ArtifactLocation, clusterName string, cfg *config.ObjectStorageConfig) (sqldb.OffloadNodeStatusRepo, error) {
+	s, err := newStore(kubeClient, namespace, location, clusterName, cfg)
+	if err != nil {
+		return nil, err
+	}
+	// the same environment variable as the SQL repo, so that offloads are deleted as aggressively
+	ttl := env.LookupEnvDurationOr("OFFLOAD_NODE_STATUS_TTL", 5*time.Minute)
+	log.WithFields(log.Fields{"ttl": ttl, "keyPrefix": s.keyPrefix}).Info("Node status offloading to object storage config")
+	return &offloadNodeStatusRepo{store: s, ttl: ttl, now: time.Now}, nil
+}
+
+type offloadNodeStatusRepo struct {
+	store *store
+	// time to live - at what ttl an offload becomes old
+	ttl time.Duration
+	now func() time.Time
+}
+
+type offloadKey struct {
+	sqldb.UUIDVersion
+	Namespace string
+	SavedAt   time.Time
+}
+
+func (k offloadKey) String() string {
+	return path.Join(offloadedNodesPrefix, k.UID, k.Namespace, fmt.Sprintf("%d-%s.json.gz", k.SavedAt.Unix(), k.Version))
+}
+
+func parseOffloadKey(key string) (offloadKey, error) {
+	parts := strings.Split(strings.TrimPrefix(key, offloadedNodesPrefix+"/"), "/")
+	if len(parts) != 3 || !strings.HasSuffix(parts[2], ".json.gz") {
+		return offloadKey{}, fmt.Errorf("invalid offloaded nodes key %q", key)
+	}
+	savedAt, version, ok := strings.Cut(strings.TrimSuffix(parts[2], ".json.gz"), "-")
+	if !ok {
+		return offloadKey{}, fmt.Errorf("invalid offloaded nodes key %q", key)
+	}
+	unix, err := strconv.ParseInt(savedAt, 10, 64)
+	if err != nil {
+		return offloadKey{}, fmt.Errorf("invalid offloaded nodes key %q: %w", key, err)
+	}
+	return offloadKey{
+		UUIDVersion: sqldb.UUIDVersion{UID: parts[0], Version: version},
+		Namespace:   parts[1],
+		SavedAt:     time.Unix(unix, 0),
+	}, nil
+}
+
+// listKeys lists the keys of offloaded nodes, of a workflow if the uid is not empty
+func (r *offloadNodeStatusRepo) listKeys(uid string) ([]offloadKey, error) {
+	objects, err := r.store.list(path.Join(offloadedNodesPrefix, uid))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list offloaded nodes: %w", err)
+	}
+	var keys []offloadKey
+	for _, object := range objects {
+		key, err := parseOffloadKey(object)
+		if err != nil {
+			log.WithError(err).Warn("Ignoring object that is not offloaded nodes")
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *offloadNodeStatusRepo) isOld(key offloadKey) bool {
+	return key.SavedAt.Before(r.now().Add(-r.ttl))
+}
+
+func (r *offloadNodeStatusRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *offloadNodeStatusRepo) Save(uid, namespace string, nodes wfv1.Nodes) (string, error) {
+	marshalled, version, err := sqldb.NodeStatusVersion(nodes)
+	if err != nil {
+		return "", err
+	}
+	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
+	keys, err := r.listKeys(uid)
+	if err != nil {
+		return "", err
+	}
+	saved := false
+	for _, key := range keys {
+		if key.Version == version {
+			// the same nodes were already saved, so the time they were first saved is kept, as it is in the SQL repo
+			saved = true
+		}
+	}
+	if saved {
+		logCtx.Info("Ignoring nodes that are already offloaded")
+	} else {
+		logCtx.Debug("Offloading nodes")
+		key := offloadKey{UUIDVersion: sqldb.UUIDVersion{UID: uid, Version: version}, Namespace: namespace, SavedAt: r.now()}
+		if err := r.store.put(key.String(), []byte(marshalled)); err != nil {
+			return "", fmt.Errorf("failed to offload nodes: %w", err)
+		}
+	}
+
+	logCtx.Debug("Nodes offloaded, cleaning up old offloads")
+	// we keep offloads that are not old, so that we can service watches
+	deleted := 0
+	for _, key := range keys {
+		if key.Version != version && r.isOld(key) {
+			if err := r.store.delete(key.String()); err != nil {
+				return "", err
+			}
+			deleted++
+		}
+	}
+	logCtx.WithField("deleted", deleted).Debug("Deleted offloaded nodes")
+	return version, nil
+}
+
+func (r *offloadNodeStatusRepo) Get(uid, version string) (wfv1.Nodes, error) {
+	log.WithFields(log.Fields{"uid": uid, "version": version}).Debug("Getting offloaded nodes")
+	keys, err := r.listKeys(uid)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Version == version {
+			return r.load(key)
+		}
+	}
+	return nil, fmt.Errorf("offloaded nodes of workflow %s version %s not found", uid, version)
+}
+
+func (r *offloadNodeStatusRepo) load(key offloadKey) (wfv1.Nodes, error) {
+	data, err := r.store.get(key.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get offloaded nodes: %w", err)
+	}
+	nodes := wfv1.Nodes{}
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+func (r *offloadNodeStatusRepo) List(namespace string) (map[sqldb.UUIDVersion]wfv1.Nodes, error) {
+	log.WithFields(log.Fields{"namespace": namespace}).Debug("Listing offloaded nodes")
+	keys, err := r.listKeys("")
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[sqldb.UUIDVersion]wfv1.Nodes)
+	for _, key := range keys {
+		if namespace != "" && key.Namespace != namespace {
+			continue
+		}
+		nodes, err := r.load(key)
+		if err != nil {
+			return nil, err
+		}
+		res[key.UUIDVersion] = nodes
+	}
+	return res, nil
+}
+
+func (r *offloadNodeStatusRepo) ListOldOffloads(namespace string) (map[string][]string, error) {
+	log.WithFields(log.Fields{"namespace": namespace}).Debug("Listing old offloaded nodes")
+	keys, err := r.listKeys("")
+	if err != nil {
+		return nil, err
+	}
+	x := make(map[string][]string)
+	for _, key := range keys {
+		if (namespace == "" || key.Namespace == namespace) && r.isOld(key) {
+			x[key.UID] = append(x[key.UID], key.Version)
+		}
+	}
+	return x, nil
+}
+
+func (r *offloadNodeStatusRepo) Delete(uid, version string) error {
+	if uid == "" {
+		return fmt.Errorf("invalid uid")
+	}
+	if version == "" {
+		return fmt.Errorf("invalid version")
+	}
+	logCtx := log.WithFields(log.Fields{"uid": uid, "version": version})
+	logCtx.Debug("Deleting offloaded nodes")
+	keys, err := r.listKeys(uid)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Version == version {
+			if err := r.store.delete(key.String()); err != nil {
+				return err
+			}
+		}
+	}
+	logCtx.Debug("Deleted offloaded nodes")
+	return nil
+}
@@ -0,0 +1,150 @@
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// fakeDriver stores artifacts in memory, keyed by their key
+type fakeDriver struct {
+	common.ArtifactDriver
+	objects map[string][]byte
+}
+
+func (d *fakeDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	key, _ := a.GetKey()
+	data, ok := d.objects[key]
+	if !ok {
+		return nil, errors.New(errors.CodeNotFound, "no such key: "+key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (d *fakeDriver) Save(path string, a *wfv1.Artifact) error {
+	key, _ := a.GetKey()
+	data, err := os.ReadFile(path)
+	d.objects[key] = data
+	return err
+}
+
+func (d *fakeDriver) Delete(a *wfv1.Artifact) error {
+	key, _ := a.GetKey()
+	delete(d.objects, key)
+	return nil
+}
+
+func (d *fakeDriver) ListObjects(a *wfv1.Artifact) ([]string, error) {
+	prefix, _ := a.GetKey()
+	var keys []string
+	for key := range d.objects {
+		if strings.HasPrefix(key, prefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func newFakeStore(t *testing.T) (*fakeDriver, *store) {
+	driver := &fakeDriver{objects: map[string][]byte{}}
+	location := &wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}}
+	s, err := newStore(nil, "argo", location, "my-cluster", &config.ObjectStorageConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.newDriver = func(context.Context, *wfv1.Artifact, resource.Interface) (common.ArtifactDriver, error) {
+		return driver, nil
+	}
+	return driver, s
+}
+
+func TestOffloadNodeStatusRepo(t *testing.T) {
+	driver, s := newFakeStore(t)
+	now := time.Unix(1700000000, 0)
+	repo := &offloadNodeStatusRepo{store: s, ttl: 5 * time.Minute, now: func() time.Time { return now }}
+	nodes := wfv1.Nodes{"my-node": wfv1.NodeStatus{Phase: wfv1.NodeRunning}}
+
+	version, err := repo.Save("my-uid", "my-ns", nodes)
+	if assert.NoError(t, err) {
+		assert.Contains(t, driver.objects, "argo-persistence/my-cluster/offloaded-nodes/my-uid/my-ns/1700000000-"+version+".json.gz")
+	}
+	got, err := repo.Get("my-uid", version)
+	if assert.NoError(t, err) {
+		assert.Equal(t, nodes, got)
+	}
+	_, err = repo.Get("my-uid", "fnv:1")
+	assert.Error(t, err)
+
+	// saving the same nodes again keeps the first offload
+	now = now.Add(time.Minute)
+	again, err := repo.Save("my-uid", "my-ns", nodes)
+	if assert.NoError(t, err) {
+		assert.Equal(t, version, again)
+		assert.Len(t, driver.objects, 1)
+	}
+
+	_, err = repo.Save("other-uid", "other-ns", wfv1.Nodes{})
+	assert.NoError(t, err)
+	listed, err := repo.List("my-ns")
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[sqldb.UUIDVersion]wfv1.Nodes{{UID: "my-uid", Version: version}: nodes}, listed)
+	}
+	listed, err = repo.List("")
+	if assert.NoError(t, err) {
+		assert.Len(t, listed, 2)
+	}
+
+	old, err := repo.ListOldOffloads("my-ns")
+	if assert.NoError(t, err) {
+		assert.Empty(t, old)
+	}
+	now = now.Add(5 * time.Minute)
+	old, err = repo.ListOldOffloads("my-ns")
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[string][]string{"my-uid": {version}}, old)
+	}
+
+	// saving new nodes deletes the old offloads of the workflow
+	nodes["my-node"] = wfv1.NodeStatus{Phase: wfv1.NodeSucceeded}
+	newVersion, err := repo.Save("my-uid", "my-ns", nodes)
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, version, newVersion)
+		_, err = repo.Get("my-uid", version)
+		assert.Error(t, err)
+	}
+
+	assert.NoError(t, repo.Delete("my-uid", newVersion))
+	listed, err = repo.List("my-ns")
+	if assert.NoError(t, err) {
+		assert.Empty(t, listed)
+	}
+	assert.Error(t, repo.Delete("", newVersion))
+}
+
+func Test_parseOffloadKey(t *testing.T) {
+	key, err := parseOffloadKey("offloaded-nodes/my-uid/my-ns/1700000000-fnv:123.json.gz")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-uid", key.UID)
+		assert.Equal(t, "my-ns", key.Namespace)
+		assert.Equal(t, "fnv:123", key.Version)
+		assert.Equal(t, int64(1700000000), key.SavedAt.Unix())
+		assert.Equal(t, "offloaded-nodes/my-uid/my-ns/1700000000-fnv:123.json.gz", key.String())
+	}
+	for _, invalid := range []string{"offloaded-nodes/my-uid/fnv:123.json.gz", "offloaded-nodes/my-uid/my-ns/fnv:123.json.gz", "offloaded-nodes/my-uid/my-ns/1700000000-fnv:123.json"} {
+		_, err := parseOffloadKey(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
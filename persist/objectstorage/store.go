@@ -0,0 +1,126 @@
+package objectstorage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// store reads and writes gzipped objects, whose keys are relative to "<key prefix>/<cluster name>" in the
+// artifact repository
+type store struct {
+	location  *wfv1.ArtifactLocation
+	newDriver artifact.NewDriverFunc
+	resources resource.Interface
+	keyPrefix string
+}
+
+func newStore(kubeClient kubernetes.Interface, namespace string, location *wfv1.ArtifactLocation, clusterName string, cfg *config.ObjectStorageConfig) (*store, error) {
+	if location == nil {
+		return nil, fmt.Errorf("an artifact repository must be configured to persist to object storage")
+	}
+	return &store{
+		location:  location,
+		newDriver: artifact.NewDriver,
+		resources: resources{kubeClient, namespace},
+		keyPrefix: path.Join(cfg.GetKeyPrefix(), clusterName),
+	}, nil
+}
+
+func (s *store) driver(key string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	a := &wfv1.Artifact{ArtifactLocation: *s.location.DeepCopy()}
+	if err := a.SetKey(path.Join(s.keyPrefix, key)); err != nil {
+		return nil, nil, err
+	}
+	driver, err := s.newDriver(context.Background(), a, s.resources)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create artifact driver: %w", err)
+	}
+	return a, driver, nil
+}
+
+// get returns the uncompressed data of the object, or an error with code errors.CodeNotFound if it does not exist
+func (s *store) get(key string) ([]byte, error) {
+	a, driver, err := s.driver(key)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := driver.OpenStream(a)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stream.Close() }()
+	r, err := gzip.NewReader(stream)
+	if err != nil {
+		return nil, fmt.Errorf("object %s is not gzipped: %w", key, err)
+	}
+	return io.ReadAll(r)
+}
+
+func (s *store) put(key string, data []byte) error {
+	a, driver, err := s.driver(key)
+	if err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", "object-storage")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	file := filepath.Join(dir, path.Base(key))
+	if err := os.WriteFile(file, compressed.Bytes(), 0o600); err != nil {
+		return err
+	}
+	return driver.Save(file, a)
+}
+
+func (s *store) delete(key string) error {
+	a, driver, err := s.driver(key)
+	if err != nil {
+		return err
+	}
+	if err := driver.Delete(a); err != nil && !errors.IsCode(errors.CodeNotFound, err) {
+		return err
+	}
+	return nil
+}
+
+// list returns the keys of the objects under the prefix
+func (s *store) list(prefix string) ([]string, error) {
+	a, driver, err := s.driver(prefix)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := driver.ListObjects(a)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(objects))
+	for _, object := range objects {
+		keys = append(keys, strings.TrimPrefix(object, s.keyPrefix+"/"))
+	}
+	return keys, nil
+}
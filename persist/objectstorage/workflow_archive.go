@@ -0,0 +1,387 @@
+package objectstorage
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/errors"
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+)
+
+const (
+	// archivedWorkflowsPrefix is the prefix of archived workflows, which are stored as "<prefix>/<uid>.json.gz"
+	archivedWorkflowsPrefix = "archived-workflows"
+	// archivedWorkflowsIndexPrefix is the prefix of the index of archived workflows, which has an entry for each
+	// workflow stored as "<prefix>/<uid>/<unix nano time archived at>.json.gz", so that workflows can be listed
+	// without getting them
+	archivedWorkflowsIndexPrefix = "archived-workflows-index"
+	// indexEntryGetParallelism is the number of index entries that are got at the same time
+	indexEntryGetParallelism = 16
+)
+
+// archivedWorkflowIndexEntry is what workflows are listed and searched by
+type archivedWorkflowIndexEntry struct {
+	InstanceID string              `json:"instanceID,omitempty"`
+	UID        string              `json:"uid"`
+	Name       string              `json:"name"`
+	Namespace  string              `json:"namespace"`
+	Phase      wfv1.WorkflowPhase  `json:"phase"`
+	StartedAt  time.Time           `json:"startedAt"`
+	FinishedAt time.Time           `json:"finishedAt"`
+	Labels     map[string]string   `json:"labels,omitempty"`
+	Fields     map[string][]string `json:"fields,omitempty"`
+}
+
+type workflowArchive struct {
+	store             *store
+	managedNamespace  string
+	instanceIDService instanceid.Service
+	now               func() time.Time
+	// index caches the entries of the index by key, as the entries of a key never change
+	index map[string]*archivedWorkflowIndexEntry
+	lock  sync.Mutex
+}
+
+// NewWorkflowArchive returns an archive that stores workflows in the artifact repository. The kube client and
+// namespace are used to get the artifact repository's secrets.
+func NewWorkflowArchive(kubeClient kubernetes.Interface, namespace string, location *wfv1.ArtifactLocation, clusterName, managedNamespace string, instanceIDService instanceid.Service, cfg *config.ObjectStorageConfig) (sqldb.WorkflowArchive, error) {
+	s, err := newStore(kubeClient, namespace, location, clusterName, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &workflowArchive{
+		store:             s,
+		managedNamespace:  managedNamespace,
+		instanceIDService: instanceIDService,
+		now:               time.Now,
+		index:             make(map[string]*archivedWorkflowIndexEntry),
+	}, nil
+}
+
+func (r *workflowArchive) IsEnabled() bool {
+	return true
+}
+
+func workflowKey(uid string) string {
+	return path.Join(archivedWorkflowsPrefix, uid+".json.gz")
+}
+
+func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
+	logCtx := log.WithFields(log.Fields{"uid": wf.UID, "labels": wf.GetLabels()})
+	logCtx.Debug("Archiving workflow")
+	uid := string(wf.UID)
+	workflow, err := json.Marshal(wf)
+	if err != nil {
+		return err
+	}
+	entry, err := json.Marshal(&archivedWorkflowIndexEntry{
+		InstanceID: r.instanceIDService.InstanceID(),
+		UID:        uid,
+		Name:       wf.Name,
+		Namespace:  wf.Namespace,
+		Phase:      wf.Status.Phase,
+		StartedAt:  wf.Status.StartedAt.Time,
+		FinishedAt: wf.Status.FinishedAt.Time,
+		Labels:     wf.GetLabels(),
+		Fields:     sqldb.ArchivedWorkflowFields(wf),
+	})
+	if err != nil {
+		return err
+	}
+	oldKeys, err := r.store.list(path.Join(archivedWorkflowsIndexPrefix, uid))
+	if err != nil {
+		return fmt.Errorf("failed to list the index of archived workflow: %w", err)
+	}
+	// the workflow is saved before its index entry, so that every entry has a workflow
+	if err := r.store.put(workflowKey(uid), workflow); err != nil {
+		return fmt.Errorf("failed to archive workflow: %w", err)
+	}
+	key := path.Join(archivedWorkflowsIndexPrefix, uid, fmt.Sprintf("%d.json.gz", r.now().UnixNano()))
+	if err := r.store.put(key, entry); err != nil {
+		return fmt.Errorf("failed to index archived workflow: %w", err)
+	}
+	for _, oldKey := range oldKeys {
+		if oldKey != key {
+			if err := r.store.delete(oldKey); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// entries returns the latest index entry of each workflow in the managed namespace and instance
+func (r *workflowArchive) entries() ([]*archivedWorkflowIndexEntry, error) {
+	return r.listEntries(archivedWorkflowsIndexPrefix, true)
+}
+
+// entry returns the latest index entry of the workflow, or nil if it is not archived in the managed namespace and
+// instance
+func (r *workflowArchive) entry(uid string) (*archivedWorkflowIndexEntry, error) {
+	entries, err := r.listEntries(path.Join(archivedWorkflowsIndexPrefix, uid), false)
+	if err != nil || len(entries) == 0 {
+		return nil, err
+	}
+	return entries[0], nil
+}
+
+// listEntries returns the latest index entries under the prefix, getting the entries that are not cached. If all,
+// entries that are no longer listed are forgotten.
+func (r *workflowArchive) listEntries(prefix string, all bool) ([]*archivedWorkflowIndexEntry, error) {
+	keys, err := r.store.list(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the index of archived workflows: %w", err)
+	}
+	latest := make(map[string]string)
+	for _, key := range keys {
+		uid, archivedAt, ok := parseIndexKey(key)
+		if !ok {
+			log.WithField("key", key).Warn("Ignoring object that is not an archived workflow index entry")
+			continue
+		}
+		if other, ok := latest[uid]; ok {
+			if _, otherArchivedAt, _ := parseIndexKey(other); otherArchivedAt > archivedAt {
+				continue
+			}
+		}
+		latest[uid] = key
+	}
+	index := make(map[string]*archivedWorkflowIndexEntry, len(latest))
+	var missing []string
+	r.lock.Lock()
+	for _, key := range latest {
+		if entry, ok := r.index[key]; ok {
+			index[key] = entry
+		} else {
+			missing = append(missing, key)
+		}
+	}
+	r.lock.Unlock()
+	// the entries are got without holding the lock, so that a slow store does not block other callers
+	fetched := make([]*archivedWorkflowIndexEntry, len(missing))
+	g := errgroup.Group{}
+	g.SetLimit(indexEntryGetParallelism)
+	for i, key := range missing {
+		i, key := i, key
+		g.Go(func() error {
+			data, err := r.store.get(key)
+			if errors.IsCode(errors.CodeNotFound, err) {
+				// the workflow was deleted since it was listed
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get archived workflow index entry %s: %w", key, err)
+			}
+			entry := &archivedWorkflowIndexEntry{}
+			if err := json.Unmarshal(data, entry); err != nil {
+				return fmt.Errorf("malformed archived workflow index entry %s: %w", key, err)
+			}
+			fetched[i] = entry
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	for i, key := range missing {
+		if fetched[i] != nil {
+			index[key] = fetched[i]
+		}
+	}
+	entries := make([]*archivedWorkflowIndexEntry, 0, len(index))
+	for _, entry := range index {
+		if r.isManaged(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if all {
+		r.index = index
+	} else {
+		for key, entry := range index {
+			r.index[key] = entry
+		}
+	}
+	return entries, nil
+}
+
+func parseIndexKey(key string) (string, int64, bool) {
+	parts := strings.Split(strings.TrimPrefix(key, archivedWorkflowsIndexPrefix+"/"), "/")
+	if len(parts) != 2 || !strings.HasSuffix(parts[1], ".json.gz") {
+		return "", 0, false
+	}
+	archivedAt, err := strconv.ParseInt(strings.TrimSuffix(parts[1], ".json.gz"), 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return parts[0], archivedAt, true
+}
+
+func (r *workflowArchive) isManaged(entry *archivedWorkflowIndexEntry) bool {
+	return (r.managedNamespace == "" || entry.Namespace == r.managedNamespace) && entry.InstanceID == r.instanceIDService.InstanceID()
+}
+
+func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, query sqldb.Query, limit int, offset int) (wfv1.Workflows, error) {
+	entries, err := r.entries()
+	if err != nil {
+		return nil, err
+	}
+	selector := labels.NewSelector().Add(labelRequirements...)
+	var matches []*archivedWorkflowIndexEntry
+	for _, entry := range entries {
+		if (namespace == "" || entry.Namespace == namespace) &&
+			(name == "" || entry.Name == name) &&
+			strings.HasPrefix(entry.Name, namePrefix) &&
+			(minStartedAt.IsZero() || entry.StartedAt.After(minStartedAt)) &&
+			(maxStartedAt.IsZero() || entry.StartedAt.Before(maxStartedAt)) &&
+			selector.Matches(labels.Set(entry.Labels)) &&
+			query.Matches(entry.Name, entry.Phase, entry.Fields) {
+			matches = append(matches, entry)
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].StartedAt.After(matches[j].StartedAt)
+	})
+	if offset > len(matches) {
+		offset = len(matches)
+	}
+	matches = matches[offset:]
+	// a limit of 0 lists all the workflows, to match the behavior of the `List` operations in the Kubernetes API
+	if limit > 0 && limit < len(matches) {
+		matches = matches[:limit]
+	}
+	wfs := make(wfv1.Workflows, len(matches))
+	for i, entry := range matches {
+		wfs[i] = wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              entry.Name,
+				Namespace:         entry.Namespace,
+				UID:               types.UID(entry.UID),
+				CreationTimestamp: metav1.Time{Time: entry.StartedAt},
+			},
+			Status: wfv1.WorkflowStatus{
+				Phase:      entry.Phase,
+				StartedAt:  metav1.Time{Time: entry.StartedAt},
+				FinishedAt: metav1.Time{Time: entry.FinishedAt},
+			},
+		}
+	}
+	return wfs, nil
+}
+
+func (r *workflowArchive) GetWorkflow(uid string) (*wfv1.Workflow, error) {
+	entry, err := r.entry(uid)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	data, err := r.store.get(workflowKey(uid))
+	if errors.IsCode(errors.CodeNotFound, err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived workflow: %w", err)
+	}
+	var wf *wfv1.Workflow
+	if err := json.Unmarshal(data, &wf); err != nil {
+		return nil, err
+	}
+	return wf, nil
+}
+
+func (r *workflowArchive) DeleteWorkflow(uid string) error {
+	entry, err := r.entry(uid)
+	if err != nil || entry == nil {
+		return err
+	}
+	if err := r.deleteWorkflow(uid); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"uid": uid}).Debug("Deleted archived workflow")
+	return nil
+}
+
+// deleteWorkflow deletes the index entries before the workflow, so that every entry has a workflow
+func (r *workflowArchive) deleteWorkflow(uid string) error {
+	keys, err := r.store.list(path.Join(archivedWorkflowsIndexPrefix, uid))
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := r.store.delete(key); err != nil {
+			return err
+		}
+	}
+	return r.store.delete(workflowKey(uid))
+}
+
+func (r *workflowArchive) DeleteExpiredWorkflows(ttl time.Duration) error {
+	entries, err := r.entries()
+	if err != nil {
+		return err
+	}
+	deleted := 0
+	for _, entry := range entries {
+		if entry.FinishedAt.Before(r.now().Add(-ttl)) {
+			if err := r.deleteWorkflow(entry.UID); err != nil {
+				return err
+			}
+			deleted++
+		}
+	}
+	log.WithFields(log.Fields{"deleted": deleted}).Info("Deleted archived workflows")
+	return nil
+}
+
+func (r *workflowArchive) ListWorkflowsLabelKeys() (*wfv1.LabelKeys, error) {
+	entries, err := r.entries()
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool)
+	for _, entry := range entries {
+		for key := range entry.Labels {
+			keys[key] = true
+		}
+	}
+	return &wfv1.LabelKeys{Items: sortedKeys(keys)}, nil
+}
+
+func (r *workflowArchive) ListWorkflowsLabelValues(key string) (*wfv1.LabelValues, error) {
+	entries, err := r.entries()
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]bool)
+	for _, entry := range entries {
+		if value, ok := entry.Labels[key]; ok {
+			values[value] = true
+		}
+	}
+	return &wfv1.LabelValues{Items: sortedKeys(values)}, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
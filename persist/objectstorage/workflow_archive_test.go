@@ -0,0 +1,134 @@
+package objectstorage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+)
+
+func newArchivedWorkflow(uid, namespace, name string, phase wfv1.WorkflowPhase, startedAt time.Time, workflowLabels map[string]string) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{UID: types.UID(uid), Namespace: namespace, Name: name, Labels: workflowLabels},
+		Status: wfv1.WorkflowStatus{
+			Phase:      phase,
+			StartedAt:  metav1.Time{Time: startedAt},
+			FinishedAt: metav1.Time{Time: startedAt.Add(time.Minute)},
+		},
+	}
+}
+
+func TestWorkflowArchive(t *testing.T) {
+	driver, s := newFakeStore(t)
+	now := time.Unix(1700000000, 0)
+	newArchive := func(managedNamespace string) *workflowArchive {
+		return &workflowArchive{
+			store:             s,
+			managedNamespace:  managedNamespace,
+			instanceIDService: instanceid.NewService(""),
+			now:               func() time.Time { return now },
+			index:             make(map[string]*archivedWorkflowIndexEntry),
+		}
+	}
+	archive := newArchive("")
+	for _, wf := range []*wfv1.Workflow{
+		newArchivedWorkflow("uid-1", "ns-1", "wf-1", wfv1.WorkflowSucceeded, now.Add(-3*time.Hour), map[string]string{"team": "a"}),
+		newArchivedWorkflow("uid-2", "ns-1", "wf-2", wfv1.WorkflowFailed, now.Add(-2*time.Hour), map[string]string{"team": "b"}),
+		newArchivedWorkflow("uid-3", "ns-2", "other-3", wfv1.WorkflowSucceeded, now.Add(-time.Hour), map[string]string{"env": "prod"}),
+	} {
+		assert.NoError(t, archive.ArchiveWorkflow(wf))
+	}
+	assert.Contains(t, driver.objects, "argo-persistence/my-cluster/archived-workflows/uid-1.json.gz")
+
+	t.Run("ListWorkflows", func(t *testing.T) {
+		list := func(namespace, name, namePrefix string, requirements labels.Requirements, query string, limit, offset int) []string {
+			q, err := sqldb.ParseQuery(query)
+			assert.NoError(t, err)
+			wfs, err := archive.ListWorkflows(namespace, name, namePrefix, time.Time{}, time.Time{}, requirements, q, limit, offset)
+			assert.NoError(t, err)
+			var names []string
+			for _, wf := range wfs {
+				names = append(names, wf.Name)
+			}
+			return names
+		}
+		assert.Equal(t, []string{"other-3", "wf-2", "wf-1"}, list("", "", "", nil, "", 0, 0), "most recently started first")
+		assert.Equal(t, []string{"wf-2"}, list("", "", "", nil, "", 1, 1))
+		assert.Empty(t, list("", "", "", nil, "", 1, 5))
+		assert.Equal(t, []string{"wf-2", "wf-1"}, list("ns-1", "", "", nil, "", 0, 0))
+		assert.Equal(t, []string{"wf-1"}, list("", "wf-1", "", nil, "", 0, 0))
+		assert.Equal(t, []string{"other-3"}, list("", "", "other-", nil, "", 0, 0))
+		requirements, _ := labels.ParseToRequirements("team!=a")
+		assert.Equal(t, []string{"other-3", "wf-2"}, list("", "", "", requirements, "", 0, 0))
+		assert.Equal(t, []string{"wf-2"}, list("", "", "", nil, "phase=Failed", 0, 0))
+
+		wfs, err := archive.ListWorkflows("", "", "", now.Add(-150*time.Minute), now, nil, nil, 0, 0)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 2) {
+			assert.Equal(t, wfv1.WorkflowSucceeded, wfs[0].Status.Phase)
+			assert.True(t, now.Add(-time.Hour).Equal(wfs[0].Status.StartedAt.Time))
+		}
+		wfs, err = newArchive("ns-2").ListWorkflows("", "", "", time.Time{}, time.Time{}, nil, nil, 0, 0)
+		if assert.NoError(t, err) {
+			assert.Len(t, wfs, 1, "only the workflows of the managed namespace are listed")
+		}
+	})
+	t.Run("ListWorkflowsLabels", func(t *testing.T) {
+		keys, err := archive.ListWorkflowsLabelKeys()
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"env", "team"}, keys.Items)
+		}
+		values, err := archive.ListWorkflowsLabelValues("team")
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a", "b"}, values.Items)
+		}
+	})
+	t.Run("GetWorkflow", func(t *testing.T) {
+		wf, err := archive.GetWorkflow("uid-2")
+		if assert.NoError(t, err) && assert.NotNil(t, wf) {
+			assert.Equal(t, "wf-2", wf.Name)
+		}
+		wf, err = archive.GetWorkflow("missing")
+		assert.NoError(t, err)
+		assert.Nil(t, wf)
+		wf, err = newArchive("ns-2").GetWorkflow("uid-2")
+		assert.NoError(t, err)
+		assert.Nil(t, wf, "workflows of other namespaces are not got")
+	})
+	t.Run("ReArchive", func(t *testing.T) {
+		now = now.Add(time.Second)
+		wf := newArchivedWorkflow("uid-2", "ns-1", "wf-2", wfv1.WorkflowSucceeded, now.Add(-2*time.Hour), map[string]string{"team": "b"})
+		assert.NoError(t, archive.ArchiveWorkflow(wf))
+		wfs, err := archive.ListWorkflows("", "wf-2", "", time.Time{}, time.Time{}, nil, nil, 0, 0)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, wfv1.WorkflowSucceeded, wfs[0].Status.Phase)
+		}
+		keys, err := s.list(archivedWorkflowsIndexPrefix + "/uid-2")
+		if assert.NoError(t, err) {
+			assert.Len(t, keys, 1, "the old index entry is deleted")
+		}
+	})
+	t.Run("DeleteWorkflow", func(t *testing.T) {
+		assert.NoError(t, newArchive("ns-2").DeleteWorkflow("uid-1"))
+		assert.Contains(t, driver.objects, "argo-persistence/my-cluster/archived-workflows/uid-1.json.gz", "workflows of other namespaces are not deleted")
+		assert.NoError(t, archive.DeleteWorkflow("uid-1"))
+		assert.NotContains(t, driver.objects, "argo-persistence/my-cluster/archived-workflows/uid-1.json.gz")
+		wf, err := archive.GetWorkflow("uid-1")
+		assert.NoError(t, err)
+		assert.Nil(t, wf)
+	})
+	t.Run("DeleteExpiredWorkflows", func(t *testing.T) {
+		assert.NoError(t, archive.DeleteExpiredWorkflows(90*time.Minute))
+		wfs, err := archive.ListWorkflows("", "", "", time.Time{}, time.Time{}, nil, nil, 0, 0)
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "other-3", wfs[0].Name)
+		}
+		assert.Len(t, driver.objects, 2)
+	})
+}
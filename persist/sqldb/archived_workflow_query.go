@@ -0,0 +1,270 @@
+package sqldb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+const (
+	archiveFieldsTableName = archiveTableName + "_fields"
+	// field values are truncated, so that they can be indexed
+	maxArchivedWorkflowFieldValueLength = 255
+)
+
+// The fields archived workflows can be searched by, as well as "parameters.<name>"
+const (
+	QueryFieldPhase                   = "phase"
+	QueryFieldMessage                 = "message"
+	QueryFieldWorkflowTemplate        = "workflowTemplate"
+	QueryFieldClusterWorkflowTemplate = "clusterWorkflowTemplate"
+	QueryFieldCronWorkflow            = "cronWorkflow"
+	QueryFieldParametersPrefix        = "parameters."
+)
+
+type QueryOperator string
+
+const (
+	QueryEquals    QueryOperator = "="
+	QueryNotEquals QueryOperator = "!="
+	// QueryContains matches values that contain the value, ignoring case
+	QueryContains QueryOperator = "~"
+)
+
+// QueryTerm is a condition on a field of archived workflows. A term without a field matches workflows whose name or
+// any field contains the value.
+type QueryTerm struct {
+	Field    string
+	Operator QueryOperator
+	Value    string
+}
+
+// Query is a list of terms that archived workflows must all match
+type Query []QueryTerm
+
+type archivedWorkflowFieldRecord struct {
+	ClusterName string `db:"clustername"`
+	UID         string `db:"uid"`
+	Name        string `db:"name"`
+	Value       string `db:"value"`
+}
+
+// ParseQuery parses a query of terms separated by spaces, e.g. `phase=Failed message~"out of memory" parameters.env!=dev`.
+// A term is either "<field><operator><value>", where the operator is "=", "!=" or "~" (contains, ignoring case), or
+// just a value, which matches workflows whose name or any field contains it. Values with spaces are double-quoted.
+func ParseQuery(query string) (Query, error) {
+	var terms Query
+	s := query
+	for {
+		s = strings.TrimLeft(s, " \t\n")
+		if s == "" {
+			return terms, nil
+		}
+		term := QueryTerm{Operator: QueryContains}
+		if i := strings.IndexAny(s, "=!~ \t\n\""); i > 0 && strings.ContainsAny(s[i:i+1], "=!~") {
+			term.Field = s[:i]
+			if !isQueryField(term.Field) {
+				return nil, fmt.Errorf("invalid query %q: unknown field %q", query, term.Field)
+			}
+			s = s[i:]
+			switch {
+			case strings.HasPrefix(s, string(QueryNotEquals)):
+				term.Operator = QueryNotEquals
+			case strings.HasPrefix(s, string(QueryEquals)):
+				term.Operator = QueryEquals
+			case strings.HasPrefix(s, string(QueryContains)):
+				term.Operator = QueryContains
+			default:
+				return nil, fmt.Errorf("invalid query %q: expected one of =, != or ~ after %q", query, term.Field)
+			}
+			s = s[len(term.Operator):]
+		}
+		value, rest, err := readQueryValue(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query %q: %w", query, err)
+		}
+		if value == "" && term.Operator == QueryContains {
+			return nil, fmt.Errorf("invalid query %q: empty value", query)
+		}
+		term.Value = value
+		terms = append(terms, term)
+		s = rest
+	}
+}
+
+// readQueryValue reads a value, which is either double-quoted, or ends at the next space
+func readQueryValue(s string) (string, string, error) {
+	if !strings.HasPrefix(s, `"`) {
+		if i := strings.IndexAny(s, " \t\n"); i >= 0 {
+			return s[:i], s[i:], nil
+		}
+		return s, "", nil
+	}
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			value, err := strconv.Unquote(s[:i+1])
+			return value, s[i+1:], err
+		}
+	}
+	return "", "", fmt.Errorf("unterminated quoted value %s", s)
+}
+
+func isQueryField(field string) bool {
+	switch field {
+	case QueryFieldPhase, QueryFieldMessage, QueryFieldWorkflowTemplate, QueryFieldClusterWorkflowTemplate, QueryFieldCronWorkflow:
+		return true
+	}
+	return strings.HasPrefix(field, QueryFieldParametersPrefix) && len(field) > len(QueryFieldParametersPrefix)
+}
+
+func queryClause(query Query) db.Compound {
+	var conds []db.Compound
+	for _, term := range query {
+		conds = append(conds, termToCondition(term))
+	}
+	return db.And(conds...)
+}
+
+func termToCondition(term QueryTerm) db.Compound {
+	fieldExists := fmt.Sprintf("exists (select 1 from %s where clustername = %s.clustername and uid = %s.uid", archiveFieldsTableName, archiveTableName, archiveTableName)
+	switch {
+	case term.Field == "":
+		// the workflow's name is not a field, so is matched separately
+		return db.Raw(fmt.Sprintf("(lower(name) like ? or %s and lower(value) like ?))", fieldExists), likePattern(term.Value), likePattern(term.Value))
+	case term.Field == QueryFieldPhase:
+		// the phase is a column of the archive
+		switch term.Operator {
+		case QueryEquals:
+			return db.Cond{"phase": term.Value}
+		case QueryNotEquals:
+			return db.Cond{"phase !=": term.Value}
+		}
+		return db.Raw("lower(phase) like ?", likePattern(term.Value))
+	}
+	switch term.Operator {
+	case QueryEquals:
+		return db.Raw(fieldExists+" and name = ? and value = ?)", term.Field, truncateFieldValue(term.Value))
+	case QueryNotEquals:
+		return db.Raw("not "+fieldExists+" and name = ? and value = ?)", term.Field, truncateFieldValue(term.Value))
+	}
+	return db.Raw(fieldExists+" and name = ? and lower(value) like ?)", term.Field, likePattern(term.Value))
+}
+
+// Matches returns whether a workflow with the name, phase and fields matches all the terms of the query, in the same
+// way as it would in the database. It is used by archives that are not databases.
+func (q Query) Matches(name string, phase wfv1.WorkflowPhase, fields map[string][]string) bool {
+	for _, term := range q {
+		if !term.matches(name, phase, fields) {
+			return false
+		}
+	}
+	return true
+}
+
+func (term QueryTerm) matches(name string, phase wfv1.WorkflowPhase, fields map[string][]string) bool {
+	contains := func(value string) bool {
+		return strings.Contains(strings.ToLower(value), strings.ToLower(term.Value))
+	}
+	switch {
+	case term.Field == "":
+		if contains(name) {
+			return true
+		}
+		for _, values := range fields {
+			for _, value := range values {
+				if contains(value) {
+					return true
+				}
+			}
+		}
+		return false
+	case term.Field == QueryFieldPhase:
+		switch term.Operator {
+		case QueryEquals:
+			return string(phase) == term.Value
+		case QueryNotEquals:
+			return string(phase) != term.Value
+		}
+		return contains(string(phase))
+	}
+	for _, value := range fields[term.Field] {
+		switch term.Operator {
+		case QueryEquals, QueryNotEquals:
+			if value == truncateFieldValue(term.Value) {
+				return term.Operator == QueryEquals
+			}
+		default:
+			if contains(value) {
+				return true
+			}
+		}
+	}
+	return term.Operator == QueryNotEquals
+}
+
+// likePattern returns a pattern that matches lower-case values that contain the value. Backslash is the default escape
+// character of both PostgreSQL and MySQL, so no escape clause is needed.
+func likePattern(value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(strings.ToLower(value))
+	return "%" + value + "%"
+}
+
+// truncateFieldValue truncates the value to the maximum length, without splitting a character
+func truncateFieldValue(value string) string {
+	if len(value) <= maxArchivedWorkflowFieldValueLength {
+		return value
+	}
+	i := maxArchivedWorkflowFieldValueLength
+	for i > 0 && !utf8.RuneStart(value[i]) {
+		i--
+	}
+	return value[:i]
+}
+
+// ArchivedWorkflowFields returns the fields the workflow can be searched by
+func ArchivedWorkflowFields(wf *wfv1.Workflow) map[string][]string {
+	fields := make(map[string][]string)
+	add := func(name, value string) {
+		if value == "" {
+			return
+		}
+		value = truncateFieldValue(value)
+		for _, v := range fields[name] {
+			if v == value {
+				return
+			}
+		}
+		fields[name] = append(fields[name], value)
+	}
+	add(QueryFieldMessage, wf.Status.Message)
+	for _, node := range wf.Status.Nodes {
+		if node.FailedOrError() {
+			add(QueryFieldMessage, node.Message)
+		}
+	}
+	if ref := wf.Spec.WorkflowTemplateRef; ref != nil {
+		if ref.ClusterScope {
+			add(QueryFieldClusterWorkflowTemplate, ref.Name)
+		} else {
+			add(QueryFieldWorkflowTemplate, ref.Name)
+		}
+	}
+	add(QueryFieldWorkflowTemplate, wf.Labels[common.LabelKeyWorkflowTemplate])
+	add(QueryFieldClusterWorkflowTemplate, wf.Labels[common.LabelKeyClusterWorkflowTemplate])
+	add(QueryFieldCronWorkflow, wf.Labels[common.LabelKeyCronWorkflow])
+	for _, param := range wf.GetExecSpec().Arguments.Parameters {
+		if param.Value != nil {
+			add(QueryFieldParametersPrefix+param.Name, param.Value.String())
+		}
+	}
+	return fields
+}
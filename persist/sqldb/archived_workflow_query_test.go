@@ -0,0 +1,143 @@
+package sqldb
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    Query
+		wantErr bool
+	}{
+		{"Empty", "  ", nil, false},
+		{"Equals", "phase=Failed", Query{{QueryFieldPhase, QueryEquals, "Failed"}}, false},
+		{"NotEquals", "parameters.env!=dev", Query{{"parameters.env", QueryNotEquals, "dev"}}, false},
+		{"Contains", `message~"out of memory"`, Query{{QueryFieldMessage, QueryContains, "out of memory"}}, false},
+		{"Bare", "oom", Query{{"", QueryContains, "oom"}}, false},
+		{"EmptyEquals", `workflowTemplate=""`, Query{{QueryFieldWorkflowTemplate, QueryEquals, ""}}, false},
+		{"Several", ` phase=Failed  cronWorkflow=nightly "my \"quoted\" value"`, Query{
+			{QueryFieldPhase, QueryEquals, "Failed"},
+			{QueryFieldCronWorkflow, QueryEquals, "nightly"},
+			{"", QueryContains, `my "quoted" value`},
+		}, false},
+		{"UnknownField", "foo=bar", nil, true},
+		{"NoParameterName", "parameters.=bar", nil, true},
+		{"EmptyContains", "message~", nil, true},
+		{"Unterminated", `message~"oom`, nil, true},
+		{"InvalidOperator", "phase!Failed", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuery(tt.query)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_queryClause(t *testing.T) {
+	fieldExists := "exists (select 1 from argo_archived_workflows_fields where clustername = argo_archived_workflows.clustername and uid = argo_archived_workflows.uid"
+	tests := []struct {
+		name  string
+		query Query
+		want  db.Compound
+	}{
+		{"Empty", nil, db.And()},
+		{"PhaseEquals", Query{{QueryFieldPhase, QueryEquals, "Failed"}}, db.And(db.Cond{"phase": "Failed"})},
+		{"PhaseContains", Query{{QueryFieldPhase, QueryContains, "fail"}}, db.And(db.Raw("lower(phase) like ?", "%fail%"))},
+		{"Bare", Query{{"", QueryContains, "100%_OK"}}, db.And(db.Raw("(lower(name) like ? or "+fieldExists+" and lower(value) like ?))", `%100\%\_ok%`, `%100\%\_ok%`))},
+		{"FieldEquals", Query{{"parameters.env", QueryEquals, "prod"}}, db.And(db.Raw(fieldExists+" and name = ? and value = ?)", "parameters.env", "prod"))},
+		{"FieldNotEquals", Query{{"parameters.env", QueryNotEquals, "prod"}}, db.And(db.Raw("not "+fieldExists+" and name = ? and value = ?)", "parameters.env", "prod"))},
+		{"FieldContains", Query{{QueryFieldMessage, QueryContains, "OOM"}}, db.And(db.Raw(fieldExists+" and name = ? and lower(value) like ?)", "message", "%oom%"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := queryClause(tt.query)
+			assert.Equal(t, tt.want.Sentences(), got.Sentences())
+		})
+	}
+}
+
+func Test_truncateFieldValue(t *testing.T) {
+	assert.Equal(t, "foo", truncateFieldValue("foo"))
+	assert.Len(t, truncateFieldValue(strings.Repeat("a", 300)), maxArchivedWorkflowFieldValueLength)
+	// "é" is two bytes, so the last one would be split at the maximum length
+	truncated := truncateFieldValue(strings.Repeat("é", 200))
+	assert.Equal(t, strings.Repeat("é", 127), truncated)
+}
+
+func TestArchivedWorkflowFields(t *testing.T) {
+	value := wfv1.AnyStringPtr("prod")
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+			common.LabelKeyWorkflowTemplate: "my-template",
+			common.LabelKeyCronWorkflow:     "my-cron",
+		}},
+		Spec: wfv1.WorkflowSpec{
+			WorkflowTemplateRef: &wfv1.WorkflowTemplateRef{Name: "my-template"},
+			Arguments:           wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "env", Value: value}, {Name: "unset"}}},
+		},
+		Status: wfv1.WorkflowStatus{
+			Message: "child 'my-wf-1' failed",
+			Nodes: wfv1.Nodes{
+				"my-wf-1": {Phase: wfv1.NodeFailed, Message: "OOMKilled"},
+				"my-wf-2": {Phase: wfv1.NodeSucceeded, Message: "ignored"},
+			},
+		},
+	}
+	fields := ArchivedWorkflowFields(wf)
+	assert.ElementsMatch(t, []string{"child 'my-wf-1' failed", "OOMKilled"}, fields[QueryFieldMessage])
+	assert.Equal(t, []string{"my-template"}, fields[QueryFieldWorkflowTemplate])
+	assert.Equal(t, []string{"my-cron"}, fields[QueryFieldCronWorkflow])
+	assert.Equal(t, []string{"prod"}, fields["parameters.env"])
+	assert.NotContains(t, fields, "parameters.unset")
+	assert.NotContains(t, fields, QueryFieldClusterWorkflowTemplate)
+}
+
+func TestQuery_Matches(t *testing.T) {
+	fields := map[string][]string{
+		QueryFieldMessage: {"child 'my-wf-1' failed", "OOMKilled"},
+		"parameters.env":  {"prod"},
+	}
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"phase=Failed", true},
+		{"phase!=Failed", false},
+		{"phase~fail", true},
+		{"message~oomkilled", true},
+		{"message=OOMKilled", true},
+		{"message=oomkilled", false},
+		{"message!=OOMKilled", false},
+		{"parameters.env!=dev", true},
+		{"parameters.other!=dev", true},
+		{"parameters.other=dev", false},
+		{"MY-WF", true},
+		{"killed", true},
+		{"missing", false},
+		{"phase=Failed parameters.env=dev", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := ParseQuery(tt.query)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, query.Matches("my-wf", wfv1.WorkflowFailed, fields))
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package sqldb
+
+import (
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// backfillArchivedWorkflowFields fills the fields table for the workflows archived before it was created, so they
+// can be searched by the same fields as the workflows archived since
+type backfillArchivedWorkflowFields struct{}
+
+func (s backfillArchivedWorkflowFields) String() string {
+	return "backfillArchivedWorkflowFields{}"
+}
+
+func (s backfillArchivedWorkflowFields) apply(session sqlbuilder.Database) error {
+	log.Info("Backfill archived workflow fields")
+	// the keys are read first, as some databases cannot be written to while a result set is open
+	var keys []archivedWorkflowMetadata
+	err := session.SelectFrom(archiveTableName).
+		Columns("clustername", "uid").
+		All(&keys)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		var record archivedWorkflowRecord
+		err := session.SelectFrom(archiveTableName).
+			Columns("workflow").
+			Where(db.Cond{"clustername": key.ClusterName}).
+			And(db.Cond{"uid": key.UID}).
+			One(&record)
+		if err != nil {
+			return err
+		}
+		var wf *wfv1.Workflow
+		err = json.Unmarshal([]byte(record.Workflow), &wf)
+		if err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{"name": wf.Name, "namespace": wf.Namespace, "uid": key.UID}).Info("Back-filling archived workflow fields")
+		// the change may be applied again if it failed part way through
+		_, err = session.
+			DeleteFrom(archiveFieldsTableName).
+			Where(db.Cond{"clustername": key.ClusterName}).
+			And(db.Cond{"uid": key.UID}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		for name, values := range ArchivedWorkflowFields(wf) {
+			for _, value := range values {
+				_, err := session.Collection(archiveFieldsTableName).
+					Insert(&archivedWorkflowFieldRecord{
+						ClusterName: key.ClusterName,
+						UID:         key.UID,
+						Name:        name,
+						Value:       value,
+					})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
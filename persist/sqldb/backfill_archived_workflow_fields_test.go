@@ -0,0 +1,45 @@
+//go:build postgres
+
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	testutil "github.com/argoproj/argo-workflows/v3/test/util"
+)
+
+func TestBackfillArchivedWorkflowFields(t *testing.T) {
+	session := testutil.NewPostgresSession(t)
+	require.NoError(t, NewMigrate(session, "default", "argo_workflows").Exec(context.Background()))
+
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", UID: "my-uid"},
+		Status:     wfv1.WorkflowStatus{Message: "out of memory"},
+	}
+	workflow, err := json.Marshal(wf)
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	_, err = session.Collection(archiveTableName).Insert(&archivedWorkflowRecord{
+		archivedWorkflowMetadata: archivedWorkflowMetadata{ClusterName: "default", UID: "my-uid", Name: "my-wf", Namespace: "my-ns", Phase: wfv1.WorkflowFailed, StartedAt: now, FinishedAt: now},
+		Workflow:                 string(workflow),
+	})
+	require.NoError(t, err)
+	// a stale field from a previous attempt is replaced
+	_, err = session.Collection(archiveFieldsTableName).Insert(&archivedWorkflowFieldRecord{ClusterName: "default", UID: "my-uid", Name: QueryFieldMessage, Value: "stale"})
+	require.NoError(t, err)
+
+	require.NoError(t, backfillArchivedWorkflowFields{}.apply(session))
+
+	var fields []archivedWorkflowFieldRecord
+	require.NoError(t, session.SelectFrom(archiveFieldsTableName).Where(db.Cond{"uid": "my-uid"}).All(&fields))
+	assert.Equal(t, []archivedWorkflowFieldRecord{{ClusterName: "default", UID: "my-uid", Name: QueryFieldMessage, Value: "out of memory"}}, fields)
+}
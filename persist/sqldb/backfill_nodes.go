@@ -50,7 +50,7 @@ func (s backfillNodes) apply(session sqlbuilder.Database) (err error) {
 		if err != nil {
 			return err
 		}
-		marshalled, version, err := nodeStatusVersion(wf.Status.Nodes)
+		marshalled, version, err := NodeStatusVersion(wf.Status.Nodes)
 		if err != nil {
 			return err
 		}
@@ -255,6 +255,39 @@ func (m migrate) Exec(ctx context.Context) (err error) {
 		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
 		// add argo_archived_workflows name index for prefix searching performance
 		ansiSQLChange(`create index argo_archived_workflows_i3 on argo_archived_workflows (clustername,instanceid,name)`),
+		// memoization cache entries, the key is called "cachekey" because key is an SQL reserved word
+		ansiSQLChange(`create table if not exists argo_memoization_cache (
+    clustername varchar(64) not null,
+    namespace varchar(64) not null,
+    name varchar(253) not null,
+    cachekey varchar(256) not null,
+    nodeid varchar(256) not null,
+    outputs json not null,
+    createdat timestamp not null default current_timestamp,
+    lasthitat timestamp not null default current_timestamp,
+    primary key (clustername, namespace, name, cachekey)
+)`),
+		// index to find entries that need deleting
+		ansiSQLChange(`create index argo_memoization_cache_i1 on argo_memoization_cache (clustername,namespace,lasthitat)`),
+		// semaphore limits, the name is "<namespace>/<key>"
+		ansiSQLChange(`create table if not exists argo_sync_limit (
+    name varchar(256) not null,
+    sizelimit int not null,
+    primary key (name)
+)`),
+		// the fields archived workflows can be searched by, there may be several values of a field, e.g. the messages of
+		// the failed nodes, so the values are indexed rather than being the primary key
+		ansiSQLChange(`create table if not exists argo_archived_workflows_fields (
+    clustername varchar(64) not null,
+    uid varchar(128) not null,
+    name varchar(256) not null,
+    value varchar(255) not null,
+    foreign key (clustername, uid) references argo_archived_workflows(clustername, uid) on delete cascade
+)`),
+		ansiSQLChange(`create index argo_archived_workflows_fields_i1 on argo_archived_workflows_fields (clustername,name,value)`),
+		ansiSQLChange(`create index argo_archived_workflows_fields_i2 on argo_archived_workflows_fields (clustername,uid)`),
+		// the fields of the workflows archived before the table was created
+		backfillArchivedWorkflowFields{},
 	} {
 		err := m.applyChange(ctx, changeSchemaVersion, change)
 		if err != nil {
@@ -6,6 +6,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	labels "k8s.io/apimachinery/pkg/labels"
 
+	sqldb "github.com/argoproj/argo-workflows/v3/persist/sqldb"
+
 	time "time"
 
 	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -95,13 +97,13 @@ func (_m *WorkflowArchive) IsEnabled() bool {
 	return r0
 }
 
-// ListWorkflows provides a mock function with given fields: namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset
-func (_m *WorkflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartAt time.Time, maxStartAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (v1alpha1.Workflows, error) {
-	ret := _m.Called(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset)
+// ListWorkflows provides a mock function with given fields: namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, query, limit, offset
+func (_m *WorkflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartAt time.Time, maxStartAt time.Time, labelRequirements labels.Requirements, query sqldb.Query, limit int, offset int) (v1alpha1.Workflows, error) {
+	ret := _m.Called(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, query, limit, offset)
 
 	var r0 v1alpha1.Workflows
-	if rf, ok := ret.Get(0).(func(string, string, string, time.Time, time.Time, labels.Requirements, int, int) v1alpha1.Workflows); ok {
-		r0 = rf(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(0).(func(string, string, string, time.Time, time.Time, labels.Requirements, sqldb.Query, int, int) v1alpha1.Workflows); ok {
+		r0 = rf(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, query, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(v1alpha1.Workflows)
@@ -109,8 +111,8 @@ func (_m *WorkflowArchive) ListWorkflows(namespace string, name string, namePref
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, time.Time, time.Time, labels.Requirements, int, int) error); ok {
-		r1 = rf(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, limit, offset)
+	if rf, ok := ret.Get(1).(func(string, string, string, time.Time, time.Time, labels.Requirements, sqldb.Query, int, int) error); ok {
+		r1 = rf(namespace, name, namePrefix, minStartAt, maxStartAt, labelRequirements, query, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,7 +58,8 @@ func (wdc *nodeOffloadRepo) IsEnabled() bool {
 	return true
 }
 
-func nodeStatusVersion(s wfv1.Nodes) (string, string, error) {
+// NodeStatusVersion returns the marshalled nodes, and a version that is the same for the same nodes
+func NodeStatusVersion(s wfv1.Nodes) (string, string, error) {
 	marshalled, err := json.Marshal(s)
 	if err != nil {
 		return "", "", err
@@ -70,7 +71,7 @@ func nodeStatusVersion(s wfv1.Nodes) (string, string, error) {
 }
 
 func (wdc *nodeOffloadRepo) Save(uid, namespace string, nodes wfv1.Nodes) (string, error) {
-	marshalled, version, err := nodeStatusVersion(nodes)
+	marshalled, version, err := NodeStatusVersion(nodes)
 	if err != nil {
 		return "", err
 	}
@@ -51,7 +51,7 @@ type archivedWorkflowLabelRecord struct {
 type WorkflowArchive interface {
 	ArchiveWorkflow(wf *wfv1.Workflow) error
 	// list workflows, with the most recently started workflows at the beginning (i.e. index 0 is the most recent)
-	ListWorkflows(namespace string, name string, namePrefix string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, limit, offset int) (wfv1.Workflows, error)
+	ListWorkflows(namespace string, name string, namePrefix string, minStartAt, maxStartAt time.Time, labelRequirements labels.Requirements, query Query, limit, offset int) (wfv1.Workflows, error)
 	GetWorkflow(uid string) (*wfv1.Workflow, error)
 	DeleteWorkflow(uid string) error
 	DeleteExpiredWorkflows(ttl time.Duration) error
@@ -132,11 +132,35 @@ func (r *workflowArchive) ArchiveWorkflow(wf *wfv1.Workflow) error {
 				return err
 			}
 		}
+
+		_, err = sess.
+			DeleteFrom(archiveFieldsTableName).
+			Where(db.Cond{"clustername": r.clusterName}).
+			And(db.Cond{"uid": wf.UID}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		// insert the fields it can be searched by
+		for name, values := range ArchivedWorkflowFields(wf) {
+			for _, value := range values {
+				_, err := sess.Collection(archiveFieldsTableName).
+					Insert(&archivedWorkflowFieldRecord{
+						ClusterName: r.clusterName,
+						UID:         string(wf.UID),
+						Name:        name,
+						Value:       value,
+					})
+				if err != nil {
+					return err
+				}
+			}
+		}
 		return nil
 	})
 }
 
-func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, limit int, offset int) (wfv1.Workflows, error) {
+func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefix string, minStartedAt, maxStartedAt time.Time, labelRequirements labels.Requirements, query Query, limit int, offset int) (wfv1.Workflows, error) {
 	var archivedWfs []archivedWorkflowMetadata
 	clause, err := labelsClause(r.dbType, labelRequirements)
 	if err != nil {
@@ -159,6 +183,7 @@ func (r *workflowArchive) ListWorkflows(namespace string, name string, namePrefi
 		And(namePrefixClause(namePrefix)).
 		And(startedAtClause(minStartedAt, maxStartedAt)).
 		And(clause).
+		And(queryClause(query)).
 		OrderBy("-startedat").
 		Limit(limit).
 		Offset(offset).
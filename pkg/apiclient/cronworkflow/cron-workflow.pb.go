@@ -542,6 +542,88 @@ func (m *CronWorkflowResumeRequest) GetNamespace() string {
 	return ""
 }
 
+type CronWorkflowBackfillRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The time of the first schedule to run, inclusive
+	From *v1.Time `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// The time of the last schedule to run, inclusive, defaults to the time of the server
+	To *v1.Time `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// The number of backfilled Workflows that may run at the same time, defaults to 1
+	Parallelism          int32    `protobuf:"varint,5,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CronWorkflowBackfillRequest) Reset()         { *m = CronWorkflowBackfillRequest{} }
+func (m *CronWorkflowBackfillRequest) String() string { return proto.CompactTextString(m) }
+func (*CronWorkflowBackfillRequest) ProtoMessage()    {}
+func (*CronWorkflowBackfillRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257f310938c448f8, []int{9}
+}
+func (m *CronWorkflowBackfillRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBackfillRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronWorkflowBackfillRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CronWorkflowBackfillRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBackfillRequest.Merge(m, src)
+}
+func (m *CronWorkflowBackfillRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBackfillRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBackfillRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBackfillRequest proto.InternalMessageInfo
+
+func (m *CronWorkflowBackfillRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CronWorkflowBackfillRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CronWorkflowBackfillRequest) GetFrom() *v1.Time {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *CronWorkflowBackfillRequest) GetTo() *v1.Time {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *CronWorkflowBackfillRequest) GetParallelism() int32 {
+	if m != nil {
+		return m.Parallelism
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LintCronWorkflowRequest)(nil), "cronworkflow.LintCronWorkflowRequest")
 	proto.RegisterType((*CreateCronWorkflowRequest)(nil), "cronworkflow.CreateCronWorkflowRequest")
@@ -552,6 +634,7 @@ func init() {
 	proto.RegisterType((*CronWorkflowDeletedResponse)(nil), "cronworkflow.CronWorkflowDeletedResponse")
 	proto.RegisterType((*CronWorkflowSuspendRequest)(nil), "cronworkflow.CronWorkflowSuspendRequest")
 	proto.RegisterType((*CronWorkflowResumeRequest)(nil), "cronworkflow.CronWorkflowResumeRequest")
+	proto.RegisterType((*CronWorkflowBackfillRequest)(nil), "cronworkflow.CronWorkflowBackfillRequest")
 }
 
 func init() {
@@ -559,55 +642,61 @@ func init() {
 }
 
 var fileDescriptor_257f310938c448f8 = []byte{
-	// 764 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x99, 0xed, 0x8f, 0x1f, 0xf4, 0x69, 0x8b, 0x3a, 0x85, 0xba, 0x8d, 0xb5, 0x94, 0x50,
-	0x6d, 0xbb, 0xda, 0x49, 0xb7, 0xad, 0x22, 0x55, 0x2f, 0x6d, 0xa1, 0x07, 0xdb, 0x2a, 0x29, 0x22,
-	0xf5, 0x22, 0x69, 0x76, 0x4c, 0x63, 0xb3, 0x99, 0x98, 0x99, 0xdd, 0x22, 0xd2, 0x8b, 0x27, 0x2f,
-	0x82, 0xe0, 0x51, 0x5f, 0x80, 0xe0, 0x3b, 0xf0, 0xcf, 0x49, 0x04, 0x11, 0x04, 0xc1, 0x37, 0x20,
-	0xc5, 0x17, 0x22, 0x99, 0xfd, 0x97, 0xc9, 0x6e, 0x34, 0x2d, 0x41, 0xf0, 0x36, 0xd9, 0xcc, 0x3c,
-	0xf3, 0xf9, 0x7e, 0xe7, 0xc9, 0x37, 0x59, 0x20, 0xc1, 0x9e, 0x63, 0x58, 0x81, 0x6b, 0x7b, 0x2e,
-	0xf5, 0x85, 0x61, 0x87, 0xcc, 0xdf, 0x67, 0xe1, 0xde, 0x7d, 0x8f, 0xed, 0xcb, 0x8b, 0xd9, 0xd6,
-	0x15, 0x09, 0x42, 0x26, 0x18, 0x1e, 0x8c, 0xcf, 0xd0, 0xc6, 0x1c, 0xc6, 0x1c, 0x8f, 0x46, 0x05,
-	0x0c, 0xcb, 0xf7, 0x99, 0xb0, 0x84, 0xcb, 0x7c, 0xde, 0x98, 0xab, 0x2d, 0xee, 0x5d, 0xe1, 0xc4,
-	0x65, 0xd1, 0xdd, 0xaa, 0x65, 0xef, 0xba, 0x3e, 0x0d, 0x1f, 0x19, 0xcd, 0xfd, 0xb8, 0x51, 0xa5,
-	0xc2, 0x32, 0xea, 0x65, 0xc3, 0xa1, 0x3e, 0x0d, 0x2d, 0x41, 0x2b, 0xcd, 0x55, 0x1b, 0x8e, 0x2b,
-	0x76, 0x6b, 0x3b, 0xc4, 0x66, 0x55, 0xc3, 0x0a, 0x1d, 0x16, 0x84, 0xec, 0x81, 0x1c, 0xb4, 0x51,
-	0x78, 0xa7, 0x48, 0x9b, 0xb5, 0x5e, 0xb6, 0xbc, 0x60, 0xd7, 0xea, 0x2a, 0xa7, 0xbf, 0x41, 0x70,
-	0x7a, 0xdd, 0xf5, 0xc5, 0x4a, 0xc8, 0xfc, 0x3b, 0xcd, 0xd9, 0x26, 0x7d, 0x58, 0xa3, 0x5c, 0xe0,
-	0x31, 0xe8, 0xf7, 0xad, 0x2a, 0xe5, 0x81, 0x65, 0xd3, 0x22, 0x9a, 0x40, 0xd3, 0xfd, 0x66, 0xe7,
-	0x07, 0x1c, 0x82, 0x14, 0xdb, 0x5a, 0x54, 0x2c, 0x4c, 0xa0, 0xe9, 0x81, 0xf9, 0x4d, 0xd2, 0xe1,
-	0x23, 0x2d, 0x3e, 0x39, 0xb8, 0xd7, 0xe6, 0x23, 0xf5, 0x85, 0xc8, 0x57, 0x12, 0x21, 0x92, 0xb6,
-	0x81, 0x2d, 0x44, 0xa2, 0xa0, 0x28, 0x7b, 0xe8, 0x4f, 0x0b, 0x30, 0xba, 0x12, 0x52, 0x4b, 0xd0,
-	0x7f, 0x82, 0x17, 0x6f, 0xc3, 0x90, 0x2d, 0x71, 0x6f, 0x06, 0xf2, 0xe4, 0x8b, 0x7d, 0x72, 0xd3,
-	0x05, 0xd2, 0x38, 0x7a, 0x12, 0x3f, 0xfa, 0xce, 0x16, 0xd1, 0xd1, 0x93, 0x7a, 0x54, 0x38, 0xb6,
-	0xd4, 0x54, 0x2b, 0xe9, 0xcf, 0x10, 0x14, 0xd7, 0x5d, 0xae, 0x1c, 0x1c, 0xcf, 0xe6, 0xc4, 0x16,
-	0x0c, 0x78, 0x2e, 0x17, 0x2d, 0xa6, 0x86, 0x11, 0xe5, 0x6c, 0x4c, 0xeb, 0x9d, 0x85, 0x66, 0xbc,
-	0x8a, 0xfe, 0x0a, 0xc1, 0xc8, 0x1a, 0xed, 0xd9, 0x47, 0x18, 0xfe, 0x8b, 0x36, 0x6f, 0x82, 0xc8,
-	0xb1, 0x4a, 0x58, 0x48, 0x12, 0xde, 0x02, 0x70, 0xa8, 0x50, 0x4d, 0x9b, 0xcb, 0x06, 0xb8, 0xd6,
-	0x5e, 0x67, 0xc6, 0x6a, 0xe8, 0x9f, 0x10, 0x8c, 0xde, 0x0e, 0x2a, 0x29, 0x9d, 0x33, 0x12, 0x27,
-	0x5c, 0x2e, 0x14, 0x51, 0x26, 0xca, 0x64, 0x47, 0xf5, 0xfd, 0x85, 0x27, 0xe0, 0x35, 0x82, 0xd1,
-	0x55, 0xea, 0xd1, 0xde, 0x3a, 0x8e, 0xee, 0xf4, 0x36, 0x0c, 0x55, 0x64, 0xb9, 0x63, 0x75, 0xe8,
-	0x6a, 0x7c, 0xa9, 0xa9, 0x56, 0xd2, 0xcf, 0xc2, 0x99, 0x38, 0x63, 0x63, 0x6e, 0xc5, 0xa4, 0x3c,
-	0x60, 0x3e, 0xa7, 0xfa, 0x26, 0x68, 0xf1, 0xdb, 0x5b, 0x35, 0x1e, 0x50, 0xbf, 0x72, 0x6c, 0x25,
-	0xfa, 0x46, 0x14, 0x0d, 0x71, 0x4b, 0x78, 0xad, 0x4a, 0x8f, 0x5d, 0x6e, 0xfe, 0xf9, 0x20, 0x0c,
-	0x2b, 0x7c, 0x34, 0xac, 0xbb, 0x36, 0xc5, 0x1f, 0x10, 0x9c, 0x4c, 0x06, 0x26, 0x3e, 0x47, 0xe2,
-	0xb9, 0x4f, 0x52, 0x02, 0x55, 0xcb, 0xb9, 0x35, 0xf4, 0xf9, 0x27, 0xdf, 0x7f, 0xbe, 0x28, 0x5c,
-	0xd4, 0xa7, 0xe4, 0x1b, 0xa6, 0x5e, 0x56, 0x5f, 0x49, 0xdc, 0x78, 0xdc, 0x96, 0x73, 0x60, 0x78,
-	0xae, 0x2f, 0x96, 0x50, 0x09, 0xbf, 0x47, 0x80, 0xbb, 0x23, 0x14, 0x4f, 0xa9, 0x0a, 0x52, 0x43,
-	0x36, 0x77, 0x0d, 0xb3, 0x52, 0xc3, 0x94, 0xae, 0xff, 0x59, 0x43, 0x84, 0xff, 0x0e, 0xc1, 0xa9,
-	0xae, 0xd8, 0xc3, 0xe7, 0x93, 0xfe, 0xf7, 0xce, 0x45, 0xcd, 0xcc, 0x17, 0x3e, 0xda, 0x47, 0x2f,
-	0x49, 0x01, 0x93, 0x38, 0x83, 0x00, 0xfc, 0x16, 0xc1, 0x89, 0x44, 0x48, 0xe2, 0x49, 0x95, 0xbd,
-	0x77, 0x86, 0xe6, 0x6e, 0x7b, 0x59, 0x52, 0x5f, 0xc0, 0x33, 0x19, 0x5a, 0x47, 0x8e, 0x0f, 0xf0,
-	0x47, 0x04, 0xb8, 0x3b, 0x42, 0x93, 0x9d, 0x93, 0x1a, 0xb2, 0xb9, 0x4b, 0x58, 0x94, 0x12, 0x88,
-	0x96, 0x5d, 0x42, 0xd4, 0x40, 0x2f, 0x11, 0xe0, 0xee, 0x00, 0x4d, 0xaa, 0x48, 0x8d, 0x58, 0x6d,
-	0x26, 0xf9, 0xa0, 0xa4, 0x27, 0x5c, 0xd3, 0xe3, 0xd2, 0x11, 0x3c, 0xfe, 0x82, 0x00, 0x37, 0x92,
-	0xeb, 0xf7, 0x4f, 0x67, 0x4a, 0xce, 0xe5, 0xee, 0xf1, 0x55, 0x29, 0xe1, 0x92, 0x36, 0x97, 0x59,
-	0x82, 0x11, 0x4a, 0xa0, 0xc8, 0xea, 0xaf, 0x08, 0x86, 0x9b, 0xb1, 0xae, 0xa8, 0x99, 0x4e, 0x57,
-	0xa3, 0xbe, 0x05, 0x72, 0x97, 0x73, 0x4d, 0xca, 0xb9, 0xac, 0x95, 0xb3, 0xcb, 0xe1, 0x0d, 0xa2,
-	0x25, 0x54, 0x5a, 0xbe, 0xf1, 0xf9, 0x70, 0x1c, 0x7d, 0x3b, 0x1c, 0x47, 0x3f, 0x0e, 0xc7, 0xd1,
-	0xdd, 0xeb, 0xd9, 0x3f, 0xc4, 0x7b, 0xfc, 0x7b, 0xd8, 0xf9, 0x5f, 0x7e, 0x7f, 0x2f, 0xfc, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xc4, 0x13, 0xb8, 0x3b, 0x62, 0x0c, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0x4d, 0x6f, 0xd3, 0x48,
+	0x18, 0xc7, 0x35, 0x69, 0xbb, 0x52, 0xa7, 0xad, 0x76, 0x77, 0xba, 0xea, 0x26, 0xde, 0x6e, 0x15,
+	0x59, 0xdd, 0x6d, 0x1a, 0xe8, 0xb8, 0x49, 0xcb, 0x8b, 0x0a, 0xe5, 0xd0, 0x56, 0xea, 0x81, 0xb6,
+	0x20, 0x17, 0x84, 0xca, 0x05, 0xb9, 0xce, 0x34, 0x35, 0xb1, 0x3d, 0xc6, 0x33, 0x49, 0x85, 0x50,
+	0x2f, 0x9c, 0xb8, 0x70, 0xe2, 0x08, 0x1f, 0x00, 0x89, 0x6f, 0xc0, 0xcb, 0x09, 0x21, 0x21, 0x24,
+	0xa4, 0x4a, 0x7c, 0x01, 0x54, 0xb8, 0xf2, 0x1d, 0x90, 0x27, 0x4e, 0x62, 0x3b, 0x71, 0x71, 0x23,
+	0x0b, 0x89, 0xdb, 0xd8, 0x9e, 0xe7, 0x99, 0xdf, 0xff, 0x99, 0x67, 0xe6, 0x2f, 0x43, 0xec, 0xd4,
+	0xaa, 0x8a, 0xe6, 0x18, 0xba, 0x69, 0x10, 0x9b, 0x2b, 0xba, 0x4b, 0xed, 0x03, 0xea, 0xd6, 0xf6,
+	0x4c, 0x7a, 0x20, 0x1e, 0xe6, 0x5a, 0x4f, 0xd8, 0x71, 0x29, 0xa7, 0x68, 0x34, 0x38, 0x43, 0x9a,
+	0xac, 0x52, 0x5a, 0x35, 0x89, 0x97, 0x40, 0xd1, 0x6c, 0x9b, 0x72, 0x8d, 0x1b, 0xd4, 0x66, 0xcd,
+	0xb9, 0xd2, 0x62, 0xed, 0x22, 0xc3, 0x06, 0xf5, 0xbe, 0x5a, 0x9a, 0xbe, 0x6f, 0xd8, 0xc4, 0xbd,
+	0xaf, 0xf8, 0xeb, 0x31, 0xc5, 0x22, 0x5c, 0x53, 0x1a, 0x25, 0xa5, 0x4a, 0x6c, 0xe2, 0x6a, 0x9c,
+	0x54, 0xfc, 0xa8, 0xcd, 0xaa, 0xc1, 0xf7, 0xeb, 0xbb, 0x58, 0xa7, 0x96, 0xa2, 0xb9, 0x55, 0xea,
+	0xb8, 0xf4, 0xae, 0x18, 0xb4, 0x51, 0x58, 0x27, 0x49, 0x9b, 0xb5, 0x51, 0xd2, 0x4c, 0x67, 0x5f,
+	0xeb, 0x4a, 0x27, 0xbf, 0x00, 0xf0, 0xef, 0x0d, 0xc3, 0xe6, 0xab, 0x2e, 0xb5, 0x6f, 0xf9, 0xb3,
+	0x55, 0x72, 0xaf, 0x4e, 0x18, 0x47, 0x93, 0x70, 0xd8, 0xd6, 0x2c, 0xc2, 0x1c, 0x4d, 0x27, 0x59,
+	0x90, 0x07, 0x85, 0x61, 0xb5, 0xf3, 0x02, 0xb9, 0x70, 0x54, 0x0f, 0x04, 0x65, 0x33, 0x79, 0x50,
+	0x18, 0x29, 0x6f, 0xe1, 0x0e, 0x1f, 0x6e, 0xf1, 0x89, 0xc1, 0x9d, 0x36, 0x1f, 0x6e, 0x2c, 0x78,
+	0x75, 0xc5, 0x1e, 0x22, 0x6e, 0xbd, 0xc5, 0x2d, 0x44, 0x1c, 0x42, 0x09, 0xad, 0x21, 0x3f, 0xca,
+	0xc0, 0xdc, 0xaa, 0x4b, 0x34, 0x4e, 0x7e, 0x09, 0x5e, 0xb4, 0x03, 0xc7, 0x74, 0x81, 0x7b, 0xcd,
+	0x11, 0x3b, 0x9f, 0x1d, 0x10, 0x8b, 0x2e, 0xe0, 0xe6, 0xd6, 0xe3, 0xe0, 0xd6, 0x77, 0x96, 0xf0,
+	0xb6, 0x1e, 0x37, 0xbc, 0xc4, 0x81, 0x50, 0x35, 0x9c, 0x49, 0x7e, 0x0c, 0x60, 0x76, 0xc3, 0x60,
+	0xa1, 0x8d, 0x63, 0xc9, 0x2a, 0xb1, 0x0d, 0x47, 0x4c, 0x83, 0xf1, 0x16, 0x53, 0xb3, 0x10, 0xa5,
+	0x64, 0x4c, 0x1b, 0x9d, 0x40, 0x35, 0x98, 0x45, 0x7e, 0x06, 0xe0, 0xc4, 0x3a, 0xe9, 0xd9, 0x47,
+	0x08, 0x0e, 0x7a, 0x8b, 0xfb, 0x20, 0x62, 0x1c, 0x26, 0xcc, 0x44, 0x09, 0xaf, 0x43, 0x58, 0x25,
+	0x3c, 0x5c, 0xb4, 0xf9, 0x64, 0x80, 0xeb, 0xed, 0x38, 0x35, 0x90, 0x43, 0x7e, 0x07, 0x60, 0xee,
+	0xa6, 0x53, 0x89, 0xe9, 0x9c, 0x89, 0x20, 0xe1, 0x4a, 0x26, 0x0b, 0x12, 0x51, 0x46, 0x3b, 0x6a,
+	0xe0, 0x27, 0x9c, 0x80, 0xe7, 0x00, 0xe6, 0xd6, 0x88, 0x49, 0x38, 0x49, 0xa7, 0xd2, 0x3b, 0x70,
+	0xac, 0x22, 0xd2, 0xf5, 0xd5, 0xa1, 0x6b, 0xc1, 0x50, 0x35, 0x9c, 0x49, 0xfe, 0x17, 0xfe, 0x13,
+	0x64, 0x6c, 0xce, 0xad, 0xa8, 0x84, 0x39, 0xd4, 0x66, 0x44, 0xde, 0x82, 0x52, 0xf0, 0xf3, 0x76,
+	0x9d, 0x39, 0xc4, 0xae, 0xf4, 0xad, 0x44, 0xde, 0x84, 0xb9, 0x60, 0x3e, 0x95, 0xb0, 0xba, 0x45,
+	0xfa, 0x4f, 0xf7, 0x0d, 0x84, 0xf1, 0x57, 0x34, 0xbd, 0xb6, 0x67, 0x98, 0x66, 0xff, 0xa5, 0xbe,
+	0x02, 0x07, 0xf7, 0x5c, 0x6a, 0xf9, 0x15, 0x2e, 0x26, 0xab, 0xf0, 0x0d, 0xc3, 0x22, 0xaa, 0x88,
+	0x43, 0x4b, 0x30, 0xc3, 0x69, 0x76, 0xf0, 0xd4, 0xd1, 0x19, 0x4e, 0x51, 0x1e, 0x8e, 0x38, 0x9a,
+	0xab, 0x99, 0x26, 0x31, 0x0d, 0x66, 0x65, 0x87, 0xf2, 0xa0, 0x30, 0xa4, 0x06, 0x5f, 0x95, 0xbf,
+	0x8c, 0xc1, 0xf1, 0xd0, 0x7e, 0x10, 0xb7, 0x61, 0xe8, 0x04, 0xbd, 0x01, 0xf0, 0x8f, 0xa8, 0x41,
+	0xa0, 0xff, 0x70, 0xd0, 0xe7, 0x70, 0x8c, 0x81, 0x48, 0x29, 0x1f, 0x05, 0xb9, 0xfc, 0xf0, 0xd3,
+	0xd7, 0x27, 0x99, 0xb3, 0xf2, 0x8c, 0x70, 0xd4, 0x46, 0x29, 0x6c, 0xc1, 0x4c, 0x79, 0xd0, 0x2e,
+	0xf6, 0xa1, 0x62, 0x1a, 0x36, 0x5f, 0x02, 0x45, 0xf4, 0x1a, 0x40, 0xd4, 0x6d, 0x19, 0x68, 0x26,
+	0xac, 0x20, 0xd6, 0x54, 0x52, 0xd7, 0x30, 0x27, 0x34, 0xcc, 0xc8, 0xf2, 0x8f, 0x35, 0x78, 0xf8,
+	0xaf, 0x00, 0xfc, 0xb3, 0xeb, 0x9a, 0x47, 0xff, 0x47, 0xeb, 0xdf, 0xdb, 0x07, 0x24, 0x35, 0x5d,
+	0x78, 0x6f, 0x1d, 0xb9, 0x28, 0x04, 0x4c, 0xa3, 0x04, 0x02, 0xd0, 0x4b, 0x00, 0x7f, 0x8f, 0x98,
+	0x02, 0x9a, 0x0e, 0xb3, 0xf7, 0xf6, 0x8c, 0xd4, 0xcb, 0x5e, 0x12, 0xd4, 0x67, 0xd0, 0x6c, 0x82,
+	0xd6, 0x11, 0xe3, 0x43, 0xf4, 0x16, 0x40, 0xd4, 0x6d, 0x19, 0xd1, 0xce, 0x89, 0x35, 0x95, 0xd4,
+	0x25, 0x2c, 0x0a, 0x09, 0x58, 0x4a, 0x2e, 0xc1, 0x6b, 0xa0, 0xa7, 0x00, 0xa2, 0x6e, 0xc3, 0x88,
+	0xaa, 0x88, 0xb5, 0x14, 0x69, 0x36, 0x7a, 0x50, 0xe2, 0x6f, 0x74, 0xbf, 0xc6, 0xc5, 0x53, 0xd4,
+	0xf8, 0x03, 0x80, 0xa8, 0x79, 0x53, 0x9f, 0x7c, 0x3a, 0x63, 0xee, 0xf5, 0xd4, 0x6b, 0x7c, 0x49,
+	0x48, 0x38, 0x27, 0xcd, 0x27, 0x96, 0xa0, 0xb8, 0x02, 0xc8, 0x2b, 0xf5, 0x47, 0x00, 0xc7, 0x7d,
+	0x1b, 0x0b, 0xa9, 0x29, 0xc4, 0xab, 0x09, 0xbb, 0x5e, 0xea, 0x72, 0x2e, 0x0b, 0x39, 0xe7, 0xa5,
+	0x52, 0x72, 0x39, 0xac, 0x49, 0xe4, 0xe9, 0x39, 0x02, 0xf0, 0xaf, 0x96, 0xed, 0x85, 0x04, 0x9d,
+	0xd0, 0x13, 0x11, 0x9b, 0x4c, 0x5d, 0xd1, 0xb2, 0x50, 0x74, 0x41, 0x2a, 0x27, 0x57, 0xb4, 0xeb,
+	0x23, 0x2d, 0x81, 0xe2, 0xca, 0xd5, 0xf7, 0xc7, 0x53, 0xe0, 0xe8, 0x78, 0x0a, 0x7c, 0x3e, 0x9e,
+	0x02, 0xb7, 0x97, 0x93, 0xff, 0x4b, 0xf5, 0xf8, 0x01, 0xdc, 0xfd, 0x4d, 0xfc, 0x42, 0x2d, 0x7c,
+	0x1f, 0x00, 0x3d, 0xe2, 0x90, 0x38, 0x25, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -630,6 +719,7 @@ type CronWorkflowServiceClient interface {
 	DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflowDeletedResponse, error)
 	ResumeCronWorkflow(ctx context.Context, in *CronWorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
 	SuspendCronWorkflow(ctx context.Context, in *CronWorkflowSuspendRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
+	BackfillCronWorkflow(ctx context.Context, in *CronWorkflowBackfillRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
 }
 
 type cronWorkflowServiceClient struct {
@@ -712,6 +802,15 @@ func (c *cronWorkflowServiceClient) SuspendCronWorkflow(ctx context.Context, in
 	return out, nil
 }
 
+func (c *cronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, in *CronWorkflowBackfillRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	out := new(v1alpha1.CronWorkflow)
+	err := c.cc.Invoke(ctx, "/cronworkflow.CronWorkflowService/BackfillCronWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CronWorkflowServiceServer is the server API for CronWorkflowService service.
 type CronWorkflowServiceServer interface {
 	LintCronWorkflow(context.Context, *LintCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
@@ -722,6 +821,7 @@ type CronWorkflowServiceServer interface {
 	DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*CronWorkflowDeletedResponse, error)
 	ResumeCronWorkflow(context.Context, *CronWorkflowResumeRequest) (*v1alpha1.CronWorkflow, error)
 	SuspendCronWorkflow(context.Context, *CronWorkflowSuspendRequest) (*v1alpha1.CronWorkflow, error)
+	BackfillCronWorkflow(context.Context, *CronWorkflowBackfillRequest) (*v1alpha1.CronWorkflow, error)
 }
 
 // UnimplementedCronWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -752,6 +852,9 @@ func (*UnimplementedCronWorkflowServiceServer) ResumeCronWorkflow(ctx context.Co
 func (*UnimplementedCronWorkflowServiceServer) SuspendCronWorkflow(ctx context.Context, req *CronWorkflowSuspendRequest) (*v1alpha1.CronWorkflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuspendCronWorkflow not implemented")
 }
+func (*UnimplementedCronWorkflowServiceServer) BackfillCronWorkflow(ctx context.Context, req *CronWorkflowBackfillRequest) (*v1alpha1.CronWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillCronWorkflow not implemented")
+}
 
 func RegisterCronWorkflowServiceServer(s *grpc.Server, srv CronWorkflowServiceServer) {
 	s.RegisterService(&_CronWorkflowService_serviceDesc, srv)
@@ -901,6 +1004,24 @@ func _CronWorkflowService_SuspendCronWorkflow_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronWorkflowService_BackfillCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CronWorkflowBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronworkflow.CronWorkflowService/BackfillCronWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, req.(*CronWorkflowBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cronworkflow.CronWorkflowService",
 	HandlerType: (*CronWorkflowServiceServer)(nil),
@@ -937,6 +1058,10 @@ var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuspendCronWorkflow",
 			Handler:    _CronWorkflowService_SuspendCronWorkflow_Handler,
 		},
+		{
+			MethodName: "BackfillCronWorkflow",
+			Handler:    _CronWorkflowService_BackfillCronWorkflow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/cronworkflow/cron-workflow.proto",
@@ -1360,6 +1485,76 @@ func (m *CronWorkflowResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *CronWorkflowBackfillRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBackfillRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBackfillRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Parallelism != 0 {
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(m.Parallelism))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCronWorkflow(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCronWorkflow(v)
 	base := offset
@@ -1559,6 +1754,37 @@ func (m *CronWorkflowResumeRequest) Size() (n int) {
 	return n
 }
 
+func (m *CronWorkflowBackfillRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.Parallelism != 0 {
+		n += 1 + sovCronWorkflow(uint64(m.Parallelism))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCronWorkflow(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2692,6 +2918,212 @@ func (m *CronWorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CronWorkflowBackfillRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowBackfillRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowBackfillRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &v1.Time{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &v1.Time{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCronWorkflow(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
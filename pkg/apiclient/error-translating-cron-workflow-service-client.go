@@ -55,3 +55,8 @@ func (c *errorTranslatingCronWorkflowServiceClient) SuspendCronWorkflow(ctx cont
 	workflow, err := c.delegate.SuspendCronWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
 }
+
+func (c *errorTranslatingCronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.CronWorkflowBackfillRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	workflow, err := c.delegate.BackfillCronWorkflow(ctx, req)
+	return workflow, grpcutil.TranslateError(err)
+}
@@ -46,6 +46,11 @@ func (h Facade) SuspendCronWorkflow(ctx context.Context, in *cronworkflowpkg.Cro
 	return out, h.Put(in, out, "/api/v1/cron-workflows/{namespace}/{name}/suspend")
 }
 
+func (h Facade) BackfillCronWorkflow(ctx context.Context, in *cronworkflowpkg.CronWorkflowBackfillRequest, opts ...grpc.CallOption) (*wfv1.CronWorkflow, error) {
+	out := &wfv1.CronWorkflow{}
+	return out, h.Put(in, out, "/api/v1/cron-workflows/{namespace}/{name}/backfill")
+}
+
 func (h CronWorkflowServiceClient) DeleteCronWorkflow(_ context.Context, in *cronworkflowpkg.DeleteCronWorkflowRequest, _ ...grpc.CallOption) (*cronworkflowpkg.CronWorkflowDeletedResponse, error) {
 	out := &cronworkflowpkg.CronWorkflowDeletedResponse{}
 	return out, h.Delete(in, out, "/api/v1/cron-workflows/{namespace}/{name}")
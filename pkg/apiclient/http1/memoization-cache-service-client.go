@@ -0,0 +1,31 @@
+package http1
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+)
+
+type MemoizationCacheServiceClient = Facade
+
+func (h MemoizationCacheServiceClient) ListMemoizationCacheEntries(_ context.Context, in *memoizationcachepkg.ListMemoizationCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	out := &memoizationcachepkg.MemoizationCacheEntryList{}
+	return out, h.Get(in, out, "/api/v1/memoization-caches/{namespace}")
+}
+
+func (h MemoizationCacheServiceClient) GetMemoizationCacheEntry(_ context.Context, in *memoizationcachepkg.GetMemoizationCacheEntryRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCacheEntry, error) {
+	out := &memoizationcachepkg.MemoizationCacheEntry{}
+	return out, h.Get(in, out, "/api/v1/memoization-caches/{namespace}/{cacheName}/{key}")
+}
+
+func (h MemoizationCacheServiceClient) DeleteMemoizationCacheEntries(_ context.Context, in *memoizationcachepkg.DeleteMemoizationCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	out := &memoizationcachepkg.MemoizationCacheEntryList{}
+	return out, h.Post(in, out, "/api/v1/memoization-caches/{namespace}/{cacheName}/delete")
+}
+
+func (h MemoizationCacheServiceClient) PruneMemoizationCacheEntries(_ context.Context, in *memoizationcachepkg.PruneMemoizationCacheEntriesRequest, _ ...grpc.CallOption) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	out := &memoizationcachepkg.MemoizationCacheEntryList{}
+	return out, h.Post(in, out, "/api/v1/memoization-caches/{namespace}/prune")
+}
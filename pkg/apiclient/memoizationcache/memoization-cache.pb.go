@@ -0,0 +1,2436 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/apiclient/memoizationcache/memoization-cache.proto
+
+package memoizationcache
+
+import (
+	context "context"
+	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MemoizationCacheEntry struct {
+	// the type of the cache, e.g. `ConfigMapCache`, `SQLCache` or `ArtifactCache`
+	CacheType            string            `protobuf:"bytes,1,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	CacheName            string            `protobuf:"bytes,2,opt,name=cacheName,proto3" json:"cacheName,omitempty"`
+	Key                  string            `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	NodeID               string            `protobuf:"bytes,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Outputs              *v1alpha1.Outputs `protobuf:"bytes,5,opt,name=outputs,proto3" json:"outputs,omitempty"`
+	CreationTimestamp    *v1.Time          `protobuf:"bytes,6,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	LastHitTimestamp     *v1.Time          `protobuf:"bytes,7,opt,name=lastHitTimestamp,proto3" json:"lastHitTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MemoizationCacheEntry) Reset()         { *m = MemoizationCacheEntry{} }
+func (m *MemoizationCacheEntry) String() string { return proto.CompactTextString(m) }
+func (*MemoizationCacheEntry) ProtoMessage()    {}
+func (*MemoizationCacheEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{0}
+}
+func (m *MemoizationCacheEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoizationCacheEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoizationCacheEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemoizationCacheEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoizationCacheEntry.Merge(m, src)
+}
+func (m *MemoizationCacheEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoizationCacheEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoizationCacheEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoizationCacheEntry proto.InternalMessageInfo
+
+func (m *MemoizationCacheEntry) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+func (m *MemoizationCacheEntry) GetCacheName() string {
+	if m != nil {
+		return m.CacheName
+	}
+	return ""
+}
+
+func (m *MemoizationCacheEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MemoizationCacheEntry) GetNodeID() string {
+	if m != nil {
+		return m.NodeID
+	}
+	return ""
+}
+
+func (m *MemoizationCacheEntry) GetOutputs() *v1alpha1.Outputs {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *MemoizationCacheEntry) GetCreationTimestamp() *v1.Time {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return nil
+}
+
+func (m *MemoizationCacheEntry) GetLastHitTimestamp() *v1.Time {
+	if m != nil {
+		return m.LastHitTimestamp
+	}
+	return nil
+}
+
+type MemoizationCacheEntryList struct {
+	Items                []*MemoizationCacheEntry `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *MemoizationCacheEntryList) Reset()         { *m = MemoizationCacheEntryList{} }
+func (m *MemoizationCacheEntryList) String() string { return proto.CompactTextString(m) }
+func (*MemoizationCacheEntryList) ProtoMessage()    {}
+func (*MemoizationCacheEntryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{1}
+}
+func (m *MemoizationCacheEntryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemoizationCacheEntryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemoizationCacheEntryList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemoizationCacheEntryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoizationCacheEntryList.Merge(m, src)
+}
+func (m *MemoizationCacheEntryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemoizationCacheEntryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoizationCacheEntryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoizationCacheEntryList proto.InternalMessageInfo
+
+func (m *MemoizationCacheEntryList) GetItems() []*MemoizationCacheEntry {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListMemoizationCacheEntriesRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// optional - only return entries of caches of this type
+	CacheType string `protobuf:"bytes,2,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	// optional - only return entries of the cache with this name
+	CacheName            string   `protobuf:"bytes,3,opt,name=cacheName,proto3" json:"cacheName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMemoizationCacheEntriesRequest) Reset()         { *m = ListMemoizationCacheEntriesRequest{} }
+func (m *ListMemoizationCacheEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMemoizationCacheEntriesRequest) ProtoMessage()    {}
+func (*ListMemoizationCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{2}
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMemoizationCacheEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMemoizationCacheEntriesRequest.Merge(m, src)
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListMemoizationCacheEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMemoizationCacheEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMemoizationCacheEntriesRequest proto.InternalMessageInfo
+
+func (m *ListMemoizationCacheEntriesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ListMemoizationCacheEntriesRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+func (m *ListMemoizationCacheEntriesRequest) GetCacheName() string {
+	if m != nil {
+		return m.CacheName
+	}
+	return ""
+}
+
+type GetMemoizationCacheEntryRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CacheName string `protobuf:"bytes,2,opt,name=cacheName,proto3" json:"cacheName,omitempty"`
+	Key       string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// optional - only look for the entry in caches of this type
+	CacheType            string   `protobuf:"bytes,4,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMemoizationCacheEntryRequest) Reset()         { *m = GetMemoizationCacheEntryRequest{} }
+func (m *GetMemoizationCacheEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMemoizationCacheEntryRequest) ProtoMessage()    {}
+func (*GetMemoizationCacheEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{3}
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMemoizationCacheEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMemoizationCacheEntryRequest.Merge(m, src)
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMemoizationCacheEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMemoizationCacheEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMemoizationCacheEntryRequest proto.InternalMessageInfo
+
+func (m *GetMemoizationCacheEntryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetMemoizationCacheEntryRequest) GetCacheName() string {
+	if m != nil {
+		return m.CacheName
+	}
+	return ""
+}
+
+func (m *GetMemoizationCacheEntryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetMemoizationCacheEntryRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+type DeleteMemoizationCacheEntriesRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CacheName string `protobuf:"bytes,2,opt,name=cacheName,proto3" json:"cacheName,omitempty"`
+	// the keys of the entries to delete
+	Keys []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	// optional - also delete the entries whose key matches this regular expression
+	KeyRegex string `protobuf:"bytes,4,opt,name=keyRegex,proto3" json:"keyRegex,omitempty"`
+	// optional - only delete entries of caches of this type
+	CacheType            string   `protobuf:"bytes,5,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) Reset()         { *m = DeleteMemoizationCacheEntriesRequest{} }
+func (m *DeleteMemoizationCacheEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMemoizationCacheEntriesRequest) ProtoMessage()    {}
+func (*DeleteMemoizationCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{4}
+}
+func (m *DeleteMemoizationCacheEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteMemoizationCacheEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteMemoizationCacheEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteMemoizationCacheEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMemoizationCacheEntriesRequest.Merge(m, src)
+}
+func (m *DeleteMemoizationCacheEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteMemoizationCacheEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMemoizationCacheEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMemoizationCacheEntriesRequest proto.InternalMessageInfo
+
+func (m *DeleteMemoizationCacheEntriesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) GetCacheName() string {
+	if m != nil {
+		return m.CacheName
+	}
+	return ""
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) GetKeyRegex() string {
+	if m != nil {
+		return m.KeyRegex
+	}
+	return ""
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+type PruneMemoizationCacheEntriesRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// delete entries created longer ago than this duration, e.g. `24h`
+	OlderThan string `protobuf:"bytes,2,opt,name=olderThan,proto3" json:"olderThan,omitempty"`
+	// optional - only prune the cache with this name
+	CacheName string `protobuf:"bytes,3,opt,name=cacheName,proto3" json:"cacheName,omitempty"`
+	// optional - only prune caches of this type
+	CacheType            string   `protobuf:"bytes,4,opt,name=cacheType,proto3" json:"cacheType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) Reset()         { *m = PruneMemoizationCacheEntriesRequest{} }
+func (m *PruneMemoizationCacheEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*PruneMemoizationCacheEntriesRequest) ProtoMessage()    {}
+func (*PruneMemoizationCacheEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9acfa579c248340, []int{5}
+}
+func (m *PruneMemoizationCacheEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruneMemoizationCacheEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruneMemoizationCacheEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruneMemoizationCacheEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruneMemoizationCacheEntriesRequest.Merge(m, src)
+}
+func (m *PruneMemoizationCacheEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruneMemoizationCacheEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruneMemoizationCacheEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruneMemoizationCacheEntriesRequest proto.InternalMessageInfo
+
+func (m *PruneMemoizationCacheEntriesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) GetOlderThan() string {
+	if m != nil {
+		return m.OlderThan
+	}
+	return ""
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) GetCacheName() string {
+	if m != nil {
+		return m.CacheName
+	}
+	return ""
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) GetCacheType() string {
+	if m != nil {
+		return m.CacheType
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MemoizationCacheEntry)(nil), "memoizationcache.MemoizationCacheEntry")
+	proto.RegisterType((*MemoizationCacheEntryList)(nil), "memoizationcache.MemoizationCacheEntryList")
+	proto.RegisterType((*ListMemoizationCacheEntriesRequest)(nil), "memoizationcache.ListMemoizationCacheEntriesRequest")
+	proto.RegisterType((*GetMemoizationCacheEntryRequest)(nil), "memoizationcache.GetMemoizationCacheEntryRequest")
+	proto.RegisterType((*DeleteMemoizationCacheEntriesRequest)(nil), "memoizationcache.DeleteMemoizationCacheEntriesRequest")
+	proto.RegisterType((*PruneMemoizationCacheEntriesRequest)(nil), "memoizationcache.PruneMemoizationCacheEntriesRequest")
+}
+
+func init() {
+	proto.RegisterFile("pkg/apiclient/memoizationcache/memoization-cache.proto", fileDescriptor_c9acfa579c248340)
+}
+
+var fileDescriptor_c9acfa579c248340 = []byte{
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4f, 0x13, 0x41,
+	0x1c, 0xcd, 0x50, 0x28, 0x32, 0x5c, 0x70, 0x12, 0x75, 0xad, 0x88, 0xcd, 0x6a, 0xb4, 0x41, 0x99,
+	0x4d, 0x01, 0x11, 0x49, 0x34, 0xfe, 0xc1, 0x28, 0x89, 0x88, 0xa9, 0xc4, 0x18, 0x2e, 0x66, 0xd8,
+	0xfe, 0xdc, 0xae, 0xfb, 0x67, 0xd6, 0x9d, 0x69, 0x71, 0x25, 0x78, 0xf0, 0xee, 0x45, 0xbd, 0xfa,
+	0x1d, 0x38, 0x9a, 0xf8, 0x05, 0x8c, 0x27, 0x13, 0xbf, 0x80, 0x21, 0x7e, 0x10, 0xb3, 0xd3, 0x76,
+	0x0b, 0xdd, 0x52, 0xb6, 0xea, 0x6d, 0xf6, 0xcd, 0xcc, 0x9b, 0xf7, 0xe6, 0xf7, 0x7e, 0xbb, 0x8b,
+	0x17, 0x02, 0xc7, 0x32, 0x58, 0x60, 0x9b, 0xae, 0x0d, 0xbe, 0x34, 0x3c, 0xf0, 0xb8, 0xfd, 0x86,
+	0x49, 0x9b, 0xfb, 0x26, 0x33, 0x6b, 0xb0, 0x1f, 0x98, 0x51, 0x08, 0x0d, 0x42, 0x2e, 0x39, 0x99,
+	0xe8, 0x5e, 0x59, 0x98, 0xb4, 0x38, 0xb7, 0x5c, 0x88, 0xc9, 0x0c, 0xe6, 0xfb, 0x5c, 0xaa, 0x39,
+	0xd1, 0x5c, 0x5f, 0x98, 0x77, 0x16, 0x05, 0xb5, 0x79, 0x3c, 0xeb, 0x31, 0xb3, 0x66, 0xfb, 0x10,
+	0x46, 0x46, 0xeb, 0x6c, 0x61, 0x78, 0x20, 0x99, 0xd1, 0x28, 0x1b, 0x16, 0xf8, 0x10, 0x32, 0x09,
+	0xd5, 0xd6, 0xae, 0x55, 0xcb, 0x96, 0xb5, 0xfa, 0x26, 0x35, 0xb9, 0x67, 0xb0, 0xd0, 0xe2, 0x41,
+	0xc8, 0x5f, 0xaa, 0xc1, 0xcc, 0x16, 0x0f, 0x9d, 0x17, 0x2e, 0xdf, 0x12, 0x1d, 0x92, 0x36, 0x64,
+	0x34, 0xca, 0xcc, 0x0d, 0x6a, 0x2c, 0x45, 0xa7, 0x7f, 0xca, 0xe1, 0x13, 0xab, 0x1d, 0xdd, 0x77,
+	0x63, 0xdd, 0xf7, 0x7c, 0x19, 0x46, 0x64, 0x12, 0x8f, 0x29, 0x17, 0xeb, 0x51, 0x00, 0x1a, 0x2a,
+	0xa2, 0xd2, 0x58, 0xa5, 0x03, 0x24, 0xb3, 0x8f, 0x98, 0x07, 0xda, 0xd0, 0xbe, 0xd9, 0x18, 0x20,
+	0x13, 0x38, 0xe7, 0x40, 0xa4, 0xe5, 0x14, 0x1e, 0x0f, 0xc9, 0x49, 0x9c, 0xf7, 0x79, 0x15, 0x56,
+	0x96, 0xb5, 0x61, 0x05, 0xb6, 0x9e, 0x88, 0x89, 0x47, 0x79, 0x5d, 0x06, 0x75, 0x29, 0xb4, 0x91,
+	0x22, 0x2a, 0x8d, 0xcf, 0xae, 0xd0, 0x8e, 0x41, 0xda, 0x36, 0xa8, 0x06, 0xcf, 0x13, 0x83, 0xb4,
+	0x31, 0x47, 0x03, 0xc7, 0xa2, 0xb1, 0x47, 0xda, 0x46, 0x69, 0xdb, 0x23, 0x5d, 0x6b, 0x12, 0x56,
+	0xda, 0xcc, 0xe4, 0x19, 0x3e, 0x6e, 0x86, 0xa0, 0x0c, 0xae, 0xdb, 0x1e, 0x08, 0xc9, 0xbc, 0x40,
+	0xcb, 0xab, 0xe3, 0xa6, 0x69, 0xb3, 0x0a, 0x74, 0x7f, 0x15, 0x3a, 0xe4, 0x71, 0x15, 0x68, 0xa3,
+	0x4c, 0xe3, 0x6d, 0x95, 0x34, 0x09, 0x79, 0x8a, 0x27, 0x5c, 0x26, 0xe4, 0x03, 0x5b, 0x76, 0x88,
+	0x47, 0x07, 0x26, 0x4e, 0x71, 0xe8, 0x1b, 0xf8, 0x74, 0xcf, 0xaa, 0x3c, 0xb4, 0x85, 0x24, 0x37,
+	0xf0, 0x88, 0x2d, 0xc1, 0x13, 0x1a, 0x2a, 0xe6, 0x4a, 0xe3, 0xb3, 0x97, 0x68, 0x77, 0xf0, 0x68,
+	0xcf, 0xbd, 0x95, 0xe6, 0x2e, 0xfd, 0x2d, 0xd6, 0x63, 0x9a, 0x5e, 0x6b, 0x6c, 0x10, 0x15, 0x78,
+	0x55, 0x07, 0x21, 0xe3, 0x02, 0xfb, 0xcc, 0x03, 0x11, 0x30, 0x33, 0x29, 0x7f, 0x02, 0x1c, 0x0c,
+	0xc7, 0x50, 0xdf, 0x70, 0xe4, 0xba, 0xc2, 0xa1, 0xbf, 0x47, 0xf8, 0xdc, 0x7d, 0x90, 0xbd, 0x35,
+	0x0e, 0x74, 0x7a, 0xd6, 0xf0, 0x1d, 0x50, 0x3b, 0xdc, 0xa5, 0x56, 0xdf, 0x45, 0xf8, 0xc2, 0x32,
+	0xb8, 0x20, 0xe1, 0xbf, 0x5c, 0x49, 0x6f, 0x51, 0x04, 0x0f, 0x3b, 0x10, 0x09, 0x2d, 0x57, 0xcc,
+	0x95, 0xc6, 0x2a, 0x6a, 0x4c, 0x0a, 0xf8, 0x98, 0x03, 0x51, 0x05, 0x2c, 0x78, 0xdd, 0x52, 0x95,
+	0x3c, 0x1f, 0x94, 0x3c, 0xd2, 0x2d, 0xf9, 0x33, 0xc2, 0xe7, 0x1f, 0x87, 0x75, 0xff, 0x9f, 0x15,
+	0x73, 0xb7, 0x0a, 0xe1, 0x7a, 0x8d, 0xf9, 0x6d, 0xc5, 0x09, 0xd0, 0xbf, 0x88, 0xfd, 0xaf, 0x74,
+	0xf6, 0x43, 0x1e, 0x9f, 0xea, 0x96, 0xf6, 0x04, 0xc2, 0x86, 0x6d, 0x02, 0xd9, 0x45, 0xf8, 0x4c,
+	0x9f, 0xfc, 0x91, 0xf9, 0x74, 0x9c, 0x8f, 0x8e, 0x6b, 0xe1, 0x72, 0xc6, 0x26, 0x88, 0xa9, 0x74,
+	0xfa, 0xee, 0xe7, 0xef, 0x8f, 0x43, 0x25, 0x72, 0x51, 0xbd, 0x99, 0x1b, 0xe5, 0xf4, 0x2b, 0x5d,
+	0x18, 0xdb, 0xc9, 0x3d, 0xed, 0x90, 0x2f, 0x08, 0x6b, 0x87, 0x25, 0x96, 0x94, 0xd3, 0x27, 0x1f,
+	0x91, 0xee, 0x42, 0xd6, 0x8e, 0xd5, 0x6f, 0x29, 0xa1, 0x4b, 0x64, 0x31, 0x9b, 0x50, 0x63, 0x3b,
+	0x29, 0xd0, 0x8e, 0xb1, 0xed, 0x40, 0xb4, 0x43, 0xbe, 0x23, 0x7c, 0xb6, 0x6f, 0xb8, 0xc9, 0x42,
+	0x5a, 0x4c, 0x96, 0x6e, 0x18, 0xec, 0xc6, 0x97, 0x95, 0x91, 0x9b, 0xfa, 0xf5, 0xbf, 0x30, 0x52,
+	0x55, 0x6a, 0x96, 0xd0, 0x34, 0xf9, 0x8a, 0xf0, 0x64, 0xbf, 0xd8, 0x93, 0xab, 0x69, 0x4d, 0x19,
+	0xda, 0x64, 0x30, 0x2b, 0xd7, 0x94, 0x95, 0xb2, 0x7e, 0x25, 0xa3, 0x95, 0x20, 0x16, 0xb0, 0x84,
+	0xa6, 0xef, 0xac, 0x7d, 0xdb, 0x9b, 0x42, 0x3f, 0xf6, 0xa6, 0xd0, 0xaf, 0xbd, 0x29, 0xb4, 0x71,
+	0x3b, 0xfb, 0x77, 0xfc, 0x90, 0x1f, 0x91, 0xcd, 0xbc, 0xfa, 0x84, 0xcf, 0xfd, 0x19, 0x00, 0x64,
+	0x7b, 0xe5, 0xe8, 0xb1, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MemoizationCacheServiceClient is the client API for MemoizationCacheService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MemoizationCacheServiceClient interface {
+	ListMemoizationCacheEntries(ctx context.Context, in *ListMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error)
+	GetMemoizationCacheEntry(ctx context.Context, in *GetMemoizationCacheEntryRequest, opts ...grpc.CallOption) (*MemoizationCacheEntry, error)
+	// DeleteMemoizationCacheEntries deletes entries by key, and returns the deleted entries
+	DeleteMemoizationCacheEntries(ctx context.Context, in *DeleteMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error)
+	// PruneMemoizationCacheEntries deletes entries older than a duration, and returns the deleted entries
+	PruneMemoizationCacheEntries(ctx context.Context, in *PruneMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error)
+}
+
+type memoizationCacheServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMemoizationCacheServiceClient(cc *grpc.ClientConn) MemoizationCacheServiceClient {
+	return &memoizationCacheServiceClient{cc}
+}
+
+func (c *memoizationCacheServiceClient) ListMemoizationCacheEntries(ctx context.Context, in *ListMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error) {
+	out := new(MemoizationCacheEntryList)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/ListMemoizationCacheEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoizationCacheServiceClient) GetMemoizationCacheEntry(ctx context.Context, in *GetMemoizationCacheEntryRequest, opts ...grpc.CallOption) (*MemoizationCacheEntry, error) {
+	out := new(MemoizationCacheEntry)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/GetMemoizationCacheEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoizationCacheServiceClient) DeleteMemoizationCacheEntries(ctx context.Context, in *DeleteMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error) {
+	out := new(MemoizationCacheEntryList)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/DeleteMemoizationCacheEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoizationCacheServiceClient) PruneMemoizationCacheEntries(ctx context.Context, in *PruneMemoizationCacheEntriesRequest, opts ...grpc.CallOption) (*MemoizationCacheEntryList, error) {
+	out := new(MemoizationCacheEntryList)
+	err := c.cc.Invoke(ctx, "/memoizationcache.MemoizationCacheService/PruneMemoizationCacheEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoizationCacheServiceServer is the server API for MemoizationCacheService service.
+type MemoizationCacheServiceServer interface {
+	ListMemoizationCacheEntries(context.Context, *ListMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error)
+	GetMemoizationCacheEntry(context.Context, *GetMemoizationCacheEntryRequest) (*MemoizationCacheEntry, error)
+	// DeleteMemoizationCacheEntries deletes entries by key, and returns the deleted entries
+	DeleteMemoizationCacheEntries(context.Context, *DeleteMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error)
+	// PruneMemoizationCacheEntries deletes entries older than a duration, and returns the deleted entries
+	PruneMemoizationCacheEntries(context.Context, *PruneMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error)
+}
+
+// UnimplementedMemoizationCacheServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMemoizationCacheServiceServer struct {
+}
+
+func (*UnimplementedMemoizationCacheServiceServer) ListMemoizationCacheEntries(ctx context.Context, req *ListMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoizationCacheEntries not implemented")
+}
+func (*UnimplementedMemoizationCacheServiceServer) GetMemoizationCacheEntry(ctx context.Context, req *GetMemoizationCacheEntryRequest) (*MemoizationCacheEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoizationCacheEntry not implemented")
+}
+func (*UnimplementedMemoizationCacheServiceServer) DeleteMemoizationCacheEntries(ctx context.Context, req *DeleteMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoizationCacheEntries not implemented")
+}
+func (*UnimplementedMemoizationCacheServiceServer) PruneMemoizationCacheEntries(ctx context.Context, req *PruneMemoizationCacheEntriesRequest) (*MemoizationCacheEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneMemoizationCacheEntries not implemented")
+}
+
+func RegisterMemoizationCacheServiceServer(s *grpc.Server, srv MemoizationCacheServiceServer) {
+	s.RegisterService(&_MemoizationCacheService_serviceDesc, srv)
+}
+
+func _MemoizationCacheService_ListMemoizationCacheEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoizationCacheEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).ListMemoizationCacheEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/ListMemoizationCacheEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).ListMemoizationCacheEntries(ctx, req.(*ListMemoizationCacheEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoizationCacheService_GetMemoizationCacheEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoizationCacheEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).GetMemoizationCacheEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/GetMemoizationCacheEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).GetMemoizationCacheEntry(ctx, req.(*GetMemoizationCacheEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoizationCacheService_DeleteMemoizationCacheEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoizationCacheEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).DeleteMemoizationCacheEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/DeleteMemoizationCacheEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).DeleteMemoizationCacheEntries(ctx, req.(*DeleteMemoizationCacheEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoizationCacheService_PruneMemoizationCacheEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneMemoizationCacheEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoizationCacheServiceServer).PruneMemoizationCacheEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/memoizationcache.MemoizationCacheService/PruneMemoizationCacheEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoizationCacheServiceServer).PruneMemoizationCacheEntries(ctx, req.(*PruneMemoizationCacheEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MemoizationCacheService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "memoizationcache.MemoizationCacheService",
+	HandlerType: (*MemoizationCacheServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMemoizationCacheEntries",
+			Handler:    _MemoizationCacheService_ListMemoizationCacheEntries_Handler,
+		},
+		{
+			MethodName: "GetMemoizationCacheEntry",
+			Handler:    _MemoizationCacheService_GetMemoizationCacheEntry_Handler,
+		},
+		{
+			MethodName: "DeleteMemoizationCacheEntries",
+			Handler:    _MemoizationCacheService_DeleteMemoizationCacheEntries_Handler,
+		},
+		{
+			MethodName: "PruneMemoizationCacheEntries",
+			Handler:    _MemoizationCacheService_PruneMemoizationCacheEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apiclient/memoizationcache/memoization-cache.proto",
+}
+
+func (m *MemoizationCacheEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoizationCacheEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoizationCacheEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastHitTimestamp != nil {
+		{
+			size, err := m.LastHitTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreationTimestamp != nil {
+		{
+			size, err := m.CreationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Outputs != nil {
+		{
+			size, err := m.Outputs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CacheName) > 0 {
+		i -= len(m.CacheName)
+		copy(dAtA[i:], m.CacheName)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MemoizationCacheEntryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoizationCacheEntryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemoizationCacheEntryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMemoizationCache(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListMemoizationCacheEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMemoizationCacheEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMemoizationCacheEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CacheName) > 0 {
+		i -= len(m.CacheName)
+		copy(dAtA[i:], m.CacheName)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMemoizationCacheEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMemoizationCacheEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMemoizationCacheEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CacheName) > 0 {
+		i -= len(m.CacheName)
+		copy(dAtA[i:], m.CacheName)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.KeyRegex) > 0 {
+		i -= len(m.KeyRegex)
+		copy(dAtA[i:], m.KeyRegex)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.KeyRegex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CacheName) > 0 {
+		i -= len(m.CacheName)
+		copy(dAtA[i:], m.CacheName)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CacheType) > 0 {
+		i -= len(m.CacheType)
+		copy(dAtA[i:], m.CacheType)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CacheName) > 0 {
+		i -= len(m.CacheName)
+		copy(dAtA[i:], m.CacheName)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.CacheName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OlderThan) > 0 {
+		i -= len(m.OlderThan)
+		copy(dAtA[i:], m.OlderThan)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.OlderThan)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMemoizationCache(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMemoizationCache(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMemoizationCache(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MemoizationCacheEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheName)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.Outputs != nil {
+		l = m.Outputs.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.CreationTimestamp != nil {
+		l = m.CreationTimestamp.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.LastHitTimestamp != nil {
+		l = m.LastHitTimestamp.Size()
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MemoizationCacheEntryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovMemoizationCache(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListMemoizationCacheEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheName)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetMemoizationCacheEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheName)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteMemoizationCacheEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheName)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovMemoizationCache(uint64(l))
+		}
+	}
+	l = len(m.KeyRegex)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PruneMemoizationCacheEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.OlderThan)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheName)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	l = len(m.CacheType)
+	if l > 0 {
+		n += 1 + l + sovMemoizationCache(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMemoizationCache(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMemoizationCache(x uint64) (n int) {
+	return sovMemoizationCache(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MemoizationCacheEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationCacheEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationCacheEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &v1alpha1.Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationTimestamp == nil {
+				m.CreationTimestamp = &v1.Time{}
+			}
+			if err := m.CreationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHitTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastHitTimestamp == nil {
+				m.LastHitTimestamp = &v1.Time{}
+			}
+			if err := m.LastHitTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoizationCacheEntryList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoizationCacheEntryList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoizationCacheEntryList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &MemoizationCacheEntry{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMemoizationCacheEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMemoizationCacheEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMemoizationCacheEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMemoizationCacheEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMemoizationCacheEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMemoizationCacheEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteMemoizationCacheEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteMemoizationCacheEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteMemoizationCacheEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PruneMemoizationCacheEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PruneMemoizationCacheEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PruneMemoizationCacheEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OlderThan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OlderThan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMemoizationCache(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMemoizationCache
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMemoizationCache(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMemoizationCache
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMemoizationCache
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMemoizationCache
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMemoizationCache
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMemoizationCache
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMemoizationCache        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMemoizationCache          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMemoizationCache = fmt.Errorf("proto: unexpected end of group")
+)
@@ -30,11 +30,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ListArchivedWorkflowsRequest struct {
-	ListOptions          *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
-	NamePrefix           string          `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	ListOptions *v1.ListOptions `protobuf:"bytes,1,opt,name=listOptions,proto3" json:"listOptions,omitempty"`
+	NamePrefix  string          `protobuf:"bytes,2,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	// Query is a search over the fields of archived workflows, e.g. `phase=Failed message~"out of memory" parameters.env=prod`
+	Query                string   `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListArchivedWorkflowsRequest) Reset()         { *m = ListArchivedWorkflowsRequest{} }
@@ -84,6 +86,13 @@ func (m *ListArchivedWorkflowsRequest) GetNamePrefix() string {
 	return ""
 }
 
+func (m *ListArchivedWorkflowsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
 type GetArchivedWorkflowRequest struct {
 	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -471,54 +480,55 @@ func init() {
 }
 
 var fileDescriptor_95ca9a2d33e8bb19 = []byte{
-	// 747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0xc7, 0x35, 0x6d, 0xef, 0x55, 0x3b, 0x5d, 0xdc, 0xcb, 0xa0, 0x42, 0x64, 0xa5, 0x69, 0xb0,
-	0xa0, 0x4d, 0x5b, 0x32, 0x6e, 0xda, 0x22, 0x50, 0x57, 0x80, 0x10, 0x48, 0xf4, 0x03, 0xe4, 0x48,
-	0x20, 0xb1, 0x41, 0x13, 0xfb, 0x34, 0x19, 0xe2, 0x78, 0x8c, 0x67, 0xec, 0x52, 0x50, 0x17, 0xc0,
-	0x23, 0xf4, 0x15, 0x78, 0x08, 0xc4, 0x82, 0x1d, 0x12, 0x2b, 0x84, 0x60, 0xc7, 0x0a, 0x55, 0x3c,
-	0x08, 0xb2, 0x13, 0x27, 0x25, 0x71, 0x3e, 0x24, 0xd2, 0x55, 0xc6, 0x67, 0x8e, 0x8f, 0x7f, 0xff,
-	0x93, 0xf9, 0x1f, 0x0d, 0xde, 0xf4, 0xea, 0x55, 0x83, 0x79, 0xdc, 0x72, 0x38, 0xb8, 0xca, 0x38,
-	0x10, 0x7e, 0x7d, 0xdf, 0x11, 0x07, 0xcc, 0xb7, 0x6a, 0x3c, 0x84, 0xf6, 0x73, 0xb1, 0x15, 0xa0,
-	0x9e, 0x2f, 0x94, 0x20, 0xff, 0x75, 0xe5, 0x69, 0xd9, 0xaa, 0x10, 0x55, 0x07, 0xa2, 0x4a, 0x06,
-	0x73, 0x5d, 0xa1, 0x98, 0xe2, 0xc2, 0x95, 0xcd, 0x74, 0x6d, 0xb3, 0x7e, 0x43, 0x52, 0x2e, 0xa2,
-	0xdd, 0x06, 0xb3, 0x6a, 0xdc, 0x05, 0xff, 0xd0, 0x68, 0x7d, 0x58, 0x1a, 0x0d, 0x50, 0xcc, 0x08,
-	0x4b, 0x46, 0x15, 0x5c, 0xf0, 0x99, 0x02, 0xbb, 0xf5, 0xd6, 0x6e, 0x95, 0xab, 0x5a, 0x50, 0xa1,
-	0x96, 0x68, 0x18, 0xcc, 0xaf, 0x0a, 0xcf, 0x17, 0xcf, 0xe2, 0x45, 0x31, 0xf9, 0xba, 0xec, 0x14,
-	0x49, 0x42, 0x46, 0x58, 0x62, 0x8e, 0x57, 0x63, 0x3d, 0xe5, 0xf4, 0x63, 0x84, 0xb3, 0x3b, 0x5c,
-	0xaa, 0x5b, 0x4d, 0x64, 0xfb, 0x71, 0x52, 0xc4, 0x84, 0xe7, 0x01, 0x48, 0x45, 0xca, 0x78, 0xd6,
-	0xe1, 0x52, 0x3d, 0xf0, 0x62, 0xf4, 0x0c, 0xca, 0xa3, 0xc2, 0xec, 0x7a, 0x89, 0x36, 0xd9, 0xe9,
-	0x69, 0x76, 0xea, 0xd5, 0xab, 0x51, 0x40, 0xd2, 0x88, 0x9d, 0x86, 0x25, 0xba, 0xd3, 0x79, 0xd1,
-	0x3c, 0x5d, 0x85, 0xe4, 0x30, 0x76, 0x59, 0x03, 0x1e, 0xfa, 0xb0, 0xcf, 0x5f, 0x64, 0x26, 0xf2,
-	0xa8, 0x30, 0x63, 0x9e, 0x8a, 0xe8, 0x14, 0x6b, 0xf7, 0xa0, 0x87, 0x29, 0x41, 0xfa, 0x1f, 0x4f,
-	0x06, 0xdc, 0x8e, 0x51, 0x66, 0xcc, 0x68, 0xa9, 0x97, 0xf0, 0xfc, 0x1d, 0x70, 0x40, 0xc1, 0xe8,
-	0xaf, 0x5c, 0xc2, 0x0b, 0xdd, 0xc9, 0xcd, 0x12, 0xb6, 0x09, 0xd2, 0x13, 0xae, 0x04, 0x7d, 0x11,
-	0x5f, 0x4e, 0x6b, 0xcd, 0x0e, 0xab, 0x80, 0xb3, 0x0d, 0x87, 0x49, 0x8b, 0xf4, 0x23, 0xbc, 0xd8,
-	0x37, 0xef, 0x11, 0x73, 0x02, 0x38, 0xd3, 0x66, 0xea, 0x1f, 0x11, 0xce, 0x9a, 0xa0, 0xfc, 0xc3,
-	0x91, 0xc5, 0x13, 0x82, 0xa7, 0xa2, 0x6e, 0xb7, 0x3a, 0x1f, 0xaf, 0x49, 0x16, 0xcf, 0x44, 0xbf,
-	0xd2, 0x63, 0x16, 0x64, 0x26, 0xe3, 0x8d, 0x4e, 0x80, 0x5c, 0xc5, 0xe7, 0x7c, 0x90, 0x8a, 0xf9,
-	0xaa, 0x1c, 0x58, 0x16, 0x48, 0xb9, 0x1f, 0x38, 0x99, 0xa9, 0x3c, 0x2a, 0x4c, 0x9b, 0xbd, 0x1b,
-	0x51, 0xb6, 0x2b, 0x6c, 0xb8, 0xcb, 0xc1, 0xb1, 0xcb, 0xe0, 0x80, 0xa5, 0x84, 0x9f, 0xf9, 0x27,
-	0xae, 0xd9, 0xbb, 0xa1, 0xbf, 0x46, 0x78, 0xc1, 0x04, 0x19, 0x54, 0x1a, 0x5c, 0x9d, 0xa5, 0x06,
-	0x0d, 0x4f, 0x37, 0xa0, 0x21, 0xf8, 0x4b, 0xb0, 0x5b, 0xe8, 0xed, 0xe7, 0xf5, 0xb7, 0xb3, 0xf8,
-	0x62, 0xf7, 0xb7, 0xcb, 0xe0, 0x87, 0xdc, 0x02, 0xf2, 0x01, 0xe1, 0xb9, 0x54, 0x8f, 0x90, 0x22,
-	0xed, 0xb2, 0x3c, 0x1d, 0xe4, 0x25, 0x6d, 0x8f, 0x76, 0xcc, 0x4b, 0x13, 0xf3, 0xc6, 0x8b, 0xa7,
-	0x6d, 0xf3, 0xd2, 0x70, 0xa3, 0xf3, 0xdf, 0x27, 0x51, 0x9a, 0xf8, 0x97, 0xb6, 0x0f, 0x17, 0x97,
-	0x4a, 0xd7, 0xdf, 0x7c, 0xff, 0x75, 0x3c, 0x91, 0x25, 0x5a, 0x3c, 0x61, 0xc2, 0x92, 0xd1, 0xa2,
-	0xb0, 0x3b, 0xb3, 0x80, 0xbc, 0x47, 0xf8, 0x7c, 0x8a, 0x97, 0xc8, 0x6a, 0x0f, 0x7a, 0x7f, 0xc7,
-	0x69, 0xf7, 0xc7, 0x07, 0xae, 0x17, 0x62, 0x68, 0x9d, 0xe4, 0xfb, 0x43, 0x1b, 0xaf, 0x02, 0x6e,
-	0x1f, 0x91, 0x77, 0x08, 0x5f, 0x48, 0xb7, 0x35, 0xa1, 0x3d, 0xf4, 0x03, 0xfd, 0xaf, 0xad, 0xf5,
-	0xe4, 0x0f, 0x33, 0x7f, 0x0b, 0x73, 0x65, 0x38, 0xe6, 0x37, 0x84, 0xe7, 0x07, 0xce, 0x09, 0x72,
-	0x6d, 0xa4, 0x63, 0xd2, 0x3d, 0x57, 0xb4, 0xed, 0xbf, 0xef, 0x7a, 0xbb, 0xa6, 0x5e, 0x8c, 0xf5,
-	0x2c, 0x91, 0x2b, 0xfd, 0xf5, 0x14, 0x9d, 0x28, 0xbb, 0x58, 0x8f, 0x90, 0x7f, 0x20, 0xbc, 0x30,
-	0x64, 0xa8, 0x91, 0xeb, 0xa3, 0xcb, 0xfa, 0x63, 0x0c, 0x6a, 0xbb, 0x63, 0x12, 0xd6, 0xac, 0xaa,
-	0x1b, 0xb1, 0xb4, 0x65, 0xb2, 0x34, 0x54, 0x5a, 0xd8, 0x04, 0xff, 0x84, 0xf0, 0x5c, 0xea, 0xc4,
-	0x4c, 0x31, 0xf4, 0xa0, 0xc9, 0x3a, 0x56, 0x5f, 0x94, 0x62, 0x15, 0xab, 0xda, 0xe2, 0xb0, 0x03,
-	0x67, 0xf8, 0x11, 0xd2, 0x16, 0x5a, 0x21, 0x5f, 0x10, 0xce, 0xf4, 0x1b, 0x9c, 0x64, 0x2d, 0x45,
-	0xca, 0xc0, 0x19, 0x3b, 0x56, 0x35, 0x9b, 0xb1, 0x1a, 0xaa, 0x2d, 0x8f, 0xa0, 0xa6, 0x49, 0xb5,
-	0x85, 0x56, 0x6e, 0xef, 0x7d, 0x3e, 0xc9, 0xa1, 0xaf, 0x27, 0x39, 0xf4, 0xf3, 0x24, 0x87, 0x9e,
-	0xdc, 0x1c, 0xfd, 0xaa, 0x93, 0x7e, 0x51, 0xab, 0xfc, 0x1b, 0x5f, 0x72, 0x36, 0x7e, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0xd2, 0xb2, 0x06, 0xc2, 0xd0, 0x09, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4d, 0x6f, 0xd3, 0x48,
+	0x18, 0xc7, 0x35, 0x7d, 0x59, 0xb5, 0xd3, 0xc3, 0xee, 0xce, 0x6e, 0x77, 0x23, 0x2b, 0x4d, 0xb3,
+	0xd6, 0x6e, 0x9b, 0xb6, 0x9b, 0x71, 0xd3, 0x76, 0xb5, 0xa8, 0x27, 0x40, 0x08, 0x24, 0xfa, 0x02,
+	0x72, 0x24, 0x90, 0xb8, 0x20, 0xc7, 0x7e, 0x9a, 0x0c, 0x71, 0x3c, 0xae, 0x67, 0xec, 0x12, 0x50,
+	0x0f, 0xc0, 0x47, 0xe0, 0x2b, 0x70, 0xe1, 0x1b, 0x20, 0x0e, 0xdc, 0x90, 0x38, 0x21, 0x04, 0x37,
+	0x4e, 0xa8, 0xe2, 0x83, 0x20, 0xbf, 0x25, 0x25, 0x71, 0x5e, 0x24, 0xd2, 0x93, 0xc7, 0xcf, 0x3c,
+	0x7e, 0xfc, 0xfb, 0xcf, 0xcc, 0xff, 0xd1, 0xe0, 0x1d, 0xb7, 0x59, 0xd7, 0x0c, 0x97, 0x99, 0x36,
+	0x03, 0x47, 0x6a, 0x27, 0xdc, 0x6b, 0x1e, 0xd9, 0xfc, 0xc4, 0xf0, 0xcc, 0x06, 0x0b, 0xa0, 0xf3,
+	0x5e, 0x4e, 0x02, 0xd4, 0xf5, 0xb8, 0xe4, 0xe4, 0xe7, 0x9e, 0x3c, 0x25, 0x5f, 0xe7, 0xbc, 0x6e,
+	0x43, 0x58, 0x49, 0x33, 0x1c, 0x87, 0x4b, 0x43, 0x32, 0xee, 0x88, 0x38, 0x5d, 0xd9, 0x69, 0x5e,
+	0x12, 0x94, 0xf1, 0x70, 0xb6, 0x65, 0x98, 0x0d, 0xe6, 0x80, 0xd7, 0xd6, 0x92, 0x1f, 0x0b, 0xad,
+	0x05, 0xd2, 0xd0, 0x82, 0x8a, 0x56, 0x07, 0x07, 0x3c, 0x43, 0x82, 0x95, 0x7c, 0x75, 0x50, 0x67,
+	0xb2, 0xe1, 0xd7, 0xa8, 0xc9, 0x5b, 0x9a, 0xe1, 0xd5, 0xb9, 0xeb, 0xf1, 0x07, 0xd1, 0xa0, 0x9c,
+	0xfe, 0x5d, 0x74, 0x8b, 0xa4, 0x21, 0x2d, 0xa8, 0x18, 0xb6, 0xdb, 0x30, 0xfa, 0xca, 0xa9, 0x2f,
+	0x11, 0xce, 0xef, 0x33, 0x21, 0xaf, 0xc4, 0xc8, 0xd6, 0xdd, 0xb4, 0x88, 0x0e, 0xc7, 0x3e, 0x08,
+	0x49, 0xaa, 0x78, 0xc1, 0x66, 0x42, 0xde, 0x72, 0x23, 0xf4, 0x1c, 0x2a, 0xa2, 0xd2, 0xc2, 0x56,
+	0x85, 0xc6, 0xec, 0xf4, 0x3c, 0x3b, 0x75, 0x9b, 0xf5, 0x30, 0x20, 0x68, 0xc8, 0x4e, 0x83, 0x0a,
+	0xdd, 0xef, 0x7e, 0xa8, 0x9f, 0xaf, 0x42, 0x0a, 0x18, 0x3b, 0x46, 0x0b, 0x6e, 0x7b, 0x70, 0xc4,
+	0x1e, 0xe6, 0xa6, 0x8a, 0xa8, 0x34, 0xaf, 0x9f, 0x8b, 0x90, 0xdf, 0xf1, 0xec, 0xb1, 0x0f, 0x5e,
+	0x3b, 0x37, 0x1d, 0x4d, 0xc5, 0x2f, 0x2a, 0xc5, 0xca, 0x0d, 0xe8, 0x23, 0x4d, 0x41, 0x7f, 0xc1,
+	0xd3, 0x3e, 0xb3, 0x22, 0xc0, 0x79, 0x3d, 0x1c, 0xaa, 0x15, 0xbc, 0x74, 0x0d, 0x6c, 0x90, 0x30,
+	0xfe, 0x27, 0x7f, 0xe1, 0xe5, 0xde, 0xe4, 0xb8, 0x84, 0xa5, 0x83, 0x70, 0xb9, 0x23, 0x40, 0x5d,
+	0xc1, 0x7f, 0x67, 0x2d, 0xd8, 0xbe, 0x51, 0x03, 0x7b, 0x0f, 0xda, 0xe9, 0xc2, 0xa9, 0xa7, 0x78,
+	0x65, 0x60, 0xde, 0x1d, 0xc3, 0xf6, 0xe1, 0x42, 0x97, 0x58, 0x7d, 0x83, 0x70, 0x5e, 0x07, 0xe9,
+	0xb5, 0xc7, 0x16, 0x4f, 0x08, 0x9e, 0x09, 0xf7, 0x20, 0xd9, 0x8f, 0x68, 0x4c, 0xf2, 0x78, 0x3e,
+	0x7c, 0x0a, 0xd7, 0x30, 0x21, 0xd9, 0x8d, 0x6e, 0x80, 0xfc, 0x8b, 0x7f, 0xf5, 0x40, 0x48, 0xc3,
+	0x93, 0x55, 0xdf, 0x34, 0x41, 0x88, 0x23, 0xdf, 0xce, 0xcd, 0x14, 0x51, 0x69, 0x4e, 0xef, 0x9f,
+	0x08, 0xb3, 0x1d, 0x6e, 0xc1, 0x75, 0x06, 0xb6, 0x55, 0x05, 0x1b, 0x4c, 0xc9, 0xbd, 0xdc, 0x6c,
+	0x54, 0xb3, 0x7f, 0x42, 0x7d, 0x82, 0xf0, 0xb2, 0x0e, 0xc2, 0xaf, 0xb5, 0x98, 0xbc, 0x48, 0x0d,
+	0x0a, 0x9e, 0x6b, 0x41, 0x8b, 0xb3, 0x47, 0x60, 0x25, 0xe8, 0x9d, 0xf7, 0xad, 0x67, 0x0b, 0xf8,
+	0xcf, 0xde, 0x7f, 0x57, 0xc1, 0x0b, 0x98, 0x09, 0xe4, 0x35, 0xc2, 0x8b, 0x99, 0xce, 0x21, 0x65,
+	0xda, 0xd3, 0x08, 0xe8, 0x30, 0x87, 0x29, 0x87, 0xb4, 0x6b, 0x69, 0x9a, 0x5a, 0x3a, 0x1a, 0xdc,
+	0xef, 0x58, 0x9a, 0x06, 0xdb, 0xdd, 0xbd, 0x4f, 0xa3, 0x34, 0x75, 0x35, 0xed, 0x1c, 0x2e, 0x26,
+	0xa4, 0xaa, 0x3e, 0xfd, 0xf4, 0xf5, 0xf9, 0x54, 0x9e, 0x28, 0x51, 0xdf, 0x09, 0x2a, 0x5a, 0x42,
+	0x61, 0x75, 0x3b, 0x04, 0x79, 0x85, 0xf0, 0x6f, 0x19, 0x5e, 0x22, 0x1b, 0x7d, 0xe8, 0x83, 0x1d,
+	0xa7, 0xdc, 0x9c, 0x1c, 0xb8, 0x5a, 0x8a, 0xa0, 0x55, 0x52, 0x1c, 0x0c, 0xad, 0x3d, 0xf6, 0x99,
+	0x75, 0x4a, 0x5e, 0x20, 0xfc, 0x47, 0xb6, 0xad, 0x09, 0xed, 0xa3, 0x1f, 0xea, 0x7f, 0x65, 0xb3,
+	0x2f, 0x7f, 0x94, 0xf9, 0x13, 0xcc, 0xf5, 0xd1, 0x98, 0x1f, 0x11, 0x5e, 0x1a, 0xda, 0x27, 0xc8,
+	0x7f, 0x63, 0x1d, 0x93, 0xde, 0xbe, 0xa2, 0xec, 0xfd, 0xf8, 0xaa, 0x77, 0x6a, 0xaa, 0xe5, 0x48,
+	0xcf, 0x2a, 0xf9, 0x67, 0xb0, 0x9e, 0xb2, 0x1d, 0x66, 0x97, 0x9b, 0x21, 0xf2, 0x67, 0x84, 0x97,
+	0x47, 0x34, 0x35, 0xf2, 0xff, 0xf8, 0xb2, 0xbe, 0x6b, 0x83, 0xca, 0xc1, 0x84, 0x84, 0xc5, 0x55,
+	0x55, 0x2d, 0x92, 0xb6, 0x46, 0x56, 0x47, 0x4a, 0x0b, 0x62, 0xf0, 0xb7, 0x08, 0x2f, 0x66, 0x76,
+	0xcc, 0x0c, 0x43, 0x0f, 0xeb, 0xac, 0x13, 0xf5, 0x45, 0x25, 0x52, 0xb1, 0xa1, 0xac, 0x8c, 0x3a,
+	0x70, 0x9a, 0x17, 0x22, 0xed, 0xa2, 0x75, 0xf2, 0x1e, 0xe1, 0xdc, 0xa0, 0xc6, 0x49, 0x36, 0x33,
+	0xa4, 0x0c, 0xed, 0xb1, 0x13, 0x55, 0xb3, 0x13, 0xa9, 0xa1, 0xca, 0xda, 0x18, 0x6a, 0x62, 0xaa,
+	0x5d, 0xb4, 0x7e, 0xf5, 0xf0, 0xdd, 0x59, 0x01, 0x7d, 0x38, 0x2b, 0xa0, 0x2f, 0x67, 0x05, 0x74,
+	0xef, 0xf2, 0xf8, 0x17, 0xa0, 0xec, 0xeb, 0x5b, 0xed, 0xa7, 0xe8, 0xea, 0xb3, 0xfd, 0x6d, 0x00,
+	0xc4, 0x0c, 0xa3, 0x5d, 0xe6, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -841,6 +851,13 @@ func (m *ListArchivedWorkflowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintWorkflowArchive(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.NamePrefix) > 0 {
 		i -= len(m.NamePrefix)
 		copy(dAtA[i:], m.NamePrefix)
@@ -1172,6 +1189,10 @@ func (m *ListArchivedWorkflowsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkflowArchive(uint64(l))
 	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovWorkflowArchive(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1411,6 +1432,38 @@ func (m *ListArchivedWorkflowsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.NamePrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkflowArchive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWorkflowArchive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkflowArchive(dAtA[iNdEx:])
@@ -27,6 +27,14 @@ func TestArtifactRepository(t *testing.T) {
 			assert.Equal(t, "http://my-repo/{{workflow.name}}/{{pod.name}}", l.Artifactory.URL)
 		}
 	})
+	t.Run("Azure", func(t *testing.T) {
+		r := &ArtifactRepository{Azure: &AzureArtifactRepository{}}
+		assert.IsType(t, &AzureArtifactRepository{}, r.Get())
+		l := r.ToArtifactLocation()
+		if assert.NotNil(t, l.Azure) {
+			assert.Equal(t, "{{workflow.name}}/{{pod.name}}", l.Azure.Blob)
+		}
+	})
 	t.Run("GCS", func(t *testing.T) {
 		r := &ArtifactRepository{GCS: &GCSArtifactRepository{}}
 		assert.IsType(t, &GCSArtifactRepository{}, r.Get())
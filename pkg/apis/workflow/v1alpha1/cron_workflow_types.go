@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"strings"
+
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,8 +44,8 @@ const annotationKeyLatestSchedule = workflow.CronWorkflowFullName + "/last-used-
 type CronWorkflowSpec struct {
 	// WorkflowSpec is the spec of the workflow to be run
 	WorkflowSpec WorkflowSpec `json:"workflowSpec" protobuf:"bytes,1,opt,name=workflowSpec,casttype=WorkflowSpec"`
-	// Schedule is a schedule to run the Workflow in Cron format
-	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Schedule is a schedule to run the Workflow in Cron format. Exactly one of this or Schedules must be set.
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
 	// ConcurrencyPolicy is the K8s-style concurrency policy that will be used
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,3,opt,name=concurrencyPolicy,casttype=ConcurrencyPolicy"`
 	// Suspend is a flag that will stop new CronWorkflows from running if set to true
@@ -59,6 +61,37 @@ type CronWorkflowSpec struct {
 	Timezone string `json:"timezone,omitempty" protobuf:"bytes,8,opt,name=timezone"`
 	// WorkflowMetadata contains some metadata of the workflow to be run
 	WorkflowMetadata *metav1.ObjectMeta `json:"workflowMetadata,omitempty" protobuf:"bytes,9,opt,name=workflowMeta"`
+	// Schedules is a list of schedules to run the Workflow in Cron format, e.g. one for weekdays and one for weekends.
+	// A Workflow is run at each time any of them is due, once if several are due at the same time.
+	Schedules []string `json:"schedules,omitempty" protobuf:"bytes,10,rep,name=schedules"`
+	// Exclusions are the dates on which no Workflow is run, in the timezone of the schedules
+	Exclusions *CronWorkflowExclusions `json:"exclusions,omitempty" protobuf:"bytes,11,opt,name=exclusions"`
+	// StopStrategy is when the CronWorkflow stops scheduling Workflows, by suspending itself
+	StopStrategy *StopStrategy `json:"stopStrategy,omitempty" protobuf:"bytes,12,opt,name=stopStrategy"`
+	// When is an expression that must be true for a scheduled Workflow to run, e.g. `cronworkflow.consecutiveFailed < 3`
+	When string `json:"when,omitempty" protobuf:"bytes,13,opt,name=when"`
+}
+
+// StopStrategy is when a CronWorkflow stops scheduling Workflows. It stops as soon as any of the fields that are set
+// is met.
+type StopStrategy struct {
+	// Succeeded is the number of Workflows that must succeed for the CronWorkflow to stop
+	Succeeded int64 `json:"succeeded,omitempty" protobuf:"varint,1,opt,name=succeeded"`
+	// Failed is the number of Workflows that must fail or error for the CronWorkflow to stop
+	Failed int64 `json:"failed,omitempty" protobuf:"varint,2,opt,name=failed"`
+	// EndTime is the time after which the CronWorkflow stops
+	EndTime *metav1.Time `json:"endTime,omitempty" protobuf:"bytes,3,opt,name=endTime"`
+	// Expression is an expression that stops the CronWorkflow when it is true, e.g. `cronworkflow.consecutiveFailed >= 3`
+	Expression string `json:"expression,omitempty" protobuf:"bytes,4,opt,name=expression"`
+}
+
+// CronWorkflowExclusions is a calendar of dates on which a CronWorkflow does not run Workflows, e.g. public holidays
+type CronWorkflowExclusions struct {
+	// Dates are the excluded dates, in the format YYYY-MM-DD
+	Dates []string `json:"dates,omitempty" protobuf:"bytes,1,rep,name=dates"`
+	// ConfigMapKeyRef is a key of a ConfigMap in the namespace of the CronWorkflow whose value is a list of excluded
+	// dates in the same format, one per line. Empty lines and lines starting with '#' are ignored.
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,2,opt,name=configMapKeyRef"`
 }
 
 // CronWorkflowStatus is the status of a CronWorkflow
@@ -69,6 +102,55 @@ type CronWorkflowStatus struct {
 	LastScheduledTime *metav1.Time `json:"lastScheduledTime" protobuf:"bytes,2,opt,name=lastScheduledTime"`
 	// Conditions is a list of conditions the CronWorkflow may have
 	Conditions Conditions `json:"conditions" protobuf:"bytes,3,rep,name=conditions"`
+	// Backfill is the status of the latest backfill of the CronWorkflow
+	Backfill *CronWorkflowBackfillStatus `json:"backfill,omitempty" protobuf:"bytes,4,opt,name=backfill"`
+	// LastSkipped is the latest scheduled time that no Workflow was run for, and why
+	LastSkipped *CronWorkflowSkip `json:"lastSkipped,omitempty" protobuf:"bytes,5,opt,name=lastSkipped"`
+	// Succeeded is the number of scheduled Workflows that succeeded
+	Succeeded int64 `json:"succeeded,omitempty" protobuf:"varint,6,opt,name=succeeded"`
+	// Failed is the number of scheduled Workflows that failed or errored
+	Failed int64 `json:"failed,omitempty" protobuf:"varint,7,opt,name=failed"`
+	// ConsecutiveFailed is the number of scheduled Workflows that failed or errored since the last one that succeeded
+	ConsecutiveFailed int64 `json:"consecutiveFailed,omitempty" protobuf:"varint,8,opt,name=consecutiveFailed"`
+}
+
+// CronWorkflowSkip is a scheduled time that no Workflow was run for
+type CronWorkflowSkip struct {
+	// ScheduledTime is the time the Workflow was scheduled to run
+	ScheduledTime metav1.Time `json:"scheduledTime" protobuf:"bytes,1,opt,name=scheduledTime"`
+	// Reason is why no Workflow was run
+	Reason string `json:"reason" protobuf:"bytes,2,opt,name=reason"`
+}
+
+// CronWorkflowBackfill runs a Workflow for each time the schedule would have run a Workflow in a time range
+type CronWorkflowBackfill struct {
+	// From is the start of the time range, inclusive
+	From metav1.Time `json:"from" protobuf:"bytes,1,opt,name=from"`
+	// To is the end of the time range, inclusive
+	To metav1.Time `json:"to" protobuf:"bytes,2,opt,name=to"`
+	// Parallelism is the maximum number of backfilled Workflows that run at the same time, default 1
+	Parallelism int32 `json:"parallelism,omitempty" protobuf:"varint,3,opt,name=parallelism"`
+}
+
+// CronWorkflowBackfillStatus is the status of a backfill
+type CronWorkflowBackfillStatus struct {
+	CronWorkflowBackfill `json:",inline" protobuf:"bytes,1,opt,name=backfill"`
+	// NextScheduledTime is the scheduled time of the next Workflow to run, unset once all of them were submitted
+	NextScheduledTime *metav1.Time `json:"nextScheduledTime,omitempty" protobuf:"bytes,2,opt,name=nextScheduledTime"`
+	// Submitted is the number of Workflows that were submitted. Scheduled times that the schedule already ran a
+	// Workflow for are skipped.
+	Submitted int32 `json:"submitted,omitempty" protobuf:"varint,3,opt,name=submitted"`
+	// Active is a list of the backfilled Workflows that are running
+	Active []v1.ObjectReference `json:"active,omitempty" protobuf:"bytes,4,rep,name=active"`
+	// StartedAt is the time the backfill was started
+	StartedAt metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,5,opt,name=startedAt"`
+	// FinishedAt is the time all the backfilled Workflows were fulfilled
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
+}
+
+// Running returns whether the backfill has Workflows to submit, or that are running
+func (b *CronWorkflowBackfillStatus) Running() bool {
+	return b != nil && b.FinishedAt == nil
 }
 
 func (c *CronWorkflow) IsUsingNewSchedule() bool {
@@ -89,12 +171,29 @@ func (c *CronWorkflow) GetLatestSchedule() string {
 	return c.Annotations[annotationKeyLatestSchedule]
 }
 
-func (c *CronWorkflowSpec) GetScheduleString() string {
-	scheduleString := c.Schedule
-	if c.Timezone != "" {
-		scheduleString = "CRON_TZ=" + c.Timezone + " " + scheduleString
+// GetSchedules returns the schedules of the CronWorkflow
+func (c *CronWorkflowSpec) GetSchedules() []string {
+	if c.Schedule != "" {
+		return append([]string{c.Schedule}, c.Schedules...)
+	}
+	return c.Schedules
+}
+
+// GetSchedulesWithTimezone returns the schedules of the CronWorkflow, prefixed with its timezone if it has one
+func (c *CronWorkflowSpec) GetSchedulesWithTimezone() []string {
+	var schedules []string
+	for _, schedule := range c.GetSchedules() {
+		if c.Timezone != "" {
+			schedule = "CRON_TZ=" + c.Timezone + " " + schedule
+		}
+		schedules = append(schedules, schedule)
 	}
-	return scheduleString
+	return schedules
+}
+
+// GetScheduleString returns the schedules of the CronWorkflow with its timezone, separated by commas
+func (c *CronWorkflowSpec) GetScheduleString() string {
+	return strings.Join(c.GetSchedulesWithTimezone(), ",")
 }
 
 func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
@@ -109,4 +208,6 @@ func (c *CronWorkflowStatus) HasActiveUID(uid types.UID) bool {
 const (
 	// ConditionTypeSubmissionError signifies that there was an error when submitting the CronWorkflow as a Workflow
 	ConditionTypeSubmissionError ConditionType = "SubmissionError"
+	// ConditionTypeStopped signifies that the CronWorkflow was suspended because its stop strategy was met
+	ConditionTypeStopped ConditionType = "Stopped"
 )
@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	apiv1 "k8s.io/api/core/v1"
+)
+
 // Data is a data template
 type Data struct {
 	// Source sources external data into a data template
@@ -18,15 +22,108 @@ func (ds *DataSource) GetArtifactIfNeeded() (*Artifact, bool) {
 
 type Transformation []TransformationStep
 
+// TransformationStep is a single step of a transformation. Exactly one field must be set.
 type TransformationStep struct {
 	// Expression defines an expr expression to apply
-	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+	Expression string `json:"expression,omitempty" protobuf:"bytes,1,opt,name=expression"`
+
+	// JQ defines a jq filter to apply. The results of the filter are always returned as a list, even if there is only one.
+	JQ string `json:"jq,omitempty" protobuf:"bytes,2,opt,name=jq"`
+
+	// JSONPath defines a JSONPath template to apply (e.g. "{.items[*].metadata.name}"). Like the results of a jq filter,
+	// the results of the template are always returned as a list.
+	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,3,opt,name=jsonPath"`
+
+	// Parse parses a string into an object
+	Parse *ParseTransformation `json:"parse,omitempty" protobuf:"bytes,4,opt,name=parse"`
+
+	// Sort sorts a list
+	Sort *SortTransformation `json:"sort,omitempty" protobuf:"bytes,5,opt,name=sort"`
+
+	// Unique removes the duplicates from a list, keeping the first occurrence
+	Unique *UniqueTransformation `json:"unique,omitempty" protobuf:"bytes,6,opt,name=unique"`
+
+	// GroupBy groups the items of a list into a list of {"key": key, "items": [items]}, in order of first occurrence
+	GroupBy *GroupByTransformation `json:"groupBy,omitempty" protobuf:"bytes,7,opt,name=groupBy"`
+
+	// Chunk splits a list into a list of lists of at most chunk.size items
+	Chunk *ChunkTransformation `json:"chunk,omitempty" protobuf:"bytes,8,opt,name=chunk"`
+}
+
+// ParseFormat is the format of the string parsed by a parse transformation
+type ParseFormat string
+
+const (
+	ParseFormatJSON ParseFormat = "json"
+	ParseFormatYAML ParseFormat = "yaml"
+	ParseFormatCSV  ParseFormat = "csv"
+)
+
+// ParseTransformation parses a string into an object
+type ParseTransformation struct {
+	// Format of the string, one of "json", "yaml" or "csv". CSV is parsed into a list of maps, keyed by the header row.
+	Format ParseFormat `json:"format" protobuf:"bytes,1,opt,name=format,casttype=ParseFormat"`
+}
+
+// SortTransformation sorts a list
+type SortTransformation struct {
+	// Key is an expr expression evaluated against each item (as "item") to sort by. Defaults to the item itself.
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
+
+	// Descending sorts in descending order
+	Descending bool `json:"descending,omitempty" protobuf:"varint,2,opt,name=descending"`
+}
+
+// UniqueTransformation removes the duplicates from a list
+type UniqueTransformation struct {
+	// Key is an expr expression evaluated against each item (as "item") to compare by. Defaults to the item itself.
+	Key string `json:"key,omitempty" protobuf:"bytes,1,opt,name=key"`
+}
+
+// GroupByTransformation groups the items of a list
+type GroupByTransformation struct {
+	// Key is an expr expression evaluated against each item (as "item") to group by
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+}
+
+// ChunkTransformation splits a list into chunks
+type ChunkTransformation struct {
+	// ChunkSize is the maximum number of items in each chunk
+	ChunkSize int32 `json:"size" protobuf:"varint,1,opt,name=size"`
 }
 
 // DataSource sources external data into a data template
 type DataSource struct {
 	// ArtifactPaths is a data transformation that collects a list of artifact paths
 	ArtifactPaths *ArtifactPaths `json:"artifactPaths,omitempty" protobuf:"bytes,1,opt,name=artifactPaths"`
+
+	// ConfigMapKeyRef sources the value of a ConfigMap key. JSON and YAML values are parsed into objects.
+	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,2,opt,name=configMapKeyRef"`
+
+	// SecretKeyRef sources the value of a Secret key. JSON and YAML values are parsed into objects.
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,3,opt,name=secretKeyRef"`
+
+	// HTTP sources the body of an HTTP response. JSON and YAML bodies are parsed into objects.
+	HTTP *HTTP `json:"http,omitempty" protobuf:"bytes,4,opt,name=http"`
+
+	// Parameters sources a map of parameter names to their values, typically the output parameters of earlier nodes
+	// (e.g. '{{steps.mystep.outputs.parameters.myparam}}'). JSON and YAML values are parsed into objects.
+	Parameters []Parameter `json:"parameters,omitempty" protobuf:"bytes,5,rep,name=parameters"`
+
+	// Resources sources the list of Kubernetes objects matching a label selector
+	Resources *ResourcesSource `json:"resources,omitempty" protobuf:"bytes,6,opt,name=resources"`
+}
+
+// ResourcesSource lists Kubernetes objects
+type ResourcesSource struct {
+	// Resource is the type of the objects to list, as understood by `kubectl get` (e.g. "pods" or "deployments.apps")
+	Resource string `json:"resource" protobuf:"bytes,1,opt,name=resource"`
+
+	// Namespace to list the objects in. Defaults to the namespace of the workflow.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+
+	// LabelSelector selects the objects to list (e.g. "app=my-app")
+	LabelSelector string `json:"labelSelector,omitempty" protobuf:"bytes,3,opt,name=labelSelector"`
 }
 
 // ArtifactPaths expands a step from a collection of artifacts
@@ -37,4 +134,8 @@ type ArtifactPaths struct {
 
 type DataSourceProcessor interface {
 	ProcessArtifactPaths(*ArtifactPaths) (interface{}, error)
+	ProcessConfigMapKeyRef(*apiv1.ConfigMapKeySelector) (string, error)
+	ProcessSecretKeyRef(*apiv1.SecretKeySelector) (string, error)
+	ProcessHTTP(*HTTP) (string, error)
+	ProcessResources(*ResourcesSource) (interface{}, error)
 }
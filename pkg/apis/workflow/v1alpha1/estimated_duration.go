@@ -12,3 +12,13 @@ func (d EstimatedDuration) ToDuration() time.Duration {
 func NewEstimatedDuration(d time.Duration) EstimatedDuration {
 	return EstimatedDuration(d.Seconds())
 }
+
+// EstimatedDurationPercentiles is the distribution of the durations of previous runs, in seconds
+type EstimatedDurationPercentiles struct {
+	// P50 is the median duration
+	P50 EstimatedDuration `json:"p50,omitempty" protobuf:"varint,1,opt,name=p50,casttype=EstimatedDuration"`
+	// P90 is the duration that 90% of previous runs completed within
+	P90 EstimatedDuration `json:"p90,omitempty" protobuf:"varint,2,opt,name=p90,casttype=EstimatedDuration"`
+	// Samples is the number of previous runs the percentiles are calculated from
+	Samples int32 `json:"samples,omitempty" protobuf:"varint,3,opt,name=samples"`
+}
@@ -12,10 +12,12 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
 	v1beta1 "k8s.io/api/policy/v1beta1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v11 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -148,10 +150,38 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactCache) Reset()      { *m = ArtifactCache{} }
+func (*ArtifactCache) ProtoMessage() {}
+func (*ArtifactCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{4}
+}
+func (m *ArtifactCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactCache.Merge(m, src)
+}
+func (m *ArtifactCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactCache proto.InternalMessageInfo
+
 func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
 func (*ArtifactGC) ProtoMessage() {}
 func (*ArtifactGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{4}
+	return fileDescriptor_724696e352c3df5f, []int{5}
 }
 func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +209,7 @@ var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{5}
+	return fileDescriptor_724696e352c3df5f, []int{6}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +237,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactPaths) Reset()      { *m = ArtifactPaths{} }
 func (*ArtifactPaths) ProtoMessage() {}
 func (*ArtifactPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{6}
+	return fileDescriptor_724696e352c3df5f, []int{7}
 }
 func (m *ArtifactPaths) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +265,7 @@ var xxx_messageInfo_ArtifactPaths proto.InternalMessageInfo
 func (m *ArtifactRepository) Reset()      { *m = ArtifactRepository{} }
 func (*ArtifactRepository) ProtoMessage() {}
 func (*ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{7}
+	return fileDescriptor_724696e352c3df5f, []int{8}
 }
 func (m *ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +293,7 @@ var xxx_messageInfo_ArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{8}
+	return fileDescriptor_724696e352c3df5f, []int{9}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +321,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{9}
+	return fileDescriptor_724696e352c3df5f, []int{10}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,10 +346,66 @@ func (m *ArtifactRepositoryRefStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 
+func (m *ArtifactTransfer) Reset()      { *m = ArtifactTransfer{} }
+func (*ArtifactTransfer) ProtoMessage() {}
+func (*ArtifactTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{11}
+}
+func (m *ArtifactTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactTransfer.Merge(m, src)
+}
+func (m *ArtifactTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactTransfer proto.InternalMessageInfo
+
+func (m *ArtifactTransfers) Reset()      { *m = ArtifactTransfers{} }
+func (*ArtifactTransfers) ProtoMessage() {}
+func (*ArtifactTransfers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{12}
+}
+func (m *ArtifactTransfers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactTransfers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactTransfers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactTransfers.Merge(m, src)
+}
+func (m *ArtifactTransfers) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactTransfers) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactTransfers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactTransfers proto.InternalMessageInfo
+
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{10}
+	return fileDescriptor_724696e352c3df5f, []int{13}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +433,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryArtifactRepository) Reset()      { *m = ArtifactoryArtifactRepository{} }
 func (*ArtifactoryArtifactRepository) ProtoMessage() {}
 func (*ArtifactoryArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{11}
+	return fileDescriptor_724696e352c3df5f, []int{14}
 }
 func (m *ArtifactoryArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +461,7 @@ var xxx_messageInfo_ArtifactoryArtifactRepository proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{12}
+	return fileDescriptor_724696e352c3df5f, []int{15}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,10 +486,94 @@ func (m *ArtifactoryAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 
+func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
+func (*AzureArtifact) ProtoMessage() {}
+func (*AzureArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{16}
+}
+func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifact.Merge(m, src)
+}
+func (m *AzureArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
+
+func (m *AzureArtifactRepository) Reset()      { *m = AzureArtifactRepository{} }
+func (*AzureArtifactRepository) ProtoMessage() {}
+func (*AzureArtifactRepository) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{17}
+}
+func (m *AzureArtifactRepository) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifactRepository) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifactRepository) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifactRepository.Merge(m, src)
+}
+func (m *AzureArtifactRepository) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifactRepository) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifactRepository.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifactRepository proto.InternalMessageInfo
+
+func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
+func (*AzureBlobContainer) ProtoMessage() {}
+func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{18}
+}
+func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureBlobContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureBlobContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureBlobContainer.Merge(m, src)
+}
+func (m *AzureBlobContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureBlobContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureBlobContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{13}
+	return fileDescriptor_724696e352c3df5f, []int{19}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +601,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
 func (*BasicAuth) ProtoMessage() {}
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{14}
+	return fileDescriptor_724696e352c3df5f, []int{20}
 }
 func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +629,7 @@ var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{15}
+	return fileDescriptor_724696e352c3df5f, []int{21}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,10 +654,38 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *ChunkTransformation) Reset()      { *m = ChunkTransformation{} }
+func (*ChunkTransformation) ProtoMessage() {}
+func (*ChunkTransformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{22}
+}
+func (m *ChunkTransformation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkTransformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChunkTransformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkTransformation.Merge(m, src)
+}
+func (m *ChunkTransformation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkTransformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkTransformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkTransformation proto.InternalMessageInfo
+
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{16}
+	return fileDescriptor_724696e352c3df5f, []int{23}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +713,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{17}
+	return fileDescriptor_724696e352c3df5f, []int{24}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +741,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{18}
+	return fileDescriptor_724696e352c3df5f, []int{25}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +769,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
 func (*ContainerNode) ProtoMessage() {}
 func (*ContainerNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{19}
+	return fileDescriptor_724696e352c3df5f, []int{26}
 }
 func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +797,7 @@ var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
 func (m *ContainerSetRetryStrategy) Reset()      { *m = ContainerSetRetryStrategy{} }
 func (*ContainerSetRetryStrategy) ProtoMessage() {}
 func (*ContainerSetRetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{20}
+	return fileDescriptor_724696e352c3df5f, []int{27}
 }
 func (m *ContainerSetRetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +825,7 @@ var xxx_messageInfo_ContainerSetRetryStrategy proto.InternalMessageInfo
 func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
 func (*ContainerSetTemplate) ProtoMessage() {}
 func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{21}
+	return fileDescriptor_724696e352c3df5f, []int{28}
 }
 func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,10 +850,38 @@ func (m *ContainerSetTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
 
+func (m *ContainerTermination) Reset()      { *m = ContainerTermination{} }
+func (*ContainerTermination) ProtoMessage() {}
+func (*ContainerTermination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{29}
+}
+func (m *ContainerTermination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerTermination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerTermination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerTermination.Merge(m, src)
+}
+func (m *ContainerTermination) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerTermination) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerTermination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerTermination proto.InternalMessageInfo
+
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{22}
+	return fileDescriptor_724696e352c3df5f, []int{30}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +909,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{23}
+	return fileDescriptor_724696e352c3df5f, []int{31}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +937,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{24}
+	return fileDescriptor_724696e352c3df5f, []int{32}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +965,7 @@ var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{25}
+	return fileDescriptor_724696e352c3df5f, []int{33}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,10 +990,94 @@ func (m *CronWorkflow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 
+func (m *CronWorkflowBackfill) Reset()      { *m = CronWorkflowBackfill{} }
+func (*CronWorkflowBackfill) ProtoMessage() {}
+func (*CronWorkflowBackfill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{34}
+}
+func (m *CronWorkflowBackfill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBackfill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowBackfill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBackfill.Merge(m, src)
+}
+func (m *CronWorkflowBackfill) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBackfill) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBackfill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBackfill proto.InternalMessageInfo
+
+func (m *CronWorkflowBackfillStatus) Reset()      { *m = CronWorkflowBackfillStatus{} }
+func (*CronWorkflowBackfillStatus) ProtoMessage() {}
+func (*CronWorkflowBackfillStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{35}
+}
+func (m *CronWorkflowBackfillStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowBackfillStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowBackfillStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowBackfillStatus.Merge(m, src)
+}
+func (m *CronWorkflowBackfillStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowBackfillStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowBackfillStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowBackfillStatus proto.InternalMessageInfo
+
+func (m *CronWorkflowExclusions) Reset()      { *m = CronWorkflowExclusions{} }
+func (*CronWorkflowExclusions) ProtoMessage() {}
+func (*CronWorkflowExclusions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{36}
+}
+func (m *CronWorkflowExclusions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowExclusions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowExclusions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowExclusions.Merge(m, src)
+}
+func (m *CronWorkflowExclusions) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowExclusions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowExclusions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowExclusions proto.InternalMessageInfo
+
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{26}
+	return fileDescriptor_724696e352c3df5f, []int{37}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -792,10 +1102,38 @@ func (m *CronWorkflowList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 
+func (m *CronWorkflowSkip) Reset()      { *m = CronWorkflowSkip{} }
+func (*CronWorkflowSkip) ProtoMessage() {}
+func (*CronWorkflowSkip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{38}
+}
+func (m *CronWorkflowSkip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronWorkflowSkip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronWorkflowSkip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronWorkflowSkip.Merge(m, src)
+}
+func (m *CronWorkflowSkip) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronWorkflowSkip) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronWorkflowSkip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronWorkflowSkip proto.InternalMessageInfo
+
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{27}
+	return fileDescriptor_724696e352c3df5f, []int{39}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1161,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{28}
+	return fileDescriptor_724696e352c3df5f, []int{40}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1189,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{29}
+	return fileDescriptor_724696e352c3df5f, []int{41}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1217,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{30}
+	return fileDescriptor_724696e352c3df5f, []int{42}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1245,7 @@ var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 func (m *Data) Reset()      { *m = Data{} }
 func (*Data) ProtoMessage() {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{31}
+	return fileDescriptor_724696e352c3df5f, []int{43}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1273,7 @@ var xxx_messageInfo_Data proto.InternalMessageInfo
 func (m *DataSource) Reset()      { *m = DataSource{} }
 func (*DataSource) ProtoMessage() {}
 func (*DataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{32}
+	return fileDescriptor_724696e352c3df5f, []int{44}
 }
 func (m *DataSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -960,10 +1298,38 @@ func (m *DataSource) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DataSource proto.InternalMessageInfo
 
+func (m *EstimatedDurationPercentiles) Reset()      { *m = EstimatedDurationPercentiles{} }
+func (*EstimatedDurationPercentiles) ProtoMessage() {}
+func (*EstimatedDurationPercentiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{45}
+}
+func (m *EstimatedDurationPercentiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimatedDurationPercentiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EstimatedDurationPercentiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimatedDurationPercentiles.Merge(m, src)
+}
+func (m *EstimatedDurationPercentiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimatedDurationPercentiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimatedDurationPercentiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimatedDurationPercentiles proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{33}
+	return fileDescriptor_724696e352c3df5f, []int{46}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1357,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{34}
+	return fileDescriptor_724696e352c3df5f, []int{47}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1385,7 @@ var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{35}
+	return fileDescriptor_724696e352c3df5f, []int{48}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1413,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSArtifactRepository) Reset()      { *m = GCSArtifactRepository{} }
 func (*GCSArtifactRepository) ProtoMessage() {}
 func (*GCSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{36}
+	return fileDescriptor_724696e352c3df5f, []int{49}
 }
 func (m *GCSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1441,7 @@ var xxx_messageInfo_GCSArtifactRepository proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{37}
+	return fileDescriptor_724696e352c3df5f, []int{50}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1469,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{38}
+	return fileDescriptor_724696e352c3df5f, []int{51}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1497,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{39}
+	return fileDescriptor_724696e352c3df5f, []int{52}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,10 +1522,38 @@ func (m *GitArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 
+func (m *GroupByTransformation) Reset()      { *m = GroupByTransformation{} }
+func (*GroupByTransformation) ProtoMessage() {}
+func (*GroupByTransformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{53}
+}
+func (m *GroupByTransformation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GroupByTransformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GroupByTransformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupByTransformation.Merge(m, src)
+}
+func (m *GroupByTransformation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GroupByTransformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupByTransformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupByTransformation proto.InternalMessageInfo
+
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{40}
+	return fileDescriptor_724696e352c3df5f, []int{54}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1581,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSArtifactRepository) Reset()      { *m = HDFSArtifactRepository{} }
 func (*HDFSArtifactRepository) ProtoMessage() {}
 func (*HDFSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{41}
+	return fileDescriptor_724696e352c3df5f, []int{55}
 }
 func (m *HDFSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1609,7 @@ var xxx_messageInfo_HDFSArtifactRepository proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{42}
+	return fileDescriptor_724696e352c3df5f, []int{56}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1637,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{43}
+	return fileDescriptor_724696e352c3df5f, []int{57}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1665,7 @@ var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 func (m *HTTP) Reset()      { *m = HTTP{} }
 func (*HTTP) ProtoMessage() {}
 func (*HTTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{44}
+	return fileDescriptor_724696e352c3df5f, []int{58}
 }
 func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1693,7 @@ var xxx_messageInfo_HTTP proto.InternalMessageInfo
 func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
 func (*HTTPArtifact) ProtoMessage() {}
 func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{45}
+	return fileDescriptor_724696e352c3df5f, []int{59}
 }
 func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1721,7 @@ var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
 func (*HTTPHeader) ProtoMessage() {}
 func (*HTTPHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{46}
+	return fileDescriptor_724696e352c3df5f, []int{60}
 }
 func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1749,7 @@ var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
 func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
 func (*HTTPHeaderSource) ProtoMessage() {}
 func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{47}
+	return fileDescriptor_724696e352c3df5f, []int{61}
 }
 func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1777,7 @@ var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{48}
+	return fileDescriptor_724696e352c3df5f, []int{62}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1805,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{49}
+	return fileDescriptor_724696e352c3df5f, []int{63}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1833,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{50}
+	return fileDescriptor_724696e352c3df5f, []int{64}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1861,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{51}
+	return fileDescriptor_724696e352c3df5f, []int{65}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1889,7 @@ var xxx_messageInfo_Item proto.InternalMessageInfo
 func (m *LabelKeys) Reset()      { *m = LabelKeys{} }
 func (*LabelKeys) ProtoMessage() {}
 func (*LabelKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{52}
+	return fileDescriptor_724696e352c3df5f, []int{66}
 }
 func (m *LabelKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1917,7 @@ var xxx_messageInfo_LabelKeys proto.InternalMessageInfo
 func (m *LabelValueFrom) Reset()      { *m = LabelValueFrom{} }
 func (*LabelValueFrom) ProtoMessage() {}
 func (*LabelValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{53}
+	return fileDescriptor_724696e352c3df5f, []int{67}
 }
 func (m *LabelValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1945,7 @@ var xxx_messageInfo_LabelValueFrom proto.InternalMessageInfo
 func (m *LabelValues) Reset()      { *m = LabelValues{} }
 func (*LabelValues) ProtoMessage() {}
 func (*LabelValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{54}
+	return fileDescriptor_724696e352c3df5f, []int{68}
 }
 func (m *LabelValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1973,7 @@ var xxx_messageInfo_LabelValues proto.InternalMessageInfo
 func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
 func (*LifecycleHook) ProtoMessage() {}
 func (*LifecycleHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{55}
+	return fileDescriptor_724696e352c3df5f, []int{69}
 }
 func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +2001,7 @@ var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{56}
+	return fileDescriptor_724696e352c3df5f, []int{70}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2029,7 @@ var xxx_messageInfo_Link proto.InternalMessageInfo
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{57}
+	return fileDescriptor_724696e352c3df5f, []int{71}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +2057,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{58}
+	return fileDescriptor_724696e352c3df5f, []int{72}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +2085,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{59}
+	return fileDescriptor_724696e352c3df5f, []int{73}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2113,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{60}
+	return fileDescriptor_724696e352c3df5f, []int{74}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2141,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{61}
+	return fileDescriptor_724696e352c3df5f, []int{75}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2169,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{62}
+	return fileDescriptor_724696e352c3df5f, []int{76}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2197,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{63}
+	return fileDescriptor_724696e352c3df5f, []int{77}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2225,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{64}
+	return fileDescriptor_724696e352c3df5f, []int{78}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2253,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeResult) Reset()      { *m = NodeResult{} }
 func (*NodeResult) ProtoMessage() {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{65}
+	return fileDescriptor_724696e352c3df5f, []int{79}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2281,7 @@ var xxx_messageInfo_NodeResult proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{66}
+	return fileDescriptor_724696e352c3df5f, []int{80}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2309,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{67}
+	return fileDescriptor_724696e352c3df5f, []int{81}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1940,10 +2334,38 @@ func (m *NodeSynchronizationStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 
+func (m *NodeTermination) Reset()      { *m = NodeTermination{} }
+func (*NodeTermination) ProtoMessage() {}
+func (*NodeTermination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{82}
+}
+func (m *NodeTermination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeTermination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeTermination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTermination.Merge(m, src)
+}
+func (m *NodeTermination) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeTermination) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTermination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTermination proto.InternalMessageInfo
+
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{68}
+	return fileDescriptor_724696e352c3df5f, []int{83}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2393,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{69}
+	return fileDescriptor_724696e352c3df5f, []int{84}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2421,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSArtifactRepository) Reset()      { *m = OSSArtifactRepository{} }
 func (*OSSArtifactRepository) ProtoMessage() {}
 func (*OSSArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{70}
+	return fileDescriptor_724696e352c3df5f, []int{85}
 }
 func (m *OSSArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2449,7 @@ var xxx_messageInfo_OSSArtifactRepository proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{71}
+	return fileDescriptor_724696e352c3df5f, []int{86}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2477,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *OSSLifecycleRule) Reset()      { *m = OSSLifecycleRule{} }
 func (*OSSLifecycleRule) ProtoMessage() {}
 func (*OSSLifecycleRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{72}
+	return fileDescriptor_724696e352c3df5f, []int{87}
 }
 func (m *OSSLifecycleRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2505,7 @@ var xxx_messageInfo_OSSLifecycleRule proto.InternalMessageInfo
 func (m *Object) Reset()      { *m = Object{} }
 func (*Object) ProtoMessage() {}
 func (*Object) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{73}
+	return fileDescriptor_724696e352c3df5f, []int{88}
 }
 func (m *Object) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2533,7 @@ var xxx_messageInfo_Object proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{74}
+	return fileDescriptor_724696e352c3df5f, []int{89}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2561,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{75}
+	return fileDescriptor_724696e352c3df5f, []int{90}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2589,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{76}
+	return fileDescriptor_724696e352c3df5f, []int{91}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2192,10 +2614,38 @@ func (m *Parameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Parameter proto.InternalMessageInfo
 
+func (m *ParseTransformation) Reset()      { *m = ParseTransformation{} }
+func (*ParseTransformation) ProtoMessage() {}
+func (*ParseTransformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{92}
+}
+func (m *ParseTransformation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParseTransformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParseTransformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParseTransformation.Merge(m, src)
+}
+func (m *ParseTransformation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParseTransformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParseTransformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParseTransformation proto.InternalMessageInfo
+
 func (m *Plugin) Reset()      { *m = Plugin{} }
 func (*Plugin) ProtoMessage() {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{77}
+	return fileDescriptor_724696e352c3df5f, []int{93}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2673,7 @@ var xxx_messageInfo_Plugin proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{78}
+	return fileDescriptor_724696e352c3df5f, []int{94}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2701,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{79}
+	return fileDescriptor_724696e352c3df5f, []int{95}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2729,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{80}
+	return fileDescriptor_724696e352c3df5f, []int{96}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2757,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{81}
+	return fileDescriptor_724696e352c3df5f, []int{97}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2332,10 +2782,38 @@ func (m *ResourceTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 
+func (m *ResourcesSource) Reset()      { *m = ResourcesSource{} }
+func (*ResourcesSource) ProtoMessage() {}
+func (*ResourcesSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{98}
+}
+func (m *ResourcesSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourcesSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourcesSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcesSource.Merge(m, src)
+}
+func (m *ResourcesSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourcesSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcesSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcesSource proto.InternalMessageInfo
+
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{82}
+	return fileDescriptor_724696e352c3df5f, []int{99}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2360,10 +2838,66 @@ func (m *RetryAffinity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 
+func (m *RetryDecision) Reset()      { *m = RetryDecision{} }
+func (*RetryDecision) ProtoMessage() {}
+func (*RetryDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{100}
+}
+func (m *RetryDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryDecision.Merge(m, src)
+}
+func (m *RetryDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryDecision proto.InternalMessageInfo
+
+func (m *RetryMatcher) Reset()      { *m = RetryMatcher{} }
+func (*RetryMatcher) ProtoMessage() {}
+func (*RetryMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{101}
+}
+func (m *RetryMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryMatcher.Merge(m, src)
+}
+func (m *RetryMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryMatcher proto.InternalMessageInfo
+
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{83}
+	return fileDescriptor_724696e352c3df5f, []int{102}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2388,10 +2922,38 @@ func (m *RetryNodeAntiAffinity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 
+func (m *RetryResources) Reset()      { *m = RetryResources{} }
+func (*RetryResources) ProtoMessage() {}
+func (*RetryResources) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{103}
+}
+func (m *RetryResources) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryResources) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryResources) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryResources.Merge(m, src)
+}
+func (m *RetryResources) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryResources) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryResources.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryResources proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{84}
+	return fileDescriptor_724696e352c3df5f, []int{104}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2981,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{85}
+	return fileDescriptor_724696e352c3df5f, []int{105}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +3009,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3ArtifactRepository) Reset()      { *m = S3ArtifactRepository{} }
 func (*S3ArtifactRepository) ProtoMessage() {}
 func (*S3ArtifactRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{86}
+	return fileDescriptor_724696e352c3df5f, []int{106}
 }
 func (m *S3ArtifactRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +3037,7 @@ var xxx_messageInfo_S3ArtifactRepository proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{87}
+	return fileDescriptor_724696e352c3df5f, []int{107}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +3065,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *S3EncryptionOptions) Reset()      { *m = S3EncryptionOptions{} }
 func (*S3EncryptionOptions) ProtoMessage() {}
 func (*S3EncryptionOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{88}
+	return fileDescriptor_724696e352c3df5f, []int{108}
 }
 func (m *S3EncryptionOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2528,10 +3090,38 @@ func (m *S3EncryptionOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_S3EncryptionOptions proto.InternalMessageInfo
 
+func (m *SQLCache) Reset()      { *m = SQLCache{} }
+func (*SQLCache) ProtoMessage() {}
+func (*SQLCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{109}
+}
+func (m *SQLCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLCache.Merge(m, src)
+}
+func (m *SQLCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLCache proto.InternalMessageInfo
+
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{89}
+	return fileDescriptor_724696e352c3df5f, []int{110}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +3149,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{90}
+	return fileDescriptor_724696e352c3df5f, []int{111}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +3177,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{91}
+	return fileDescriptor_724696e352c3df5f, []int{112}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +3205,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{92}
+	return fileDescriptor_724696e352c3df5f, []int{113}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +3233,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{93}
+	return fileDescriptor_724696e352c3df5f, []int{114}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2668,10 +3258,94 @@ func (m *Sequence) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Sequence proto.InternalMessageInfo
 
+func (m *SharedArtifactCacheStatus) Reset()      { *m = SharedArtifactCacheStatus{} }
+func (*SharedArtifactCacheStatus) ProtoMessage() {}
+func (*SharedArtifactCacheStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{115}
+}
+func (m *SharedArtifactCacheStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharedArtifactCacheStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SharedArtifactCacheStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedArtifactCacheStatus.Merge(m, src)
+}
+func (m *SharedArtifactCacheStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharedArtifactCacheStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedArtifactCacheStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedArtifactCacheStatus proto.InternalMessageInfo
+
+func (m *SortTransformation) Reset()      { *m = SortTransformation{} }
+func (*SortTransformation) ProtoMessage() {}
+func (*SortTransformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{116}
+}
+func (m *SortTransformation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SortTransformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SortTransformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SortTransformation.Merge(m, src)
+}
+func (m *SortTransformation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SortTransformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SortTransformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SortTransformation proto.InternalMessageInfo
+
+func (m *StopStrategy) Reset()      { *m = StopStrategy{} }
+func (*StopStrategy) ProtoMessage() {}
+func (*StopStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{117}
+}
+func (m *StopStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StopStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopStrategy.Merge(m, src)
+}
+func (m *StopStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopStrategy proto.InternalMessageInfo
+
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{94}
+	return fileDescriptor_724696e352c3df5f, []int{118}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +3373,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{95}
+	return fileDescriptor_724696e352c3df5f, []int{119}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +3401,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{96}
+	return fileDescriptor_724696e352c3df5f, []int{120}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3429,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{97}
+	return fileDescriptor_724696e352c3df5f, []int{121}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2780,10 +3454,66 @@ func (m *SuspendTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 
+func (m *SyncDatabaseRef) Reset()      { *m = SyncDatabaseRef{} }
+func (*SyncDatabaseRef) ProtoMessage() {}
+func (*SyncDatabaseRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{122}
+}
+func (m *SyncDatabaseRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncDatabaseRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncDatabaseRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncDatabaseRef.Merge(m, src)
+}
+func (m *SyncDatabaseRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncDatabaseRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncDatabaseRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncDatabaseRef proto.InternalMessageInfo
+
+func (m *SyncHTTPRef) Reset()      { *m = SyncHTTPRef{} }
+func (*SyncHTTPRef) ProtoMessage() {}
+func (*SyncHTTPRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{123}
+}
+func (m *SyncHTTPRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncHTTPRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncHTTPRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncHTTPRef.Merge(m, src)
+}
+func (m *SyncHTTPRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncHTTPRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncHTTPRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncHTTPRef proto.InternalMessageInfo
+
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{98}
+	return fileDescriptor_724696e352c3df5f, []int{124}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2811,7 +3541,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{99}
+	return fileDescriptor_724696e352c3df5f, []int{125}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2839,7 +3569,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{100}
+	return fileDescriptor_724696e352c3df5f, []int{126}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2867,7 +3597,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{101}
+	return fileDescriptor_724696e352c3df5f, []int{127}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3625,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{102}
+	return fileDescriptor_724696e352c3df5f, []int{128}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3653,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{103}
+	return fileDescriptor_724696e352c3df5f, []int{129}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2951,7 +3681,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *TransformationStep) Reset()      { *m = TransformationStep{} }
 func (*TransformationStep) ProtoMessage() {}
 func (*TransformationStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{104}
+	return fileDescriptor_724696e352c3df5f, []int{130}
 }
 func (m *TransformationStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2976,10 +3706,38 @@ func (m *TransformationStep) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransformationStep proto.InternalMessageInfo
 
+func (m *UniqueTransformation) Reset()      { *m = UniqueTransformation{} }
+func (*UniqueTransformation) ProtoMessage() {}
+func (*UniqueTransformation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{131}
+}
+func (m *UniqueTransformation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UniqueTransformation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UniqueTransformation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UniqueTransformation.Merge(m, src)
+}
+func (m *UniqueTransformation) XXX_Size() int {
+	return m.Size()
+}
+func (m *UniqueTransformation) XXX_DiscardUnknown() {
+	xxx_messageInfo_UniqueTransformation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UniqueTransformation proto.InternalMessageInfo
+
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{105}
+	return fileDescriptor_724696e352c3df5f, []int{132}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3007,7 +3765,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{106}
+	return fileDescriptor_724696e352c3df5f, []int{133}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3035,7 +3793,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{107}
+	return fileDescriptor_724696e352c3df5f, []int{134}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3821,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{108}
+	return fileDescriptor_724696e352c3df5f, []int{135}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3091,7 +3849,7 @@ var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{109}
+	return fileDescriptor_724696e352c3df5f, []int{136}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3119,7 +3877,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{110}
+	return fileDescriptor_724696e352c3df5f, []int{137}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3147,7 +3905,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{111}
+	return fileDescriptor_724696e352c3df5f, []int{138}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3933,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{112}
+	return fileDescriptor_724696e352c3df5f, []int{139}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3203,7 +3961,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{113}
+	return fileDescriptor_724696e352c3df5f, []int{140}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3231,7 +3989,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowMetadata) Reset()      { *m = WorkflowMetadata{} }
 func (*WorkflowMetadata) ProtoMessage() {}
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{114}
+	return fileDescriptor_724696e352c3df5f, []int{141}
 }
 func (m *WorkflowMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3259,7 +4017,7 @@ var xxx_messageInfo_WorkflowMetadata proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{115}
+	return fileDescriptor_724696e352c3df5f, []int{142}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3287,7 +4045,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{116}
+	return fileDescriptor_724696e352c3df5f, []int{143}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3315,7 +4073,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{117}
+	return fileDescriptor_724696e352c3df5f, []int{144}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3343,7 +4101,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTaskResult) Reset()      { *m = WorkflowTaskResult{} }
 func (*WorkflowTaskResult) ProtoMessage() {}
 func (*WorkflowTaskResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{118}
+	return fileDescriptor_724696e352c3df5f, []int{145}
 }
 func (m *WorkflowTaskResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3371,7 +4129,7 @@ var xxx_messageInfo_WorkflowTaskResult proto.InternalMessageInfo
 func (m *WorkflowTaskResultList) Reset()      { *m = WorkflowTaskResultList{} }
 func (*WorkflowTaskResultList) ProtoMessage() {}
 func (*WorkflowTaskResultList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{119}
+	return fileDescriptor_724696e352c3df5f, []int{146}
 }
 func (m *WorkflowTaskResultList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3399,7 +4157,7 @@ var xxx_messageInfo_WorkflowTaskResultList proto.InternalMessageInfo
 func (m *WorkflowTaskSet) Reset()      { *m = WorkflowTaskSet{} }
 func (*WorkflowTaskSet) ProtoMessage() {}
 func (*WorkflowTaskSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{120}
+	return fileDescriptor_724696e352c3df5f, []int{147}
 }
 func (m *WorkflowTaskSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3427,7 +4185,7 @@ var xxx_messageInfo_WorkflowTaskSet proto.InternalMessageInfo
 func (m *WorkflowTaskSetList) Reset()      { *m = WorkflowTaskSetList{} }
 func (*WorkflowTaskSetList) ProtoMessage() {}
 func (*WorkflowTaskSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{121}
+	return fileDescriptor_724696e352c3df5f, []int{148}
 }
 func (m *WorkflowTaskSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3455,7 +4213,7 @@ var xxx_messageInfo_WorkflowTaskSetList proto.InternalMessageInfo
 func (m *WorkflowTaskSetSpec) Reset()      { *m = WorkflowTaskSetSpec{} }
 func (*WorkflowTaskSetSpec) ProtoMessage() {}
 func (*WorkflowTaskSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{122}
+	return fileDescriptor_724696e352c3df5f, []int{149}
 }
 func (m *WorkflowTaskSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3483,7 +4241,7 @@ var xxx_messageInfo_WorkflowTaskSetSpec proto.InternalMessageInfo
 func (m *WorkflowTaskSetStatus) Reset()      { *m = WorkflowTaskSetStatus{} }
 func (*WorkflowTaskSetStatus) ProtoMessage() {}
 func (*WorkflowTaskSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{123}
+	return fileDescriptor_724696e352c3df5f, []int{150}
 }
 func (m *WorkflowTaskSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3511,7 +4269,7 @@ var xxx_messageInfo_WorkflowTaskSetStatus proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{124}
+	return fileDescriptor_724696e352c3df5f, []int{151}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3539,7 +4297,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{125}
+	return fileDescriptor_724696e352c3df5f, []int{152}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +4325,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{126}
+	return fileDescriptor_724696e352c3df5f, []int{153}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +4353,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_724696e352c3df5f, []int{127}
+	return fileDescriptor_724696e352c3df5f, []int{154}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3620,34 +4378,74 @@ func (m *ZipStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ZipStrategy proto.InternalMessageInfo
 
+func (m *ZstdStrategy) Reset()      { *m = ZstdStrategy{} }
+func (*ZstdStrategy) ProtoMessage() {}
+func (*ZstdStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724696e352c3df5f, []int{155}
+}
+func (m *ZstdStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ZstdStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ZstdStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZstdStrategy.Merge(m, src)
+}
+func (m *ZstdStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ZstdStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZstdStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZstdStrategy proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Amount")
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Arguments")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactCache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactCache")
 	proto.RegisterType((*ArtifactGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactGC")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactPaths)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactPaths")
 	proto.RegisterType((*ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepository")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactRepositoryRefStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRefStatus")
+	proto.RegisterType((*ArtifactTransfer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactTransfer")
+	proto.RegisterType((*ArtifactTransfers)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactTransfers")
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryArtifactRepository")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
+	proto.RegisterType((*AzureArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureArtifact")
+	proto.RegisterType((*AzureArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureArtifactRepository")
+	proto.RegisterType((*AzureBlobContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.AzureBlobContainer")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.BasicAuth")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*ChunkTransformation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ChunkTransformation")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Condition")
 	proto.RegisterType((*ContainerNode)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerNode")
 	proto.RegisterType((*ContainerSetRetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetRetryStrategy")
 	proto.RegisterType((*ContainerSetTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerSetTemplate")
+	proto.RegisterType((*ContainerTermination)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContainerTermination")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ContinueOn")
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflow")
+	proto.RegisterType((*CronWorkflowBackfill)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowBackfill")
+	proto.RegisterType((*CronWorkflowBackfillStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowBackfillStatus")
+	proto.RegisterType((*CronWorkflowExclusions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowExclusions")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowList")
+	proto.RegisterType((*CronWorkflowSkip)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSkip")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTask")
@@ -3655,6 +4453,7 @@ func init() {
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DAGTemplate")
 	proto.RegisterType((*Data)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Data")
 	proto.RegisterType((*DataSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.DataSource")
+	proto.RegisterType((*EstimatedDurationPercentiles)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.EstimatedDurationPercentiles")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Event")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ExecutorConfig")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSArtifact")
@@ -3662,6 +4461,7 @@ func init() {
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GCSBucket")
 	proto.RegisterType((*Gauge)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Gauge")
 	proto.RegisterType((*GitArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GitArtifact")
+	proto.RegisterType((*GroupByTransformation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.GroupByTransformation")
 	proto.RegisterType((*HDFSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSArtifact")
 	proto.RegisterType((*HDFSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSArtifactRepository")
 	proto.RegisterType((*HDFSConfig)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.HDFSConfig")
@@ -3692,7 +4492,9 @@ func init() {
 	proto.RegisterType((*NodeResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeResult")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesDurationEntry")
+	proto.RegisterMapType((map[string]v11.ResourceRequirements)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesEntry")
 	proto.RegisterType((*NodeSynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeSynchronizationStatus")
+	proto.RegisterType((*NodeTermination)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NodeTermination")
 	proto.RegisterType((*NoneStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.NoneStrategy")
 	proto.RegisterType((*OSSArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSArtifact")
 	proto.RegisterType((*OSSArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.OSSArtifactRepository")
@@ -3702,27 +4504,41 @@ func init() {
 	proto.RegisterType((*Outputs)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Outputs")
 	proto.RegisterType((*ParallelSteps)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParallelSteps")
 	proto.RegisterType((*Parameter)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Parameter")
+	proto.RegisterType((*ParseTransformation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ParseTransformation")
 	proto.RegisterType((*Plugin)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Plugin")
 	proto.RegisterType((*PodGC)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.PodGC")
 	proto.RegisterType((*Prometheus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Prometheus")
 	proto.RegisterType((*RawArtifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RawArtifact")
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourceTemplate")
+	proto.RegisterType((*ResourcesSource)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ResourcesSource")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryAffinity")
+	proto.RegisterType((*RetryDecision)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryDecision")
+	proto.RegisterType((*RetryMatcher)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryMatcher")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
+	proto.RegisterType((*RetryResources)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryResources")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryResources.IncreaseEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryResources.MaxEntry")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3ArtifactRepository)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3ArtifactRepository")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*S3EncryptionOptions)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.S3EncryptionOptions")
+	proto.RegisterType((*SQLCache)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SQLCache")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
+	proto.RegisterMapType((map[string]int32)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreHolding.PermitsEntry")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*SemaphoreStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SemaphoreStatus")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Sequence")
+	proto.RegisterType((*SharedArtifactCacheStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SharedArtifactCacheStatus")
+	proto.RegisterType((*SortTransformation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SortTransformation")
+	proto.RegisterType((*StopStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.StopStrategy")
 	proto.RegisterType((*Submit)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Submit")
 	proto.RegisterType((*SubmitOpts)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SubmitOpts")
 	proto.RegisterType((*SuppliedValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuppliedValueFrom")
 	proto.RegisterType((*SuspendTemplate)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SuspendTemplate")
+	proto.RegisterType((*SyncDatabaseRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SyncDatabaseRef")
+	proto.RegisterType((*SyncHTTPRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SyncHTTPRef")
 	proto.RegisterType((*Synchronization)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Synchronization")
 	proto.RegisterType((*SynchronizationStatus)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.SynchronizationStatus")
 	proto.RegisterType((*TTLStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TTLStrategy")
@@ -3731,6 +4547,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Template.NodeSelectorEntry")
 	proto.RegisterType((*TemplateRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TemplateRef")
 	proto.RegisterType((*TransformationStep)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.TransformationStep")
+	proto.RegisterType((*UniqueTransformation)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.UniqueTransformation")
 	proto.RegisterType((*UserContainer)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.UserContainer")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ValueFrom")
 	proto.RegisterType((*Version)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.Version")
@@ -3751,6 +4568,7 @@ func init() {
 	proto.RegisterMapType((Nodes)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.NodesEntry")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.ResourcesDurationEntry")
 	proto.RegisterMapType((map[string]Template)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.StoredTemplatesEntry")
+	proto.RegisterMapType((map[string]EstimatedDurationPercentiles)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStatus.TemplateEstimatedDurationPercentilesEntry")
 	proto.RegisterType((*WorkflowStep)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStep")
 	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowStep.HooksEntry")
 	proto.RegisterType((*WorkflowTaskResult)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTaskResult")
@@ -3765,6 +4583,7 @@ func init() {
 	proto.RegisterType((*WorkflowTemplateList)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateList")
 	proto.RegisterType((*WorkflowTemplateRef)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.WorkflowTemplateRef")
 	proto.RegisterType((*ZipStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ZipStrategy")
+	proto.RegisterType((*ZstdStrategy)(nil), "github.com.argoproj.argo_workflows.v3.pkg.apis.workflow.v1alpha1.ZstdStrategy")
 }
 
 func init() {
@@ -3772,597 +4591,729 @@ func init() {
 }
 
 var fileDescriptor_724696e352c3df5f = []byte{
-	// 9439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6f, 0x70, 0x24, 0xc7,
-	0x75, 0x18, 0xce, 0x59, 0x60, 0x81, 0xc5, 0x03, 0x70, 0xc0, 0xf5, 0xfd, 0x5b, 0x82, 0xe4, 0x81,
-	0x1e, 0x9a, 0xfc, 0xf1, 0x2c, 0x0a, 0x30, 0xef, 0xc4, 0x5f, 0x18, 0xa9, 0x22, 0x0b, 0x0b, 0x1c,
-	0xee, 0x8e, 0x38, 0x1c, 0xc0, 0xb7, 0xb8, 0xbb, 0x90, 0x62, 0x68, 0x0d, 0x76, 0x1b, 0xbb, 0x43,
-	0xec, 0xce, 0x2c, 0x67, 0x66, 0x81, 0x03, 0x79, 0x14, 0x15, 0xd9, 0x96, 0xc4, 0x58, 0xb1, 0xf2,
-	0xc7, 0x96, 0x65, 0x25, 0xa9, 0x52, 0x39, 0x56, 0xac, 0x72, 0x5c, 0x49, 0xa9, 0x2a, 0x95, 0x0f,
-	0xf6, 0xd7, 0x54, 0x4a, 0xa9, 0xa4, 0x2a, 0x76, 0x59, 0x89, 0xf4, 0x21, 0x81, 0x22, 0x38, 0xd1,
-	0x97, 0x94, 0x52, 0x15, 0x57, 0xac, 0x38, 0x97, 0x7c, 0x48, 0xf5, 0xdf, 0xe9, 0x99, 0x9d, 0xc5,
-	0x01, 0x77, 0x03, 0x1c, 0x63, 0x7f, 0xdb, 0x7d, 0xfd, 0xfa, 0xbd, 0xee, 0xe9, 0xee, 0xd7, 0xaf,
-	0xdf, 0x7b, 0xfd, 0x1a, 0x56, 0x1b, 0x6e, 0xd4, 0xec, 0xae, 0xcf, 0xd4, 0xfc, 0xf6, 0xac, 0x13,
-	0x34, 0xfc, 0x4e, 0xe0, 0xbf, 0xc5, 0x7f, 0x7c, 0x74, 0xdb, 0x0f, 0x36, 0x37, 0x5a, 0xfe, 0x76,
-	0x38, 0xbb, 0x75, 0x69, 0xb6, 0xb3, 0xd9, 0x98, 0x75, 0x3a, 0x6e, 0x38, 0xab, 0xa0, 0xb3, 0x5b,
-	0x2f, 0x3a, 0xad, 0x4e, 0xd3, 0x79, 0x71, 0xb6, 0x41, 0x3d, 0x1a, 0x38, 0x11, 0xad, 0xcf, 0x74,
-	0x02, 0x3f, 0xf2, 0xc9, 0xa7, 0x62, 0x8a, 0x33, 0x8a, 0x22, 0xff, 0xf1, 0xf3, 0x9a, 0xe2, 0xcc,
-	0xd6, 0xa5, 0x99, 0xce, 0x66, 0x63, 0x86, 0x51, 0x9c, 0x51, 0xd0, 0x19, 0x45, 0x71, 0xea, 0xa3,
-	0x46, 0x9b, 0x1a, 0x7e, 0xc3, 0x9f, 0xe5, 0x84, 0xd7, 0xbb, 0x1b, 0xfc, 0x1f, 0xff, 0xc3, 0x7f,
-	0x09, 0x86, 0x53, 0xf6, 0xe6, 0xcb, 0xe1, 0x8c, 0xeb, 0xb3, 0xf6, 0xcd, 0xd6, 0xfc, 0x80, 0xce,
-	0x6e, 0xf5, 0x34, 0x6a, 0xea, 0x82, 0x81, 0xd3, 0xf1, 0x5b, 0x6e, 0x6d, 0x67, 0x76, 0xeb, 0xc5,
-	0x75, 0x1a, 0xf5, 0xb6, 0x7f, 0xea, 0x63, 0x31, 0x6a, 0xdb, 0xa9, 0x35, 0x5d, 0x8f, 0x06, 0x3b,
-	0x71, 0xff, 0xdb, 0x34, 0x72, 0xb2, 0x18, 0xcc, 0xf6, 0xab, 0x15, 0x74, 0xbd, 0xc8, 0x6d, 0xd3,
-	0x9e, 0x0a, 0xff, 0xff, 0xfd, 0x2a, 0x84, 0xb5, 0x26, 0x6d, 0x3b, 0x3d, 0xf5, 0x2e, 0xf5, 0xab,
-	0xd7, 0x8d, 0xdc, 0xd6, 0xac, 0xeb, 0x45, 0x61, 0x14, 0xa4, 0x2b, 0xd9, 0x97, 0x61, 0x68, 0xae,
-	0xed, 0x77, 0xbd, 0x88, 0x7c, 0x02, 0x8a, 0x5b, 0x4e, 0xab, 0x4b, 0xcb, 0xd6, 0xd3, 0xd6, 0xf3,
-	0x23, 0x95, 0x67, 0xbf, 0xb3, 0x3b, 0xfd, 0xd8, 0xde, 0xee, 0x74, 0xf1, 0x16, 0x03, 0xde, 0xdb,
-	0x9d, 0x3e, 0x4d, 0xbd, 0x9a, 0x5f, 0x77, 0xbd, 0xc6, 0xec, 0x5b, 0xa1, 0xef, 0xcd, 0xdc, 0xe8,
-	0xb6, 0xd7, 0x69, 0x80, 0xa2, 0x8e, 0xfd, 0x47, 0x05, 0x98, 0x98, 0x0b, 0x6a, 0x4d, 0x77, 0x8b,
-	0x56, 0x23, 0x46, 0xbf, 0xb1, 0x43, 0x9a, 0x30, 0x10, 0x39, 0x01, 0x27, 0x37, 0x7a, 0x71, 0x79,
-	0xe6, 0x61, 0x07, 0x7f, 0x66, 0xcd, 0x09, 0x14, 0xed, 0xca, 0xf0, 0xde, 0xee, 0xf4, 0xc0, 0x9a,
-	0x13, 0x20, 0x63, 0x41, 0x5a, 0x30, 0xe8, 0xf9, 0x1e, 0x2d, 0x17, 0x38, 0xab, 0x1b, 0x0f, 0xcf,
-	0xea, 0x86, 0xef, 0xe9, 0x7e, 0x54, 0x4a, 0x7b, 0xbb, 0xd3, 0x83, 0x0c, 0x82, 0x9c, 0x0b, 0xeb,
-	0xd7, 0x3b, 0x6e, 0xa7, 0x3c, 0x90, 0x57, 0xbf, 0x5e, 0x77, 0x3b, 0xc9, 0x7e, 0xbd, 0xee, 0x76,
-	0x90, 0xb1, 0xb0, 0x3f, 0x28, 0xc0, 0xc8, 0x5c, 0xd0, 0xe8, 0xb6, 0xa9, 0x17, 0x85, 0xe4, 0x7d,
-	0x80, 0x8e, 0x13, 0x38, 0x6d, 0x1a, 0xd1, 0x20, 0x2c, 0x5b, 0x4f, 0x0f, 0x3c, 0x3f, 0x7a, 0x71,
-	0xe9, 0xe1, 0xd9, 0xaf, 0x2a, 0x9a, 0x15, 0x22, 0x87, 0x1c, 0x34, 0x28, 0x44, 0x83, 0x25, 0x79,
-	0x17, 0x46, 0x9c, 0x20, 0x72, 0x37, 0x9c, 0x5a, 0x14, 0x96, 0x0b, 0x9c, 0xff, 0x2b, 0x0f, 0xcf,
-	0x7f, 0x4e, 0x92, 0xac, 0x9c, 0x94, 0xec, 0x47, 0x14, 0x24, 0xc4, 0x98, 0x9f, 0xfd, 0xdb, 0x45,
-	0x28, 0xa9, 0x02, 0xf2, 0x34, 0x0c, 0x7a, 0x4e, 0x5b, 0x4d, 0xd5, 0x31, 0x59, 0x71, 0xf0, 0x86,
-	0xd3, 0x66, 0x83, 0xe4, 0xb4, 0x29, 0xc3, 0xe8, 0x38, 0x51, 0x93, 0x4f, 0x09, 0x03, 0x63, 0xd5,
-	0x89, 0x9a, 0xc8, 0x4b, 0xc8, 0x93, 0x30, 0xd8, 0xf6, 0xeb, 0x94, 0x8f, 0x63, 0x51, 0x0c, 0xf2,
-	0xb2, 0x5f, 0xa7, 0xc8, 0xa1, 0xac, 0xfe, 0x46, 0xe0, 0xb7, 0xcb, 0x83, 0xc9, 0xfa, 0x8b, 0x81,
-	0xdf, 0x46, 0x5e, 0x42, 0xbe, 0x66, 0xc1, 0xa4, 0x6a, 0xde, 0x75, 0xbf, 0xe6, 0x44, 0xae, 0xef,
-	0x95, 0x8b, 0x7c, 0x52, 0x60, 0x7e, 0x5f, 0x45, 0x51, 0xae, 0x94, 0x65, 0x13, 0x26, 0xd3, 0x25,
-	0xd8, 0xd3, 0x0a, 0x72, 0x11, 0xa0, 0xd1, 0xf2, 0xd7, 0x9d, 0x16, 0xfb, 0x20, 0xe5, 0x21, 0xde,
-	0x05, 0x3d, 0xb8, 0x57, 0x74, 0x09, 0x1a, 0x58, 0xe4, 0x0e, 0x0c, 0x3b, 0x62, 0x01, 0x97, 0x87,
-	0x79, 0x27, 0x5e, 0xcd, 0xa3, 0x13, 0x09, 0x89, 0x50, 0x19, 0xdd, 0xdb, 0x9d, 0x1e, 0x96, 0x40,
-	0x54, 0xec, 0xc8, 0x0b, 0x50, 0xf2, 0x3b, 0xac, 0xdd, 0x4e, 0xab, 0x5c, 0x7a, 0xda, 0x7a, 0xbe,
-	0x54, 0x99, 0x94, 0x6d, 0x2d, 0xad, 0x48, 0x38, 0x6a, 0x0c, 0x72, 0x01, 0x86, 0xc3, 0xee, 0x3a,
-	0x1b, 0xc7, 0xf2, 0x08, 0xef, 0xd8, 0x84, 0x44, 0x1e, 0xae, 0x0a, 0x30, 0xaa, 0x72, 0xf2, 0x12,
-	0x8c, 0x06, 0xb4, 0xd6, 0x0d, 0x42, 0xca, 0x06, 0xb6, 0x0c, 0x9c, 0xf6, 0x29, 0x89, 0x3e, 0x8a,
-	0x71, 0x11, 0x9a, 0x78, 0xe4, 0x93, 0x70, 0x82, 0x0d, 0xf0, 0xe5, 0x3b, 0x9d, 0x80, 0x86, 0x21,
-	0x1b, 0xd5, 0x51, 0xce, 0xe8, 0xac, 0xac, 0x79, 0x62, 0x31, 0x51, 0x8a, 0x29, 0x6c, 0x7b, 0x15,
-	0x40, 0x8d, 0xd1, 0x95, 0x79, 0x52, 0x81, 0x52, 0x28, 0xfb, 0x2f, 0xa7, 0xeb, 0x73, 0xaa, 0x77,
-	0xea, 0xbb, 0xdc, 0xdb, 0x9d, 0x26, 0x71, 0x0d, 0x05, 0x45, 0x5d, 0xcf, 0xfe, 0xbd, 0x61, 0xe8,
-	0x19, 0x76, 0xf2, 0x22, 0x8c, 0xca, 0x2f, 0x78, 0xdd, 0x6f, 0x84, 0x9c, 0x76, 0xa9, 0x32, 0xc1,
-	0x7a, 0x36, 0x17, 0x83, 0xd1, 0xc4, 0x21, 0x75, 0x28, 0x84, 0x97, 0xa4, 0x94, 0xbc, 0xfe, 0xf0,
-	0xc3, 0x5b, 0xbd, 0xa4, 0xd7, 0xee, 0xd0, 0xde, 0xee, 0x74, 0xa1, 0x7a, 0x09, 0x0b, 0xe1, 0x25,
-	0x26, 0x1f, 0x1b, 0x6e, 0x94, 0x9f, 0x7c, 0xbc, 0xe2, 0x46, 0x9a, 0x0f, 0x97, 0x8f, 0x57, 0xdc,
-	0x08, 0x19, 0x0b, 0x26, 0xf7, 0x9b, 0x51, 0xd4, 0xe1, 0x8b, 0x34, 0x17, 0xb9, 0x7f, 0x75, 0x6d,
-	0x6d, 0x55, 0xf3, 0xe2, 0x22, 0x81, 0x41, 0x90, 0x73, 0x21, 0x5f, 0xb2, 0xd8, 0x17, 0x17, 0x85,
-	0x7e, 0xb0, 0x23, 0xd7, 0xfa, 0xcd, 0xfc, 0xd6, 0xba, 0x1f, 0xec, 0x68, 0xe6, 0x72, 0x20, 0x75,
-	0x01, 0x9a, 0xac, 0x79, 0xc7, 0xeb, 0x1b, 0x21, 0x5f, 0xda, 0xf9, 0x74, 0x7c, 0x61, 0xb1, 0x9a,
-	0xea, 0xf8, 0xc2, 0x62, 0x15, 0x39, 0x17, 0x36, 0xa0, 0x81, 0xb3, 0x2d, 0xc5, 0x42, 0x0e, 0x03,
-	0x8a, 0xce, 0x76, 0x72, 0x40, 0xd1, 0xd9, 0x46, 0xc6, 0x82, 0x71, 0xf2, 0xc3, 0x90, 0x4b, 0x81,
-	0x5c, 0x38, 0xad, 0x54, 0xab, 0x49, 0x4e, 0x2b, 0xd5, 0x2a, 0x32, 0x16, 0x7c, 0x92, 0xd6, 0x42,
-	0x2e, 0x42, 0xf2, 0x99, 0xa4, 0xf3, 0x29, 0x4e, 0x57, 0xe6, 0xab, 0xc8, 0x58, 0xd8, 0x1f, 0x58,
-	0x30, 0xae, 0x8a, 0x98, 0x58, 0x0a, 0xc9, 0x1d, 0x28, 0xa9, 0xc1, 0x94, 0xda, 0x51, 0x9e, 0xdb,
-	0xa8, 0x16, 0x9e, 0x0a, 0x82, 0x9a, 0x9b, 0xfd, 0xbb, 0x45, 0xd0, 0x92, 0x06, 0x69, 0xc7, 0x0f,
-	0x5d, 0x3e, 0x9d, 0x1e, 0x40, 0x94, 0x78, 0x86, 0x28, 0xb9, 0x95, 0xa7, 0x28, 0x89, 0x9b, 0x95,
-	0x10, 0x2a, 0x7f, 0x27, 0xb5, 0xf8, 0x84, 0x74, 0xf9, 0xf9, 0x23, 0x59, 0x7c, 0x46, 0x13, 0xf6,
-	0x5f, 0x86, 0x5b, 0x72, 0x19, 0x0a, 0xf9, 0xf3, 0x57, 0xf3, 0x5d, 0x86, 0x46, 0x2b, 0xd2, 0x0b,
-	0x32, 0x10, 0xcb, 0x44, 0x08, 0xa0, 0xdb, 0xb9, 0x2e, 0x13, 0x83, 0x6b, 0x72, 0xc1, 0x04, 0x62,
-	0xc1, 0x0c, 0xe5, 0xc5, 0xd3, 0x58, 0x30, 0x69, 0x9e, 0x7a, 0xe9, 0xbc, 0x0d, 0x67, 0x7a, 0x71,
-	0x90, 0x6e, 0x90, 0x59, 0x18, 0xa9, 0xf9, 0xde, 0x86, 0xdb, 0x58, 0x76, 0x3a, 0x72, 0x57, 0xd5,
-	0xda, 0xe3, 0xbc, 0x2a, 0xc0, 0x18, 0x87, 0x3c, 0x05, 0x03, 0x9b, 0x74, 0x47, 0x6a, 0x83, 0xa3,
-	0x12, 0x75, 0x60, 0x89, 0xee, 0x20, 0x83, 0x7f, 0xbc, 0xf4, 0xb5, 0x6f, 0x4c, 0x3f, 0xf6, 0xb9,
-	0xff, 0xf0, 0xf4, 0x63, 0xf6, 0x1f, 0x0e, 0xc0, 0x13, 0x99, 0x3c, 0xab, 0x91, 0x13, 0x75, 0x43,
-	0xf2, 0xbb, 0x16, 0x9c, 0x71, 0xb2, 0xca, 0xe5, 0x4a, 0xbe, 0x9d, 0xdf, 0x8c, 0x4c, 0x90, 0xaf,
-	0x3c, 0x25, 0x1b, 0x9d, 0xfd, 0x45, 0x30, 0xbb, 0x51, 0xec, 0x43, 0x31, 0x75, 0x38, 0xec, 0x38,
-	0x35, 0x2a, 0x7b, 0xaf, 0x3f, 0xd4, 0x0d, 0x55, 0x80, 0x31, 0x0e, 0x53, 0xaf, 0xea, 0x74, 0xc3,
-	0xe9, 0xb6, 0xc4, 0x06, 0x5e, 0x8a, 0xd5, 0xab, 0x05, 0x01, 0x46, 0x55, 0x4e, 0xfe, 0xbe, 0x05,
-	0xa4, 0x97, 0xab, 0x5c, 0x0c, 0x6b, 0x47, 0xf1, 0x1d, 0x2a, 0x67, 0xf7, 0x0c, 0x55, 0xc9, 0xe8,
-	0x69, 0x46, 0x3b, 0x8c, 0x31, 0xfd, 0xd7, 0x16, 0x9c, 0xca, 0x58, 0xe6, 0x6c, 0x52, 0x74, 0x83,
-	0x96, 0x9c, 0x3f, 0x7a, 0x52, 0xdc, 0xc4, 0xeb, 0xc8, 0xe0, 0xe4, 0x57, 0x2d, 0x98, 0x30, 0x56,
-	0xfb, 0x5c, 0x57, 0x1e, 0x27, 0x72, 0x52, 0x8d, 0x13, 0x84, 0x2b, 0xe7, 0x24, 0xfb, 0x89, 0x54,
-	0x01, 0xa6, 0x9b, 0x60, 0xff, 0xd0, 0x82, 0xa7, 0xf6, 0x15, 0x5a, 0x99, 0x0d, 0xb7, 0x1e, 0x79,
-	0xc3, 0xd9, 0xd4, 0x0a, 0x68, 0xc7, 0xbf, 0x89, 0xd7, 0xe5, 0x4c, 0xd4, 0x53, 0x0b, 0x05, 0x18,
-	0x55, 0xb9, 0xfd, 0x3d, 0x0b, 0xd2, 0xf4, 0x88, 0x03, 0x27, 0xba, 0x21, 0x0d, 0xd8, 0x54, 0xad,
-	0xd2, 0x5a, 0x40, 0xd5, 0xde, 0xf9, 0xec, 0x8c, 0xb0, 0x7b, 0xb0, 0x06, 0xcf, 0xd4, 0xfc, 0x80,
-	0xce, 0x6c, 0xbd, 0x38, 0x23, 0x30, 0x96, 0xe8, 0x4e, 0x95, 0xb6, 0x28, 0xa3, 0x51, 0x21, 0x4c,
-	0x73, 0xbf, 0x99, 0x20, 0x80, 0x29, 0x82, 0x8c, 0x45, 0xc7, 0x09, 0xc3, 0x6d, 0x3f, 0xa8, 0x4b,
-	0x16, 0x85, 0x43, 0xb3, 0x58, 0x4d, 0x10, 0xc0, 0x14, 0x41, 0xfb, 0x5f, 0x58, 0x30, 0x5c, 0x71,
-	0x6a, 0x9b, 0xfe, 0xc6, 0x06, 0x3b, 0xf8, 0xd4, 0xbb, 0x81, 0x38, 0x38, 0x8a, 0x49, 0xa8, 0xf7,
-	0xee, 0x05, 0x09, 0x47, 0x8d, 0x41, 0xd6, 0x60, 0x48, 0x7c, 0x0e, 0xd9, 0xa8, 0x9f, 0x35, 0x1a,
-	0xa5, 0xed, 0x3d, 0x7c, 0xe4, 0xba, 0x91, 0xdb, 0x9a, 0x11, 0xf6, 0x9e, 0x99, 0x6b, 0x5e, 0xb4,
-	0x12, 0x54, 0xa3, 0xc0, 0xf5, 0x1a, 0x15, 0xd8, 0xdb, 0x9d, 0x1e, 0x5a, 0xe4, 0x34, 0x50, 0xd2,
-	0x62, 0x67, 0xa4, 0xb6, 0x73, 0x47, 0xb1, 0xe3, 0x6b, 0x7e, 0x24, 0x3e, 0x23, 0x2d, 0xc7, 0x45,
-	0x68, 0xe2, 0xd9, 0x7f, 0x68, 0xc1, 0x48, 0xc5, 0x09, 0xdd, 0xda, 0x9f, 0xa3, 0xa1, 0x79, 0x13,
-	0x8a, 0xf3, 0x4e, 0xad, 0x49, 0xc9, 0xcd, 0xf4, 0xee, 0x32, 0x7a, 0xf1, 0xf9, 0x2c, 0x36, 0x7a,
-	0xa7, 0x31, 0x39, 0x8d, 0xf7, 0xdb, 0x83, 0xec, 0x1f, 0x5b, 0x70, 0x6e, 0xbe, 0xd5, 0x0d, 0x23,
-	0x1a, 0xdc, 0x96, 0xcb, 0x6a, 0x8d, 0xb6, 0x3b, 0x2d, 0x27, 0xa2, 0xe4, 0x33, 0x50, 0x6a, 0xd3,
-	0xc8, 0xa9, 0x3b, 0x91, 0x23, 0x39, 0xf6, 0x1f, 0x5e, 0xbe, 0x30, 0x19, 0x36, 0x6b, 0xc3, 0xca,
-	0xfa, 0x5b, 0xb4, 0x16, 0x2d, 0xd3, 0xc8, 0x89, 0x4f, 0xf8, 0x31, 0x0c, 0x35, 0x55, 0xd2, 0x81,
-	0xc1, 0xb0, 0x43, 0x6b, 0xf9, 0xd9, 0xc8, 0x54, 0x1f, 0xaa, 0x1d, 0x5a, 0x8b, 0x0d, 0x24, 0xec,
-	0x1f, 0x72, 0x4e, 0xf6, 0xff, 0xb6, 0xe0, 0x89, 0x3e, 0xfd, 0xbd, 0xee, 0x86, 0x11, 0x79, 0xa3,
-	0xa7, 0xcf, 0x33, 0x07, 0xeb, 0x33, 0xab, 0xcd, 0x7b, 0xac, 0x97, 0x8b, 0x82, 0x18, 0xfd, 0xfd,
-	0x2c, 0x14, 0xdd, 0x88, 0xb6, 0x95, 0xa1, 0xea, 0xb5, 0x87, 0xef, 0x70, 0x9f, 0xbe, 0x54, 0xc6,
-	0x95, 0xa5, 0xf4, 0x1a, 0xe3, 0x87, 0x82, 0xad, 0xfd, 0xaf, 0x2c, 0x60, 0xd3, 0xa0, 0xee, 0xca,
-	0xc3, 0xfa, 0x60, 0xb4, 0xd3, 0x51, 0x06, 0x2b, 0xb5, 0x97, 0x0f, 0xae, 0xed, 0x74, 0xe8, 0xbd,
-	0xdd, 0xe9, 0x71, 0x8d, 0xc8, 0x00, 0xc8, 0x51, 0xc9, 0x9b, 0x30, 0x14, 0x72, 0x9d, 0x43, 0x4a,
-	0xcb, 0x45, 0x59, 0x69, 0x48, 0x68, 0x22, 0xf7, 0x76, 0xa7, 0x0f, 0x64, 0x8f, 0x9e, 0xd1, 0xb4,
-	0x45, 0x3d, 0x94, 0x54, 0x99, 0x38, 0x6e, 0xd3, 0x30, 0x74, 0x1a, 0x54, 0xae, 0x7a, 0x2d, 0x8e,
-	0x97, 0x05, 0x18, 0x55, 0xb9, 0xfd, 0x6b, 0x16, 0xb0, 0x26, 0x46, 0x0e, 0x63, 0x71, 0xc3, 0xaf,
-	0x53, 0x72, 0x83, 0x2f, 0x11, 0x01, 0x90, 0x83, 0xf7, 0x54, 0x9f, 0x25, 0x22, 0x90, 0x12, 0xfa,
-	0x99, 0x00, 0x61, 0x4c, 0x82, 0x7c, 0x0c, 0xc6, 0xea, 0xb4, 0x43, 0xbd, 0x3a, 0xf5, 0x6a, 0x2e,
-	0x15, 0x83, 0x36, 0x52, 0x99, 0xdc, 0xdb, 0x9d, 0x1e, 0x5b, 0x30, 0xe0, 0x98, 0xc0, 0xb2, 0x7f,
-	0xd3, 0x82, 0xc7, 0x35, 0xb9, 0x2a, 0x8d, 0x90, 0x46, 0xc1, 0x8e, 0xb6, 0x3f, 0x1f, 0x4e, 0xbc,
-	0xde, 0x66, 0xbb, 0x53, 0x14, 0x08, 0xe6, 0x0f, 0x26, 0x5f, 0x47, 0xc5, 0x5e, 0xc6, 0x89, 0xa0,
-	0xa2, 0x66, 0xff, 0xca, 0x00, 0x9c, 0x36, 0x1b, 0xa9, 0xd7, 0xfc, 0x2f, 0x58, 0x00, 0xfa, 0x0b,
-	0xb0, 0x43, 0x04, 0x9b, 0xa7, 0x2b, 0x39, 0xcc, 0x53, 0x73, 0xa4, 0x62, 0xa9, 0xa0, 0xc1, 0x21,
-	0x1a, 0x6c, 0xc9, 0x6b, 0x30, 0xb6, 0xe5, 0xb7, 0xba, 0x6d, 0xba, 0xec, 0x77, 0xbd, 0x28, 0x2c,
-	0x0f, 0xf0, 0x66, 0x4c, 0x67, 0x0d, 0xe6, 0xad, 0x18, 0xaf, 0x72, 0x5a, 0x92, 0x1d, 0x33, 0x80,
-	0x21, 0x26, 0x48, 0x31, 0x3d, 0x64, 0x3c, 0x30, 0x87, 0x44, 0x9e, 0x58, 0x3e, 0x9d, 0x63, 0x1f,
-	0xd3, 0xa3, 0x5e, 0x39, 0xb9, 0xb7, 0x3b, 0x3d, 0x9e, 0x00, 0x61, 0xb2, 0x11, 0xf6, 0x6b, 0xc0,
-	0xbf, 0x85, 0xeb, 0x75, 0xe9, 0x8a, 0x47, 0x9e, 0x81, 0x22, 0x0d, 0x02, 0x3f, 0x90, 0xa7, 0x5e,
-	0xbd, 0x98, 0x2f, 0x33, 0x20, 0x8a, 0x32, 0xf2, 0x1c, 0xdb, 0x7b, 0xdd, 0x16, 0xad, 0xf3, 0xb9,
-	0x51, 0xaa, 0x9c, 0x50, 0x6b, 0x71, 0x91, 0x43, 0x51, 0x96, 0xda, 0x33, 0x30, 0x3c, 0xcf, 0xfa,
-	0x4e, 0x03, 0x46, 0xd7, 0x74, 0xa7, 0x8c, 0x27, 0xdc, 0x29, 0xca, 0x6d, 0xb2, 0x06, 0x67, 0xe6,
-	0x03, 0xea, 0x44, 0xb4, 0x7a, 0xa9, 0xd2, 0xad, 0x6d, 0xd2, 0x48, 0x18, 0x3c, 0x43, 0xf2, 0x09,
-	0x18, 0xf7, 0xb9, 0x14, 0xbf, 0xee, 0xd7, 0x36, 0x5d, 0xaf, 0x21, 0x95, 0xf1, 0x33, 0x92, 0xca,
-	0xf8, 0x8a, 0x59, 0x88, 0x49, 0x5c, 0xfb, 0x3f, 0x17, 0x60, 0x6c, 0x3e, 0xf0, 0x3d, 0x25, 0xa9,
-	0x8e, 0x61, 0x77, 0x89, 0x12, 0xbb, 0x4b, 0x0e, 0xf6, 0x6f, 0xb3, 0xfd, 0xfd, 0x76, 0x18, 0x72,
-	0x57, 0x8b, 0xc8, 0x81, 0xbc, 0x0e, 0x1d, 0x09, 0xbe, 0x9c, 0x76, 0x3c, 0xd8, 0x49, 0x01, 0x6a,
-	0xff, 0x17, 0x0b, 0x26, 0x4d, 0xf4, 0x63, 0xd8, 0xd4, 0xc2, 0xe4, 0xa6, 0x76, 0x23, 0xdf, 0xfe,
-	0xf6, 0xd9, 0xc9, 0x3e, 0x18, 0x4a, 0xf6, 0x93, 0x0d, 0x00, 0xf9, 0x9a, 0x05, 0x63, 0xdb, 0x06,
-	0x40, 0x76, 0x36, 0x6f, 0xbd, 0xe2, 0xa7, 0x95, 0x98, 0x31, 0xa1, 0xf7, 0x52, 0xff, 0x31, 0xd1,
-	0x12, 0x26, 0xf7, 0xc3, 0x5a, 0x93, 0xd6, 0xbb, 0x2d, 0x75, 0xe4, 0xd5, 0x9f, 0xb4, 0x2a, 0xe1,
-	0xa8, 0x31, 0xc8, 0x1b, 0x70, 0xb2, 0xe6, 0x7b, 0xb5, 0x6e, 0x10, 0x50, 0xaf, 0xb6, 0xb3, 0xca,
-	0x3d, 0xc0, 0x72, 0x43, 0x9c, 0x91, 0xd5, 0x4e, 0xce, 0xa7, 0x11, 0xee, 0x65, 0x01, 0xb1, 0x97,
-	0x90, 0xf0, 0x56, 0x84, 0x6c, 0xcb, 0xe2, 0xe7, 0xe2, 0x92, 0xe9, 0xad, 0xe0, 0x60, 0x54, 0xe5,
-	0xe4, 0x26, 0x9c, 0x0b, 0x23, 0x76, 0x66, 0xf2, 0x1a, 0x0b, 0xd4, 0xa9, 0xb7, 0x5c, 0x8f, 0xe9,
-	0xbe, 0xbe, 0x57, 0x17, 0x86, 0x9e, 0x81, 0xca, 0x13, 0x7b, 0xbb, 0xd3, 0xe7, 0xaa, 0xd9, 0x28,
-	0xd8, 0xaf, 0x2e, 0x79, 0x13, 0xa6, 0xc2, 0x6e, 0xad, 0x46, 0xc3, 0x70, 0xa3, 0xdb, 0x7a, 0xc5,
-	0x5f, 0x0f, 0xaf, 0xba, 0x21, 0x3b, 0x53, 0x5d, 0x77, 0xdb, 0x6e, 0xc4, 0xcd, 0x39, 0xc5, 0xca,
-	0xf9, 0xbd, 0xdd, 0xe9, 0xa9, 0x6a, 0x5f, 0x2c, 0xdc, 0x87, 0x02, 0x41, 0x38, 0x2b, 0x84, 0x5f,
-	0x0f, 0xed, 0x61, 0x4e, 0x7b, 0x6a, 0x6f, 0x77, 0xfa, 0xec, 0x62, 0x26, 0x06, 0xf6, 0xa9, 0xc9,
-	0x46, 0x30, 0x72, 0xdb, 0xf4, 0x1d, 0xdf, 0xa3, 0xdc, 0x16, 0x6c, 0x8c, 0xe0, 0x9a, 0x84, 0xa3,
-	0xc6, 0x20, 0x6f, 0xc5, 0x33, 0x91, 0x2d, 0x17, 0x69, 0xd3, 0x3d, 0xbc, 0x84, 0x3b, 0xbd, 0xb7,
-	0x3b, 0x3d, 0x79, 0xdb, 0xa0, 0xc4, 0x96, 0x1c, 0x26, 0x68, 0xdb, 0x7f, 0x54, 0x00, 0xd2, 0x2b,
-	0x22, 0xc8, 0x12, 0x0c, 0x39, 0xb5, 0xc8, 0xdd, 0xa2, 0xd2, 0x2d, 0xfb, 0x4c, 0xd6, 0xf6, 0x29,
-	0x58, 0x21, 0xdd, 0xa0, 0x6c, 0x86, 0xd0, 0x58, 0xae, 0xcc, 0xf1, 0xaa, 0x28, 0x49, 0x10, 0x1f,
-	0x4e, 0xb6, 0x9c, 0x30, 0x52, 0x73, 0xb5, 0xce, 0xba, 0x2c, 0x05, 0xeb, 0xcf, 0x1c, 0xac, 0x53,
-	0xac, 0x46, 0xe5, 0x0c, 0x9b, 0xb9, 0xd7, 0xd3, 0x84, 0xb0, 0x97, 0x36, 0x79, 0x9f, 0xeb, 0x21,
-	0x42, 0x49, 0x54, 0x0a, 0xc0, 0x52, 0x2e, 0x7b, 0xb4, 0xa0, 0x99, 0xd0, 0x41, 0x24, 0x1b, 0x34,
-	0x58, 0xda, 0xff, 0x06, 0x60, 0x78, 0x61, 0xee, 0xca, 0x9a, 0x13, 0x6e, 0x1e, 0xc0, 0xb5, 0xcb,
-	0x66, 0x87, 0xd4, 0xa1, 0xd2, 0xeb, 0x5b, 0xe9, 0x56, 0xa8, 0x31, 0x88, 0x07, 0x43, 0xae, 0xc7,
-	0x16, 0x44, 0xf9, 0x44, 0x5e, 0xa6, 0x76, 0xad, 0xf9, 0xf3, 0x03, 0xf5, 0x35, 0x4e, 0x1d, 0x25,
-	0x17, 0x72, 0x17, 0x46, 0x1c, 0xe5, 0xb2, 0x97, 0xdb, 0xd2, 0x52, 0x1e, 0x56, 0x17, 0x49, 0xd2,
-	0xf4, 0x92, 0x4b, 0x10, 0xc6, 0x0c, 0xc9, 0xe7, 0x2c, 0x18, 0x55, 0x5d, 0x47, 0xba, 0x21, 0x8d,
-	0x71, 0xcb, 0xf9, 0xf5, 0x19, 0xe9, 0x86, 0x30, 0x8a, 0x1b, 0x00, 0x34, 0x59, 0xf6, 0xa8, 0xf2,
-	0xc5, 0x83, 0xa8, 0xf2, 0x64, 0x1b, 0x46, 0xb6, 0xdd, 0xa8, 0xc9, 0x37, 0x9e, 0xf2, 0x10, 0x9f,
-	0x82, 0x8b, 0x0f, 0xdf, 0x6a, 0x46, 0x2e, 0xfe, 0x62, 0xb7, 0x15, 0x03, 0x8c, 0x79, 0x91, 0x59,
-	0xc1, 0x98, 0x87, 0x3c, 0x70, 0x91, 0x35, 0x92, 0xac, 0xc0, 0x0b, 0x30, 0xc6, 0x61, 0x9f, 0x78,
-	0x8c, 0xfd, 0xab, 0xd2, 0xb7, 0xbb, 0x6c, 0x1d, 0x4b, 0x6f, 0x55, 0x0e, 0xf3, 0x4a, 0x51, 0x14,
-	0x1f, 0xeb, 0xb6, 0xc1, 0x03, 0x13, 0x1c, 0xd9, 0x1a, 0xd9, 0x6e, 0x52, 0x4f, 0x3a, 0xc0, 0xf5,
-	0x1a, 0xb9, 0xdd, 0xa4, 0x1e, 0xf2, 0x12, 0x72, 0x57, 0x1c, 0x2d, 0x84, 0x8e, 0xcb, 0x3d, 0xdf,
-	0xb9, 0x78, 0x7c, 0x63, 0xbd, 0xb9, 0x72, 0x42, 0x9d, 0x29, 0xc4, 0x7f, 0x34, 0xf8, 0x31, 0x75,
-	0xd9, 0xf7, 0x2e, 0xdf, 0x71, 0x23, 0xe9, 0x39, 0xd7, 0x92, 0x6e, 0x85, 0x43, 0x51, 0x96, 0x0a,
-	0x63, 0x33, 0x9b, 0x04, 0x61, 0x79, 0x2c, 0x79, 0x04, 0x15, 0x33, 0x25, 0x44, 0x55, 0x4e, 0xfe,
-	0x81, 0x05, 0xc5, 0xa6, 0xef, 0x6f, 0x86, 0xe5, 0x71, 0x3e, 0x39, 0x72, 0x50, 0xf5, 0xa4, 0xc4,
-	0x99, 0xb9, 0xca, 0xc8, 0x5e, 0xf6, 0xa2, 0x60, 0xa7, 0xf2, 0xa2, 0x52, 0x80, 0x38, 0xec, 0xde,
-	0xee, 0xf4, 0x89, 0xeb, 0xee, 0x06, 0xad, 0xed, 0xd4, 0x5a, 0x94, 0x43, 0x3e, 0xff, 0x03, 0x03,
-	0x72, 0x79, 0x8b, 0x7a, 0x11, 0x8a, 0x56, 0x4d, 0x7d, 0x60, 0x01, 0xc4, 0x84, 0xc8, 0xa4, 0xf0,
-	0x37, 0x70, 0x21, 0xc6, 0x5d, 0x0c, 0x84, 0xaa, 0xf3, 0x80, 0x90, 0xe4, 0x39, 0x9c, 0xf3, 0x12,
-	0x4d, 0x93, 0x27, 0x8a, 0x8f, 0x17, 0x5e, 0xb6, 0xec, 0x7f, 0x6b, 0xc1, 0x28, 0xeb, 0x9c, 0x12,
-	0x81, 0xcf, 0xc1, 0x50, 0xe4, 0x04, 0x0d, 0x69, 0x96, 0x33, 0x86, 0x63, 0x8d, 0x43, 0x51, 0x96,
-	0x12, 0x0f, 0x8a, 0x91, 0x13, 0x6e, 0x2a, 0xed, 0xf2, 0x5a, 0x6e, 0x9f, 0x38, 0x56, 0x2c, 0xd9,
-	0xbf, 0x10, 0x05, 0x1b, 0xf2, 0x3c, 0x94, 0x98, 0x02, 0xb0, 0xe8, 0x84, 0xca, 0xd9, 0x30, 0xc6,
-	0x84, 0xf8, 0xa2, 0x84, 0xa1, 0x2e, 0xb5, 0xff, 0x6e, 0x01, 0x06, 0x17, 0xc4, 0x39, 0x63, 0x28,
-	0xf4, 0xbb, 0x41, 0x8d, 0x4a, 0x7d, 0x33, 0x87, 0x39, 0xcd, 0xe8, 0x56, 0x39, 0x4d, 0x43, 0xd3,
-	0xe7, 0xff, 0x51, 0xf2, 0x62, 0x07, 0xd9, 0x13, 0x51, 0xe0, 0x78, 0xe1, 0x86, 0x1f, 0xb4, 0x85,
-	0x41, 0xa1, 0x90, 0xd7, 0x2c, 0x5c, 0x4b, 0xd0, 0xad, 0x46, 0xb4, 0x13, 0x07, 0x9a, 0x24, 0xcb,
-	0x30, 0xd5, 0x06, 0xfb, 0xd7, 0x2d, 0x80, 0xb8, 0xf5, 0xe4, 0x4b, 0x16, 0x8c, 0x3b, 0xa6, 0xa3,
-	0x59, 0x7e, 0xa3, 0x95, 0xfc, 0x8c, 0xfe, 0x9c, 0xac, 0x38, 0x62, 0x27, 0x40, 0x98, 0x64, 0x6c,
-	0xbf, 0x04, 0x45, 0xbe, 0x3a, 0xb8, 0x2e, 0x2e, 0x2d, 0xa3, 0x69, 0x1b, 0x8c, 0xb2, 0x98, 0xa2,
-	0xc6, 0xb0, 0xdf, 0x80, 0x13, 0x97, 0xef, 0xd0, 0x5a, 0x37, 0xf2, 0x03, 0x61, 0x41, 0x25, 0xaf,
-	0x00, 0x09, 0x69, 0xb0, 0xe5, 0xd6, 0xe8, 0x5c, 0xad, 0xc6, 0x4e, 0xd6, 0x37, 0x62, 0xdd, 0x60,
-	0x4a, 0x52, 0x22, 0xd5, 0x1e, 0x0c, 0xcc, 0xa8, 0x65, 0xff, 0x8e, 0x05, 0xa3, 0x86, 0xd7, 0x91,
-	0xed, 0xd4, 0x8d, 0xf9, 0xaa, 0x38, 0x77, 0xcb, 0x4f, 0xb5, 0x94, 0x8b, 0x5f, 0x53, 0x90, 0x8c,
-	0xb7, 0x11, 0x0d, 0xc2, 0x98, 0xe1, 0x7d, 0x3c, 0x92, 0xf6, 0xbf, 0xb4, 0xe0, 0x4c, 0xa6, 0x8b,
-	0xf4, 0x11, 0x37, 0x7b, 0x16, 0x46, 0x36, 0xe9, 0xce, 0x22, 0x9f, 0x83, 0x69, 0x87, 0xe2, 0x92,
-	0x2a, 0xc0, 0x18, 0xc7, 0xfe, 0xb6, 0x05, 0x31, 0x25, 0x26, 0x8a, 0xd6, 0xe3, 0x96, 0x1b, 0xa2,
-	0x48, 0x72, 0x92, 0xa5, 0xe4, 0x2e, 0x9c, 0x4b, 0x8e, 0x20, 0x37, 0xb1, 0x1f, 0xde, 0xee, 0x2f,
-	0xce, 0x4c, 0xd9, 0x94, 0xb0, 0x1f, 0x0b, 0xfb, 0x16, 0x14, 0xaf, 0x38, 0xdd, 0x06, 0x3d, 0x90,
-	0x11, 0x87, 0x89, 0xb1, 0x80, 0x3a, 0xad, 0x48, 0xa9, 0xe9, 0x52, 0x8c, 0xa1, 0x84, 0xa1, 0x2e,
-	0xb5, 0xbf, 0x57, 0x84, 0x51, 0x23, 0x9a, 0x89, 0xed, 0xe3, 0x01, 0xed, 0xf8, 0x69, 0x5d, 0x97,
-	0x0d, 0x36, 0xf2, 0x12, 0xb6, 0x7e, 0x02, 0xba, 0xe5, 0x86, 0x42, 0xe4, 0x24, 0xd6, 0x0f, 0x4a,
-	0x38, 0x6a, 0x0c, 0x32, 0x0d, 0xc5, 0x3a, 0xed, 0x44, 0x4d, 0x2e, 0x4d, 0x07, 0x2b, 0x23, 0xac,
-	0xa9, 0x0b, 0x0c, 0x80, 0x02, 0xce, 0x10, 0x36, 0x68, 0x54, 0x6b, 0x72, 0x63, 0xe3, 0x88, 0x40,
-	0x58, 0x64, 0x00, 0x14, 0xf0, 0x0c, 0x4f, 0x4e, 0xf1, 0xe8, 0x3d, 0x39, 0x43, 0x39, 0x7b, 0x72,
-	0x48, 0x07, 0x4e, 0x85, 0x61, 0x73, 0x35, 0x70, 0xb7, 0x9c, 0x88, 0xc6, 0x33, 0x67, 0xf8, 0x30,
-	0x7c, 0xce, 0xed, 0xed, 0x4e, 0x9f, 0xaa, 0x56, 0xaf, 0xa6, 0xa9, 0x60, 0x16, 0x69, 0x52, 0x85,
-	0x33, 0xae, 0x17, 0xd2, 0x5a, 0x37, 0xa0, 0xd7, 0x1a, 0x9e, 0x1f, 0xd0, 0xab, 0x7e, 0xc8, 0xc8,
-	0xc9, 0x80, 0x46, 0xed, 0xbc, 0xbf, 0x96, 0x85, 0x84, 0xd9, 0x75, 0xc9, 0x15, 0x38, 0x59, 0x77,
-	0x43, 0x67, 0xbd, 0x45, 0xab, 0xdd, 0xf5, 0xb6, 0xcf, 0x0e, 0x6c, 0x22, 0x62, 0xa9, 0x54, 0x79,
-	0x5c, 0x99, 0x26, 0x16, 0xd2, 0x08, 0xd8, 0x5b, 0x87, 0xbc, 0x0c, 0x63, 0xa1, 0xeb, 0x35, 0x5a,
-	0xb4, 0x12, 0x38, 0x5e, 0xad, 0x29, 0x23, 0x21, 0xb5, 0x09, 0xb7, 0x6a, 0x94, 0x61, 0x02, 0x93,
-	0xaf, 0x57, 0x51, 0x27, 0xa5, 0xc9, 0x49, 0x6c, 0x59, 0x6a, 0x7f, 0xdf, 0x82, 0x31, 0x33, 0x78,
-	0x85, 0x69, 0xc9, 0xd0, 0x5c, 0x58, 0xac, 0x0a, 0x39, 0x9e, 0xdf, 0x6e, 0x7d, 0x55, 0xd3, 0x8c,
-	0x4f, 0x95, 0x31, 0x0c, 0x0d, 0x9e, 0x07, 0x08, 0x01, 0x7e, 0x06, 0x8a, 0x1b, 0x3e, 0x53, 0x26,
-	0x06, 0x92, 0xb6, 0xdf, 0x45, 0x06, 0x44, 0x51, 0x66, 0xff, 0x0f, 0x0b, 0xce, 0x66, 0xc7, 0xe5,
-	0x7c, 0x18, 0x3a, 0x79, 0x11, 0x80, 0x75, 0x25, 0x21, 0x90, 0x8d, 0x38, 0x6e, 0x55, 0x82, 0x06,
-	0xd6, 0xc1, 0xba, 0xfd, 0x13, 0xa6, 0xd0, 0xc6, 0x7c, 0xbe, 0x6c, 0xc1, 0x38, 0x63, 0xbb, 0x14,
-	0xac, 0x27, 0x7a, 0xbb, 0x92, 0x4f, 0x6f, 0x35, 0xd9, 0xd8, 0xc4, 0x9d, 0x00, 0x63, 0x92, 0x39,
-	0xf9, 0x08, 0x8c, 0x38, 0xf5, 0x7a, 0x40, 0xc3, 0x50, 0x3b, 0x8b, 0xb8, 0xe3, 0x75, 0x4e, 0x01,
-	0x31, 0x2e, 0x67, 0x42, 0xb4, 0x59, 0xdf, 0x08, 0x99, 0x5c, 0x92, 0x96, 0x3d, 0x2d, 0x44, 0x19,
-	0x13, 0x06, 0x47, 0x8d, 0x61, 0xff, 0xcd, 0x41, 0x48, 0xf2, 0x26, 0x75, 0x98, 0xd8, 0x0c, 0xd6,
-	0xe7, 0xb9, 0x73, 0xf8, 0x41, 0xfc, 0xdb, 0xa7, 0xf6, 0x76, 0xa7, 0x27, 0x96, 0x92, 0x14, 0x30,
-	0x4d, 0x52, 0x72, 0x59, 0xa2, 0x3b, 0x91, 0xb3, 0xfe, 0x20, 0x5b, 0x9d, 0xe2, 0x62, 0x52, 0xc0,
-	0x34, 0x49, 0xf2, 0x12, 0x8c, 0x6e, 0x06, 0xeb, 0x4a, 0x44, 0xa7, 0xfd, 0xfd, 0x4b, 0x71, 0x11,
-	0x9a, 0x78, 0xec, 0x13, 0x6e, 0x06, 0xeb, 0x6c, 0x4b, 0x53, 0x21, 0xf1, 0xfa, 0x13, 0x2e, 0x49,
-	0x38, 0x6a, 0x0c, 0xd2, 0x01, 0xb2, 0xa9, 0xbe, 0x9e, 0x76, 0x85, 0xcb, 0x9d, 0xe4, 0xe0, 0x9e,
-	0x74, 0x1e, 0xec, 0xb3, 0xd4, 0x43, 0x07, 0x33, 0x68, 0x93, 0xd7, 0xe0, 0xdc, 0x66, 0xb0, 0x2e,
-	0x37, 0xfa, 0xd5, 0xc0, 0xf5, 0x6a, 0x6e, 0x27, 0x11, 0xfe, 0x3e, 0x2d, 0x9b, 0x7b, 0x6e, 0x29,
-	0x1b, 0x0d, 0xfb, 0xd5, 0xb7, 0xff, 0xf9, 0x00, 0xf0, 0x28, 0x60, 0x26, 0x0b, 0xdb, 0x34, 0x6a,
-	0xfa, 0xf5, 0xb4, 0xee, 0xb2, 0xcc, 0xa1, 0x28, 0x4b, 0x55, 0x58, 0x51, 0xa1, 0x4f, 0x58, 0xd1,
-	0x36, 0x0c, 0x37, 0xa9, 0x53, 0xa7, 0x81, 0x32, 0xb5, 0x5d, 0xcf, 0x27, 0x6e, 0xf9, 0x2a, 0x27,
-	0x1a, 0x1f, 0xa1, 0xc5, 0xff, 0x10, 0x15, 0x37, 0xf2, 0x71, 0x38, 0xc1, 0xb4, 0x10, 0xbf, 0x1b,
-	0x29, 0xbb, 0xf2, 0x20, 0xb7, 0x2b, 0xf3, 0x1d, 0x75, 0x2d, 0x51, 0x82, 0x29, 0x4c, 0xb2, 0x00,
-	0x93, 0xd2, 0x06, 0xac, 0x4d, 0x78, 0xf2, 0xc3, 0xea, 0x7b, 0x09, 0xd5, 0x54, 0x39, 0xf6, 0xd4,
-	0x60, 0x12, 0x79, 0xdd, 0xaf, 0x0b, 0x37, 0xa0, 0x21, 0x91, 0x2b, 0x7e, 0x7d, 0x07, 0x79, 0x09,
-	0xd3, 0xf7, 0xd5, 0x5e, 0x58, 0xdd, 0x74, 0x3b, 0xb7, 0x68, 0xe0, 0x6e, 0xec, 0xf0, 0x8d, 0xbb,
-	0x14, 0xeb, 0xfb, 0xd7, 0x7a, 0x30, 0x30, 0xa3, 0x96, 0xfd, 0x8d, 0x02, 0x8c, 0x99, 0x01, 0xdd,
-	0xf7, 0x8b, 0xf7, 0x0a, 0xe3, 0x81, 0x11, 0xa7, 0xbb, 0xab, 0x39, 0x0c, 0xcc, 0xfd, 0x06, 0xe5,
-	0x2e, 0x8c, 0xac, 0xab, 0x38, 0x9a, 0xfc, 0xcc, 0x85, 0x3a, 0x34, 0x27, 0x56, 0xce, 0x35, 0x08,
-	0x63, 0x86, 0xf6, 0x77, 0x99, 0x90, 0xd7, 0x73, 0xe7, 0x00, 0xb6, 0xd7, 0x67, 0x4c, 0x2b, 0x46,
-	0x3f, 0x85, 0xf8, 0x7d, 0x18, 0xe1, 0x3f, 0x16, 0x03, 0xbf, 0x2d, 0xfb, 0x84, 0x79, 0xce, 0x71,
-	0x79, 0x5a, 0xe7, 0x02, 0xff, 0x96, 0x62, 0x84, 0x31, 0x4f, 0xdb, 0x87, 0xc9, 0x34, 0x36, 0xf9,
-	0x34, 0x8c, 0x85, 0x4a, 0x66, 0xc6, 0xe1, 0x9a, 0x07, 0x94, 0xad, 0xdc, 0x20, 0x57, 0x35, 0xaa,
-	0x63, 0x82, 0x98, 0xbd, 0x02, 0x43, 0xb9, 0x7e, 0x42, 0xfb, 0x9b, 0x16, 0x8c, 0x70, 0x97, 0x48,
-	0x23, 0x70, 0xda, 0x71, 0x95, 0x81, 0x7d, 0xbe, 0x7a, 0x08, 0xc3, 0xe2, 0xf0, 0xa4, 0x42, 0x09,
-	0x72, 0x98, 0xbe, 0xe2, 0x6a, 0x60, 0x3c, 0x7d, 0xc5, 0x29, 0x2d, 0x44, 0xc5, 0xc9, 0xfe, 0x42,
-	0x01, 0x86, 0xae, 0x79, 0x9d, 0xee, 0x5f, 0xf8, 0xeb, 0x69, 0xcb, 0x30, 0x78, 0x2d, 0xa2, 0xed,
-	0xe4, 0x2d, 0xca, 0xb1, 0xca, 0xb3, 0xe6, 0x0d, 0xca, 0x72, 0xf2, 0x06, 0x25, 0x3a, 0xdb, 0x2a,
-	0xd2, 0x46, 0x1a, 0xef, 0xe2, 0x90, 0xd5, 0x17, 0x60, 0xe4, 0xba, 0xb3, 0x4e, 0x5b, 0x4b, 0x74,
-	0x27, 0x64, 0xa7, 0x36, 0xe1, 0xf5, 0xb5, 0xe2, 0x53, 0x5b, 0xc2, 0x43, 0xbb, 0x00, 0x27, 0x38,
-	0xb6, 0x5e, 0x0c, 0x4c, 0x2d, 0xa4, 0xf1, 0xfd, 0x25, 0x2b, 0xa9, 0x16, 0x1a, 0x77, 0x97, 0x0c,
-	0x2c, 0x7b, 0x06, 0x46, 0x63, 0x2a, 0x07, 0xe0, 0xfa, 0x27, 0x05, 0x18, 0x4f, 0xd8, 0x20, 0x13,
-	0x9e, 0x19, 0xeb, 0xbe, 0x9e, 0x99, 0x84, 0xa7, 0xa4, 0xf0, 0xa8, 0x3d, 0x25, 0x03, 0xc7, 0xef,
-	0x29, 0x49, 0x0e, 0xd2, 0xe0, 0x81, 0x06, 0xa9, 0x05, 0x83, 0xd7, 0x5d, 0x6f, 0xf3, 0x60, 0x72,
-	0x26, 0xac, 0xf9, 0x9d, 0x1e, 0x39, 0x53, 0x65, 0x40, 0x14, 0x65, 0x6a, 0x4b, 0x1c, 0xc8, 0xde,
-	0x12, 0xed, 0xcf, 0x5b, 0x70, 0x72, 0x99, 0xb6, 0x7d, 0xf7, 0x1d, 0x27, 0x8e, 0x20, 0x63, 0x95,
-	0x9a, 0x6e, 0x24, 0x03, 0x66, 0x74, 0xa5, 0xab, 0x6e, 0x84, 0x0c, 0x7e, 0x1f, 0xcb, 0x16, 0x8f,
-	0xdd, 0x67, 0x6a, 0xef, 0x8d, 0x58, 0xff, 0x8c, 0x63, 0xc3, 0x54, 0x01, 0xc6, 0x38, 0xf6, 0xef,
-	0x59, 0x30, 0x2c, 0x1a, 0x41, 0x15, 0x6d, 0xab, 0x0f, 0xed, 0x26, 0x14, 0x79, 0x3d, 0x39, 0x9d,
-	0xae, 0xe4, 0xe0, 0xf1, 0x60, 0xe4, 0xc4, 0xe4, 0xe7, 0x3f, 0x51, 0x30, 0xe0, 0xca, 0xa0, 0x73,
-	0x67, 0x4e, 0x07, 0xcf, 0xc5, 0xca, 0x20, 0x87, 0xa2, 0x2c, 0xb5, 0xbf, 0x3e, 0x00, 0x25, 0xe5,
-	0x4b, 0x16, 0x97, 0x58, 0x3c, 0xcf, 0x8f, 0x1c, 0xe1, 0x6a, 0x15, 0x42, 0x32, 0x87, 0x70, 0x28,
-	0xc5, 0x61, 0x66, 0x2e, 0xa6, 0x2e, 0x3c, 0x1a, 0x5a, 0xb5, 0x37, 0x4a, 0xd0, 0x6c, 0x04, 0xf9,
-	0x2c, 0x0c, 0xb5, 0xd8, 0xb2, 0x57, 0x32, 0xf3, 0x56, 0x8e, 0xcd, 0xe1, 0xf2, 0x44, 0xb6, 0x44,
-	0x7f, 0x21, 0x01, 0x44, 0xc9, 0x75, 0xea, 0x93, 0x30, 0x99, 0x6e, 0x75, 0x86, 0xfb, 0xe4, 0x74,
-	0x62, 0xd7, 0x34, 0xbc, 0x1d, 0x53, 0x7f, 0x59, 0x8a, 0xad, 0xc3, 0x57, 0xb5, 0x5f, 0x85, 0xd1,
-	0x65, 0x1a, 0x05, 0x6e, 0x8d, 0x13, 0xb8, 0xdf, 0xe4, 0x3a, 0xd0, 0xc6, 0xfd, 0x45, 0x3e, 0x59,
-	0x19, 0x4d, 0xa6, 0xdb, 0x41, 0x27, 0xf0, 0xd9, 0xa9, 0x80, 0x76, 0xd5, 0x60, 0xe7, 0xa0, 0xec,
-	0xaf, 0x6a, 0x9a, 0xc2, 0x09, 0x17, 0xff, 0x47, 0x83, 0x9f, 0x7d, 0x01, 0x8a, 0xcb, 0xdd, 0x88,
-	0xde, 0xb9, 0xbf, 0xa8, 0xb0, 0x3f, 0x0d, 0x63, 0x1c, 0xf5, 0xaa, 0xdf, 0x62, 0xdb, 0x13, 0xeb,
-	0x69, 0x9b, 0xfd, 0x4f, 0x9b, 0x3d, 0x39, 0x12, 0x8a, 0x32, 0xb6, 0x02, 0x9a, 0x7e, 0xab, 0x4e,
-	0x03, 0xf9, 0x3d, 0xf4, 0xf8, 0x5e, 0xe5, 0x50, 0x94, 0xa5, 0xf6, 0x2f, 0x14, 0x60, 0x94, 0x57,
-	0x94, 0xd2, 0x63, 0x07, 0x86, 0x9b, 0x82, 0x8f, 0xfc, 0x24, 0x39, 0xc4, 0x0c, 0x99, 0xad, 0x37,
-	0x94, 0x6d, 0x01, 0x40, 0xc5, 0x8f, 0xb1, 0xde, 0x76, 0xdc, 0x88, 0xb1, 0x2e, 0x1c, 0x2d, 0xeb,
-	0xdb, 0x82, 0x0d, 0x2a, 0x7e, 0xf6, 0xaf, 0x15, 0x00, 0x6e, 0xf8, 0x75, 0x8a, 0x34, 0xec, 0xb6,
-	0x22, 0xf2, 0xb3, 0x50, 0xec, 0x34, 0x9d, 0x30, 0xed, 0xca, 0x28, 0xae, 0x32, 0xe0, 0xbd, 0xdd,
-	0xe9, 0x11, 0x86, 0xcb, 0xff, 0xa0, 0x40, 0x34, 0xc3, 0x75, 0x0b, 0xfb, 0x87, 0xeb, 0x92, 0x0e,
-	0x0c, 0xfb, 0xdd, 0x88, 0x29, 0x65, 0x72, 0x57, 0xcb, 0xc1, 0x93, 0xb7, 0x22, 0x08, 0x8a, 0x18,
-	0x57, 0xf9, 0x07, 0x15, 0x1b, 0xf2, 0x32, 0x94, 0x3a, 0x81, 0xdf, 0x60, 0x9b, 0x94, 0xdc, 0xc7,
-	0x9e, 0x54, 0x1b, 0xff, 0xaa, 0x84, 0xdf, 0x33, 0x7e, 0xa3, 0xc6, 0xb6, 0x7f, 0x34, 0x21, 0xbe,
-	0x8b, 0x9c, 0x1c, 0x53, 0x50, 0x70, 0xd5, 0xf9, 0x1a, 0x24, 0x89, 0xc2, 0xb5, 0x05, 0x2c, 0xb8,
-	0x75, 0x3d, 0x8f, 0x0b, 0x7d, 0xb7, 0xbc, 0x97, 0x60, 0xb4, 0xee, 0x86, 0x9d, 0x96, 0xb3, 0x73,
-	0x23, 0xc3, 0xb8, 0xb1, 0x10, 0x17, 0xa1, 0x89, 0x47, 0x5e, 0x90, 0xc1, 0xd9, 0x83, 0x89, 0x03,
-	0xad, 0x0a, 0xce, 0x2e, 0xb1, 0xe6, 0x19, 0x71, 0xd9, 0x2f, 0xc3, 0x98, 0xda, 0xc4, 0x39, 0x17,
-	0x71, 0x98, 0xd5, 0xc6, 0xd4, 0x35, 0xa3, 0x0c, 0x13, 0x98, 0x3d, 0x2a, 0xc7, 0xd0, 0xf1, 0xab,
-	0x1c, 0x9f, 0x80, 0x71, 0xf5, 0x97, 0xeb, 0x01, 0xe5, 0xd3, 0xbc, 0xf5, 0xda, 0xe8, 0xb6, 0x66,
-	0x16, 0x62, 0x12, 0x37, 0x9e, 0xb4, 0xc3, 0x07, 0x9d, 0xb4, 0x17, 0x01, 0xd6, 0xfd, 0xae, 0x57,
-	0x77, 0x82, 0x9d, 0x6b, 0x0b, 0x32, 0x94, 0x4b, 0x6b, 0x38, 0x15, 0x5d, 0x82, 0x06, 0x96, 0x39,
-	0xd1, 0x47, 0xee, 0x33, 0xd1, 0x3f, 0x0d, 0x23, 0x3c, 0xec, 0x8d, 0xd6, 0xe7, 0x22, 0x19, 0xe4,
-	0x70, 0x98, 0x08, 0x29, 0xad, 0x76, 0x54, 0x15, 0x11, 0x8c, 0xe9, 0x91, 0x37, 0x01, 0x36, 0x5c,
-	0xcf, 0x0d, 0x9b, 0x9c, 0xfa, 0xe8, 0xa1, 0xa9, 0xeb, 0x7e, 0x2e, 0x6a, 0x2a, 0x68, 0x50, 0x24,
-	0x6f, 0xc0, 0x49, 0x1a, 0x46, 0x6e, 0xdb, 0x89, 0x68, 0x5d, 0xdf, 0xbf, 0x29, 0x73, 0x8b, 0x8c,
-	0x0e, 0x3c, 0xbc, 0x9c, 0x46, 0xb8, 0x97, 0x05, 0xc4, 0x5e, 0x42, 0x89, 0x15, 0x39, 0x75, 0x98,
-	0x15, 0x49, 0xfe, 0xcc, 0x82, 0x93, 0x01, 0x15, 0x9e, 0xef, 0x50, 0x37, 0xec, 0x0c, 0x97, 0x97,
-	0xb5, 0x3c, 0x52, 0xab, 0xa8, 0xc5, 0x3e, 0x83, 0x69, 0x2e, 0x42, 0x51, 0xa0, 0xaa, 0xf7, 0x3d,
-	0xe5, 0xf7, 0xb2, 0x80, 0x9f, 0xff, 0xc1, 0xf4, 0x74, 0x6f, 0x9e, 0x1f, 0x4d, 0x9c, 0xad, 0xbc,
-	0xbf, 0xf1, 0x83, 0xe9, 0x49, 0xf5, 0x3f, 0xfe, 0x68, 0x3d, 0x9d, 0x64, 0xfb, 0x5e, 0xc7, 0xaf,
-	0x5f, 0x5b, 0x95, 0xd1, 0x28, 0x7a, 0xdf, 0x5b, 0x65, 0x40, 0x14, 0x65, 0xe4, 0x79, 0x28, 0xd5,
-	0x1d, 0xda, 0xf6, 0x3d, 0x5a, 0x2f, 0x8f, 0xc7, 0xee, 0xbe, 0x05, 0x09, 0x43, 0x5d, 0x4a, 0x5a,
-	0x30, 0xe4, 0xf2, 0xb3, 0xb1, 0x0c, 0x3d, 0xcb, 0xe1, 0x40, 0x2e, 0xce, 0xda, 0x2a, 0xf0, 0x8c,
-	0x0b, 0x61, 0xc9, 0xc3, 0x94, 0xfa, 0x13, 0xc7, 0x23, 0xf5, 0x9f, 0x87, 0x52, 0xad, 0xe9, 0xb6,
-	0xea, 0x01, 0xf5, 0xca, 0x93, 0xfc, 0x90, 0xc8, 0xbf, 0xc4, 0xbc, 0x84, 0xa1, 0x2e, 0x25, 0x7f,
-	0x09, 0xc6, 0xfd, 0x6e, 0xc4, 0x17, 0x39, 0x1b, 0xff, 0xb0, 0x7c, 0x92, 0xa3, 0xf3, 0x40, 0x82,
-	0x15, 0xb3, 0x00, 0x93, 0x78, 0x4c, 0xd8, 0x36, 0xfd, 0x30, 0x62, 0x7f, 0xb8, 0xb0, 0x3d, 0x9b,
-	0x14, 0xb6, 0x57, 0x8d, 0x32, 0x4c, 0x60, 0x92, 0xaf, 0x59, 0x70, 0xb2, 0x9d, 0x3e, 0xba, 0x94,
-	0xcf, 0xf1, 0x2f, 0x53, 0xcd, 0x43, 0xc5, 0x4d, 0x91, 0x16, 0xf1, 0x96, 0x3d, 0x60, 0xec, 0x6d,
-	0x04, 0xbf, 0x43, 0x1c, 0xee, 0x78, 0xb5, 0x66, 0xe0, 0x7b, 0xc9, 0xe6, 0x3d, 0x9e, 0xd7, 0xfd,
-	0x08, 0xbe, 0xca, 0xb2, 0x58, 0x54, 0x1e, 0xdf, 0xdb, 0x9d, 0x3e, 0x93, 0x59, 0x84, 0xd9, 0x8d,
-	0x9a, 0x5a, 0x80, 0xb3, 0xd9, 0x2b, 0xf5, 0x7e, 0xba, 0xf6, 0x80, 0xa9, 0x6b, 0x2f, 0xc2, 0xe3,
-	0x7d, 0x1b, 0xc5, 0x64, 0xbe, 0x52, 0xcc, 0xac, 0xa4, 0xcc, 0xef, 0x51, 0xa4, 0x4e, 0xc0, 0x98,
-	0x99, 0x9d, 0x89, 0x47, 0x75, 0x18, 0xf7, 0xd7, 0xc9, 0x5d, 0x18, 0xf1, 0xab, 0xb9, 0x87, 0x47,
-	0xac, 0x54, 0x7b, 0xc2, 0x23, 0x34, 0x08, 0x63, 0x86, 0x07, 0x89, 0xea, 0xc8, 0xbc, 0x6c, 0xff,
-	0x88, 0x9b, 0x7d, 0xe8, 0xa8, 0x8e, 0x7f, 0x3f, 0x08, 0x31, 0x25, 0xf2, 0x02, 0x94, 0xa8, 0x57,
-	0xef, 0xf8, 0xae, 0x17, 0xa5, 0xed, 0x3e, 0x97, 0x25, 0x1c, 0x35, 0x86, 0x11, 0x03, 0x52, 0xd8,
-	0x37, 0x06, 0xa4, 0x0e, 0x13, 0x0e, 0x77, 0x20, 0xc4, 0x1e, 0xfc, 0x81, 0x43, 0x3b, 0xc4, 0xe6,
-	0x92, 0x14, 0x30, 0x4d, 0x92, 0x71, 0x09, 0xe3, 0xaa, 0x9c, 0xcb, 0xe0, 0xa1, 0xb9, 0x54, 0x93,
-	0x14, 0x30, 0x4d, 0x92, 0xbc, 0x01, 0xe5, 0x1a, 0xbf, 0xe8, 0x23, 0xfa, 0x78, 0x6d, 0xe3, 0x86,
-	0x1f, 0xad, 0x06, 0x34, 0xa4, 0x9e, 0x88, 0xb0, 0x28, 0x55, 0x9e, 0x96, 0x5f, 0xa1, 0x3c, 0xdf,
-	0x07, 0x0f, 0xfb, 0x52, 0x60, 0x5a, 0x1d, 0xf7, 0x7e, 0xb8, 0xd1, 0xce, 0x9a, 0xbf, 0x49, 0x95,
-	0x6b, 0x46, 0x6b, 0x75, 0x55, 0xb3, 0x10, 0x93, 0xb8, 0xe4, 0x97, 0x2d, 0x18, 0x6f, 0x29, 0x33,
-	0x1e, 0x76, 0x5b, 0x2a, 0xff, 0x13, 0xe6, 0x32, 0xfd, 0xae, 0x9b, 0x94, 0x85, 0xc0, 0x4f, 0x80,
-	0x30, 0xc9, 0xdb, 0xfe, 0xae, 0x05, 0x93, 0xe9, 0x6a, 0x64, 0x13, 0x9e, 0x6a, 0x3b, 0xc1, 0xe6,
-	0x35, 0x6f, 0x23, 0xe0, 0x21, 0xb0, 0x91, 0x18, 0xd5, 0xb9, 0x8d, 0x88, 0x06, 0x0b, 0xce, 0x8e,
-	0x08, 0x74, 0x2b, 0xea, 0x94, 0x75, 0x4f, 0x2d, 0xef, 0x87, 0x8c, 0xfb, 0xd3, 0x22, 0x55, 0x38,
-	0xc3, 0x10, 0x16, 0x68, 0x8b, 0x32, 0x09, 0x15, 0x33, 0x29, 0x70, 0x26, 0x3a, 0x94, 0x63, 0x39,
-	0x0b, 0x09, 0xb3, 0xeb, 0xda, 0x25, 0x18, 0x12, 0xe1, 0xff, 0xf6, 0xbf, 0x2b, 0x80, 0xda, 0x49,
-	0xff, 0x62, 0x9b, 0xcc, 0x89, 0x0d, 0x43, 0x01, 0x3f, 0x0d, 0xcb, 0x83, 0x1a, 0x57, 0x6a, 0xc4,
-	0xf9, 0x18, 0x65, 0x09, 0x53, 0x31, 0xe8, 0x1d, 0x37, 0x9a, 0xf7, 0xeb, 0xea, 0x78, 0xc6, 0x55,
-	0x8c, 0xcb, 0x12, 0x86, 0xba, 0xd4, 0xfe, 0x45, 0x0b, 0xc6, 0x59, 0x2f, 0x5b, 0x2d, 0xda, 0xaa,
-	0x46, 0xb4, 0x13, 0x92, 0x10, 0x8a, 0x21, 0xfb, 0x91, 0x9f, 0x99, 0x21, 0xbe, 0xf5, 0x41, 0x3b,
-	0x86, 0x41, 0x95, 0x31, 0x41, 0xc1, 0xcb, 0xfe, 0xd6, 0x00, 0x8c, 0xe8, 0x8f, 0x7d, 0x00, 0x2b,
-	0xed, 0xc5, 0x38, 0xdf, 0x86, 0x90, 0x86, 0x65, 0x23, 0xd7, 0x06, 0x3b, 0x53, 0xcd, 0x79, 0x3b,
-	0xe2, 0x7a, 0x69, 0x9c, 0x78, 0xe3, 0x85, 0xa4, 0x3b, 0xe8, 0xac, 0xe9, 0x63, 0x30, 0xf0, 0xa5,
-	0x5f, 0xe8, 0x8e, 0xe9, 0x8d, 0x1b, 0xcc, 0x6b, 0x67, 0xd1, 0xae, 0x86, 0xfe, 0x6e, 0xb8, 0x54,
-	0x1a, 0xba, 0xe2, 0x81, 0xd2, 0xd0, 0x5d, 0x80, 0x41, 0xea, 0x75, 0xdb, 0xfc, 0x0a, 0xc0, 0x08,
-	0xd7, 0xa9, 0x06, 0x2f, 0x7b, 0xdd, 0x76, 0xb2, 0x67, 0x1c, 0x85, 0x7c, 0x12, 0x46, 0xeb, 0x34,
-	0xac, 0x05, 0x2e, 0xbf, 0x33, 0x29, 0x0f, 0xa5, 0x4f, 0xf2, 0x93, 0x7e, 0x0c, 0x4e, 0x56, 0x34,
-	0x2b, 0xd8, 0xef, 0xc0, 0xd0, 0x6a, 0xab, 0xdb, 0x70, 0x3d, 0xd2, 0x81, 0x21, 0x71, 0x83, 0x52,
-	0xee, 0xbc, 0x39, 0x28, 0xea, 0x62, 0xb5, 0x1b, 0x91, 0xef, 0xe2, 0xf2, 0x8f, 0xe4, 0x63, 0xff,
-	0x33, 0x0b, 0xd8, 0xa9, 0xe2, 0xca, 0x3c, 0xf9, 0x2b, 0x3d, 0xf9, 0xe1, 0x7e, 0x2a, 0x23, 0x3f,
-	0xdc, 0x38, 0x47, 0xee, 0x4d, 0x0d, 0x47, 0x5a, 0x30, 0xce, 0xed, 0xa8, 0x6a, 0x3f, 0x92, 0x96,
-	0xef, 0x4b, 0x07, 0xbc, 0x74, 0x68, 0x56, 0x95, 0xd2, 0xd9, 0x04, 0x61, 0x92, 0xb8, 0xfd, 0xfb,
-	0x83, 0x60, 0x98, 0x1b, 0x0f, 0x30, 0xbd, 0xdf, 0x4e, 0x19, 0x97, 0x97, 0x73, 0x31, 0x2e, 0x2b,
-	0x8b, 0xad, 0x10, 0x19, 0x49, 0x7b, 0x32, 0x6b, 0x54, 0x93, 0xb6, 0x3a, 0x72, 0x71, 0xe8, 0x46,
-	0x5d, 0xa5, 0xad, 0x0e, 0xf2, 0x12, 0x7d, 0x7d, 0x62, 0xb0, 0xef, 0xf5, 0x89, 0x26, 0x14, 0x1b,
-	0x4e, 0xb7, 0x41, 0x65, 0xcc, 0x4a, 0x0e, 0x7e, 0x04, 0x1e, 0x4f, 0x2a, 0xfc, 0x08, 0xfc, 0x27,
-	0x0a, 0x06, 0x6c, 0x75, 0x36, 0x95, 0x9f, 0x57, 0x1a, 0x84, 0x72, 0x58, 0x9d, 0xda, 0x75, 0x2c,
-	0x56, 0xa7, 0xfe, 0x8b, 0x31, 0x33, 0x76, 0x5e, 0xac, 0x89, 0xbb, 0xca, 0x72, 0xc3, 0xbf, 0x96,
-	0xc7, 0xfd, 0x10, 0x4e, 0x50, 0x9c, 0x17, 0xe5, 0x1f, 0x54, 0x6c, 0xec, 0x59, 0x18, 0x35, 0x52,
-	0xbf, 0xb1, 0x61, 0xd0, 0xd7, 0x64, 0x8d, 0x61, 0x58, 0x70, 0x22, 0x07, 0x79, 0x89, 0xfd, 0xf7,
-	0x06, 0x40, 0x9f, 0xdb, 0xcd, 0xdb, 0x0c, 0x4e, 0xcd, 0xb8, 0xd4, 0x9f, 0xb8, 0x46, 0xe7, 0x7b,
-	0x28, 0x4b, 0x99, 0x52, 0xd4, 0xa6, 0x41, 0x43, 0x9f, 0x14, 0xa4, 0x7c, 0xd5, 0x4a, 0xd1, 0xb2,
-	0x59, 0x88, 0x49, 0x5c, 0xa6, 0xd1, 0xb6, 0x1d, 0xcf, 0xdd, 0xa0, 0x61, 0x94, 0x0e, 0x19, 0x5b,
-	0x96, 0x70, 0xd4, 0x18, 0xe4, 0x0a, 0x9c, 0x0c, 0x69, 0xb4, 0xb2, 0xed, 0xd1, 0x40, 0x5f, 0xef,
-	0x93, 0xf7, 0x3d, 0x75, 0xa0, 0x66, 0x35, 0x8d, 0x80, 0xbd, 0x75, 0x32, 0xc3, 0x6c, 0x8a, 0x87,
-	0x0e, 0xb3, 0x59, 0x80, 0xc9, 0x0d, 0xc7, 0x6d, 0x75, 0x03, 0xda, 0x37, 0x58, 0x67, 0x31, 0x55,
-	0x8e, 0x3d, 0x35, 0x78, 0xac, 0x70, 0xcb, 0x69, 0x84, 0xe5, 0x61, 0x23, 0x56, 0x98, 0x01, 0x50,
-	0xc0, 0xed, 0x7f, 0x6c, 0x81, 0xb8, 0x68, 0x3f, 0xb7, 0xb1, 0xe1, 0x7a, 0x6e, 0xb4, 0x43, 0x7e,
-	0xc3, 0x82, 0x49, 0xcf, 0xaf, 0xd3, 0x39, 0x2f, 0x72, 0x15, 0x30, 0xbf, 0xbc, 0x58, 0x9c, 0xd7,
-	0x8d, 0x14, 0x79, 0x71, 0x6b, 0x33, 0x0d, 0xc5, 0x9e, 0x66, 0xd8, 0xe7, 0xe0, 0x4c, 0x26, 0x01,
-	0xfb, 0xbb, 0x03, 0x90, 0xcc, 0x17, 0x40, 0x5e, 0x85, 0x62, 0x8b, 0xdf, 0x60, 0xb5, 0x1e, 0x30,
-	0x11, 0x04, 0xff, 0x56, 0xe2, 0x8a, 0xab, 0xa0, 0x44, 0x16, 0x60, 0x94, 0x27, 0x21, 0x90, 0xf7,
-	0x8b, 0xc5, 0x54, 0xb4, 0xe3, 0x54, 0xa4, 0xba, 0xe8, 0x5e, 0xf2, 0x2f, 0x9a, 0xd5, 0xc8, 0xbb,
-	0x30, 0xbc, 0x2e, 0x72, 0x07, 0xe5, 0x67, 0xd8, 0x97, 0xc9, 0x88, 0xb8, 0x16, 0xa1, 0x32, 0x13,
-	0xdd, 0x8b, 0x7f, 0xa2, 0xe2, 0x48, 0x76, 0xa0, 0xe4, 0xa8, 0x31, 0x1d, 0xcc, 0x2b, 0xf6, 0x33,
-	0x31, 0x7f, 0x84, 0x6e, 0xa7, 0xc7, 0x50, 0xb3, 0x4b, 0x39, 0xca, 0x8b, 0x07, 0x72, 0x94, 0x7f,
-	0xd3, 0x02, 0x88, 0xb3, 0x0a, 0x92, 0x3b, 0x50, 0x0a, 0x2f, 0x25, 0x8e, 0xd7, 0x79, 0x5c, 0xd8,
-	0x93, 0x14, 0x8d, 0x4b, 0x2d, 0x12, 0x82, 0x9a, 0xdb, 0xfd, 0x4c, 0x02, 0x7f, 0x62, 0xc1, 0xe9,
-	0xac, 0xec, 0x87, 0x8f, 0xb0, 0xc5, 0x87, 0xb5, 0x06, 0xc8, 0x0a, 0xab, 0x01, 0xdd, 0x70, 0xef,
-	0xa4, 0x5d, 0xfa, 0x4b, 0xaa, 0x00, 0x63, 0x1c, 0xfb, 0xdb, 0x43, 0xa0, 0x19, 0x1f, 0x91, 0xf5,
-	0xe0, 0x39, 0x76, 0xba, 0x68, 0xc4, 0x39, 0xad, 0x34, 0x1e, 0x72, 0x28, 0xca, 0x52, 0x76, 0xc2,
-	0x50, 0xb1, 0x83, 0x52, 0x64, 0xf3, 0x59, 0xa8, 0x62, 0x0c, 0x51, 0x97, 0x66, 0xd9, 0x23, 0x8a,
-	0xc7, 0x62, 0x8f, 0x18, 0xca, 0xdf, 0x1e, 0x71, 0x01, 0x86, 0x03, 0xbf, 0x45, 0xe7, 0xf0, 0x86,
-	0xd4, 0x9b, 0xe3, 0x5c, 0x6c, 0x02, 0x8c, 0xaa, 0x9c, 0xbc, 0x04, 0xa3, 0xdd, 0x90, 0x56, 0x17,
-	0x96, 0xe6, 0x03, 0x5a, 0x0f, 0xe5, 0x85, 0x06, 0xed, 0x54, 0xbb, 0x19, 0x17, 0xa1, 0x89, 0x47,
-	0xbe, 0x6d, 0xed, 0x63, 0xf2, 0x18, 0xc9, 0x6b, 0x4f, 0xc8, 0xcc, 0x9e, 0xc2, 0x0f, 0x01, 0x0f,
-	0x62, 0x47, 0xf9, 0xba, 0x05, 0x27, 0xa9, 0x57, 0x0b, 0x76, 0x38, 0x1d, 0x49, 0x4d, 0x3a, 0x96,
-	0x6e, 0xe6, 0xb1, 0xf8, 0x2e, 0xa7, 0x89, 0x0b, 0xab, 0x71, 0x0f, 0x18, 0x7b, 0x9b, 0x61, 0xff,
-	0xa8, 0x00, 0xa7, 0x32, 0x28, 0xf0, 0xd0, 0xec, 0x36, 0x9b, 0x40, 0xd7, 0xea, 0xe9, 0xe5, 0xb3,
-	0x24, 0xe1, 0xa8, 0x31, 0xc8, 0x2a, 0x9c, 0xde, 0x6c, 0x87, 0x31, 0x95, 0x79, 0xdf, 0x8b, 0xe8,
-	0x1d, 0xb5, 0x98, 0x94, 0x8f, 0xe8, 0xf4, 0x52, 0x06, 0x0e, 0x66, 0xd6, 0x64, 0xda, 0x06, 0xf5,
-	0x9c, 0xf5, 0x16, 0x8d, 0x8b, 0xe4, 0xc5, 0x02, 0xad, 0x6d, 0x5c, 0x4e, 0x95, 0x63, 0x4f, 0x0d,
-	0xf2, 0x25, 0x0b, 0x9e, 0x08, 0x69, 0xb0, 0x45, 0x83, 0xaa, 0x5b, 0xa7, 0xf3, 0xdd, 0x30, 0xf2,
-	0xdb, 0x34, 0x78, 0x40, 0x9b, 0xdc, 0xf4, 0xde, 0xee, 0xf4, 0x13, 0xd5, 0xfe, 0xd4, 0x70, 0x3f,
-	0x56, 0xf6, 0x97, 0x2c, 0x38, 0x51, 0xe5, 0xa7, 0x44, 0xad, 0x73, 0xe6, 0x9d, 0xee, 0xea, 0x39,
-	0x7d, 0x8d, 0x35, 0x25, 0xc4, 0x92, 0x17, 0x4f, 0xed, 0xb7, 0x60, 0xb2, 0x4a, 0xdb, 0x4e, 0xa7,
-	0xc9, 0x6f, 0x05, 0x89, 0x20, 0x86, 0x59, 0x18, 0x09, 0x15, 0x2c, 0x9d, 0xfb, 0x54, 0x23, 0x63,
-	0x8c, 0x43, 0x9e, 0x15, 0x01, 0x17, 0x2a, 0xae, 0x79, 0x44, 0x68, 0xe7, 0x22, 0x4a, 0x23, 0x44,
-	0x55, 0x66, 0x6f, 0xc3, 0x58, 0x5c, 0x9d, 0x6e, 0x90, 0x06, 0x4c, 0xd4, 0x8c, 0xb0, 0xfc, 0x38,
-	0x66, 0xf6, 0xe0, 0x11, 0xfc, 0x5c, 0x16, 0xcd, 0x27, 0x89, 0x60, 0x9a, 0xaa, 0xfd, 0x95, 0x02,
-	0x4c, 0x68, 0xce, 0xd2, 0x21, 0xf0, 0x5e, 0x3a, 0x48, 0x04, 0xf3, 0xb8, 0x5e, 0x9f, 0xfc, 0x92,
-	0xfb, 0x04, 0x8a, 0xbc, 0x97, 0x0e, 0x14, 0x39, 0x52, 0xf6, 0x3d, 0x3e, 0x8e, 0x6f, 0x16, 0xa0,
-	0xa4, 0x2f, 0xfb, 0xbf, 0x0a, 0x45, 0x7e, 0x80, 0x7a, 0x38, 0x6d, 0x94, 0x1f, 0xc6, 0x50, 0x50,
-	0x62, 0x24, 0xb9, 0x9f, 0xfb, 0x81, 0x33, 0x9d, 0x8d, 0x08, 0xbb, 0x97, 0x13, 0x44, 0x28, 0x28,
-	0x91, 0x25, 0x18, 0xa0, 0x5e, 0x5d, 0xaa, 0xa5, 0x87, 0x27, 0xc8, 0xf3, 0xfe, 0x5e, 0xf6, 0xea,
-	0xc8, 0xa8, 0xf0, 0x74, 0x5b, 0x42, 0xfb, 0x18, 0x4c, 0x2e, 0x0f, 0xa9, 0x7a, 0xc8, 0x52, 0xfb,
-	0x97, 0x07, 0x60, 0xa8, 0xda, 0x5d, 0x67, 0x0a, 0xf6, 0x6f, 0x59, 0x70, 0x6a, 0x3b, 0x95, 0x99,
-	0x2f, 0x9e, 0xb2, 0x37, 0xf3, 0xb3, 0xfd, 0x99, 0xb1, 0x16, 0x4f, 0xc8, 0x76, 0x9d, 0xca, 0x28,
-	0xc4, 0xac, 0xe6, 0x24, 0x32, 0x71, 0x0d, 0x1c, 0x49, 0x26, 0xae, 0x3b, 0x47, 0x1c, 0x55, 0x3b,
-	0xde, 0x2f, 0xa2, 0xd6, 0xfe, 0xfd, 0x22, 0x80, 0x18, 0x8d, 0x95, 0x4e, 0x74, 0x10, 0xe3, 0xd0,
-	0xcb, 0x30, 0xa6, 0x9e, 0xa3, 0xb9, 0x11, 0x07, 0xf6, 0x68, 0xe7, 0xee, 0x15, 0xa3, 0x0c, 0x13,
-	0x98, 0xfc, 0x40, 0xe0, 0x45, 0xc1, 0x8e, 0x50, 0x1a, 0xd3, 0x91, 0xb3, 0xba, 0x04, 0x0d, 0x2c,
-	0x32, 0x93, 0x30, 0xb6, 0x8b, 0xac, 0x24, 0x27, 0xf6, 0xb1, 0x8d, 0x7f, 0x12, 0x4e, 0x24, 0xef,
-	0x07, 0x4b, 0x4d, 0x49, 0xdf, 0xce, 0x4f, 0x5e, 0x2b, 0xc6, 0x14, 0x36, 0x9b, 0xc4, 0xf5, 0x60,
-	0x07, 0xbb, 0x9e, 0x54, 0x99, 0xf4, 0x24, 0x5e, 0xe0, 0x50, 0x94, 0xa5, 0xfc, 0x72, 0x26, 0xdf,
-	0x8d, 0x04, 0x5c, 0x5e, 0xf0, 0x8c, 0x2f, 0x67, 0x1a, 0x65, 0x98, 0xc0, 0x64, 0x1c, 0xa4, 0x71,
-	0x0d, 0x92, 0xcb, 0x24, 0x65, 0x11, 0xeb, 0xc0, 0x09, 0x3f, 0x69, 0x9b, 0x10, 0xd1, 0x2c, 0x1f,
-	0x3b, 0xe0, 0xd4, 0x4b, 0xd4, 0x15, 0xd7, 0x85, 0x52, 0xa6, 0x8c, 0x14, 0x7d, 0xa6, 0x33, 0x9a,
-	0x71, 0xae, 0x63, 0xc9, 0x40, 0xac, 0xbe, 0xa1, 0xa8, 0xab, 0x70, 0xba, 0xe3, 0xd7, 0x57, 0x03,
-	0xd7, 0x0f, 0xdc, 0x68, 0x67, 0xbe, 0xe5, 0x84, 0x21, 0x9f, 0x18, 0xe3, 0x49, 0xe5, 0x64, 0x35,
-	0x03, 0x07, 0x33, 0x6b, 0x32, 0xed, 0xbe, 0x23, 0x81, 0x3c, 0x08, 0xa3, 0x28, 0xb4, 0x7b, 0x85,
-	0x88, 0xba, 0xd4, 0x3e, 0x05, 0x27, 0xab, 0xdd, 0x4e, 0xa7, 0xe5, 0xd2, 0xba, 0x36, 0x66, 0xdb,
-	0x3f, 0x07, 0x13, 0x32, 0x4f, 0x97, 0x56, 0x05, 0x0e, 0x95, 0x55, 0xd2, 0xfe, 0x33, 0x0b, 0x26,
-	0x52, 0x2e, 0x6f, 0xf2, 0x6e, 0x7a, 0x03, 0xcf, 0xc5, 0x37, 0x61, 0xee, 0xdd, 0x62, 0x91, 0x66,
-	0x2a, 0x03, 0x4d, 0x15, 0xda, 0x99, 0x5b, 0x84, 0x34, 0x0f, 0x80, 0x14, 0x3b, 0x82, 0x19, 0x1f,
-	0x6a, 0x7f, 0xb1, 0x00, 0xd9, 0x71, 0x06, 0xe4, 0xb3, 0xbd, 0x1f, 0xe0, 0xd5, 0x1c, 0x3f, 0x80,
-	0x0c, 0x74, 0xe8, 0xff, 0x0d, 0xbc, 0xe4, 0x37, 0x58, 0xce, 0xe9, 0x1b, 0x48, 0xbe, 0xbd, 0x5f,
-	0xe2, 0x7f, 0x5a, 0x30, 0xba, 0xb6, 0x76, 0x5d, 0xdb, 0x97, 0x10, 0xce, 0x86, 0xe2, 0x5e, 0x1d,
-	0x77, 0x10, 0xce, 0xfb, 0xed, 0x8e, 0xf0, 0x17, 0x4a, 0x3f, 0x26, 0x4f, 0x99, 0x56, 0xcd, 0xc4,
-	0xc0, 0x3e, 0x35, 0xc9, 0x35, 0x38, 0x65, 0x96, 0x48, 0x2b, 0xa1, 0xf4, 0x59, 0x8a, 0xbb, 0xec,
-	0xbd, 0xc5, 0x98, 0x55, 0x27, 0x4d, 0x4a, 0x9a, 0x0a, 0xe5, 0x3b, 0x49, 0x3d, 0xa4, 0x64, 0x31,
-	0x66, 0xd5, 0xb1, 0x57, 0x60, 0xd4, 0x78, 0xb5, 0x8b, 0x7c, 0x0a, 0x26, 0x6b, 0x7e, 0x5b, 0x99,
-	0x68, 0xae, 0xd3, 0x2d, 0xda, 0x92, 0x5d, 0xe6, 0x56, 0xbc, 0xf9, 0x54, 0x19, 0xf6, 0x60, 0xdb,
-	0xff, 0x6d, 0x1a, 0xf4, 0x55, 0x92, 0x03, 0xec, 0x30, 0x1d, 0x1d, 0x81, 0x55, 0xcc, 0x39, 0x02,
-	0x4b, 0xcb, 0xda, 0x54, 0x14, 0x56, 0x14, 0x47, 0x61, 0x0d, 0xe5, 0x1d, 0x85, 0xa5, 0x15, 0xc6,
-	0x9e, 0x48, 0xac, 0xaf, 0x5a, 0x30, 0xe6, 0xf9, 0x75, 0xaa, 0xbd, 0x40, 0xc3, 0x5c, 0x6b, 0x7d,
-	0x23, 0xbf, 0xd0, 0x52, 0x11, 0x51, 0x24, 0xc9, 0x8b, 0x38, 0x3d, 0xbd, 0x45, 0x99, 0x45, 0x98,
-	0x68, 0x07, 0x59, 0x34, 0x8c, 0x86, 0x22, 0x4f, 0xd6, 0x93, 0x59, 0xa7, 0x87, 0xfb, 0x5a, 0x00,
-	0xef, 0x18, 0x7a, 0xd3, 0x48, 0x5e, 0xc6, 0x30, 0x75, 0x4d, 0xc1, 0xb0, 0xed, 0xab, 0xac, 0x7f,
-	0xb1, 0x3e, 0x65, 0xc3, 0x90, 0x08, 0xe8, 0x93, 0x59, 0x13, 0xb8, 0xcb, 0x49, 0x04, 0xfb, 0xa1,
-	0x2c, 0x21, 0x91, 0xf2, 0x34, 0x8f, 0xe6, 0x95, 0xc3, 0x37, 0xe1, 0xc9, 0xce, 0x76, 0x35, 0x93,
-	0x57, 0xcc, 0x43, 0xe9, 0xd8, 0x41, 0x0e, 0xa5, 0xe3, 0x7d, 0x0f, 0xa4, 0x5f, 0xb6, 0x60, 0xac,
-	0x66, 0xe4, 0xd4, 0x2d, 0x3f, 0x9f, 0xd7, 0xcb, 0x2e, 0x59, 0xa9, 0x8f, 0xc5, 0x7d, 0xca, 0x44,
-	0x0e, 0xdf, 0x04, 0x77, 0x9e, 0xe6, 0x89, 0x9f, 0xc0, 0xf9, 0xd6, 0x3f, 0x7a, 0x71, 0x35, 0x87,
-	0xed, 0x21, 0x71, 0xa2, 0x17, 0xc3, 0x28, 0x60, 0x28, 0x79, 0x91, 0xbb, 0x50, 0x52, 0x31, 0xa1,
-	0x32, 0x62, 0x13, 0xf3, 0xb0, 0x70, 0x27, 0xfd, 0x57, 0x2a, 0x39, 0x8c, 0x80, 0xa2, 0xe6, 0x48,
-	0x9a, 0x30, 0x50, 0x77, 0x1a, 0x32, 0x76, 0x73, 0x39, 0x9f, 0xdc, 0x5b, 0x8a, 0x27, 0x3f, 0x5e,
-	0x2d, 0xcc, 0x5d, 0x41, 0xc6, 0x82, 0xdc, 0x89, 0x93, 0x92, 0x4e, 0xe6, 0xb6, 0xfb, 0x26, 0xd5,
-	0x24, 0x61, 0x63, 0xe8, 0xc9, 0x71, 0x5a, 0x97, 0x2e, 0xbf, 0xff, 0x8f, 0xb3, 0x5d, 0xcc, 0x27,
-	0x79, 0x97, 0x78, 0x1e, 0x27, 0x76, 0x1b, 0x32, 0x2e, 0xfc, 0x59, 0xb0, 0x9f, 0xc9, 0x8b, 0xcb,
-	0xd5, 0xb5, 0xb5, 0xd5, 0x9e, 0xe7, 0xc0, 0x5a, 0x30, 0xd4, 0xe1, 0xe1, 0x03, 0xe5, 0x8f, 0xe4,
-	0xb5, 0xb7, 0x88, 0x70, 0x04, 0x31, 0x37, 0xc5, 0x6f, 0x94, 0x3c, 0xc8, 0x65, 0x18, 0x16, 0xb9,
-	0xb5, 0x45, 0xec, 0xec, 0xe8, 0xc5, 0xa9, 0xfe, 0x19, 0xba, 0xe3, 0x8d, 0x42, 0xfc, 0x0f, 0x51,
-	0xd5, 0x25, 0x5f, 0xb1, 0xe0, 0x04, 0x93, 0xa8, 0x71, 0x32, 0xf0, 0x32, 0xc9, 0x4b, 0x66, 0xdd,
-	0x0c, 0x99, 0x46, 0xa2, 0x64, 0x8d, 0x3e, 0x26, 0x5d, 0x4b, 0xb0, 0xc3, 0x14, 0x7b, 0xf2, 0x1e,
-	0x94, 0x42, 0xb7, 0x4e, 0x6b, 0x4e, 0x10, 0x96, 0x4f, 0x1d, 0x4d, 0x53, 0x62, 0x5f, 0x87, 0x64,
-	0x84, 0x9a, 0x25, 0xf9, 0xdb, 0xfc, 0xad, 0x14, 0xf9, 0xae, 0x95, 0x7c, 0xc4, 0xf1, 0xf4, 0x91,
-	0x3d, 0xe2, 0x28, 0x5c, 0x00, 0x49, 0x76, 0x98, 0xe6, 0x4f, 0xfe, 0xba, 0x05, 0x67, 0x44, 0x2e,
-	0xd8, 0x74, 0x22, 0xe0, 0x33, 0x0f, 0x68, 0x5e, 0xe1, 0x41, 0xbf, 0x73, 0x59, 0x24, 0x31, 0x9b,
-	0x13, 0x4f, 0x26, 0x97, 0xcc, 0xdd, 0x7e, 0x36, 0x57, 0x9f, 0xdf, 0xc1, 0xf3, 0xb5, 0x93, 0x17,
-	0x61, 0xb4, 0x23, 0xb7, 0x43, 0x37, 0x6c, 0xf3, 0x10, 0xee, 0x01, 0x71, 0xcd, 0x65, 0x35, 0x06,
-	0xa3, 0x89, 0x93, 0xc8, 0x2c, 0x78, 0x61, 0xbf, 0xcc, 0x82, 0xe4, 0x26, 0x8c, 0x46, 0x7e, 0x8b,
-	0x06, 0xf2, 0xa4, 0x5a, 0xe6, 0x33, 0xf0, 0x7c, 0xd6, 0xda, 0x5a, 0xd3, 0x68, 0xf1, 0x49, 0x36,
-	0x86, 0x85, 0x68, 0xd2, 0xe1, 0x11, 0x99, 0x32, 0xc7, 0x6e, 0xc0, 0x8f, 0xb0, 0x8f, 0xa7, 0x22,
-	0x32, 0xcd, 0x42, 0x4c, 0xe2, 0x92, 0x2b, 0x70, 0xb2, 0xd3, 0x73, 0x06, 0x16, 0x97, 0x38, 0x74,
-	0x38, 0x41, 0xef, 0x01, 0xb8, 0xb7, 0x4e, 0xe2, 0xf4, 0xfb, 0xc4, 0x7e, 0xa7, 0xdf, 0x3e, 0x79,
-	0xf6, 0x9e, 0x7c, 0x90, 0x3c, 0x7b, 0xa4, 0x0e, 0x4f, 0x3a, 0xdd, 0xc8, 0xe7, 0xa9, 0x03, 0x92,
-	0x55, 0x44, 0x70, 0xea, 0xd3, 0x22, 0xde, 0x75, 0x6f, 0x77, 0xfa, 0xc9, 0xb9, 0x7d, 0xf0, 0x70,
-	0x5f, 0x2a, 0xe4, 0x1d, 0x28, 0x51, 0x99, 0x2b, 0xb0, 0xfc, 0x53, 0x79, 0x29, 0x09, 0xc9, 0xec,
-	0x83, 0x2a, 0xd6, 0x50, 0xc0, 0x50, 0xf3, 0x23, 0x6b, 0x30, 0xda, 0xf4, 0xc3, 0x68, 0xae, 0xe5,
-	0x3a, 0x21, 0x0d, 0xcb, 0x4f, 0xf1, 0x49, 0x93, 0xa9, 0x7b, 0x5d, 0x55, 0x68, 0xf1, 0x9c, 0xb9,
-	0x1a, 0xd7, 0x44, 0x93, 0x0c, 0xa1, 0xdc, 0xf3, 0xc7, 0x23, 0x73, 0x95, 0x57, 0xe6, 0x3c, 0xef,
-	0xd8, 0x73, 0x59, 0x94, 0x57, 0xfd, 0x7a, 0x35, 0x89, 0xad, 0x5d, 0x7f, 0x26, 0x10, 0xd3, 0x34,
-	0xc9, 0xcb, 0x30, 0xd6, 0xf1, 0xeb, 0xd5, 0x0e, 0xad, 0xad, 0x3a, 0x51, 0xad, 0x59, 0x9e, 0x4e,
-	0x5a, 0xdd, 0x56, 0x8d, 0x32, 0x4c, 0x60, 0x92, 0x0e, 0x0c, 0xb7, 0xc5, 0xd5, 0xd6, 0xf2, 0x33,
-	0x79, 0x9d, 0x6d, 0xe4, 0x5d, 0x59, 0xa1, 0x2f, 0xc8, 0x3f, 0xa8, 0xd8, 0x90, 0x7f, 0x68, 0xc1,
-	0x44, 0xea, 0x52, 0x42, 0xf9, 0xa7, 0x73, 0x53, 0x59, 0x92, 0x84, 0x2b, 0xcf, 0xf1, 0xcf, 0x97,
-	0x04, 0xde, 0xeb, 0x05, 0x61, 0xba, 0x45, 0xe2, 0xbb, 0xf0, 0xfb, 0xe9, 0xe5, 0x67, 0xf3, 0xfb,
-	0x2e, 0x9c, 0xa0, 0xfa, 0x2e, 0xfc, 0x0f, 0x2a, 0x36, 0xe4, 0x02, 0x0c, 0xcb, 0x04, 0x3d, 0xe5,
-	0xe7, 0x92, 0xee, 0x5b, 0x99, 0xc7, 0x07, 0x55, 0x39, 0xb9, 0x0b, 0xe0, 0xe8, 0xb7, 0x65, 0xcb,
-	0x2f, 0xe4, 0x95, 0xa2, 0x2c, 0x7e, 0xaf, 0x56, 0x18, 0x51, 0xe3, 0xff, 0x68, 0xf0, 0x9b, 0xfa,
-	0x39, 0x38, 0xd9, 0x73, 0x70, 0x3c, 0xd4, 0x15, 0xed, 0x5f, 0xb7, 0xc0, 0xbc, 0xcd, 0x98, 0x7b,
-	0x7a, 0xf0, 0x97, 0x61, 0xac, 0x26, 0xde, 0xf5, 0x11, 0xf7, 0x21, 0x07, 0x93, 0xd6, 0xd7, 0x79,
-	0xa3, 0x0c, 0x13, 0x98, 0xf6, 0x55, 0x20, 0xbd, 0xb9, 0x5b, 0x1f, 0x28, 0xf1, 0xc6, 0x6f, 0x5b,
-	0x30, 0x9e, 0xd0, 0x58, 0x72, 0xf7, 0x37, 0x2e, 0x02, 0x69, 0xbb, 0x41, 0xe0, 0x07, 0xe6, 0x6b,
-	0x2d, 0x32, 0x59, 0x25, 0x4f, 0xb3, 0xb5, 0xdc, 0x53, 0x8a, 0x19, 0x35, 0xec, 0x7f, 0x3a, 0x08,
-	0x71, 0xa8, 0xaf, 0x4e, 0xb0, 0x67, 0xf5, 0x4d, 0xb0, 0xf7, 0x02, 0x94, 0xde, 0x0a, 0x7d, 0x6f,
-	0x35, 0x4e, 0xc3, 0xa7, 0xc7, 0xe2, 0x95, 0xea, 0xca, 0x0d, 0x8e, 0xa9, 0x31, 0x38, 0xf6, 0xdb,
-	0x8b, 0x6e, 0x2b, 0xea, 0xcd, 0xd3, 0xf6, 0xca, 0xab, 0x02, 0x8e, 0x1a, 0x83, 0x3f, 0xdc, 0xb2,
-	0x45, 0xb5, 0x59, 0x3e, 0x7e, 0xb8, 0x45, 0xa4, 0x65, 0xe6, 0x65, 0x64, 0x16, 0x46, 0xb4, 0x49,
-	0x5f, 0xfa, 0x09, 0xf4, 0x97, 0xd2, 0x76, 0x7f, 0x8c, 0x71, 0xb8, 0x3a, 0x2a, 0xcd, 0xc0, 0xd2,
-	0x80, 0x53, 0xcd, 0xe3, 0x70, 0x94, 0x32, 0x2c, 0x8b, 0x9d, 0x45, 0x81, 0x51, 0xb3, 0xcc, 0x72,
-	0xba, 0x8e, 0x1c, 0x85, 0xd3, 0xd5, 0x8c, 0x3b, 0x2f, 0x1e, 0x34, 0xee, 0x3c, 0x39, 0xb7, 0x4b,
-	0x07, 0x9a, 0xdb, 0xbf, 0x34, 0x00, 0xc3, 0xb7, 0x68, 0xc0, 0xd3, 0x93, 0x5e, 0x80, 0xe1, 0x2d,
-	0xf1, 0x33, 0x7d, 0xcb, 0x4b, 0x62, 0xa0, 0x2a, 0x67, 0xe3, 0xb6, 0xde, 0x75, 0x5b, 0xf5, 0x85,
-	0x78, 0x15, 0xc7, 0x99, 0xac, 0x54, 0x01, 0xc6, 0x38, 0xac, 0x42, 0x83, 0x9d, 0x2b, 0xda, 0x6d,
-	0x37, 0x4a, 0x87, 0x20, 0x5d, 0x51, 0x05, 0x18, 0xe3, 0x90, 0xe7, 0x60, 0xa8, 0xe1, 0x46, 0x6b,
-	0x4e, 0x23, 0xed, 0x63, 0xbc, 0xc2, 0xa1, 0x28, 0x4b, 0xb9, 0x93, 0xca, 0x8d, 0xd6, 0x02, 0xca,
-	0xed, 0xca, 0x3d, 0xd7, 0xbd, 0xaf, 0x18, 0x65, 0x98, 0xc0, 0xe4, 0x4d, 0xf2, 0x65, 0xcf, 0x64,
-	0xfc, 0x65, 0xdc, 0x24, 0x55, 0x80, 0x31, 0x0e, 0x9b, 0xff, 0x35, 0xbf, 0xdd, 0x71, 0x5b, 0x32,
-	0x24, 0xd7, 0x98, 0xff, 0xf3, 0x12, 0x8e, 0x1a, 0x83, 0x61, 0x33, 0x11, 0xc6, 0xc4, 0x4f, 0xfa,
-	0x91, 0x8c, 0x55, 0x09, 0x47, 0x8d, 0x61, 0xdf, 0x82, 0x71, 0xb1, 0x92, 0xe7, 0x5b, 0x8e, 0xdb,
-	0xbe, 0x32, 0x4f, 0x2e, 0xf7, 0xc4, 0x9d, 0x5f, 0xc8, 0x88, 0x3b, 0x3f, 0x93, 0xa8, 0x94, 0xf1,
-	0x34, 0xf9, 0xf7, 0x0b, 0x50, 0x3a, 0xc6, 0x77, 0x86, 0x8e, 0xfd, 0x15, 0x3b, 0x72, 0x27, 0xf5,
-	0xc6, 0xd0, 0x6a, 0x9e, 0xd7, 0x48, 0xf6, 0x7d, 0x5f, 0xe8, 0x27, 0x16, 0x9c, 0x56, 0xa8, 0x5c,
-	0xa8, 0x55, 0x5c, 0x8f, 0x47, 0x27, 0x1c, 0xfd, 0x67, 0xbe, 0x9b, 0xf8, 0xcc, 0xaf, 0xe7, 0xd7,
-	0x65, 0xb3, 0x1f, 0x7d, 0x1f, 0x0e, 0xfc, 0x53, 0x0b, 0xca, 0x59, 0x15, 0x8e, 0xe1, 0x81, 0xa5,
-	0x77, 0x93, 0x0f, 0x2c, 0xdd, 0x3a, 0x9a, 0x9e, 0xf7, 0x79, 0x68, 0xe9, 0x27, 0x7d, 0xfa, 0xcd,
-	0x5f, 0x35, 0x6a, 0xa9, 0xed, 0xce, 0xca, 0xcb, 0x71, 0x27, 0x58, 0x64, 0xef, 0x9b, 0x2d, 0x18,
-	0x0a, 0xb9, 0x2b, 0x5f, 0x4e, 0x81, 0xab, 0x79, 0x6c, 0x82, 0x8c, 0x9e, 0x34, 0xbc, 0xf2, 0xdf,
-	0x28, 0x79, 0xd8, 0xff, 0xd1, 0x82, 0xb1, 0x63, 0x7c, 0x45, 0xcb, 0x4f, 0x0e, 0xf2, 0x2b, 0xf9,
-	0x0d, 0x72, 0x9f, 0x81, 0xdd, 0x2d, 0x42, 0xcf, 0xc3, 0x42, 0xe4, 0x0b, 0x96, 0x76, 0xdf, 0x8b,
-	0x10, 0xa7, 0x37, 0xf3, 0x6b, 0xc7, 0x61, 0x12, 0x30, 0x91, 0xaf, 0xa7, 0xb2, 0x52, 0x15, 0xf2,
-	0x4a, 0xf5, 0xd0, 0xd3, 0x9a, 0x07, 0xc8, 0x4e, 0xf5, 0x55, 0x0b, 0x40, 0xb4, 0x53, 0x66, 0x93,
-	0x64, 0x6d, 0x5b, 0x3f, 0xb2, 0x2f, 0xc5, 0x98, 0x88, 0xa6, 0x69, 0x01, 0x19, 0x17, 0xa0, 0xd1,
-	0x92, 0x87, 0x48, 0x3b, 0xf5, 0xd0, 0x19, 0xaf, 0xbe, 0x62, 0xc1, 0x44, 0xaa, 0xb9, 0x19, 0xf5,
-	0x37, 0x92, 0x0f, 0x8e, 0xe4, 0xb0, 0x6f, 0x25, 0x73, 0x0c, 0x9a, 0xa7, 0xb4, 0x3f, 0xb6, 0x21,
-	0xf1, 0x22, 0x1b, 0x79, 0x17, 0x46, 0xd4, 0x11, 0x4b, 0x4d, 0xef, 0x3c, 0x1f, 0x5e, 0xd2, 0x7a,
-	0x94, 0x82, 0x84, 0x18, 0xf3, 0x4b, 0x45, 0x07, 0x15, 0x0e, 0x14, 0x1d, 0xf4, 0x68, 0x9f, 0x6d,
-	0xca, 0x36, 0xbf, 0x0d, 0x1e, 0x89, 0xf9, 0xed, 0xc9, 0xdc, 0xcd, 0x6f, 0x4f, 0x1d, 0xb3, 0xf9,
-	0xcd, 0xf0, 0x85, 0x14, 0x1f, 0xc2, 0x17, 0xf2, 0x2e, 0x9c, 0xde, 0x8a, 0xb5, 0x5b, 0x3d, 0x93,
-	0xe4, 0xeb, 0x53, 0x17, 0x32, 0x8d, 0x6e, 0x4c, 0x53, 0x0f, 0x23, 0xea, 0x45, 0x86, 0x5e, 0x1c,
-	0x07, 0x26, 0xdd, 0xca, 0x20, 0x87, 0x99, 0x4c, 0xd2, 0x46, 0xed, 0xe1, 0x03, 0x18, 0xb5, 0xbf,
-	0x65, 0x41, 0xf6, 0x2b, 0xff, 0xd2, 0xb3, 0x7e, 0xfb, 0x28, 0x9e, 0xdc, 0x47, 0xba, 0x21, 0xbd,
-	0x07, 0x59, 0x45, 0x98, 0xdd, 0x20, 0xf2, 0x6c, 0xec, 0x61, 0x14, 0xe1, 0x6c, 0xd9, 0xee, 0xc0,
-	0xaf, 0xa7, 0xc3, 0x16, 0x80, 0x7f, 0xfa, 0xcf, 0xe4, 0xab, 0xd6, 0xe7, 0x10, 0xba, 0x30, 0xfa,
-	0x10, 0xa1, 0x0b, 0x29, 0x0f, 0xc3, 0x58, 0x4e, 0x1e, 0x06, 0x0f, 0x26, 0xdd, 0xb6, 0xd3, 0xa0,
-	0xab, 0xdd, 0x56, 0x4b, 0x04, 0xae, 0xab, 0xa7, 0xb1, 0x32, 0x4d, 0x05, 0xd7, 0xfd, 0x9a, 0xd3,
-	0x4a, 0xbf, 0x40, 0xa8, 0x03, 0xf4, 0xaf, 0xa5, 0x28, 0x61, 0x0f, 0x6d, 0x36, 0x61, 0x79, 0x7e,
-	0x1d, 0x1a, 0xb1, 0xaf, 0xcd, 0xfd, 0xe3, 0x25, 0x31, 0x61, 0xaf, 0xc6, 0x60, 0x34, 0x71, 0xc8,
-	0x12, 0x8c, 0xd4, 0xbd, 0x50, 0x5e, 0x79, 0x9b, 0xe0, 0xc2, 0xec, 0xa3, 0x4c, 0x04, 0x2e, 0xdc,
-	0xa8, 0xea, 0xcb, 0x6e, 0x4f, 0x66, 0xa4, 0x6e, 0xd2, 0xe5, 0x18, 0xd7, 0x27, 0xcb, 0x9c, 0x98,
-	0x7c, 0x7b, 0x40, 0xb8, 0xad, 0x9f, 0xee, 0x63, 0x17, 0x5f, 0xb8, 0xa1, 0x5e, 0x4f, 0x18, 0x97,
-	0xec, 0xe4, 0x23, 0x02, 0x31, 0x05, 0xe3, 0x89, 0xb2, 0x93, 0xfb, 0x3e, 0x51, 0xc6, 0x73, 0xb6,
-	0x45, 0x2d, 0xed, 0x05, 0x3b, 0x9f, 0x5b, 0xce, 0xb6, 0x38, 0x20, 0x4c, 0xe6, 0x6c, 0x8b, 0x01,
-	0x68, 0xb2, 0x24, 0x2b, 0xfd, 0xbc, 0x81, 0xa7, 0xb8, 0xd0, 0x38, 0xbc, 0x6f, 0xcf, 0x74, 0x0b,
-	0x9d, 0xde, 0xd7, 0x2d, 0xd4, 0xe3, 0xc6, 0x3a, 0x73, 0x08, 0x37, 0x56, 0x93, 0x67, 0xd3, 0xba,
-	0x32, 0x2f, 0x3d, 0x87, 0x39, 0x9c, 0x58, 0xf8, 0x25, 0x78, 0x11, 0x60, 0xc7, 0x7f, 0xa2, 0x60,
-	0xd0, 0x37, 0x6e, 0xf4, 0xdc, 0x03, 0xc7, 0x8d, 0x32, 0xf1, 0x1c, 0xc3, 0x79, 0x5a, 0xb6, 0xa2,
-	0x14, 0xcf, 0x31, 0x18, 0x4d, 0x9c, 0xb4, 0x53, 0xe8, 0xf1, 0x23, 0x73, 0x0a, 0x4d, 0x1d, 0x83,
-	0x53, 0xe8, 0x89, 0x03, 0x3b, 0x85, 0xde, 0x83, 0x53, 0x1d, 0xbf, 0xbe, 0xe0, 0x86, 0x41, 0x97,
-	0xdf, 0xe4, 0xa9, 0x74, 0xeb, 0x0d, 0x1a, 0x71, 0xaf, 0xd2, 0xe8, 0xc5, 0x8b, 0x66, 0x23, 0x3b,
-	0x7c, 0x21, 0xcf, 0x6c, 0xbd, 0xb8, 0x4e, 0x23, 0x31, 0x98, 0xe9, 0x5a, 0xdc, 0x22, 0xc0, 0x23,
-	0x0c, 0x33, 0x0a, 0x31, 0x8b, 0x8f, 0xe9, 0x93, 0x7a, 0xfa, 0x78, 0x7c, 0x52, 0x9f, 0x82, 0x52,
-	0xd8, 0xec, 0x46, 0x75, 0x7f, 0xdb, 0xe3, 0x8e, 0xc7, 0x11, 0xfd, 0x48, 0x71, 0xa9, 0x2a, 0xe1,
-	0xf7, 0x76, 0xa7, 0x27, 0xd5, 0x6f, 0xc3, 0x66, 0x26, 0x21, 0xe4, 0x1b, 0x7d, 0xee, 0x2a, 0xd8,
-	0x47, 0x79, 0x57, 0xe1, 0xdc, 0xa1, 0xee, 0x29, 0x64, 0x39, 0xde, 0x9e, 0xf9, 0xd0, 0x39, 0xde,
-	0x7e, 0xc3, 0x82, 0xf1, 0x2d, 0xd3, 0x40, 0x29, 0x9d, 0x83, 0x39, 0x04, 0x29, 0x24, 0xec, 0x9e,
-	0x15, 0x9b, 0x09, 0xbb, 0x04, 0xe8, 0x5e, 0x1a, 0x80, 0xc9, 0x96, 0x64, 0x04, 0x50, 0x3c, 0xfb,
-	0xa8, 0x02, 0x28, 0xde, 0xe3, 0xc2, 0x4c, 0x9d, 0x74, 0xb9, 0xc7, 0x30, 0xdf, 0xf8, 0x49, 0x25,
-	0x18, 0x75, 0xf8, 0xa4, 0xc9, 0x8f, 0x7c, 0xd9, 0x82, 0x49, 0x75, 0x38, 0x93, 0x0e, 0x86, 0x50,
-	0x46, 0x80, 0xe5, 0x79, 0x26, 0xe4, 0x21, 0xc4, 0x6b, 0x29, 0x3e, 0xd8, 0xc3, 0x99, 0x89, 0x76,
-	0x1d, 0x70, 0xd3, 0x08, 0x79, 0xa0, 0xa3, 0x54, 0x64, 0xe6, 0x62, 0x30, 0x9a, 0x38, 0xe4, 0x37,
-	0xf5, 0xe3, 0xa3, 0x17, 0xb8, 0x54, 0x7f, 0x2d, 0x67, 0x05, 0x35, 0x8f, 0x17, 0x48, 0xc9, 0xaf,
-	0x5a, 0x30, 0xb9, 0x9d, 0xb2, 0x6a, 0xc8, 0x10, 0x38, 0xcc, 0xdf, 0x5e, 0xd2, 0xe7, 0xb5, 0xec,
-	0x9e, 0x16, 0xa4, 0xfc, 0xcf, 0x1f, 0xf9, 0x7f, 0xcc, 0xff, 0xfc, 0xa1, 0x7a, 0xd7, 0xf5, 0x7b,
-	0x04, 0x4e, 0xa4, 0x1e, 0x1e, 0xff, 0x58, 0x32, 0x8f, 0xf4, 0xf9, 0x74, 0x4a, 0xde, 0x71, 0x85,
-	0x9f, 0x48, 0xcb, 0x9b, 0xc8, 0x9b, 0x5b, 0x38, 0xd2, 0xbc, 0xb9, 0x03, 0xc7, 0x93, 0x37, 0x77,
-	0xf2, 0x28, 0xf2, 0xe6, 0x9e, 0x3c, 0x54, 0xde, 0x5c, 0x23, 0x6f, 0xf1, 0xe0, 0x7d, 0xf2, 0x16,
-	0xcf, 0xc1, 0x84, 0xba, 0xd9, 0x40, 0x65, 0x42, 0x54, 0xe1, 0x56, 0x3c, 0x27, 0xab, 0x4c, 0xcc,
-	0x27, 0x8b, 0x31, 0x8d, 0x4f, 0x3e, 0xb0, 0xa0, 0xe8, 0xf1, 0x9a, 0x43, 0x79, 0x3d, 0x22, 0x90,
-	0x9c, 0x5a, 0xfc, 0xd4, 0x2c, 0x85, 0x92, 0x8a, 0xe5, 0x2c, 0x72, 0xd8, 0x3d, 0xf5, 0x03, 0x45,
-	0x0b, 0xc8, 0x1b, 0x50, 0xf6, 0x37, 0x36, 0x5a, 0xbe, 0x53, 0x8f, 0x93, 0xfb, 0x2a, 0xbf, 0xa7,
-	0xb8, 0x99, 0xa6, 0x93, 0x1b, 0xae, 0xf4, 0xc1, 0xc3, 0xbe, 0x14, 0xc8, 0xb7, 0x98, 0x2a, 0x12,
-	0xf9, 0x01, 0xad, 0xc7, 0x26, 0x9a, 0x11, 0xde, 0x67, 0x9a, 0x7b, 0x9f, 0xab, 0x49, 0x3e, 0xa2,
-	0xf7, 0x7a, 0x50, 0x52, 0xa5, 0x98, 0x6e, 0x16, 0x09, 0xe0, 0x6c, 0x27, 0xcb, 0x42, 0x14, 0xca,
-	0xfb, 0x18, 0xfb, 0xd9, 0xa9, 0xd4, 0xd2, 0x3d, 0x9b, 0x69, 0x63, 0x0a, 0xb1, 0x0f, 0x65, 0x33,
-	0xed, 0x6f, 0xe9, 0x78, 0xd2, 0xfe, 0xbe, 0x0f, 0xc6, 0xdb, 0xfd, 0xd2, 0xe6, 0xb0, 0x94, 0xcb,
-	0x45, 0x01, 0x41, 0x33, 0x96, 0x00, 0x1a, 0x14, 0xa2, 0xc1, 0x92, 0xfc, 0x9f, 0xcc, 0x0c, 0xd5,
-	0xc2, 0xb0, 0xd2, 0xc8, 0x7d, 0x4e, 0x7c, 0xe8, 0xb2, 0x54, 0xff, 0x23, 0x0b, 0xa6, 0xc4, 0xcc,
-	0x4b, 0xab, 0xf3, 0x4c, 0x99, 0x90, 0x37, 0x17, 0xf2, 0x76, 0x8d, 0xf3, 0x28, 0xa1, 0x6a, 0x82,
-	0x2b, 0xf7, 0xd8, 0xee, 0xd3, 0x12, 0xf2, 0xd5, 0x8c, 0x43, 0xc4, 0x44, 0x5e, 0xa6, 0xca, 0xec,
-	0xec, 0xc6, 0xa7, 0xf6, 0x0e, 0x72, 0x6e, 0xf8, 0x27, 0x7d, 0x2d, 0xa9, 0x84, 0x37, 0xef, 0xaf,
-	0x1d, 0x91, 0x25, 0xd5, 0x4c, 0xc1, 0x7c, 0x18, 0x7b, 0xea, 0xd4, 0x17, 0x2c, 0xf1, 0x4a, 0x42,
-	0x5f, 0x2d, 0x64, 0x3d, 0xa9, 0x85, 0x5c, 0xcf, 0x33, 0x4f, 0xbb, 0xa9, 0x0e, 0xfd, 0x8a, 0x05,
-	0xa7, 0xb3, 0x84, 0x64, 0x46, 0x93, 0x3e, 0x93, 0x6c, 0x52, 0x8e, 0xaa, 0xbe, 0xd9, 0xa0, 0x7c,
-	0x92, 0x53, 0xff, 0xe9, 0x88, 0xe1, 0xbf, 0x8a, 0x68, 0x27, 0xf7, 0x30, 0x43, 0x0f, 0x86, 0x5c,
-	0xaf, 0xe5, 0x7a, 0x54, 0x5e, 0x68, 0xca, 0xf3, 0xe0, 0x23, 0x93, 0xc1, 0x33, 0xea, 0x28, 0xb9,
-	0x3c, 0x62, 0x77, 0x56, 0xfa, 0xa1, 0x8b, 0xc1, 0xe3, 0x7f, 0xe8, 0x62, 0x1b, 0x46, 0xb6, 0xdd,
-	0xa8, 0xc9, 0xdd, 0xf0, 0xd2, 0x4b, 0x94, 0xc3, 0x45, 0x20, 0x46, 0x2e, 0xee, 0xfb, 0x6d, 0xc5,
-	0x00, 0x63, 0x5e, 0x64, 0x56, 0x30, 0xe6, 0xc1, 0x85, 0xe9, 0xa8, 0xaf, 0xdb, 0xaa, 0x00, 0x63,
-	0x1c, 0xf6, 0xb1, 0xc6, 0xd8, 0x3f, 0x95, 0xf0, 0x43, 0x66, 0x63, 0xcc, 0x23, 0xd9, 0x97, 0xa4,
-	0x28, 0xae, 0xdb, 0xdd, 0x36, 0x78, 0x60, 0x82, 0xa3, 0x4e, 0x88, 0x59, 0xea, 0x9b, 0x10, 0xf3,
-	0x2e, 0xdf, 0xf3, 0x23, 0xd7, 0xeb, 0xd2, 0x15, 0x4f, 0x86, 0x24, 0x5e, 0xcf, 0xe7, 0x72, 0xa0,
-	0xa0, 0x29, 0x4e, 0x71, 0xf1, 0x7f, 0x34, 0xf8, 0x19, 0xc6, 0xfa, 0xd1, 0x7d, 0x8d, 0xf5, 0xf1,
-	0x39, 0x7d, 0x2c, 0xf7, 0x73, 0x7a, 0x44, 0x3b, 0xb9, 0x9c, 0xd3, 0x3f, 0x54, 0x27, 0xca, 0xff,
-	0x65, 0x01, 0xd1, 0x5b, 0xb7, 0x13, 0x6e, 0xca, 0xd7, 0x89, 0x8e, 0x3e, 0xc0, 0xec, 0x73, 0x16,
-	0x80, 0xa7, 0x9f, 0x43, 0xca, 0x77, 0xd7, 0x12, 0x34, 0xe3, 0x06, 0xc4, 0x30, 0x34, 0x78, 0xda,
-	0xff, 0xdd, 0x82, 0xb3, 0xbd, 0x7d, 0x3f, 0x86, 0xf0, 0xa3, 0x9d, 0x64, 0xf8, 0xd1, 0x5a, 0x8e,
-	0xf6, 0x5e, 0xdd, 0x8d, 0x3e, 0x81, 0x48, 0x3f, 0x2e, 0xc0, 0x84, 0x89, 0x5c, 0xa5, 0xc7, 0x31,
-	0xd8, 0xdb, 0x89, 0x68, 0xc2, 0x9b, 0xf9, 0xf6, 0xb7, 0x2a, 0xdd, 0x06, 0x59, 0xb1, 0x9b, 0xef,
-	0xa7, 0x62, 0x37, 0x6f, 0xe7, 0xcf, 0x7a, 0xff, 0x10, 0xce, 0xff, 0x6a, 0xc1, 0xa9, 0x54, 0x8d,
-	0x63, 0x98, 0x60, 0x5b, 0xc9, 0x09, 0xf6, 0x6a, 0xee, 0xbd, 0xee, 0x33, 0xbb, 0x7e, 0xab, 0xd0,
-	0xd3, 0x5b, 0x7e, 0x0e, 0xf8, 0x25, 0x0b, 0x8a, 0x91, 0x13, 0x6e, 0xaa, 0x48, 0xa0, 0xcf, 0x1c,
-	0xc9, 0x0c, 0x98, 0x61, 0xbf, 0xa5, 0x74, 0xd6, 0xed, 0xe3, 0x30, 0x14, 0xdc, 0xa7, 0x7e, 0xd1,
-	0x02, 0x88, 0x91, 0x1e, 0x95, 0xca, 0x6a, 0xff, 0x4e, 0x01, 0xce, 0x64, 0x4e, 0x23, 0xf2, 0x45,
-	0x6d, 0xd4, 0xb1, 0xf2, 0x8e, 0x73, 0x4b, 0x30, 0x32, 0x6d, 0x3b, 0xe3, 0x09, 0xdb, 0x8e, 0x34,
-	0xe9, 0x3c, 0xaa, 0x03, 0x87, 0x14, 0xd3, 0xc6, 0xc7, 0xfa, 0x91, 0x15, 0x87, 0x4e, 0xea, 0xc4,
-	0x1f, 0x7f, 0x0e, 0xe3, 0xcc, 0xed, 0x1f, 0x1b, 0xd1, 0xde, 0xaa, 0xa3, 0xc7, 0x20, 0x2b, 0xb6,
-	0x93, 0xb2, 0x02, 0xf3, 0x77, 0x3e, 0xf6, 0x11, 0x16, 0x6f, 0x43, 0x96, 0x37, 0xf2, 0x60, 0x59,
-	0xc3, 0x12, 0x37, 0xb6, 0x0a, 0x07, 0xbe, 0xb1, 0x35, 0x0e, 0xa3, 0xaf, 0xbb, 0x1d, 0xed, 0x38,
-	0x9b, 0xf9, 0xce, 0x0f, 0xcf, 0x3f, 0xf6, 0x07, 0x3f, 0x3c, 0xff, 0xd8, 0xf7, 0x7f, 0x78, 0xfe,
-	0xb1, 0xcf, 0xed, 0x9d, 0xb7, 0xbe, 0xb3, 0x77, 0xde, 0xfa, 0x83, 0xbd, 0xf3, 0xd6, 0xf7, 0xf7,
-	0xce, 0x5b, 0xff, 0x69, 0xef, 0xbc, 0xf5, 0xb7, 0xfe, 0xf8, 0xfc, 0x63, 0xaf, 0x97, 0x54, 0xc7,
-	0xfe, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x26, 0x63, 0x0d, 0x11, 0xb5, 0x00, 0x00,
+	// 11550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6b, 0x6c, 0x24, 0xc9,
+	0x79, 0xd8, 0xf5, 0x3c, 0xf8, 0x28, 0x3e, 0xb7, 0xf7, 0xd5, 0xc7, 0xdb, 0x5b, 0xae, 0xfb, 0x1e,
+	0xbe, 0x93, 0x4e, 0xdc, 0xbb, 0x3d, 0x9d, 0x73, 0x92, 0x92, 0xb3, 0x38, 0xe4, 0x72, 0x77, 0x8f,
+	0xe4, 0x92, 0x5b, 0xc3, 0xbb, 0x8d, 0x4e, 0x67, 0x59, 0xcd, 0x99, 0xe2, 0x4c, 0x1f, 0x67, 0xba,
+	0xe7, 0xba, 0x7b, 0xb8, 0xcb, 0xbd, 0x3b, 0x49, 0x91, 0xf5, 0xb0, 0x6c, 0x45, 0x72, 0x12, 0xc7,
+	0x91, 0x95, 0x04, 0x50, 0x6c, 0xcb, 0x11, 0x1c, 0x27, 0x81, 0xa0, 0x20, 0x01, 0x6c, 0x20, 0x48,
+	0x10, 0x21, 0x50, 0x90, 0x00, 0xb1, 0x82, 0x24, 0x52, 0x80, 0x84, 0xb2, 0x56, 0x89, 0x81, 0x3c,
+	0x64, 0x20, 0x42, 0xec, 0x38, 0x1b, 0xff, 0x30, 0xbe, 0x7a, 0x75, 0x55, 0x4f, 0x0f, 0x39, 0xdc,
+	0x2d, 0xee, 0x49, 0xf6, 0x2f, 0x72, 0xbe, 0xfa, 0xea, 0xfb, 0xaa, 0xaa, 0xeb, 0xf1, 0xd5, 0xf7,
+	0x2a, 0xb4, 0xde, 0xf0, 0x93, 0x66, 0x77, 0x73, 0xae, 0x16, 0xb6, 0xcf, 0x7b, 0x51, 0x23, 0xec,
+	0x44, 0xe1, 0x6b, 0xf4, 0x9f, 0x77, 0xdd, 0x08, 0xa3, 0xed, 0xad, 0x56, 0x78, 0x23, 0x3e, 0xbf,
+	0xf3, 0xec, 0xf9, 0xce, 0x76, 0xe3, 0xbc, 0xd7, 0xf1, 0xe3, 0xf3, 0x02, 0x7a, 0x7e, 0xe7, 0x19,
+	0xaf, 0xd5, 0x69, 0x7a, 0xcf, 0x9c, 0x6f, 0x90, 0x80, 0x44, 0x5e, 0x42, 0xea, 0x73, 0x9d, 0x28,
+	0x4c, 0x42, 0xfb, 0xfd, 0x29, 0xc5, 0x39, 0x41, 0x91, 0xfe, 0xf3, 0xd3, 0x92, 0xe2, 0xdc, 0xce,
+	0xb3, 0x73, 0x9d, 0xed, 0xc6, 0x1c, 0x50, 0x9c, 0x13, 0xd0, 0x39, 0x41, 0x71, 0xe6, 0x5d, 0x4a,
+	0x9b, 0x1a, 0x61, 0x23, 0x3c, 0x4f, 0x09, 0x6f, 0x76, 0xb7, 0xe8, 0x2f, 0xfa, 0x83, 0xfe, 0xc7,
+	0x18, 0xce, 0xb8, 0xdb, 0xcf, 0xc7, 0x73, 0x7e, 0x08, 0xed, 0x3b, 0x5f, 0x0b, 0x23, 0x72, 0x7e,
+	0xa7, 0xa7, 0x51, 0x33, 0x4f, 0x2a, 0x38, 0x9d, 0xb0, 0xe5, 0xd7, 0x76, 0xcf, 0xef, 0x3c, 0xb3,
+	0x49, 0x92, 0xde, 0xf6, 0xcf, 0xbc, 0x3b, 0x45, 0x6d, 0x7b, 0xb5, 0xa6, 0x1f, 0x90, 0x68, 0x57,
+	0xf4, 0xff, 0x7c, 0x44, 0xe2, 0xb0, 0x1b, 0xd5, 0xc8, 0xa1, 0x6a, 0xc5, 0xe7, 0xdb, 0x24, 0xf1,
+	0xf2, 0x9a, 0x75, 0xbe, 0x5f, 0xad, 0xa8, 0x1b, 0x24, 0x7e, 0xbb, 0x97, 0xcd, 0x4f, 0x1c, 0x54,
+	0x21, 0xae, 0x35, 0x49, 0xdb, 0xeb, 0xa9, 0xf7, 0x6c, 0xbf, 0x7a, 0xdd, 0xc4, 0x6f, 0x9d, 0xf7,
+	0x83, 0x24, 0x4e, 0xa2, 0x6c, 0x25, 0xf7, 0x22, 0x1a, 0x9a, 0x6f, 0x87, 0xdd, 0x20, 0xb1, 0xdf,
+	0x87, 0xca, 0x3b, 0x5e, 0xab, 0x4b, 0x1c, 0xeb, 0x9c, 0xf5, 0xc4, 0x68, 0xe5, 0xb1, 0x6f, 0xec,
+	0xcd, 0x3e, 0x70, 0x7b, 0x6f, 0xb6, 0xfc, 0x32, 0x00, 0xef, 0xec, 0xcd, 0x9e, 0x20, 0x41, 0x2d,
+	0xac, 0xfb, 0x41, 0xe3, 0xfc, 0x6b, 0x71, 0x18, 0xcc, 0x5d, 0xed, 0xb6, 0x37, 0x49, 0x84, 0x59,
+	0x1d, 0xf7, 0x9f, 0x17, 0xd1, 0xd4, 0x7c, 0x54, 0x6b, 0xfa, 0x3b, 0xa4, 0x9a, 0x00, 0xfd, 0xc6,
+	0xae, 0xdd, 0x44, 0xc5, 0xc4, 0x8b, 0x28, 0xb9, 0xb1, 0x0b, 0xab, 0x73, 0xf7, 0x3a, 0x65, 0xe6,
+	0x36, 0xbc, 0x48, 0xd0, 0xae, 0x0c, 0xdf, 0xde, 0x9b, 0x2d, 0x6e, 0x78, 0x11, 0x06, 0x16, 0x76,
+	0x0b, 0x95, 0x82, 0x30, 0x20, 0x4e, 0x81, 0xb2, 0xba, 0x7a, 0xef, 0xac, 0xae, 0x86, 0x81, 0xec,
+	0x47, 0x65, 0xe4, 0xf6, 0xde, 0x6c, 0x09, 0x20, 0x98, 0x72, 0x81, 0x7e, 0xdd, 0xf2, 0x3b, 0x4e,
+	0xd1, 0x54, 0xbf, 0x5e, 0xf1, 0x3b, 0x7a, 0xbf, 0x5e, 0xf1, 0x3b, 0x18, 0x58, 0x40, 0xbf, 0x6e,
+	0xc5, 0x49, 0xdd, 0x29, 0x99, 0xea, 0xd7, 0x2b, 0x71, 0x52, 0xd7, 0xfb, 0x05, 0x10, 0x4c, 0xb9,
+	0xb8, 0x9f, 0x29, 0xa0, 0xd1, 0xf9, 0xa8, 0xd1, 0x6d, 0x93, 0x20, 0x89, 0xed, 0x8f, 0x22, 0xd4,
+	0xf1, 0x22, 0xaf, 0x4d, 0x12, 0x12, 0xc5, 0x8e, 0x75, 0xae, 0xf8, 0xc4, 0xd8, 0x85, 0xe5, 0x7b,
+	0x6f, 0xc1, 0xba, 0xa0, 0x59, 0xb1, 0xf9, 0x04, 0x43, 0x12, 0x14, 0x63, 0x85, 0xa5, 0xfd, 0x06,
+	0x1a, 0xf5, 0xa2, 0xc4, 0xdf, 0xf2, 0x6a, 0x49, 0xec, 0x14, 0x28, 0xff, 0x17, 0xef, 0x9d, 0xff,
+	0x3c, 0x27, 0x59, 0x39, 0xc6, 0xd9, 0x8f, 0x0a, 0x48, 0x8c, 0x53, 0x7e, 0xee, 0xef, 0x0e, 0xa3,
+	0x11, 0x51, 0x60, 0x9f, 0x43, 0xa5, 0xc0, 0x6b, 0x8b, 0x85, 0x31, 0xce, 0x2b, 0x96, 0xae, 0x7a,
+	0x6d, 0x98, 0x12, 0x5e, 0x9b, 0x00, 0x46, 0xc7, 0x4b, 0x9a, 0x4e, 0x41, 0xc7, 0x58, 0xf7, 0x92,
+	0x26, 0xa6, 0x25, 0xf6, 0x19, 0x54, 0x6a, 0x87, 0x75, 0x42, 0x67, 0x4d, 0x99, 0x0d, 0xfd, 0x6a,
+	0x58, 0x27, 0x98, 0x42, 0xa1, 0xfe, 0x56, 0x14, 0xb6, 0x9d, 0x92, 0x5e, 0x7f, 0x29, 0x0a, 0xdb,
+	0x98, 0x96, 0xd8, 0x5f, 0xb0, 0xd0, 0xb4, 0x68, 0xde, 0x4a, 0x58, 0xf3, 0x12, 0x3f, 0x0c, 0x9c,
+	0x32, 0x9d, 0x17, 0xd8, 0xdc, 0xa8, 0x08, 0xca, 0x15, 0x87, 0x37, 0x61, 0x3a, 0x5b, 0x82, 0x7b,
+	0x5a, 0x61, 0x5f, 0x40, 0xa8, 0xd1, 0x0a, 0x37, 0xbd, 0x16, 0x0c, 0x88, 0x33, 0x44, 0xbb, 0x20,
+	0x3f, 0xee, 0x25, 0x59, 0x82, 0x15, 0x2c, 0xfb, 0x26, 0x1a, 0xf6, 0xd8, 0x76, 0xe1, 0x0c, 0xd3,
+	0x4e, 0x5c, 0x33, 0xd1, 0x09, 0x6d, 0xff, 0xa9, 0x8c, 0xdd, 0xde, 0x9b, 0x1d, 0xe6, 0x40, 0x2c,
+	0xd8, 0xd9, 0x4f, 0xa1, 0x91, 0xb0, 0x03, 0xed, 0xf6, 0x5a, 0xce, 0xc8, 0x39, 0xeb, 0x89, 0x91,
+	0xca, 0x34, 0x6f, 0xeb, 0xc8, 0x1a, 0x87, 0x63, 0x89, 0x61, 0x3f, 0x89, 0x86, 0xe3, 0xee, 0x26,
+	0x7c, 0x47, 0x67, 0x94, 0x76, 0x6c, 0x8a, 0x23, 0x0f, 0x57, 0x19, 0x18, 0x8b, 0x72, 0xfb, 0x39,
+	0x34, 0x16, 0x91, 0x5a, 0x37, 0x8a, 0x09, 0x7c, 0x58, 0x07, 0x51, 0xda, 0xc7, 0x39, 0xfa, 0x18,
+	0x4e, 0x8b, 0xb0, 0x8a, 0x67, 0xbf, 0x80, 0x26, 0xe1, 0x03, 0x5f, 0xbc, 0xd9, 0x89, 0x48, 0x1c,
+	0xc3, 0x57, 0x1d, 0xa3, 0x8c, 0x4e, 0xf1, 0x9a, 0x93, 0x4b, 0x5a, 0x29, 0xce, 0x60, 0xdb, 0x6f,
+	0x22, 0x24, 0xbe, 0xc8, 0xa5, 0x05, 0x67, 0x9c, 0x0e, 0xe6, 0x8a, 0xb9, 0x19, 0x71, 0x69, 0xa1,
+	0x32, 0x09, 0xdf, 0x31, 0xfd, 0x8d, 0x15, 0x7e, 0x30, 0x3e, 0x75, 0xd2, 0x22, 0x09, 0xa9, 0x3b,
+	0x13, 0xb4, 0xc3, 0x72, 0x7c, 0x16, 0x19, 0x18, 0x8b, 0x72, 0x18, 0x9f, 0x3a, 0xa9, 0x77, 0x3b,
+	0x2d, 0xbf, 0xe6, 0x25, 0xc4, 0x99, 0xd4, 0xc7, 0x67, 0x31, 0x2d, 0xc2, 0x2a, 0x9e, 0xfd, 0x38,
+	0x1a, 0xaa, 0xfb, 0x0d, 0x12, 0x27, 0xce, 0x14, 0x1d, 0x97, 0x49, 0x5e, 0x63, 0x68, 0x91, 0x42,
+	0x31, 0x2f, 0xb5, 0xcf, 0xa3, 0xd1, 0xd8, 0xbf, 0x45, 0x2a, 0xbb, 0x09, 0x89, 0x9d, 0xe9, 0x73,
+	0xd6, 0x13, 0xc5, 0x74, 0x89, 0x57, 0x45, 0x01, 0x4e, 0x71, 0xdc, 0x67, 0xd0, 0x84, 0xe8, 0xd4,
+	0x82, 0x57, 0x6b, 0x92, 0x83, 0x97, 0xb9, 0xbb, 0x8e, 0x94, 0x71, 0xb0, 0x2b, 0x68, 0x24, 0xe6,
+	0x73, 0x8d, 0xd7, 0x79, 0x5c, 0xcc, 0x24, 0x31, 0x07, 0xef, 0xec, 0xcd, 0xda, 0x69, 0x0d, 0x01,
+	0xc5, 0xb2, 0x9e, 0xfb, 0x9b, 0x23, 0xa8, 0x67, 0x89, 0xd9, 0xcf, 0xa0, 0x31, 0x3e, 0x5b, 0x57,
+	0xc2, 0x46, 0x4c, 0x69, 0x8f, 0x54, 0xa6, 0x60, 0x94, 0xe6, 0x53, 0x30, 0x56, 0x71, 0xec, 0x3a,
+	0x2a, 0xc4, 0xcf, 0x3a, 0x05, 0x53, 0x5f, 0xbf, 0xfa, 0xac, 0xdc, 0x27, 0x87, 0x6e, 0xef, 0xcd,
+	0x16, 0xaa, 0xcf, 0xe2, 0x42, 0xfc, 0x2c, 0x9c, 0x7c, 0x0d, 0x3f, 0x31, 0x77, 0xf2, 0x5d, 0xf2,
+	0x13, 0xc9, 0x87, 0x9e, 0x7c, 0x97, 0xfc, 0x04, 0x03, 0x0b, 0x38, 0xf9, 0x9a, 0x49, 0xd2, 0x31,
+	0x77, 0xf2, 0x5d, 0xde, 0xd8, 0x58, 0x97, 0xbc, 0xe8, 0xf6, 0x0b, 0x10, 0x4c, 0xb9, 0xd8, 0x3f,
+	0x6b, 0xc1, 0x88, 0xb3, 0xc2, 0x30, 0xda, 0xe5, 0xfb, 0xea, 0x4b, 0xe6, 0x56, 0x51, 0x18, 0xed,
+	0x4a, 0xe6, 0xfc, 0x43, 0xca, 0x02, 0xac, 0xb2, 0xa6, 0x1d, 0xaf, 0x6f, 0xc5, 0xce, 0x90, 0xb1,
+	0x8e, 0x2f, 0x2e, 0x55, 0x33, 0x1d, 0x5f, 0x5c, 0xaa, 0x62, 0xca, 0x05, 0x3e, 0x68, 0xe4, 0xdd,
+	0x70, 0x86, 0x4d, 0x7d, 0x50, 0xec, 0xdd, 0xd0, 0x3f, 0x28, 0xf6, 0x6e, 0x60, 0x60, 0x01, 0x9c,
+	0xc2, 0x38, 0x76, 0x46, 0x4c, 0x71, 0x5a, 0xab, 0x56, 0x75, 0x4e, 0x6b, 0xd5, 0x2a, 0x06, 0x16,
+	0x74, 0x92, 0xd6, 0x62, 0x67, 0xd4, 0x14, 0xa7, 0x4b, 0x0b, 0x19, 0x4e, 0x97, 0x16, 0xaa, 0x18,
+	0x58, 0xd8, 0x1d, 0x54, 0xf6, 0x6e, 0x75, 0x23, 0xb6, 0xd7, 0x8f, 0x5d, 0x58, 0x33, 0x30, 0x5f,
+	0x80, 0x9c, 0xe4, 0x36, 0x0a, 0xe2, 0x37, 0x05, 0x61, 0xc6, 0xc8, 0xfd, 0x8c, 0x95, 0x6e, 0x5a,
+	0x70, 0xe8, 0xc4, 0xf6, 0x4d, 0x34, 0x22, 0xa6, 0x0f, 0x97, 0xb4, 0x4d, 0x0a, 0x49, 0xf2, 0x68,
+	0x14, 0x10, 0x2c, 0xb9, 0xb9, 0x5f, 0x19, 0x42, 0x72, 0x6f, 0xc3, 0xa4, 0x13, 0xc6, 0x3e, 0x9d,
+	0xc0, 0x77, 0xb1, 0x79, 0x05, 0xca, 0xe6, 0xf5, 0xb2, 0xc9, 0xcd, 0x2b, 0x6d, 0x96, 0xb6, 0x8d,
+	0xfd, 0xd5, 0xcc, 0x72, 0x67, 0xfb, 0xd9, 0x4f, 0x1f, 0xc9, 0x72, 0x57, 0x9a, 0xb0, 0xff, 0xc2,
+	0xdf, 0xe1, 0x0b, 0x9f, 0xed, 0x78, 0x7f, 0xd1, 0xec, 0xc2, 0x57, 0x5a, 0x91, 0xdd, 0x02, 0x22,
+	0xb6, 0x30, 0xd9, 0x96, 0x77, 0xdd, 0xe8, 0xc2, 0x54, 0xb8, 0xea, 0x4b, 0x34, 0x62, 0x4b, 0x74,
+	0xc8, 0x14, 0xcf, 0x4b, 0x0b, 0x7d, 0x79, 0xca, 0xc5, 0x7a, 0x4b, 0x2c, 0x56, 0xb6, 0xd9, 0x7d,
+	0xc0, 0xf0, 0x62, 0x55, 0xf8, 0xf6, 0x2e, 0xdb, 0xd7, 0xd1, 0xc9, 0x5e, 0x3c, 0x4c, 0xb6, 0x40,
+	0x68, 0xa9, 0x85, 0xc1, 0x96, 0xdf, 0x58, 0xf5, 0x3a, 0x5c, 0x86, 0x90, 0x42, 0xcb, 0x82, 0x28,
+	0xc0, 0x29, 0x8e, 0xfd, 0x30, 0x2a, 0x6e, 0x93, 0x5d, 0x7e, 0xcf, 0x18, 0xe3, 0xa8, 0xc5, 0x65,
+	0xb2, 0x8b, 0x01, 0xfe, 0xde, 0x91, 0x2f, 0x7c, 0x69, 0xf6, 0x81, 0x8f, 0xfd, 0xe7, 0x73, 0x0f,
+	0xb8, 0xdf, 0x2c, 0xa2, 0x87, 0x72, 0x79, 0x56, 0x13, 0x2f, 0xe9, 0xc6, 0xf6, 0x6f, 0x5a, 0xe8,
+	0xa4, 0x97, 0x57, 0xee, 0x58, 0xa6, 0xbe, 0x4a, 0x2e, 0xfb, 0xca, 0xc3, 0xbc, 0xd1, 0xf9, 0x23,
+	0x82, 0x4f, 0x7a, 0xfd, 0x06, 0x0a, 0x24, 0xb0, 0xb8, 0xe3, 0xd5, 0x88, 0x53, 0xd0, 0x07, 0xea,
+	0xaa, 0x28, 0xc0, 0x29, 0x0e, 0x13, 0x4c, 0xb7, 0xbc, 0x6e, 0x8b, 0x89, 0x2b, 0x9a, 0x60, 0x4a,
+	0xc1, 0x58, 0x94, 0xdb, 0x7f, 0xcb, 0x42, 0x76, 0x2f, 0x57, 0xbe, 0x10, 0x37, 0x8e, 0x62, 0x1c,
+	0x2a, 0xa7, 0x6e, 0x2b, 0x82, 0xa1, 0xd2, 0xd3, 0x9c, 0x76, 0x28, 0xdf, 0xf4, 0x5f, 0x58, 0xa9,
+	0xb0, 0xb8, 0x11, 0x79, 0x41, 0xbc, 0x45, 0xa2, 0x01, 0x2e, 0xa7, 0x9a, 0x64, 0x5c, 0x38, 0x58,
+	0x32, 0xb6, 0x5f, 0x45, 0x23, 0xf5, 0x6e, 0xc4, 0xae, 0x98, 0x6c, 0x6f, 0x9c, 0x9b, 0x63, 0xba,
+	0xa5, 0x39, 0x55, 0xb7, 0x94, 0xf6, 0x19, 0x54, 0x5f, 0x73, 0x3b, 0xcf, 0xcc, 0x2d, 0xf2, 0x5a,
+	0xe9, 0xb9, 0x21, 0x20, 0x58, 0x52, 0x74, 0x3f, 0x59, 0x40, 0xc7, 0xb2, 0xbd, 0x88, 0xed, 0x1b,
+	0xa8, 0xdc, 0x0a, 0xbd, 0xba, 0xd0, 0x34, 0x18, 0xbc, 0xd3, 0x0a, 0x1e, 0x95, 0x09, 0xa1, 0xd1,
+	0x5a, 0x01, 0x46, 0x98, 0xf1, 0x03, 0xc6, 0xb1, 0xb7, 0x43, 0x84, 0x8a, 0xe1, 0x48, 0x19, 0x57,
+	0x81, 0x11, 0x66, 0xfc, 0xdc, 0x7f, 0x6d, 0xa1, 0xe3, 0x39, 0x07, 0x06, 0x2c, 0xf1, 0x6e, 0xd4,
+	0x72, 0x2c, 0x7d, 0x89, 0xbf, 0x84, 0x57, 0x30, 0xc0, 0xed, 0x5f, 0xb4, 0xd0, 0x94, 0x72, 0x6e,
+	0xcc, 0x77, 0xb9, 0xda, 0xc1, 0xd0, 0x15, 0x5a, 0x23, 0x5c, 0x39, 0xcd, 0xd9, 0x4f, 0x65, 0x0a,
+	0x70, 0xb6, 0x09, 0xee, 0x77, 0x2d, 0xf4, 0xf0, 0xbe, 0xc7, 0x5f, 0x6e, 0xc3, 0xad, 0xb7, 0xbd,
+	0xe1, 0xb0, 0x51, 0x44, 0xa4, 0x13, 0xbe, 0x84, 0x57, 0xf8, 0xbe, 0x22, 0x37, 0x0a, 0xcc, 0xc0,
+	0x58, 0x94, 0xbb, 0xdf, 0xb2, 0x50, 0x96, 0x9e, 0xed, 0xa1, 0xc9, 0x6e, 0x4c, 0x22, 0x58, 0x68,
+	0x55, 0x52, 0x8b, 0x88, 0x90, 0xc2, 0x1e, 0x53, 0x56, 0xcc, 0x5c, 0x2d, 0x8c, 0x08, 0xac, 0x0f,
+	0x86, 0xb1, 0x4c, 0x76, 0xab, 0xa4, 0x45, 0x80, 0x46, 0xc5, 0x86, 0x1b, 0xfe, 0x4b, 0x1a, 0x01,
+	0x9c, 0x21, 0x08, 0x2c, 0x3a, 0x5e, 0x1c, 0xdf, 0x08, 0xa3, 0x3a, 0x67, 0x51, 0x38, 0x34, 0x8b,
+	0x75, 0x8d, 0x00, 0xce, 0x10, 0x74, 0xff, 0x3d, 0xc8, 0x95, 0xea, 0x71, 0x66, 0x7f, 0x09, 0x36,
+	0x45, 0x80, 0x54, 0x5a, 0xe1, 0xe6, 0x42, 0x18, 0x24, 0x1e, 0xac, 0x79, 0xc7, 0x32, 0xb6, 0x29,
+	0xf6, 0xd0, 0xae, 0xcc, 0xf0, 0x81, 0xb7, 0x7b, 0xcb, 0x70, 0x4e, 0x5b, 0x60, 0xe7, 0xdb, 0x6c,
+	0x85, 0x9b, 0x59, 0xa5, 0x1b, 0x20, 0x61, 0x5a, 0xe2, 0xfe, 0xb1, 0x85, 0x4e, 0xf7, 0x39, 0xa5,
+	0x7f, 0x14, 0x3a, 0xf8, 0x02, 0x9a, 0x84, 0x6e, 0xc0, 0x56, 0xbe, 0x14, 0x46, 0x6d, 0x2f, 0x71,
+	0x0a, 0xba, 0x6a, 0xa8, 0xa2, 0x95, 0xe2, 0x0c, 0xb6, 0xfb, 0x77, 0x8a, 0x28, 0x87, 0x15, 0xcc,
+	0x78, 0xaf, 0x56, 0x03, 0x9d, 0xbf, 0x63, 0xe9, 0x33, 0x7e, 0x9e, 0x81, 0xb1, 0x28, 0x07, 0x65,
+	0x19, 0x09, 0xea, 0x9d, 0xd0, 0x0f, 0x04, 0x6f, 0xb9, 0xb3, 0x5f, 0xe4, 0x70, 0x2c, 0x31, 0xb8,
+	0x34, 0xc3, 0x07, 0xb2, 0xd8, 0x23, 0xcd, 0xf0, 0x9e, 0xa6, 0x38, 0x76, 0x03, 0x4d, 0x73, 0x4e,
+	0x74, 0xc2, 0xd2, 0xb9, 0x5d, 0x3a, 0xcc, 0xdc, 0x3e, 0x41, 0x55, 0x94, 0x19, 0x12, 0xb8, 0x87,
+	0x28, 0x2c, 0xa1, 0xd8, 0x8b, 0x37, 0xc2, 0x6d, 0x12, 0x70, 0x36, 0xe5, 0x43, 0x2f, 0xa1, 0xea,
+	0x7c, 0x55, 0x21, 0x80, 0x33, 0x04, 0x41, 0xbd, 0xd5, 0x8d, 0x49, 0x75, 0x71, 0x79, 0x21, 0x22,
+	0x75, 0x26, 0xdb, 0x2a, 0xea, 0xad, 0x97, 0xd2, 0x22, 0xac, 0xe2, 0xb9, 0x5f, 0xb7, 0xd0, 0x70,
+	0xc5, 0xab, 0x6d, 0x87, 0x5b, 0x5b, 0x30, 0xda, 0xf2, 0xdc, 0xb5, 0xf4, 0xd1, 0xee, 0x3d, 0x47,
+	0xed, 0x0d, 0x34, 0xc4, 0x36, 0x22, 0xbe, 0x1d, 0x3c, 0xdd, 0xf7, 0x8c, 0x06, 0xfb, 0xcf, 0x1c,
+	0xb3, 0xff, 0xcc, 0x5d, 0x09, 0x92, 0x35, 0x30, 0xa3, 0xf8, 0x41, 0xa3, 0x82, 0x40, 0x8d, 0xb6,
+	0x44, 0x69, 0x60, 0x4e, 0x0b, 0xba, 0xd1, 0xf6, 0x6e, 0x2e, 0xaa, 0xc7, 0xff, 0x68, 0xda, 0x8d,
+	0xd5, 0xb4, 0x08, 0xab, 0x78, 0xee, 0x37, 0x2d, 0x34, 0x5a, 0xf1, 0x62, 0xbf, 0xf6, 0xa7, 0x68,
+	0x53, 0xfc, 0x67, 0x05, 0x54, 0x66, 0x9a, 0xc1, 0x97, 0xb2, 0x62, 0xfa, 0xd8, 0x85, 0x27, 0xf2,
+	0xf8, 0x48, 0x91, 0x5d, 0x65, 0x35, 0xd1, 0x57, 0x98, 0x27, 0xa8, 0x18, 0xbf, 0xde, 0x72, 0x0a,
+	0xa6, 0xae, 0xed, 0xd5, 0x6b, 0x2b, 0xb4, 0xbd, 0xec, 0xe6, 0x53, 0xbd, 0xb6, 0x82, 0x81, 0xbe,
+	0xbd, 0xab, 0xa8, 0x08, 0x8a, 0xc6, 0x34, 0x15, 0xaa, 0xea, 0xb4, 0x32, 0xde, 0x47, 0x47, 0xf0,
+	0xe7, 0xd1, 0xf1, 0x85, 0x66, 0x37, 0xd8, 0x66, 0xa2, 0x10, 0xdd, 0x95, 0x60, 0xea, 0x3e, 0x86,
+	0x4a, 0x20, 0x6d, 0xd2, 0xa1, 0x2c, 0x2b, 0x7b, 0x04, 0xa0, 0x82, 0x44, 0x8a, 0x69, 0xb1, 0xfb,
+	0x7d, 0x0b, 0x9d, 0x5e, 0x68, 0x75, 0xe3, 0x84, 0x44, 0xd7, 0x79, 0x03, 0x36, 0x48, 0xbb, 0xd3,
+	0x02, 0xb5, 0xf0, 0x87, 0xd1, 0x08, 0x48, 0x9d, 0x75, 0x2f, 0xf1, 0x1c, 0xeb, 0x80, 0xf9, 0xaf,
+	0xc9, 0xa8, 0x6b, 0x9b, 0xaf, 0x91, 0x5a, 0xb2, 0x4a, 0x12, 0x2f, 0x35, 0x52, 0xa4, 0x30, 0x2c,
+	0xa9, 0xda, 0x1d, 0x54, 0x8a, 0x3b, 0xa4, 0x66, 0xce, 0xa8, 0x28, 0xfa, 0x50, 0xed, 0x90, 0x5a,
+	0x7a, 0x5c, 0xc1, 0x2f, 0x4c, 0x39, 0xb9, 0xff, 0xdf, 0x42, 0x0f, 0xf5, 0xe9, 0xef, 0x8a, 0x1f,
+	0x27, 0x20, 0x97, 0x67, 0xfa, 0x3c, 0xa0, 0x5c, 0x0e, 0xb5, 0x69, 0x8f, 0xe5, 0x7e, 0x22, 0x20,
+	0x4a, 0x7f, 0x3f, 0x82, 0xca, 0x7e, 0x42, 0xda, 0x42, 0x10, 0x36, 0x70, 0x41, 0xee, 0xd3, 0x97,
+	0x54, 0x1e, 0xbe, 0x02, 0xfc, 0x30, 0x63, 0xeb, 0xfe, 0x2b, 0x0b, 0xc1, 0x32, 0xa9, 0xfb, 0x5c,
+	0x07, 0x5e, 0x4a, 0x76, 0x3b, 0xe2, 0x5a, 0x23, 0x2e, 0x8d, 0xa5, 0x8d, 0xdd, 0x0e, 0xd8, 0xa2,
+	0x27, 0x24, 0x22, 0x00, 0x30, 0x45, 0xb5, 0x3f, 0x84, 0x86, 0x62, 0x7a, 0xb9, 0xe5, 0x47, 0xd5,
+	0x92, 0xb0, 0x14, 0xb0, 0x2b, 0xef, 0x9d, 0xbd, 0xd9, 0x81, 0x0c, 0xf8, 0x73, 0x92, 0x36, 0xab,
+	0x87, 0x39, 0x55, 0x38, 0x37, 0xdb, 0x24, 0x8e, 0xbd, 0x06, 0x71, 0x8a, 0xfa, 0xb9, 0xb9, 0xca,
+	0xc0, 0x58, 0x94, 0xbb, 0x7f, 0xdd, 0x42, 0x13, 0xf2, 0xc4, 0xbb, 0x0a, 0x66, 0x9e, 0xab, 0xea,
+	0xd9, 0xc8, 0x3e, 0xde, 0xc3, 0x7d, 0xb6, 0x10, 0x86, 0x74, 0xc0, 0xd1, 0xf9, 0x6e, 0x34, 0x5e,
+	0x27, 0x1d, 0x12, 0xd4, 0x49, 0x50, 0xf3, 0xf9, 0xed, 0x65, 0xb4, 0x32, 0x7d, 0x7b, 0x6f, 0x76,
+	0x7c, 0x51, 0x81, 0x63, 0x0d, 0xcb, 0xfd, 0x15, 0x0b, 0x3d, 0x28, 0xc9, 0x55, 0x49, 0x82, 0x49,
+	0x12, 0xed, 0x4a, 0x83, 0xfd, 0xe1, 0xce, 0x9f, 0xeb, 0x20, 0x38, 0x27, 0x91, 0xcf, 0x2f, 0x95,
+	0x77, 0x73, 0x00, 0x8d, 0x31, 0x31, 0x9b, 0x12, 0xc1, 0x82, 0x9a, 0xfb, 0xb9, 0x22, 0x3a, 0xa1,
+	0x36, 0x52, 0xae, 0xf9, 0x9f, 0xb1, 0x10, 0x92, 0x23, 0x00, 0x9a, 0xb2, 0xa2, 0x99, 0xbd, 0x4c,
+	0xfb, 0x52, 0xe9, 0xae, 0x20, 0xc1, 0x31, 0x56, 0xd8, 0xda, 0x1f, 0x40, 0xe3, 0x3b, 0x61, 0xab,
+	0xdb, 0x26, 0xab, 0x20, 0x63, 0xc4, 0x4e, 0x91, 0x36, 0x63, 0x36, 0xef, 0x63, 0xbe, 0x9c, 0xe2,
+	0x55, 0x4e, 0x70, 0xb2, 0xe3, 0x0a, 0x30, 0xc6, 0x1a, 0x29, 0xb8, 0x22, 0x4d, 0x44, 0xea, 0x27,
+	0xe1, 0x62, 0xca, 0x07, 0x0d, 0xf6, 0x31, 0xfb, 0xd5, 0x2b, 0xc7, 0x6e, 0xef, 0xcd, 0x4e, 0x68,
+	0x20, 0xac, 0x37, 0xc2, 0xfd, 0x9c, 0xa5, 0x7c, 0x90, 0x0d, 0x12, 0xb5, 0xfd, 0x80, 0x4d, 0x81,
+	0x83, 0x75, 0x0f, 0x20, 0x40, 0xde, 0xf4, 0x93, 0x05, 0xb0, 0x88, 0x16, 0xe8, 0x6e, 0x9f, 0x0a,
+	0x90, 0x1c, 0x8e, 0x25, 0x06, 0xd8, 0xfa, 0x22, 0xe2, 0xc5, 0x52, 0xee, 0x90, 0xb6, 0x3e, 0x4c,
+	0xa1, 0x98, 0x97, 0xba, 0x1f, 0x40, 0xf4, 0xe3, 0xf8, 0x41, 0x97, 0xac, 0x05, 0xf6, 0x23, 0xa8,
+	0x4c, 0xa2, 0x28, 0x8c, 0xb8, 0xae, 0x59, 0xee, 0x2e, 0x17, 0x01, 0x88, 0x59, 0x19, 0x90, 0xde,
+	0xf2, 0xfc, 0x16, 0xa9, 0xd3, 0x66, 0x8c, 0xa4, 0xa4, 0x97, 0x28, 0x14, 0xf3, 0x52, 0x77, 0x0e,
+	0x0d, 0x2f, 0xc0, 0xc7, 0x20, 0x11, 0xd0, 0x55, 0x1d, 0x62, 0x26, 0x34, 0x87, 0x18, 0xe1, 0xf8,
+	0xb2, 0x81, 0x4e, 0x2e, 0x44, 0xc4, 0x4b, 0x48, 0xf5, 0xd9, 0x4a, 0xb7, 0xb6, 0x4d, 0x12, 0x66,
+	0x44, 0x8e, 0xed, 0xf7, 0xa1, 0x89, 0x90, 0x1e, 0x2b, 0x2b, 0x61, 0x6d, 0xdb, 0x0f, 0x1a, 0x5c,
+	0x0d, 0x75, 0x92, 0x53, 0x99, 0x58, 0x53, 0x0b, 0xb1, 0x8e, 0xeb, 0xfe, 0xd7, 0x02, 0x1a, 0x5f,
+	0x88, 0xc2, 0x40, 0x6c, 0x9d, 0xf7, 0xe1, 0xb8, 0x4b, 0xb4, 0xe3, 0xce, 0x80, 0x1a, 0x44, 0x6d,
+	0x7f, 0xbf, 0x23, 0xcf, 0x7e, 0x53, 0xee, 0xd9, 0x45, 0x53, 0x17, 0x2f, 0x8d, 0x2f, 0xa5, 0x9d,
+	0x7e, 0x6c, 0x7d, 0x47, 0x77, 0xbf, 0x07, 0x13, 0x5b, 0x41, 0x07, 0x41, 0x7c, 0xcb, 0x6f, 0xb5,
+	0xec, 0x15, 0xee, 0x8f, 0xc1, 0x86, 0xfa, 0x1d, 0x83, 0x0d, 0xf5, 0x86, 0xdf, 0x26, 0xb9, 0xbe,
+	0x1b, 0x4b, 0xa8, 0x90, 0x84, 0x4e, 0xe1, 0xd0, 0xb4, 0x10, 0xa7, 0x55, 0xd8, 0x08, 0x71, 0x21,
+	0x09, 0x41, 0x36, 0xef, 0x78, 0x91, 0xd7, 0x6a, 0x91, 0x96, 0x1f, 0xb7, 0xb9, 0x2b, 0x89, 0x94,
+	0xcd, 0xd7, 0xd3, 0x22, 0xac, 0xe2, 0xb9, 0xdf, 0x2a, 0xa1, 0x99, 0xbc, 0x5e, 0x72, 0x4d, 0xf0,
+	0x2f, 0x58, 0x68, 0x64, 0x93, 0x83, 0x1c, 0xcb, 0x94, 0x11, 0x26, 0x8f, 0x61, 0xe5, 0x0c, 0x6f,
+	0x6b, 0xee, 0xa0, 0x63, 0xd9, 0x0a, 0x3b, 0x44, 0xc7, 0x02, 0x72, 0x33, 0xa9, 0xd6, 0x9a, 0xa4,
+	0xde, 0x6d, 0x91, 0x3a, 0x8c, 0xc6, 0x5d, 0x8c, 0xdf, 0xc9, 0xdb, 0x7b, 0xb3, 0xc7, 0xae, 0x66,
+	0x09, 0xe1, 0x5e, 0xda, 0x54, 0x45, 0xda, 0xdd, 0x6c, 0xfb, 0x09, 0x38, 0x32, 0x14, 0x75, 0xa9,
+	0xb4, 0x2a, 0x0a, 0x70, 0x8a, 0x63, 0x2f, 0xa3, 0x21, 0xaf, 0x96, 0x80, 0xfb, 0x0a, 0x3b, 0x85,
+	0x1e, 0xc9, 0xdb, 0xfe, 0xd9, 0x3a, 0xc3, 0x64, 0x8b, 0x44, 0x24, 0xa8, 0x91, 0x74, 0x1a, 0xce,
+	0xd3, 0xaa, 0x98, 0x93, 0xb0, 0x3f, 0x88, 0x46, 0xe3, 0xc4, 0x8b, 0x12, 0x52, 0x9f, 0x17, 0x17,
+	0xd3, 0xc3, 0x74, 0x33, 0x6d, 0xa9, 0x20, 0x82, 0x53, 0x7a, 0xf6, 0x2b, 0x08, 0x6d, 0xf9, 0x81,
+	0x1f, 0x37, 0x29, 0xf5, 0xa1, 0x43, 0x53, 0xa7, 0xde, 0x1f, 0x4b, 0x92, 0x02, 0x56, 0xa8, 0x81,
+	0x38, 0x71, 0x4a, 0xfd, 0x94, 0x17, 0x6f, 0xd6, 0x5a, 0xdd, 0x98, 0x6e, 0x7f, 0xb3, 0xa8, 0x5c,
+	0xf7, 0x12, 0xc2, 0xf4, 0xb9, 0xa3, 0xcc, 0x26, 0xb2, 0x08, 0x00, 0xcc, 0xe0, 0x76, 0x03, 0x4d,
+	0xd5, 0x94, 0xeb, 0x12, 0x58, 0x1e, 0x0a, 0x87, 0xbc, 0x59, 0x1d, 0x07, 0x05, 0xdf, 0x82, 0x4e,
+	0x04, 0x67, 0xa9, 0xba, 0xff, 0xcd, 0x42, 0xd3, 0x6a, 0x23, 0xef, 0x83, 0x28, 0x1d, 0xeb, 0xa2,
+	0xf4, 0x55, 0xc3, 0xcb, 0x29, 0x5f, 0x7e, 0xfe, 0xd5, 0x4c, 0x3f, 0xab, 0xdb, 0x7e, 0xc7, 0x6e,
+	0xa0, 0x89, 0x58, 0x5b, 0x45, 0x87, 0xdf, 0xd1, 0xe4, 0x89, 0xa5, 0xaf, 0x22, 0x9d, 0xae, 0x72,
+	0x74, 0x17, 0xf6, 0x3d, 0xba, 0xff, 0xc7, 0x48, 0xa6, 0x95, 0x70, 0x0a, 0x7c, 0xc1, 0x42, 0xe3,
+	0x37, 0x14, 0x00, 0x6f, 0xa5, 0xe9, 0x3b, 0xd7, 0xa3, 0x42, 0x04, 0x53, 0xa1, 0x77, 0x32, 0xbf,
+	0xb1, 0xd6, 0x12, 0x10, 0x60, 0x44, 0x47, 0xb3, 0x1a, 0x30, 0x31, 0x1e, 0x58, 0x62, 0xd8, 0xaf,
+	0xa2, 0x63, 0xb5, 0x30, 0xa8, 0x75, 0x23, 0x58, 0xf0, 0xbb, 0xeb, 0xd4, 0x09, 0x99, 0xcb, 0x32,
+	0x73, 0xbc, 0xda, 0xb1, 0x85, 0x2c, 0xc2, 0x9d, 0x3c, 0x20, 0xee, 0x25, 0xc4, 0x9c, 0xd1, 0x62,
+	0x10, 0xe7, 0x9d, 0x92, 0x6e, 0xd3, 0xaa, 0x32, 0x30, 0x16, 0xe5, 0xf6, 0x4b, 0xe8, 0x34, 0xdd,
+	0x02, 0xfc, 0xa0, 0xb1, 0x48, 0xbc, 0x7a, 0xcb, 0x0f, 0x40, 0x71, 0x12, 0x06, 0x75, 0x66, 0xe9,
+	0x2d, 0x56, 0x1e, 0xba, 0xbd, 0x37, 0x7b, 0xba, 0x9a, 0x8f, 0x82, 0xfb, 0xd5, 0xb5, 0x3f, 0x84,
+	0x66, 0xe2, 0x6e, 0xad, 0x46, 0xe2, 0x78, 0xab, 0xdb, 0x7a, 0x31, 0xdc, 0x8c, 0x2f, 0xfb, 0x31,
+	0x68, 0x53, 0x57, 0xfc, 0xb6, 0xcf, 0x36, 0x97, 0x72, 0xe5, 0xec, 0xed, 0xbd, 0xd9, 0x99, 0x6a,
+	0x5f, 0x2c, 0xbc, 0x0f, 0x05, 0x1b, 0xa3, 0x53, 0x4c, 0x0e, 0xeb, 0xa1, 0x3d, 0x4c, 0x69, 0xcf,
+	0xdc, 0xde, 0x9b, 0x3d, 0xb5, 0x94, 0x8b, 0x81, 0xfb, 0xd4, 0x84, 0x2f, 0x08, 0x5e, 0xd3, 0xb7,
+	0xc0, 0x41, 0x78, 0x44, 0xff, 0x82, 0x1b, 0x1c, 0x8e, 0x25, 0x86, 0xfd, 0x5a, 0x3a, 0x13, 0x61,
+	0x51, 0x3b, 0xa3, 0x77, 0x29, 0x6c, 0x51, 0xcd, 0xe4, 0x75, 0x85, 0x12, 0x6c, 0x0c, 0x58, 0xa3,
+	0x6d, 0xbf, 0x13, 0x8d, 0x8a, 0x99, 0x13, 0x3b, 0x88, 0xee, 0x93, 0x54, 0x59, 0x24, 0x26, 0x16,
+	0x18, 0xe5, 0xc4, 0xbf, 0xe0, 0xa3, 0x84, 0x88, 0xdc, 0x5f, 0x9d, 0x31, 0x53, 0x6e, 0x02, 0xf9,
+	0xfb, 0x37, 0xdb, 0xf6, 0xd3, 0xdf, 0x58, 0xe1, 0x6d, 0x7f, 0xc2, 0x42, 0xe3, 0x71, 0x12, 0x4a,
+	0xaf, 0x65, 0x67, 0xdc, 0xd4, 0x72, 0xad, 0x2a, 0x54, 0xd9, 0x65, 0x56, 0x85, 0x60, 0x8d, 0x2b,
+	0xdc, 0x3e, 0x6e, 0x34, 0x49, 0xe0, 0x4c, 0xe8, 0xb7, 0x8f, 0xeb, 0x4d, 0x12, 0x60, 0x5a, 0xe2,
+	0x7e, 0x6a, 0x08, 0xd9, 0xbd, 0xe2, 0xa0, 0x72, 0x78, 0x5b, 0xf7, 0x7e, 0x78, 0x87, 0xe8, 0x58,
+	0xcb, 0x8b, 0xcd, 0xc8, 0x2a, 0x2b, 0x59, 0x42, 0xb8, 0x97, 0x36, 0x38, 0x66, 0xd7, 0x84, 0x86,
+	0x42, 0xdc, 0x3e, 0x97, 0x8d, 0x5c, 0x10, 0x19, 0x4d, 0xed, 0x02, 0xcc, 0xd9, 0x60, 0x85, 0xa5,
+	0xfd, 0x29, 0x55, 0x60, 0x64, 0xea, 0xfa, 0x57, 0x8f, 0x46, 0x60, 0xe4, 0xe2, 0x3b, 0xd5, 0x2e,
+	0xe6, 0x88, 0x89, 0x9f, 0xb4, 0xd0, 0x18, 0x1d, 0x9f, 0x6d, 0xbf, 0xd3, 0x21, 0x75, 0x73, 0xee,
+	0xd0, 0xd9, 0x63, 0x94, 0xb9, 0xee, 0xac, 0xa4, 0xac, 0xb0, 0xca, 0x97, 0x49, 0x8f, 0xb5, 0x1a,
+	0x21, 0x75, 0x52, 0x77, 0x86, 0x32, 0x06, 0x76, 0x51, 0x80, 0x53, 0x1c, 0xe5, 0x32, 0x3a, 0x4c,
+	0xb1, 0xfb, 0x5c, 0x46, 0xed, 0x4b, 0xf4, 0x38, 0x89, 0x49, 0xad, 0x0b, 0x53, 0x8d, 0x15, 0xd2,
+	0x3d, 0xac, 0x58, 0x79, 0x50, 0x39, 0x4e, 0x74, 0x04, 0xdc, 0x5b, 0xc7, 0xfd, 0x37, 0x08, 0x0d,
+	0x2f, 0xce, 0x5f, 0xda, 0xf0, 0xe2, 0xed, 0xc1, 0x2e, 0xed, 0x09, 0xd7, 0xb9, 0x64, 0xcf, 0x3c,
+	0xa1, 0x8b, 0xc1, 0x12, 0xc3, 0x0e, 0xd0, 0x90, 0x1f, 0xc0, 0x21, 0xe1, 0x4c, 0x9a, 0x52, 0x64,
+	0x0b, 0x2e, 0xcc, 0x42, 0x71, 0x85, 0x52, 0xc7, 0x9c, 0x8b, 0xfd, 0x26, 0xc4, 0x05, 0xf0, 0x28,
+	0x05, 0x7e, 0x6b, 0x5c, 0x36, 0xa1, 0xcf, 0xe6, 0x24, 0xd5, 0xc0, 0x00, 0x0e, 0xc2, 0x29, 0x43,
+	0xfb, 0x63, 0x16, 0x1a, 0x13, 0x5d, 0x07, 0x99, 0xb5, 0x64, 0x2c, 0xba, 0x25, 0x25, 0xca, 0xa6,
+	0x9b, 0x02, 0xc0, 0x2a, 0xcb, 0x1e, 0xd5, 0x5f, 0x79, 0x10, 0xd5, 0x9f, 0x7d, 0x03, 0x8d, 0xde,
+	0xf0, 0x93, 0x26, 0x15, 0x19, 0x9d, 0x21, 0xba, 0x6b, 0x2c, 0xdd, 0x7b, 0xab, 0x81, 0x5c, 0x3a,
+	0x62, 0xd7, 0x05, 0x03, 0x9c, 0xf2, 0x82, 0xd5, 0x01, 0x3f, 0x68, 0x94, 0x87, 0x33, 0xac, 0x5b,
+	0x05, 0xaf, 0x8b, 0x02, 0x9c, 0xe2, 0xc0, 0x10, 0x8f, 0xc3, 0xaf, 0x2a, 0x79, 0xbd, 0x0b, 0x5b,
+	0xaf, 0x33, 0x62, 0x6a, 0x5e, 0x09, 0x8a, 0x6c, 0xb0, 0xae, 0x2b, 0x3c, 0xb0, 0xc6, 0x51, 0x1e,
+	0x2d, 0xa3, 0xfd, 0x8e, 0x16, 0x70, 0xbb, 0xaf, 0x49, 0x15, 0x94, 0x83, 0x4c, 0x39, 0x5e, 0xa7,
+	0x6a, 0x2d, 0x76, 0x02, 0xa7, 0xbf, 0xb1, 0xc2, 0x0f, 0x36, 0x90, 0x30, 0x00, 0x05, 0x1a, 0x0f,
+	0x16, 0x90, 0x1b, 0xc8, 0x1a, 0x85, 0x62, 0x5e, 0xca, 0xbc, 0xa0, 0x60, 0x12, 0xc4, 0xce, 0xb8,
+	0xae, 0xb2, 0x66, 0x33, 0x25, 0xc6, 0xa2, 0xdc, 0xfe, 0xdb, 0x16, 0x2a, 0x37, 0xc3, 0x70, 0x3b,
+	0x76, 0x26, 0xce, 0x15, 0xcd, 0x68, 0x62, 0xf8, 0x8e, 0x33, 0x77, 0x19, 0xc8, 0x5e, 0x0c, 0x92,
+	0x68, 0xb7, 0xf2, 0x8c, 0xb8, 0xba, 0x50, 0xd8, 0x9d, 0xbd, 0xd9, 0xc9, 0x15, 0x7f, 0x8b, 0xd4,
+	0x76, 0x6b, 0x2d, 0x42, 0x21, 0x1f, 0xff, 0x8e, 0x02, 0xb9, 0xb8, 0x43, 0x82, 0x04, 0xb3, 0x56,
+	0xcd, 0x7c, 0xc6, 0x42, 0x28, 0x25, 0x64, 0x4f, 0x33, 0x47, 0x38, 0xba, 0x89, 0x51, 0xdf, 0x37,
+	0x9b, 0x08, 0x75, 0x5d, 0xc1, 0x94, 0x8d, 0x4b, 0x6b, 0x1a, 0x57, 0xf8, 0xbd, 0xb7, 0xf0, 0xbc,
+	0xe5, 0xfe, 0x5b, 0x0b, 0x8d, 0x41, 0xe7, 0xc4, 0x16, 0xf8, 0x38, 0x1a, 0x4a, 0xbc, 0xa8, 0x41,
+	0x84, 0x41, 0x5d, 0x7e, 0x8e, 0x0d, 0x0a, 0xc5, 0xbc, 0xd4, 0x0e, 0x50, 0x39, 0xf1, 0xe2, 0x6d,
+	0x71, 0x2f, 0xbc, 0x62, 0x6c, 0x88, 0xd3, 0x2b, 0x21, 0xfc, 0x8a, 0x31, 0x63, 0x63, 0x3f, 0x81,
+	0x46, 0xe0, 0x24, 0x59, 0xf2, 0x62, 0xe1, 0x05, 0x47, 0x8f, 0xd2, 0x25, 0x0e, 0xc3, 0xb2, 0xd4,
+	0xfd, 0x6b, 0x05, 0x54, 0x5a, 0x64, 0x6a, 0xc0, 0x21, 0x16, 0xfd, 0xe8, 0x58, 0xa6, 0xe6, 0x34,
+	0xd0, 0xad, 0x52, 0x9a, 0x8a, 0x22, 0x8e, 0xfe, 0xc6, 0x9c, 0x17, 0x28, 0xbe, 0x27, 0x13, 0xcd,
+	0x46, 0xe8, 0x14, 0x4c, 0xcd, 0x42, 0xdd, 0xf6, 0x58, 0x4d, 0x48, 0x27, 0x75, 0xa0, 0xd0, 0xcb,
+	0x70, 0xa6, 0x0d, 0xee, 0xd7, 0xcb, 0x08, 0xa5, 0xad, 0x07, 0x11, 0x7c, 0xc2, 0x53, 0xbd, 0xaf,
+	0x1d, 0xcb, 0xd4, 0x54, 0xd3, 0x9c, 0xba, 0x99, 0x4a, 0x5e, 0x03, 0x61, 0x9d, 0xf1, 0x7d, 0xd3,
+	0x9e, 0xd8, 0x1f, 0x44, 0xe3, 0xb1, 0xb0, 0x9e, 0x03, 0x97, 0xe2, 0x61, 0xac, 0xec, 0x4c, 0x82,
+	0x57, 0xaa, 0x63, 0x8d, 0x98, 0x5d, 0xd7, 0xa2, 0x3c, 0x96, 0xcc, 0x44, 0x79, 0xf4, 0x44, 0x77,
+	0xe8, 0x91, 0x8c, 0xe5, 0xfb, 0x1f, 0xc9, 0xf8, 0x11, 0x34, 0x2a, 0x62, 0x8a, 0x85, 0xd3, 0xb3,
+	0x01, 0x97, 0x37, 0x2c, 0x48, 0xf2, 0xb5, 0x45, 0xaf, 0x8e, 0x12, 0x88, 0x53, 0x96, 0xee, 0x57,
+	0x2d, 0x74, 0xe6, 0x62, 0x9c, 0xf8, 0x6d, 0x88, 0xfb, 0x15, 0x96, 0xbc, 0x75, 0x12, 0xd5, 0x48,
+	0x90, 0xf8, 0x70, 0xb7, 0xbc, 0x80, 0x8a, 0x9d, 0xe7, 0x9e, 0xa6, 0xb3, 0xb9, 0x58, 0x39, 0x27,
+	0x5c, 0x0e, 0xd7, 0x9f, 0x7b, 0x1a, 0x54, 0x13, 0x3d, 0x35, 0x31, 0x20, 0xd3, 0x3a, 0xef, 0x79,
+	0xda, 0x29, 0x64, 0xea, 0xbc, 0xa7, 0x6f, 0x9d, 0xf7, 0x3c, 0x4d, 0x15, 0x18, 0x5e, 0xbb, 0x03,
+	0xd7, 0x5d, 0xa6, 0x64, 0x4d, 0x15, 0x18, 0x0c, 0x8c, 0x45, 0xb9, 0xfb, 0x1c, 0x2a, 0xd3, 0xed,
+	0x9f, 0x2a, 0x60, 0xf8, 0x7c, 0xca, 0x1a, 0x25, 0xc5, 0x3c, 0xc3, 0x12, 0xc3, 0x7d, 0x15, 0x4d,
+	0x5e, 0xbc, 0x09, 0xb2, 0x6f, 0x18, 0xb1, 0xa9, 0x6d, 0xbf, 0x88, 0xec, 0x98, 0x44, 0x3b, 0x7e,
+	0x8d, 0x70, 0x3f, 0xa1, 0xab, 0xa9, 0xf0, 0x2b, 0x1d, 0xb2, 0xaa, 0x3d, 0x18, 0x38, 0xa7, 0x96,
+	0xfb, 0x1b, 0x16, 0x1a, 0x53, 0x7c, 0xcd, 0x41, 0x14, 0x6d, 0x2c, 0x54, 0x99, 0xdd, 0x87, 0xef,
+	0x05, 0xcb, 0x46, 0xbc, 0xd9, 0x19, 0xc9, 0x54, 0x4e, 0x92, 0x20, 0x9c, 0x32, 0x3c, 0xc0, 0x17,
+	0xdc, 0xfd, 0x97, 0x16, 0x3a, 0x99, 0xeb, 0x18, 0xff, 0x36, 0x37, 0xfb, 0x3c, 0x1a, 0xdd, 0x26,
+	0xbb, 0x9a, 0x43, 0x9b, 0xac, 0xb0, 0x2c, 0x0a, 0x70, 0x8a, 0x03, 0xd3, 0x37, 0xa5, 0x04, 0x67,
+	0xed, 0x66, 0xda, 0x72, 0xe5, 0xac, 0xe5, 0x9c, 0x78, 0xa9, 0xfd, 0x26, 0x3a, 0xad, 0x7f, 0xc1,
+	0xd4, 0xc5, 0xec, 0x50, 0x9e, 0x42, 0x4c, 0x51, 0x96, 0x4f, 0x09, 0xf7, 0x63, 0xe1, 0xbe, 0x8c,
+	0xca, 0x97, 0xbc, 0x6e, 0x83, 0x0c, 0x64, 0x44, 0x84, 0x73, 0x3a, 0x22, 0x5e, 0x2b, 0x11, 0xaa,
+	0x03, 0x7e, 0x4e, 0x63, 0x0e, 0xc3, 0xb2, 0xd4, 0xfd, 0x56, 0x19, 0x8d, 0x29, 0x51, 0x73, 0x20,
+	0xa8, 0x46, 0xa4, 0x13, 0x66, 0x2f, 0x73, 0xf0, 0xb1, 0x31, 0x2d, 0x81, 0xf5, 0x13, 0x91, 0x1d,
+	0x3f, 0xf6, 0xa5, 0x6a, 0x56, 0xae, 0x1f, 0xcc, 0xe1, 0x58, 0x62, 0x50, 0xb5, 0x3d, 0xe9, 0x24,
+	0x4d, 0xba, 0x3e, 0x4b, 0x5c, 0x6d, 0x0f, 0x00, 0xcc, 0xe0, 0x80, 0xb0, 0x45, 0x92, 0x5a, 0xd3,
+	0x29, 0xa5, 0x7a, 0xfd, 0x25, 0x00, 0x60, 0x06, 0xcf, 0xf1, 0xfd, 0x2a, 0x1f, 0xbd, 0xef, 0xd7,
+	0x90, 0x61, 0xdf, 0x2f, 0xbb, 0x83, 0x8e, 0xc7, 0x71, 0x73, 0x3d, 0xf2, 0x77, 0xbc, 0x84, 0xa4,
+	0x33, 0x67, 0xf8, 0x30, 0x7c, 0x4e, 0xdf, 0xde, 0x9b, 0x3d, 0x5e, 0xad, 0x5e, 0xce, 0x52, 0xc1,
+	0x79, 0xa4, 0xed, 0x2a, 0x3a, 0xe9, 0xd3, 0x6b, 0x7b, 0x44, 0xae, 0x34, 0x82, 0x30, 0x22, 0x97,
+	0xc3, 0x18, 0xc8, 0xf1, 0x20, 0x65, 0x19, 0x36, 0x71, 0x25, 0x0f, 0x09, 0xe7, 0xd7, 0x05, 0x05,
+	0x42, 0xdd, 0x8f, 0xbd, 0xcd, 0x16, 0x01, 0x2b, 0x56, 0xc8, 0x34, 0x8d, 0xa3, 0x94, 0xa0, 0x54,
+	0x20, 0x2c, 0x66, 0x11, 0x70, 0x6f, 0x1d, 0xfb, 0x79, 0x34, 0x1e, 0xfb, 0x41, 0xa3, 0x45, 0x2a,
+	0x91, 0x17, 0xd4, 0x9a, 0x3c, 0xba, 0x59, 0xfa, 0x34, 0x54, 0x95, 0x32, 0xac, 0x61, 0xd2, 0xf5,
+	0xca, 0xea, 0x64, 0xae, 0x2a, 0x1c, 0x9b, 0x97, 0xba, 0x3f, 0x81, 0x4e, 0x5e, 0x8a, 0xc2, 0x6e,
+	0xa7, 0xb2, 0x9b, 0x71, 0x16, 0x7b, 0x58, 0x91, 0xf4, 0x73, 0xb6, 0xb9, 0x6f, 0x5b, 0x68, 0x5c,
+	0x0d, 0x75, 0x82, 0xeb, 0x23, 0x6a, 0x2e, 0x2e, 0x55, 0xd9, 0xfe, 0x6f, 0x4e, 0x8c, 0xbd, 0x2c,
+	0x69, 0xa6, 0x07, 0x7e, 0x0a, 0xc3, 0x0a, 0xcf, 0x01, 0xd2, 0x01, 0x3c, 0x82, 0xca, 0x5b, 0x21,
+	0x48, 0xd9, 0x45, 0xdd, 0x67, 0x61, 0x09, 0x80, 0x98, 0x95, 0xb9, 0xff, 0xc7, 0x42, 0xa7, 0xf2,
+	0xa3, 0xb8, 0x7e, 0x18, 0x3a, 0x79, 0x01, 0xc4, 0xaa, 0xa4, 0xa9, 0x6d, 0xe4, 0x8a, 0x24, 0x24,
+	0x4a, 0xb0, 0x82, 0x35, 0x58, 0xb7, 0xff, 0x10, 0x6e, 0x7a, 0x29, 0x9f, 0xcf, 0x5a, 0x68, 0x02,
+	0xd8, 0x2e, 0x47, 0x9b, 0x5a, 0x6f, 0xd7, 0xcc, 0xf4, 0x56, 0x92, 0x4d, 0x0d, 0x5d, 0x1a, 0x18,
+	0xeb, 0xcc, 0x41, 0x69, 0xef, 0xd5, 0xeb, 0x11, 0x89, 0x63, 0xe9, 0x75, 0x45, 0x25, 0xaf, 0x79,
+	0x01, 0xc4, 0x69, 0x39, 0x6c, 0xbe, 0x10, 0x64, 0x07, 0xfb, 0x99, 0x53, 0xd4, 0x37, 0x5f, 0x60,
+	0x02, 0x70, 0x2c, 0x31, 0xdc, 0xbf, 0x5c, 0x42, 0x3a, 0x6f, 0xbb, 0x8e, 0xa6, 0xb6, 0xa3, 0xcd,
+	0x05, 0xea, 0x64, 0x79, 0x37, 0x9e, 0xb4, 0x54, 0xc6, 0x5f, 0xd6, 0x29, 0xe0, 0x2c, 0x49, 0xce,
+	0x65, 0x99, 0xec, 0x26, 0xde, 0xe6, 0xdd, 0x1c, 0x91, 0x82, 0x8b, 0x4a, 0x01, 0x67, 0x49, 0x82,
+	0xf7, 0xc2, 0x76, 0xb4, 0x29, 0xb6, 0xf6, 0xac, 0x67, 0xf1, 0x72, 0x5a, 0x84, 0x55, 0x3c, 0x18,
+	0xc2, 0xed, 0x68, 0x13, 0x8e, 0x42, 0x91, 0x1e, 0x43, 0x0e, 0xe1, 0x32, 0x87, 0x63, 0x89, 0x61,
+	0x77, 0x90, 0xbd, 0x2d, 0x46, 0x4f, 0xde, 0x6d, 0x9c, 0xf2, 0x21, 0xaf, 0x46, 0x34, 0x3c, 0x6b,
+	0xb9, 0x87, 0x0e, 0xce, 0xa1, 0x6d, 0x7f, 0x00, 0x9d, 0xde, 0x8e, 0x36, 0xb9, 0x80, 0xb0, 0x1e,
+	0xf9, 0x41, 0xcd, 0xef, 0x68, 0xa9, 0x30, 0x66, 0x79, 0x73, 0x4f, 0x2f, 0xe7, 0xa3, 0xe1, 0x7e,
+	0xf5, 0xdd, 0x7f, 0x5c, 0x44, 0xf4, 0x1e, 0x03, 0x7b, 0x68, 0x9b, 0x24, 0xcd, 0xb0, 0x9e, 0x95,
+	0x79, 0x56, 0x29, 0x14, 0xf3, 0x52, 0x11, 0x3a, 0x54, 0xe8, 0x13, 0x3a, 0x74, 0x03, 0x0d, 0x37,
+	0x89, 0x57, 0x27, 0x91, 0x30, 0x1b, 0xac, 0x98, 0xb9, 0x71, 0x5d, 0xa6, 0x44, 0x53, 0x61, 0x9e,
+	0xfd, 0x8e, 0xb1, 0xe0, 0x66, 0xbf, 0x17, 0x4d, 0x82, 0xf4, 0x12, 0x76, 0x13, 0x61, 0x84, 0x2c,
+	0xd1, 0x6b, 0x03, 0x3d, 0x89, 0x37, 0xb4, 0x12, 0x9c, 0xc1, 0xb4, 0x17, 0xd1, 0x34, 0x37, 0x18,
+	0x4a, 0x73, 0x04, 0x1f, 0x58, 0x99, 0xa3, 0xa4, 0x9a, 0x29, 0xc7, 0x3d, 0x35, 0x68, 0xac, 0x48,
+	0x58, 0x67, 0xfe, 0x74, 0x6a, 0xac, 0x48, 0x58, 0xdf, 0xc5, 0xb4, 0x04, 0xee, 0x09, 0xe2, 0x0c,
+	0x05, 0xbd, 0xfe, 0xcb, 0x24, 0xf2, 0xb7, 0x76, 0xe9, 0x81, 0x3f, 0x92, 0xde, 0x13, 0xae, 0xf4,
+	0x60, 0xe0, 0x9c, 0x5a, 0xee, 0x97, 0x0a, 0x68, 0x5c, 0x4d, 0x38, 0x70, 0x50, 0x4c, 0x57, 0x9c,
+	0x7e, 0x18, 0xa6, 0xf6, 0xb8, 0x6c, 0xe0, 0xc3, 0x1c, 0xf4, 0x51, 0xde, 0x44, 0xa3, 0x9b, 0xc2,
+	0x63, 0xdf, 0x9c, 0x1e, 0x5d, 0x06, 0x01, 0xa4, 0x42, 0xbd, 0x04, 0xe1, 0x94, 0x21, 0x44, 0x1c,
+	0xa1, 0x74, 0xee, 0x0c, 0x60, 0x94, 0x78, 0x44, 0x55, 0xef, 0xf5, 0x13, 0xa4, 0x3f, 0x8a, 0x46,
+	0xe9, 0x3f, 0xe0, 0x7c, 0xe5, 0x14, 0x4d, 0x99, 0x83, 0xd2, 0x76, 0xaa, 0x57, 0xed, 0x97, 0x05,
+	0x23, 0x9c, 0xf2, 0x74, 0x43, 0x34, 0x9d, 0xc5, 0xee, 0x51, 0xa1, 0x58, 0x06, 0x55, 0x28, 0xee,
+	0x1a, 0x1a, 0x32, 0x3a, 0x84, 0xee, 0x97, 0x2d, 0x34, 0x4a, 0xed, 0xe7, 0x0d, 0xd0, 0xc5, 0xcb,
+	0x2a, 0xc5, 0x7d, 0x46, 0x3d, 0x46, 0xc3, 0xec, 0xd2, 0x25, 0x7c, 0x72, 0x0d, 0x4c, 0x5f, 0x96,
+	0x94, 0x2c, 0x9d, 0xbe, 0xec, 0x76, 0x17, 0x63, 0xc1, 0xc9, 0xfd, 0x54, 0x01, 0x0d, 0x5d, 0x09,
+	0x3a, 0xdd, 0x3f, 0xf3, 0xa9, 0xaa, 0x56, 0x51, 0x09, 0x0c, 0x2d, 0x7a, 0xfe, 0xb6, 0xf1, 0xca,
+	0x63, 0x6a, 0xee, 0x36, 0x47, 0xcf, 0xdd, 0x86, 0xbd, 0x1b, 0xc2, 0x65, 0x9d, 0x6b, 0xb5, 0xd3,
+	0x20, 0xe3, 0xa7, 0xd0, 0xe8, 0x8a, 0xb7, 0x49, 0x5a, 0xcb, 0x64, 0x97, 0x7a, 0x71, 0x31, 0x47,
+	0x26, 0xc5, 0x8b, 0x4b, 0x73, 0x3a, 0x5a, 0x44, 0x93, 0x14, 0x5b, 0x2e, 0x06, 0x10, 0x0b, 0x49,
+	0x9a, 0xcb, 0xc8, 0xd2, 0xc5, 0x42, 0x25, 0x8f, 0x91, 0x82, 0xe5, 0xce, 0xa1, 0xb1, 0x94, 0xca,
+	0x00, 0x5c, 0x7f, 0x50, 0x40, 0x13, 0x9a, 0x72, 0x5e, 0x33, 0x59, 0x5a, 0x07, 0x9a, 0x2c, 0x35,
+	0x13, 0x62, 0xe1, 0xed, 0x36, 0x21, 0x16, 0xef, 0xbf, 0x09, 0x51, 0xff, 0x48, 0xa5, 0x81, 0x3e,
+	0x52, 0x0b, 0x95, 0x56, 0xfc, 0x60, 0x7b, 0xb0, 0x7d, 0x26, 0xae, 0x85, 0x9d, 0x9e, 0x7d, 0xa6,
+	0x0a, 0x40, 0xcc, 0xca, 0xc4, 0x91, 0x58, 0xcc, 0x3f, 0x12, 0xdd, 0xaf, 0x59, 0xe8, 0xd8, 0x2a,
+	0x69, 0x87, 0xfe, 0x2d, 0x2f, 0x0d, 0xc5, 0x80, 0x4a, 0x4d, 0x3f, 0xe1, 0x8e, 0xde, 0xb2, 0xd2,
+	0x65, 0xc8, 0x1a, 0xd4, 0xf4, 0x0f, 0xd2, 0x88, 0xd1, 0xf8, 0x44, 0x10, 0x7b, 0xaf, 0xa6, 0xf2,
+	0x67, 0x1a, 0x64, 0x21, 0x0a, 0x70, 0x8a, 0x23, 0x2b, 0x40, 0x90, 0x89, 0x53, 0xca, 0xa9, 0x00,
+	0x05, 0x38, 0xc5, 0x71, 0x7f, 0xcb, 0x42, 0xc3, 0xac, 0xd5, 0xe4, 0x80, 0x7b, 0xab, 0xdd, 0x44,
+	0x65, 0x5a, 0x8f, 0xcf, 0xbf, 0x4b, 0x06, 0x6c, 0x87, 0x40, 0x8e, 0xad, 0x16, 0xfa, 0x2f, 0x66,
+	0x0c, 0xa8, 0xf4, 0xe8, 0xdd, 0x9c, 0x97, 0x61, 0x2b, 0xa9, 0xf4, 0x48, 0xa1, 0x98, 0x97, 0xba,
+	0x5f, 0x2c, 0xa2, 0x11, 0xe1, 0xa9, 0xc4, 0x72, 0xa4, 0x04, 0x41, 0x98, 0x78, 0xcc, 0xcf, 0x84,
+	0xed, 0xaa, 0x06, 0x02, 0x11, 0x04, 0x87, 0xb9, 0xf9, 0x94, 0x3a, 0xb3, 0x0d, 0xca, 0xbb, 0x80,
+	0x52, 0x82, 0xd5, 0x46, 0xd8, 0x1f, 0x41, 0x43, 0x2d, 0xd8, 0x27, 0xc4, 0x26, 0xfb, 0xb2, 0xc1,
+	0xe6, 0xd0, 0x0d, 0x88, 0xb7, 0x44, 0x8e, 0x10, 0x03, 0x62, 0xce, 0x75, 0xe6, 0x05, 0x34, 0x9d,
+	0x6d, 0x75, 0x8e, 0x21, 0xf2, 0x84, 0x76, 0xcc, 0x2a, 0x76, 0xc3, 0x99, 0xf7, 0xf0, 0x7d, 0xee,
+	0xf0, 0x55, 0xdd, 0x6b, 0x68, 0x6c, 0x95, 0x24, 0x91, 0x5f, 0xa3, 0x04, 0x0e, 0x9a, 0x5c, 0x03,
+	0x9d, 0xf4, 0x9f, 0xa6, 0x93, 0x15, 0x68, 0x82, 0x30, 0x88, 0x3a, 0x51, 0x08, 0xd7, 0x08, 0xd2,
+	0x15, 0x1f, 0xdb, 0xc0, 0xed, 0x60, 0x5d, 0xd2, 0x64, 0xe6, 0xec, 0xf4, 0x37, 0x56, 0xf8, 0xb9,
+	0x4f, 0xa2, 0xf2, 0x6a, 0x37, 0x21, 0x37, 0x07, 0x48, 0xc1, 0xf6, 0x41, 0x34, 0x4e, 0x51, 0x2f,
+	0x87, 0x2d, 0x38, 0xcf, 0xa0, 0xa7, 0x6d, 0xf8, 0x9d, 0xd5, 0xaf, 0x52, 0x24, 0xcc, 0xca, 0x60,
+	0x05, 0x34, 0xc3, 0x56, 0x9d, 0x44, 0x59, 0xe7, 0xd4, 0xcb, 0x14, 0x8a, 0x79, 0xa9, 0xfb, 0x33,
+	0x05, 0x34, 0x46, 0x2b, 0xf2, 0xed, 0x66, 0x17, 0x0d, 0x37, 0x19, 0x1f, 0x3e, 0x24, 0x06, 0x5c,
+	0xdc, 0xd4, 0xd6, 0x2b, 0xd2, 0x39, 0x03, 0x60, 0xc1, 0x0f, 0x58, 0xdf, 0xf0, 0x7c, 0xf0, 0xc1,
+	0x74, 0x0a, 0x47, 0xcb, 0xfa, 0x3a, 0x63, 0x83, 0x05, 0x3f, 0xf7, 0x3f, 0x95, 0x10, 0x82, 0x48,
+	0x28, 0x4c, 0x62, 0x48, 0x8f, 0xf2, 0x34, 0x2a, 0x77, 0x9a, 0x5e, 0x9c, 0xb5, 0x99, 0x94, 0xd7,
+	0x01, 0x78, 0x07, 0xf2, 0xaf, 0x84, 0x75, 0x42, 0x7f, 0x60, 0x86, 0xa8, 0x06, 0xca, 0x15, 0xf6,
+	0x0f, 0x94, 0xb3, 0x3b, 0x68, 0x38, 0xec, 0x26, 0x20, 0xc5, 0xf1, 0x63, 0xd0, 0x80, 0x4d, 0x7c,
+	0x8d, 0x11, 0x64, 0xd1, 0x65, 0xfc, 0x07, 0x16, 0x6c, 0xec, 0xe7, 0xd1, 0x48, 0x27, 0x0a, 0x1b,
+	0x70, 0xaa, 0xf1, 0x2d, 0x5d, 0x44, 0x25, 0x8c, 0xac, 0x73, 0xf8, 0x1d, 0xe5, 0x7f, 0x2c, 0xb1,
+	0xed, 0x5f, 0xb7, 0xd0, 0xf1, 0xb8, 0xe9, 0x45, 0xa4, 0xae, 0x05, 0xbf, 0x9a, 0x8b, 0xd1, 0xaa,
+	0xf6, 0x12, 0xe7, 0x1e, 0x70, 0x4c, 0x95, 0xdc, 0x5b, 0x8c, 0xf3, 0x1a, 0x04, 0xee, 0xd4, 0xc7,
+	0xbc, 0x6c, 0x86, 0x15, 0xae, 0x23, 0xaf, 0x9a, 0xcf, 0x6f, 0x12, 0x33, 0xe7, 0xc5, 0x1e, 0x30,
+	0xee, 0x6d, 0x84, 0xfb, 0xb5, 0x53, 0x6c, 0x6e, 0xf1, 0x05, 0x36, 0x83, 0x0a, 0xbe, 0x50, 0x6a,
+	0xc8, 0x68, 0x97, 0x2b, 0x8b, 0xb8, 0xe0, 0xd7, 0xe5, 0x5e, 0x50, 0xe8, 0x2b, 0x67, 0x40, 0x46,
+	0x49, 0x3f, 0xee, 0xb4, 0xbc, 0xdd, 0xab, 0x39, 0x1a, 0xa5, 0xc5, 0xb4, 0x08, 0xab, 0x78, 0xf6,
+	0x53, 0x3c, 0xb4, 0xb4, 0xa4, 0x69, 0x11, 0x44, 0x68, 0xe9, 0x08, 0x34, 0x4f, 0x89, 0x2a, 0x7d,
+	0x1e, 0x8d, 0x0b, 0xc9, 0x89, 0x72, 0x61, 0x1a, 0x04, 0xa9, 0xf9, 0xde, 0x50, 0xca, 0xb0, 0x86,
+	0xd9, 0x23, 0xe7, 0x0d, 0xdd, 0x7f, 0x39, 0xef, 0x7d, 0x68, 0x42, 0xfc, 0xa4, 0xc2, 0x97, 0x73,
+	0x82, 0xb6, 0x5e, 0x6a, 0x3a, 0x37, 0xd4, 0x42, 0xac, 0xe3, 0xa6, 0x0b, 0x7f, 0x78, 0xd0, 0x85,
+	0x7f, 0x01, 0xa1, 0xcd, 0xb0, 0x1b, 0xd4, 0xbd, 0x68, 0xf7, 0xca, 0xa2, 0x33, 0xa2, 0x8b, 0x95,
+	0x15, 0x59, 0x82, 0x15, 0x2c, 0x75, 0xb3, 0x18, 0x3d, 0x60, 0xb3, 0xd0, 0xe2, 0x64, 0x90, 0xe1,
+	0x38, 0x99, 0x0f, 0x69, 0x71, 0x32, 0x63, 0x87, 0xa6, 0x2e, 0xfb, 0x99, 0x1f, 0x2b, 0x03, 0xa1,
+	0x01, 0x24, 0x6b, 0x15, 0x77, 0x1c, 0xaa, 0x06, 0x93, 0xa1, 0x01, 0x3d, 0x66, 0xf3, 0x7c, 0x5b,
+	0x7a, 0x2f, 0x21, 0x6d, 0x57, 0x9b, 0x39, 0xd4, 0xae, 0xf6, 0x47, 0x16, 0x3a, 0x26, 0x5d, 0x05,
+	0x64, 0xc3, 0x4e, 0xd2, 0x33, 0xa7, 0x66, 0x22, 0x93, 0xb6, 0x58, 0xec, 0x73, 0x38, 0xcb, 0x85,
+	0x09, 0x5b, 0x44, 0xf4, 0xbe, 0xa7, 0xfc, 0x4e, 0x1e, 0xf0, 0xe3, 0xdf, 0x99, 0x9d, 0xed, 0x4d,
+	0x06, 0x2f, 0x89, 0xc3, 0xca, 0xfb, 0xb9, 0xef, 0xcc, 0x4e, 0x8b, 0xdf, 0xe9, 0xa0, 0xf5, 0x74,
+	0x12, 0x64, 0x87, 0x4e, 0x58, 0xbf, 0xb2, 0xee, 0x8c, 0xeb, 0xb2, 0xc3, 0x3a, 0x00, 0x31, 0x2b,
+	0x03, 0xdb, 0x6c, 0xdd, 0x23, 0xed, 0x30, 0x90, 0x29, 0x6e, 0xa9, 0x6d, 0x76, 0x91, 0xc3, 0xb0,
+	0x2c, 0xb5, 0x5b, 0xe0, 0x08, 0x4b, 0x8f, 0x32, 0xe6, 0x08, 0x6b, 0x40, 0x0b, 0xc2, 0x14, 0x1c,
+	0xc2, 0x0d, 0x16, 0xfe, 0xc7, 0x9c, 0x87, 0x7a, 0x72, 0x4e, 0xdd, 0x9f, 0x93, 0xf3, 0x09, 0x34,
+	0x52, 0x6b, 0xfa, 0xad, 0x7a, 0x44, 0x02, 0x67, 0x9a, 0xde, 0xcc, 0xe9, 0x48, 0x2c, 0x70, 0x18,
+	0x96, 0xa5, 0xf6, 0x9f, 0x43, 0x13, 0x61, 0x37, 0xa1, 0x8b, 0x1c, 0xbe, 0x7f, 0xec, 0x1c, 0xa3,
+	0xe8, 0xd4, 0xad, 0x69, 0x4d, 0x2d, 0xc0, 0x3a, 0x1e, 0x6c, 0xb6, 0xcd, 0x30, 0x4e, 0xe0, 0x07,
+	0xdd, 0x6c, 0x4f, 0xe9, 0x9b, 0xed, 0x65, 0xa5, 0x0c, 0x6b, 0x98, 0xf4, 0xcc, 0x6b, 0x67, 0xef,
+	0x8b, 0xce, 0x69, 0x53, 0x67, 0x5e, 0xcf, 0x55, 0x94, 0x9d, 0x79, 0x3d, 0x60, 0xdc, 0xdb, 0x08,
+	0x9a, 0x6a, 0x2f, 0xde, 0x0d, 0x6a, 0xcd, 0x28, 0x0c, 0xf4, 0xe6, 0x3d, 0x68, 0x4a, 0x72, 0xa0,
+	0xab, 0x2c, 0x8f, 0x45, 0xe5, 0x41, 0xb0, 0x19, 0xe7, 0x16, 0xe1, 0xfc, 0x46, 0xf5, 0x15, 0x73,
+	0xce, 0xfc, 0x68, 0x88, 0x39, 0x0f, 0xff, 0x10, 0x88, 0x39, 0x10, 0x21, 0x33, 0x96, 0xa4, 0x81,
+	0xf2, 0xce, 0x59, 0x53, 0x4e, 0x5f, 0x54, 0x38, 0x49, 0x09, 0x8b, 0xe3, 0x5f, 0x02, 0xb0, 0xca,
+	0x96, 0x3a, 0x2c, 0xd2, 0x50, 0xfe, 0x45, 0x52, 0x63, 0x1e, 0x20, 0xb3, 0xa6, 0x4c, 0xa7, 0x58,
+	0x25, 0xab, 0xe4, 0x10, 0x10, 0x20, 0xac, 0x33, 0x06, 0x2b, 0xae, 0xe2, 0x04, 0x77, 0xce, 0x94,
+	0x36, 0x21, 0xef, 0x78, 0x61, 0xc7, 0x8a, 0x3c, 0xed, 0xf3, 0x5c, 0xe2, 0x66, 0x16, 0xd1, 0xa9,
+	0xfc, 0xe3, 0xe8, 0xa0, 0x4b, 0x79, 0x51, 0xbd, 0xcf, 0x6f, 0xa1, 0x49, 0x9d, 0x6b, 0x4e, 0xed,
+	0x17, 0x74, 0xb7, 0xe4, 0x5c, 0x23, 0xa4, 0x20, 0x82, 0xc9, 0xeb, 0x5d, 0x3f, 0x22, 0x4c, 0x63,
+	0xa8, 0x5c, 0xfe, 0x97, 0xd0, 0x83, 0x7d, 0x57, 0x38, 0x08, 0x50, 0xe2, 0xa6, 0x98, 0x49, 0xe7,
+	0xd5, 0x73, 0xb3, 0xfb, 0x72, 0x01, 0x4d, 0x65, 0x66, 0x90, 0xfd, 0x73, 0x7a, 0x52, 0x0d, 0xcb,
+	0x94, 0x62, 0x25, 0x2f, 0x61, 0xc4, 0x81, 0xb9, 0x35, 0x06, 0x8c, 0x22, 0x05, 0x75, 0x6f, 0x10,
+	0xd6, 0xc9, 0x4a, 0x28, 0x1d, 0x9b, 0xa5, 0xba, 0xf7, 0x2a, 0x87, 0x63, 0x89, 0x01, 0x6a, 0xbc,
+	0x4e, 0x44, 0x48, 0xbb, 0x03, 0xd1, 0xdd, 0x2c, 0x72, 0x52, 0xce, 0x8e, 0x75, 0x51, 0x80, 0x53,
+	0x1c, 0x77, 0x12, 0x8d, 0xab, 0x2f, 0x80, 0x50, 0xbf, 0x3f, 0x25, 0xaf, 0x2d, 0xe8, 0x8f, 0xc3,
+	0xaa, 0x71, 0x07, 0xba, 0xb5, 0x6a, 0x8f, 0x03, 0x9d, 0x04, 0xe1, 0x94, 0xe1, 0x20, 0x7e, 0x7f,
+	0xb9, 0x49, 0x78, 0xdf, 0xe6, 0x66, 0x1f, 0xda, 0xef, 0xef, 0x3f, 0x96, 0x50, 0x4a, 0x49, 0x4b,
+	0x45, 0x67, 0x1d, 0x98, 0x8a, 0x2e, 0xf5, 0x12, 0x2c, 0xec, 0xeb, 0x25, 0x58, 0x47, 0x53, 0x1e,
+	0x35, 0x15, 0xa7, 0x3e, 0x5e, 0xc5, 0x43, 0xbb, 0x3e, 0xcc, 0xeb, 0x14, 0x70, 0x96, 0x24, 0x70,
+	0x89, 0xd3, 0xaa, 0x87, 0x4f, 0x73, 0x47, 0xb9, 0x54, 0x75, 0x0a, 0x38, 0x4b, 0xd2, 0x7e, 0x15,
+	0x39, 0x35, 0x9a, 0x8a, 0x84, 0xf5, 0xf1, 0xca, 0xd6, 0xd5, 0x30, 0x59, 0x8f, 0x48, 0x4c, 0x02,
+	0xe6, 0x83, 0x37, 0x22, 0xdd, 0x74, 0x9d, 0x85, 0x3e, 0x78, 0xb8, 0x2f, 0x05, 0xb8, 0x4a, 0x52,
+	0x3b, 0xb7, 0x9f, 0xec, 0xd2, 0xb4, 0x77, 0xce, 0x90, 0x7e, 0x95, 0xac, 0xaa, 0x85, 0x58, 0xc7,
+	0xb5, 0x7f, 0xde, 0x42, 0x13, 0x2d, 0x61, 0xb0, 0xc1, 0xdd, 0x16, 0xbb, 0x53, 0x1a, 0x31, 0xce,
+	0xae, 0x55, 0xab, 0x2b, 0x2a, 0x65, 0x76, 0x16, 0x69, 0x20, 0xac, 0xf3, 0x06, 0xdb, 0xf3, 0x74,
+	0xb6, 0x9a, 0xbd, 0x8d, 0x1e, 0x6e, 0x7b, 0xd1, 0xf6, 0x95, 0x60, 0x2b, 0xa2, 0x51, 0x40, 0x09,
+	0xfb, 0xaa, 0xf3, 0x5b, 0x09, 0x89, 0x16, 0xbd, 0xdd, 0x98, 0x27, 0x2b, 0x13, 0xcf, 0x22, 0x3d,
+	0xbc, 0xba, 0x1f, 0x32, 0xde, 0x9f, 0x16, 0x38, 0xfb, 0x01, 0x02, 0x7d, 0x23, 0xc3, 0x0f, 0x83,
+	0x94, 0x09, 0xcb, 0x91, 0x23, 0x9d, 0xfd, 0x56, 0xf3, 0x90, 0x70, 0x7e, 0x5d, 0x77, 0x04, 0x0d,
+	0xb1, 0xa0, 0x55, 0xf7, 0x3f, 0x14, 0x90, 0x10, 0xdf, 0xff, 0x6c, 0x1b, 0x47, 0x6d, 0x17, 0x0e,
+	0x94, 0x58, 0x64, 0x81, 0x1e, 0x65, 0x37, 0x29, 0xa6, 0xd8, 0xc4, 0xbc, 0x04, 0xee, 0x35, 0x32,
+	0x47, 0x11, 0x7f, 0x80, 0x27, 0x3f, 0x3f, 0x91, 0xfb, 0x09, 0x0b, 0x4d, 0x88, 0x34, 0x2b, 0x10,
+	0x48, 0x12, 0x43, 0xa6, 0x87, 0x18, 0xfe, 0x31, 0xa7, 0x1f, 0x4e, 0x63, 0x95, 0x49, 0x47, 0x31,
+	0x9d, 0x01, 0x13, 0xcc, 0x78, 0xb9, 0x5f, 0x29, 0xa2, 0x51, 0x39, 0xd8, 0x03, 0xd8, 0xe3, 0x2e,
+	0xa4, 0xb9, 0xb0, 0xd9, 0x6e, 0xe8, 0x28, 0x79, 0xb0, 0x41, 0x91, 0x33, 0x1f, 0xec, 0xb2, 0x8c,
+	0x5c, 0x69, 0x52, 0xec, 0xa7, 0x74, 0xc3, 0xff, 0x29, 0xd5, 0x9a, 0xac, 0xe0, 0x33, 0x24, 0xfb,
+	0xa6, 0xea, 0x77, 0x51, 0x32, 0x75, 0xb2, 0x48, 0xa3, 0x72, 0x7f, 0x87, 0x8b, 0xcc, 0xe3, 0x43,
+	0xe5, 0x81, 0x1e, 0x1f, 0x7a, 0x12, 0x95, 0x48, 0xd0, 0x6d, 0xd3, 0x28, 0xc8, 0x51, 0x2a, 0xd5,
+	0x97, 0x2e, 0x06, 0xdd, 0xb6, 0xde, 0x33, 0x8a, 0x62, 0xbf, 0x00, 0x8f, 0xd6, 0xc4, 0xb5, 0xc8,
+	0xa7, 0x59, 0x9d, 0xb8, 0x26, 0xec, 0x0c, 0x7b, 0xb0, 0x46, 0x82, 0xf5, 0x8a, 0x6a, 0x05, 0x77,
+	0x05, 0x1d, 0x5f, 0xf7, 0xa2, 0x98, 0x64, 0x7c, 0x5a, 0x9f, 0x43, 0x43, 0xec, 0x47, 0x26, 0xbf,
+	0xdd, 0x10, 0x3b, 0xfa, 0xee, 0xb0, 0x74, 0x3e, 0xb1, 0x48, 0xec, 0xca, 0x91, 0xdd, 0x5b, 0x68,
+	0x68, 0xbd, 0xd5, 0x6d, 0xf8, 0x81, 0xdd, 0x41, 0x43, 0x2c, 0x63, 0x94, 0x63, 0x99, 0xd2, 0x35,
+	0xb0, 0xbd, 0x43, 0x09, 0x25, 0xa4, 0xbf, 0x31, 0xe7, 0xe3, 0xfe, 0x23, 0x0b, 0x81, 0x62, 0xe4,
+	0xd2, 0x82, 0xfd, 0x17, 0x7a, 0xde, 0xbd, 0xf9, 0xb1, 0x9c, 0x77, 0x6f, 0x26, 0x28, 0x72, 0xef,
+	0x93, 0x37, 0x76, 0x0b, 0x4d, 0x50, 0x73, 0x9a, 0x38, 0xdd, 0xb8, 0x60, 0xfc, 0xec, 0x80, 0xf9,
+	0x57, 0xd4, 0xaa, 0x7c, 0xaf, 0x57, 0x41, 0x58, 0x27, 0xee, 0xfe, 0x76, 0x09, 0x29, 0x56, 0xa7,
+	0x01, 0x16, 0xcb, 0xeb, 0x19, 0x1b, 0xe3, 0xaa, 0x11, 0x1b, 0xa3, 0x30, 0xdc, 0xb1, 0x0d, 0x48,
+	0x37, 0x2b, 0x42, 0xa3, 0x9a, 0xa4, 0xd5, 0x71, 0x8a, 0x7a, 0xa3, 0x2e, 0x93, 0x56, 0x07, 0xd3,
+	0x12, 0x19, 0x8f, 0x5a, 0xea, 0x1b, 0x8f, 0xda, 0x44, 0xe5, 0x06, 0x04, 0x1c, 0x38, 0x65, 0x53,
+	0xe6, 0x64, 0x1a, 0xbf, 0xc0, 0xcc, 0xc9, 0xf4, 0x5f, 0xcc, 0x18, 0xc0, 0x5a, 0x6f, 0x0a, 0xff,
+	0x20, 0x67, 0xc8, 0xd4, 0x5a, 0x97, 0x2e, 0x47, 0x6c, 0xad, 0xcb, 0x9f, 0x38, 0x65, 0x06, 0x2a,
+	0xaf, 0x1a, 0xcb, 0xcd, 0xe6, 0x0c, 0x9b, 0x52, 0x79, 0xf1, 0x64, 0x6f, 0x4c, 0xe5, 0xc5, 0x7f,
+	0x60, 0xc1, 0xc6, 0x3d, 0x8f, 0xc6, 0x94, 0x27, 0x6d, 0xe0, 0x33, 0xc8, 0x8c, 0x41, 0xca, 0x67,
+	0x80, 0x10, 0x41, 0x4c, 0x4b, 0xdc, 0xbf, 0x59, 0x44, 0x52, 0xf5, 0xa8, 0x86, 0x87, 0x7a, 0x35,
+	0x25, 0xab, 0xa2, 0x96, 0x4a, 0x02, 0x6e, 0x35, 0xac, 0x14, 0x44, 0xac, 0x36, 0x89, 0x1a, 0xf2,
+	0xde, 0xe1, 0x14, 0x74, 0x11, 0x6b, 0x55, 0x2d, 0xc4, 0x3a, 0x2e, 0xc8, 0xc7, 0x6d, 0x2f, 0xf0,
+	0xb7, 0x48, 0x9c, 0x64, 0x5d, 0x8d, 0x57, 0x39, 0x1c, 0x4b, 0x0c, 0x08, 0x0c, 0x88, 0x49, 0xb2,
+	0x76, 0x23, 0x20, 0x91, 0x4c, 0x71, 0xe1, 0x94, 0xf4, 0xc0, 0x80, 0x6a, 0x16, 0x01, 0xf7, 0xd6,
+	0xc9, 0x75, 0xcf, 0x2c, 0x1f, 0xda, 0x3d, 0x73, 0x11, 0x4d, 0x43, 0x28, 0x6a, 0x37, 0x22, 0x7d,
+	0x9d, 0x3c, 0x97, 0x32, 0xe5, 0xb8, 0xa7, 0x06, 0x8d, 0x4d, 0x69, 0x79, 0x8d, 0xd8, 0x19, 0x56,
+	0x62, 0x53, 0x00, 0x80, 0x19, 0xdc, 0xfd, 0xfb, 0x16, 0x9a, 0xca, 0x84, 0xcd, 0xb1, 0xf8, 0x18,
+	0x25, 0xe4, 0x55, 0x8b, 0x8f, 0x61, 0x70, 0x2c, 0x31, 0x0e, 0xff, 0x0e, 0xc5, 0xfb, 0xb2, 0xbb,
+	0x5d, 0x51, 0xff, 0xa6, 0xfb, 0x6e, 0x5e, 0x7f, 0xcf, 0x42, 0x4c, 0xab, 0x32, 0xbf, 0x05, 0x96,
+	0x84, 0x64, 0xd7, 0xfe, 0x65, 0x0b, 0x4d, 0xc3, 0xbd, 0x76, 0x3e, 0x48, 0x7c, 0x01, 0x34, 0xf7,
+	0x62, 0x07, 0xe5, 0x75, 0x35, 0x43, 0x9e, 0xa5, 0xb2, 0xc9, 0x42, 0x71, 0x4f, 0x33, 0xdc, 0x4d,
+	0xa4, 0xab, 0x80, 0x58, 0x6a, 0xfd, 0x24, 0xf2, 0x49, 0x5d, 0x3c, 0x03, 0x94, 0xa6, 0xd6, 0xa7,
+	0x60, 0x91, 0xf3, 0xb3, 0x3e, 0x70, 0xfa, 0xa8, 0xff, 0x65, 0xa1, 0x71, 0xca, 0x64, 0xd5, 0x4b,
+	0x6a, 0x4d, 0x12, 0x81, 0x3b, 0xbe, 0x10, 0xcf, 0x98, 0x10, 0x56, 0x66, 0x1b, 0x88, 0x90, 0xde,
+	0x62, 0x9c, 0x96, 0xdb, 0x8f, 0xa1, 0x61, 0x46, 0x47, 0x78, 0xee, 0xf3, 0x04, 0xa4, 0x14, 0x84,
+	0x45, 0xd9, 0x21, 0x12, 0xbd, 0x6a, 0x8a, 0x88, 0xd2, 0xe1, 0x14, 0x11, 0xe5, 0x01, 0x14, 0x11,
+	0xa7, 0xd1, 0xc9, 0xdc, 0x6f, 0xe2, 0xfe, 0xf7, 0x21, 0x50, 0x3d, 0x25, 0xf0, 0x38, 0x0a, 0x9f,
+	0xce, 0xf6, 0x47, 0x10, 0x22, 0x71, 0xcd, 0x83, 0x1d, 0x67, 0x2d, 0x30, 0x97, 0x41, 0x4b, 0x1d,
+	0x6d, 0x9e, 0x15, 0x48, 0x72, 0xc1, 0x0a, 0x47, 0x30, 0x7f, 0x68, 0xf9, 0xc8, 0xcd, 0x39, 0x81,
+	0xe6, 0xe5, 0x29, 0xff, 0x77, 0x16, 0x1a, 0xf1, 0x83, 0x1a, 0x7c, 0x32, 0xc2, 0xbd, 0xd9, 0x3f,
+	0x64, 0xa8, 0xb3, 0x72, 0x48, 0xe7, 0xae, 0x70, 0x06, 0x4c, 0xa3, 0xf8, 0xb2, 0xf8, 0xb2, 0x02,
+	0x7c, 0x67, 0x6f, 0x5f, 0x53, 0x14, 0x24, 0x86, 0x1b, 0xc0, 0x5a, 0x85, 0x65, 0x3f, 0xec, 0x7f,
+	0x6a, 0xa1, 0x62, 0xdb, 0xbb, 0xe9, 0x94, 0x4c, 0x65, 0x60, 0xce, 0xf4, 0x67, 0xd5, 0xbb, 0xc9,
+	0xba, 0x72, 0x4d, 0x68, 0x8d, 0x56, 0xbd, 0x9b, 0x86, 0x7a, 0x01, 0x0d, 0x9f, 0xd9, 0x46, 0x13,
+	0xda, 0x98, 0xe5, 0xe8, 0x43, 0x17, 0x75, 0x7d, 0xe8, 0xbe, 0x69, 0xf7, 0xe6, 0xc4, 0xbe, 0x3b,
+	0x77, 0xad, 0xeb, 0x05, 0x09, 0x6c, 0x3b, 0x9a, 0xf6, 0x75, 0x44, 0x74, 0xe8, 0x28, 0xf9, 0xb8,
+	0xdf, 0x1c, 0x42, 0x7a, 0x7e, 0x5c, 0xfb, 0x1a, 0x2a, 0xb7, 0x68, 0x56, 0x32, 0xeb, 0x2e, 0x13,
+	0x1f, 0xd3, 0xa3, 0x89, 0xa5, 0x2d, 0x63, 0x94, 0xec, 0x45, 0x78, 0x3d, 0x34, 0x89, 0x44, 0xce,
+	0x38, 0xb6, 0x0b, 0xba, 0xe9, 0xeb, 0xa1, 0xb2, 0xe8, 0x8e, 0xfe, 0x13, 0xab, 0xd5, 0xec, 0x37,
+	0xd0, 0xf0, 0x26, 0x7b, 0x4c, 0xc0, 0x9c, 0x3b, 0x0d, 0x7f, 0x9d, 0x80, 0x5e, 0x01, 0xc5, 0x53,
+	0x05, 0x77, 0xd2, 0x7f, 0xb1, 0xe0, 0x48, 0xf3, 0xcc, 0x8b, 0x23, 0xa9, 0x64, 0xd4, 0xce, 0x20,
+	0x8f, 0x22, 0x96, 0x67, 0x9e, 0xff, 0xc2, 0x92, 0x5d, 0xc6, 0x9f, 0xb5, 0x3c, 0x88, 0x3f, 0xab,
+	0xdd, 0x65, 0xa7, 0xd3, 0xee, 0x5a, 0x60, 0xee, 0xb1, 0x45, 0x6d, 0xb3, 0x94, 0xd9, 0xad, 0x77,
+	0xd7, 0x02, 0x2c, 0x78, 0xd1, 0xe4, 0x69, 0xf5, 0xf0, 0x6a, 0x98, 0xf0, 0x12, 0x67, 0xf8, 0x48,
+	0x98, 0xb3, 0x74, 0x40, 0x0a, 0x1f, 0xac, 0x71, 0xb5, 0xdf, 0x52, 0xcd, 0x31, 0x2c, 0xc1, 0xce,
+	0xba, 0xe9, 0xfd, 0x66, 0x9f, 0x94, 0x04, 0x5f, 0xb6, 0x10, 0x4a, 0xdf, 0xe9, 0x83, 0x57, 0x0c,
+	0xe3, 0x67, 0x35, 0xc5, 0xb4, 0x89, 0x6c, 0x3f, 0x9c, 0xa2, 0x92, 0x30, 0x80, 0x43, 0xb0, 0xe4,
+	0x76, 0x90, 0x32, 0xfd, 0x07, 0x16, 0x3a, 0x91, 0xf7, 0x9e, 0xe0, 0xdb, 0xd8, 0xe2, 0xc3, 0xea,
+	0xd1, 0x79, 0x85, 0xf5, 0x88, 0x6c, 0xf9, 0x37, 0xb3, 0x6e, 0xcf, 0xcb, 0xa2, 0x00, 0xa7, 0x38,
+	0xee, 0x57, 0x87, 0x90, 0x64, 0x7c, 0x44, 0x7a, 0x77, 0x2a, 0xef, 0x35, 0xfc, 0xbc, 0x4c, 0xdf,
+	0x0d, 0x9f, 0xc9, 0x7b, 0xf0, 0x17, 0x74, 0x73, 0x22, 0xbe, 0x8a, 0xcb, 0x57, 0xe3, 0xec, 0x04,
+	0x66, 0x30, 0x2c, 0x4b, 0xf3, 0x34, 0xf9, 0xe5, 0xfb, 0xa2, 0xc9, 0x1f, 0x32, 0xaf, 0xc9, 0x07,
+	0xc1, 0x39, 0x6c, 0x91, 0x79, 0x7c, 0xd5, 0x19, 0xd6, 0x05, 0x50, 0xcc, 0xc0, 0x58, 0x94, 0x67,
+	0x9f, 0x9d, 0x19, 0x19, 0xec, 0xd9, 0x19, 0xfb, 0xab, 0xd6, 0x3e, 0xc6, 0x82, 0x51, 0x53, 0xf7,
+	0x89, 0xdc, 0xcc, 0xe8, 0x95, 0x33, 0x77, 0x69, 0x81, 0xf8, 0xa2, 0x85, 0x8e, 0x91, 0xa0, 0x16,
+	0xed, 0x52, 0x3a, 0x9c, 0x9a, 0x83, 0x4c, 0xbd, 0xd5, 0x5b, 0x7d, 0xf6, 0x62, 0x96, 0x38, 0xb3,
+	0xf8, 0xf7, 0x80, 0x71, 0x6f, 0x33, 0xdc, 0xdf, 0x2b, 0xa0, 0xe3, 0x39, 0x14, 0x68, 0xf8, 0x6a,
+	0x1b, 0x26, 0xd0, 0x95, 0x7a, 0x76, 0xf9, 0x2c, 0x73, 0x38, 0x96, 0x18, 0xf6, 0x3a, 0x3a, 0xb1,
+	0xdd, 0x8e, 0x53, 0x2a, 0x60, 0x26, 0x25, 0x37, 0xc5, 0x62, 0x92, 0xe9, 0xb3, 0x97, 0x73, 0x70,
+	0x70, 0x6e, 0x4d, 0xb8, 0x59, 0x93, 0x00, 0x82, 0xf9, 0xd3, 0x22, 0x6e, 0x31, 0x95, 0x37, 0xeb,
+	0x8b, 0x99, 0x72, 0xdc, 0x53, 0x03, 0x1c, 0x09, 0x1e, 0x8a, 0x49, 0xb4, 0x43, 0xa2, 0xaa, 0x5f,
+	0x27, 0x0b, 0xdd, 0x38, 0x09, 0xdb, 0x24, 0xba, 0x4b, 0x6b, 0xd6, 0xec, 0xed, 0xbd, 0xd9, 0x87,
+	0xaa, 0xfd, 0xa9, 0xe1, 0xfd, 0x58, 0xb9, 0x4f, 0xa1, 0x11, 0xf1, 0xce, 0xcd, 0x00, 0xee, 0xe2,
+	0x3f, 0x6b, 0xa1, 0xc9, 0x2a, 0xd5, 0xc6, 0x4a, 0x6d, 0x8c, 0xe9, 0x97, 0x38, 0x1e, 0x97, 0x19,
+	0xb3, 0x32, 0x5b, 0x9e, 0x9e, 0xe3, 0xca, 0xfd, 0x5a, 0x01, 0x4d, 0x57, 0x49, 0xdb, 0xeb, 0x34,
+	0x69, 0x82, 0x06, 0xe6, 0xe6, 0x0d, 0xa9, 0x23, 0x05, 0x2c, 0xfb, 0x00, 0xa8, 0x44, 0xc6, 0x29,
+	0x0e, 0x5c, 0x61, 0x99, 0xb3, 0xba, 0x76, 0x85, 0x65, 0x7e, 0xec, 0x31, 0x16, 0x65, 0x60, 0xd5,
+	0x1f, 0xee, 0x80, 0xe1, 0x5d, 0x3e, 0x50, 0x61, 0xe0, 0x79, 0xdb, 0x6c, 0xeb, 0xe7, 0xd6, 0x19,
+	0x07, 0x76, 0xa7, 0x90, 0x7b, 0x14, 0x87, 0x62, 0xd1, 0x80, 0x99, 0xf7, 0xa2, 0x71, 0x15, 0xf3,
+	0x20, 0x17, 0x8b, 0xb2, 0x2a, 0x7c, 0xff, 0x56, 0x11, 0x8d, 0xa7, 0x03, 0x41, 0xb6, 0xf2, 0x32,
+	0x5f, 0x59, 0x47, 0x92, 0xf9, 0xea, 0x0d, 0x70, 0xfc, 0x4b, 0xbc, 0x4d, 0x2f, 0x66, 0xcd, 0x32,
+	0xe2, 0xbf, 0x03, 0x2e, 0x1c, 0x8b, 0x9c, 0x2a, 0xb8, 0xef, 0x72, 0x5f, 0x42, 0x0e, 0x90, 0x0c,
+	0xed, 0x6d, 0x9e, 0x19, 0xcb, 0x58, 0x6c, 0x18, 0x30, 0xa6, 0x39, 0xb1, 0xc8, 0x56, 0x4f, 0x82,
+	0xac, 0xeb, 0xe9, 0x5c, 0x29, 0xdd, 0xcb, 0x4b, 0x2e, 0xd9, 0x0f, 0xef, 0x7e, 0xbe, 0x80, 0xa6,
+	0xe4, 0xc7, 0xe3, 0xee, 0x2a, 0x6f, 0x65, 0x63, 0x2a, 0xb0, 0xf9, 0x89, 0xb9, 0x4f, 0x5c, 0xc5,
+	0x5b, 0xd9, 0xb8, 0x8a, 0x23, 0x65, 0x9f, 0xe7, 0x81, 0x33, 0x22, 0xb3, 0x4c, 0x5e, 0x43, 0xe5,
+	0xf4, 0x19, 0xbe, 0xbb, 0xbe, 0x46, 0x52, 0xa5, 0x35, 0x66, 0x94, 0x80, 0x24, 0x75, 0x69, 0x76,
+	0x0a, 0xf7, 0x42, 0x92, 0x3a, 0x48, 0x63, 0x46, 0xc9, 0x5e, 0x46, 0x45, 0xc8, 0x38, 0x5e, 0xbc,
+	0x4b, 0x82, 0xf4, 0x2d, 0xb2, 0x8b, 0x41, 0x1d, 0x03, 0x15, 0x9a, 0xf9, 0x96, 0x49, 0xae, 0x25,
+	0x7d, 0xb3, 0xcc, 0x58, 0xba, 0x7e, 0x0a, 0x3d, 0xd8, 0xd7, 0x4d, 0xd0, 0x3e, 0x83, 0x4a, 0x4d,
+	0x98, 0xac, 0x2c, 0x3c, 0x94, 0xcd, 0x66, 0x98, 0x77, 0x14, 0x0a, 0x26, 0xdf, 0xb6, 0xaf, 0x64,
+	0xe7, 0xa0, 0xda, 0xa3, 0x55, 0x0a, 0xc1, 0xbc, 0xc4, 0x6d, 0x20, 0xbb, 0x1a, 0x46, 0xc9, 0xa1,
+	0x32, 0xcd, 0xc0, 0x25, 0x13, 0x4c, 0x7b, 0x24, 0xa8, 0xb3, 0xd9, 0x03, 0x87, 0xa8, 0xbc, 0x64,
+	0x2e, 0xca, 0x12, 0xac, 0x60, 0xb9, 0xbf, 0x6f, 0x21, 0x2d, 0x85, 0xb5, 0x9e, 0x2b, 0xd8, 0x3a,
+	0x54, 0xae, 0xe0, 0xc2, 0xbe, 0xb9, 0x82, 0xaf, 0xa1, 0x61, 0x12, 0xb0, 0xec, 0xd3, 0xc5, 0x43,
+	0x3b, 0xaf, 0xd3, 0xe5, 0x7b, 0x91, 0x55, 0xc7, 0x82, 0xce, 0x5d, 0x45, 0x89, 0xfe, 0x7c, 0x11,
+	0x0d, 0xb1, 0x17, 0x33, 0xec, 0x5f, 0xb3, 0xd0, 0xf1, 0x1b, 0x99, 0xb7, 0xbf, 0xd2, 0xed, 0xfa,
+	0x25, 0x73, 0xa6, 0x72, 0x85, 0x78, 0xe5, 0x21, 0xde, 0xbe, 0xe3, 0x39, 0x85, 0x38, 0xaf, 0x39,
+	0xda, 0xd3, 0x3a, 0xc5, 0x23, 0x79, 0x5a, 0xe7, 0xe6, 0x11, 0x87, 0x1b, 0x4f, 0xf4, 0x0b, 0x35,
+	0x76, 0x7f, 0xbb, 0x8c, 0x10, 0xfb, 0x1a, 0x6b, 0x9d, 0x64, 0x10, 0xeb, 0xe7, 0xf3, 0x68, 0xbc,
+	0x41, 0x02, 0x12, 0x89, 0x68, 0x97, 0x82, 0xee, 0x80, 0x7d, 0x49, 0x29, 0xc3, 0x1a, 0x26, 0x9d,
+	0x2c, 0x70, 0xba, 0xb3, 0x9b, 0x62, 0x76, 0xb2, 0xc8, 0x12, 0xac, 0x60, 0xd9, 0x73, 0x3d, 0x99,
+	0x19, 0x47, 0x79, 0x9c, 0x60, 0xbe, 0x2b, 0xc9, 0x0b, 0x68, 0x52, 0x4f, 0xb8, 0xc6, 0xaf, 0x47,
+	0x32, 0x9f, 0xa7, 0x9e, 0xa7, 0x0d, 0x67, 0xb0, 0x61, 0x2d, 0xd5, 0xa3, 0x5d, 0xdc, 0x0d, 0xf8,
+	0x3d, 0x49, 0xae, 0xa5, 0x45, 0x0a, 0xc5, 0xbc, 0x14, 0x46, 0x81, 0x89, 0xa0, 0x0c, 0xce, 0x33,
+	0x66, 0xa5, 0xd9, 0xae, 0x94, 0x32, 0xac, 0x61, 0x02, 0x07, 0x6e, 0x3d, 0x46, 0xfa, 0xfe, 0x96,
+	0x31, 0xf9, 0x76, 0xd0, 0x64, 0xa8, 0x1b, 0xdf, 0x58, 0xc4, 0xc9, 0xbb, 0x07, 0x9c, 0x7a, 0x5a,
+	0x5d, 0x96, 0x47, 0x45, 0x87, 0xe1, 0x0c, 0x7d, 0xb8, 0x28, 0xaa, 0xf1, 0xbc, 0xe3, 0x7a, 0xb0,
+	0x54, 0xdf, 0x90, 0xdb, 0x75, 0x74, 0xa2, 0x13, 0xd6, 0xd7, 0x23, 0x3f, 0x04, 0x6f, 0xae, 0x85,
+	0x96, 0x17, 0xc7, 0x74, 0x62, 0x4c, 0xe8, 0x37, 0x92, 0xf5, 0x1c, 0x1c, 0x9c, 0x5b, 0x13, 0xae,
+	0xf4, 0x1d, 0x0e, 0xa4, 0x81, 0x12, 0x65, 0x26, 0x04, 0x09, 0x44, 0x2c, 0x4b, 0xdd, 0xe3, 0xe8,
+	0x58, 0xb5, 0xdb, 0xe9, 0xb4, 0x7c, 0x52, 0x97, 0xbe, 0x1f, 0xee, 0x4f, 0xa2, 0x29, 0xfe, 0xda,
+	0x85, 0x94, 0xe8, 0x0f, 0xf5, 0x6e, 0x9d, 0xfb, 0x34, 0x9a, 0xca, 0x48, 0x61, 0x07, 0xa5, 0x18,
+	0x3b, 0x8f, 0xc6, 0x14, 0xf1, 0x69, 0x80, 0x5b, 0xc7, 0x1f, 0x59, 0x68, 0x2a, 0xe3, 0xac, 0x0b,
+	0x6e, 0x50, 0xba, 0xa4, 0x6f, 0xe6, 0xc1, 0x04, 0x45, 0x34, 0xe6, 0x8f, 0x47, 0xe4, 0xdd, 0x1a,
+	0x9a, 0x22, 0x4a, 0xd6, 0x58, 0xb0, 0x39, 0x8d, 0x25, 0x65, 0xd2, 0x82, 0x1a, 0x6a, 0xeb, 0x7e,
+	0xba, 0x80, 0xf2, 0xc3, 0x0d, 0x20, 0x0b, 0x6a, 0x76, 0x00, 0xae, 0x19, 0x1c, 0x00, 0xc6, 0x65,
+	0x9f, 0x31, 0x08, 0xf4, 0x31, 0x58, 0x35, 0x34, 0x06, 0x9c, 0x6f, 0xef, 0x48, 0xfc, 0x5f, 0x0b,
+	0x8d, 0x6d, 0x6c, 0xac, 0xc8, 0x93, 0x1f, 0xa3, 0x53, 0x31, 0xcb, 0x69, 0x44, 0x5d, 0xf6, 0x16,
+	0xc2, 0x76, 0x87, 0x79, 0xf0, 0x39, 0x56, 0xfa, 0xb6, 0x49, 0x35, 0x17, 0x03, 0xf7, 0xa9, 0x69,
+	0x5f, 0x41, 0xc7, 0xd5, 0x12, 0x6e, 0x69, 0xe7, 0x5e, 0x84, 0x2c, 0x9a, 0xa2, 0xb7, 0x18, 0xe7,
+	0xd5, 0xc9, 0x92, 0xe2, 0xe6, 0x76, 0xa7, 0x98, 0x4f, 0x8a, 0x17, 0xe3, 0xbc, 0x3a, 0xee, 0x67,
+	0xa1, 0xe7, 0x5e, 0x24, 0x7b, 0xfe, 0x7e, 0x34, 0x5d, 0x0b, 0xdb, 0x42, 0x44, 0x58, 0x21, 0x3b,
+	0xa4, 0xc5, 0xfb, 0x4c, 0x4d, 0xcb, 0x0b, 0x99, 0x32, 0xdc, 0x83, 0x0d, 0x56, 0xf4, 0x20, 0x54,
+	0xf0, 0xb8, 0xf4, 0x25, 0xad, 0xe8, 0x57, 0xd5, 0x42, 0xac, 0xe3, 0xba, 0xbf, 0x3f, 0x8b, 0x64,
+	0x12, 0x90, 0x01, 0x8e, 0xc0, 0x8e, 0x0c, 0xe3, 0x2a, 0x1b, 0x0e, 0xe3, 0x92, 0x87, 0x41, 0x26,
+	0x94, 0x2b, 0x49, 0x43, 0xb9, 0x86, 0x4c, 0x87, 0x72, 0xc9, 0xab, 0x48, 0x4f, 0x38, 0xd7, 0x2f,
+	0x59, 0x68, 0x1c, 0x2c, 0xd3, 0xd2, 0x33, 0x61, 0xf8, 0x5c, 0xd1, 0xcc, 0x53, 0x1e, 0x62, 0xb0,
+	0xe7, 0xae, 0x2a, 0xe4, 0x99, 0x92, 0x40, 0x9e, 0xa1, 0x6a, 0x11, 0xd6, 0xda, 0x61, 0x2f, 0x29,
+	0x76, 0x24, 0x66, 0x99, 0x38, 0x93, 0x77, 0xb5, 0x3f, 0xd0, 0x28, 0x74, 0x53, 0x11, 0xec, 0x46,
+	0x4d, 0xa9, 0xe8, 0x45, 0xbe, 0x08, 0xc5, 0xbb, 0x86, 0x43, 0x14, 0x81, 0xcf, 0x45, 0x43, 0x2c,
+	0x2a, 0x90, 0xe7, 0xc9, 0xa4, 0x57, 0x10, 0x16, 0x31, 0x88, 0x79, 0x89, 0x9d, 0x08, 0xcf, 0xd1,
+	0x31, 0x53, 0xcf, 0x98, 0x6a, 0x9e, 0xa9, 0xf9, 0xae, 0xa3, 0xf6, 0x8b, 0xaa, 0xf2, 0x6b, 0x7c,
+	0x10, 0xe5, 0xd7, 0x44, 0x5f, 0xc5, 0xd7, 0x67, 0x2d, 0x34, 0x5e, 0x53, 0x9e, 0x15, 0x75, 0x9e,
+	0x30, 0xf6, 0x78, 0x60, 0xce, 0xeb, 0xaf, 0xcc, 0xa2, 0xa5, 0x96, 0x60, 0x8d, 0x3b, 0xcd, 0x5c,
+	0x4f, 0x35, 0x7d, 0xce, 0x84, 0x29, 0x73, 0x96, 0xae, 0x39, 0x64, 0x9f, 0x91, 0xc1, 0x30, 0xe7,
+	0x65, 0xbf, 0xa9, 0xb8, 0x0f, 0x4d, 0x9a, 0xf2, 0x69, 0xcf, 0x7a, 0x90, 0x89, 0x74, 0xc0, 0x3d,
+	0xee, 0x48, 0x4d, 0x54, 0xac, 0x7b, 0x0d, 0x67, 0xca, 0xd4, 0x89, 0xa6, 0x3c, 0x6a, 0xc0, 0x2e,
+	0xee, 0x8b, 0xf3, 0x97, 0x30, 0xb0, 0xb0, 0x6f, 0xa6, 0x6f, 0x8f, 0x4d, 0x1b, 0x3b, 0xbb, 0x75,
+	0x39, 0x8e, 0xdd, 0x42, 0x7b, 0x9e, 0x32, 0xab, 0x73, 0xa7, 0xbb, 0x1f, 0x37, 0x95, 0x24, 0x1e,
+	0x24, 0x3f, 0xa6, 0x35, 0x48, 0x1d, 0xf7, 0x64, 0x2a, 0xfa, 0x77, 0x1c, 0x69, 0x2a, 0xfa, 0x16,
+	0x1a, 0xea, 0x50, 0x07, 0x5e, 0xe7, 0x9d, 0xa6, 0xce, 0x16, 0xe6, 0x10, 0xcc, 0xe6, 0x26, 0xfb,
+	0x1f, 0x73, 0x1e, 0xf6, 0x45, 0x34, 0xcc, 0x9e, 0x17, 0x66, 0x01, 0xb8, 0x63, 0x17, 0x66, 0xfa,
+	0x3f, 0x52, 0x9c, 0x1e, 0x14, 0xec, 0x77, 0x8c, 0x45, 0x5d, 0xfb, 0xf3, 0x16, 0x9a, 0x84, 0x1d,
+	0x35, 0x8d, 0xd9, 0x72, 0x6c, 0x53, 0x7b, 0x16, 0xa4, 0xf9, 0x4c, 0xf7, 0x1a, 0x79, 0x8f, 0xbb,
+	0xa2, 0xb1, 0xc3, 0x19, 0xf6, 0xf6, 0x5b, 0x68, 0x24, 0xf6, 0xeb, 0xa4, 0xe6, 0x45, 0xb1, 0x73,
+	0xfc, 0x68, 0x9a, 0x92, 0x5a, 0x60, 0x39, 0x23, 0x2c, 0x59, 0xda, 0x7f, 0xc5, 0x42, 0x53, 0x5e,
+	0x54, 0x6b, 0xfa, 0x3b, 0x64, 0x25, 0xac, 0xb1, 0x7b, 0xc7, 0x09, 0x53, 0x6b, 0x5f, 0x28, 0xbc,
+	0x04, 0x65, 0x6e, 0x98, 0xd4, 0xd9, 0xe1, 0x2c, 0x7f, 0xfb, 0x2f, 0x59, 0xe8, 0x24, 0x7b, 0x91,
+	0x2c, 0xfb, 0xde, 0xdf, 0xc9, 0xbb, 0x54, 0xdc, 0xd1, 0xc8, 0xe1, 0xf9, 0x3c, 0x92, 0x38, 0x9f,
+	0x53, 0x1a, 0x6e, 0x2a, 0x1d, 0x58, 0x4f, 0x19, 0x75, 0x03, 0x19, 0xfc, 0xc9, 0x6a, 0xfb, 0x19,
+	0xfd, 0xa9, 0xdc, 0xd3, 0x54, 0x75, 0x36, 0xb5, 0xdf, 0x33, 0xb9, 0xda, 0x63, 0x29, 0x4f, 0xee,
+	0xf7, 0x58, 0x8a, 0xfd, 0x12, 0x1a, 0x4b, 0xc2, 0x16, 0x89, 0xf8, 0x55, 0xda, 0xa1, 0x33, 0xf0,
+	0x6c, 0xde, 0xda, 0xda, 0x90, 0x68, 0xe9, 0x55, 0x3b, 0x85, 0xc5, 0x58, 0xa5, 0x43, 0x23, 0xac,
+	0xf8, 0x4b, 0x6f, 0x11, 0xbd, 0x63, 0x3f, 0x98, 0x89, 0xb0, 0x52, 0x0b, 0xb1, 0x8e, 0x0b, 0x0e,
+	0xbd, 0x9d, 0x9e, 0x4b, 0x3a, 0xcb, 0x04, 0x21, 0x1d, 0x7a, 0x7b, 0x6f, 0xe8, 0xbd, 0x75, 0xb4,
+	0xeb, 0xf9, 0x43, 0xfb, 0x5d, 0xcf, 0xfb, 0xbc, 0xac, 0x70, 0xe6, 0x6e, 0x5e, 0x56, 0xb0, 0xeb,
+	0xe8, 0x8c, 0xd7, 0x4d, 0x42, 0xea, 0xef, 0xa7, 0x57, 0x61, 0xc1, 0x66, 0xe7, 0x58, 0xfc, 0xda,
+	0xed, 0xbd, 0xd9, 0x33, 0xf3, 0xfb, 0xe0, 0xe1, 0x7d, 0xa9, 0xd8, 0xb7, 0x20, 0xd2, 0x87, 0xbd,
+	0x0e, 0xe1, 0xfc, 0x98, 0x29, 0x21, 0x41, 0x7f, 0x6f, 0x42, 0xc4, 0x0e, 0x31, 0x18, 0x96, 0xfc,
+	0xec, 0x0d, 0x34, 0xd6, 0x0c, 0xe3, 0x64, 0xbe, 0xe5, 0x7b, 0xa0, 0x9b, 0x7e, 0xf8, 0x5c, 0xb1,
+	0x9f, 0xec, 0x75, 0x59, 0xa0, 0xa5, 0x73, 0xe6, 0x72, 0x5a, 0x13, 0xab, 0x64, 0x6c, 0x82, 0xa6,
+	0x44, 0xa4, 0x9d, 0xb0, 0x15, 0xb3, 0x58, 0xf3, 0xc7, 0xf3, 0x28, 0xaf, 0x87, 0xf5, 0xaa, 0x8e,
+	0x2d, 0x1d, 0x12, 0x54, 0x20, 0xce, 0xd2, 0x04, 0x85, 0x58, 0x27, 0xac, 0xc3, 0x83, 0xa8, 0xeb,
+	0xe0, 0x5f, 0xe4, 0xcc, 0xea, 0x6a, 0xc1, 0x75, 0xa5, 0x0c, 0x6b, 0x98, 0xe0, 0xb3, 0xdf, 0x66,
+	0x39, 0xc6, 0x9c, 0x47, 0x4c, 0xdd, 0x6d, 0x78, 0xd2, 0x32, 0x26, 0x2f, 0xf0, 0x1f, 0x58, 0xb0,
+	0xb1, 0x7f, 0xd5, 0x42, 0x53, 0x99, 0xcc, 0x06, 0xce, 0xa3, 0x26, 0xed, 0x77, 0x0a, 0xe1, 0xca,
+	0xe3, 0x74, 0xf8, 0x74, 0xe0, 0x9d, 0x5e, 0x10, 0xce, 0xb6, 0x88, 0x8d, 0x0b, 0x4d, 0x14, 0xe8,
+	0x3c, 0x66, 0x6e, 0x5c, 0x28, 0x41, 0x31, 0x2e, 0xf4, 0x07, 0x16, 0x6c, 0xc0, 0xa9, 0x84, 0xa7,
+	0x56, 0x76, 0x1e, 0xd7, 0x9d, 0x4a, 0x78, 0x06, 0x66, 0x2c, 0xca, 0x21, 0x1b, 0x9c, 0x08, 0xa1,
+	0xbb, 0xb4, 0xe0, 0x3c, 0x65, 0x2a, 0xb9, 0xfc, 0xbc, 0xa4, 0xc9, 0xb4, 0xbc, 0xe9, 0x6f, 0xac,
+	0xf0, 0x9b, 0xf9, 0x49, 0x74, 0xac, 0xe7, 0xe2, 0x78, 0xa8, 0x5c, 0x79, 0x7f, 0x03, 0xf4, 0x0f,
+	0x8a, 0x86, 0xdf, 0xf4, 0x8b, 0x87, 0xcf, 0xa3, 0x71, 0x78, 0x0b, 0x15, 0x94, 0x27, 0x34, 0xa9,
+	0x52, 0x49, 0x57, 0x0f, 0x2f, 0x28, 0x65, 0x58, 0xc3, 0x74, 0x7f, 0x50, 0x46, 0x76, 0xef, 0x7b,
+	0x54, 0x77, 0x93, 0x33, 0x15, 0x52, 0x67, 0xbd, 0xf6, 0xba, 0x53, 0xd0, 0x53, 0x67, 0xbd, 0x78,
+	0x0d, 0x17, 0x5e, 0x7b, 0x1d, 0xba, 0x03, 0x79, 0x5e, 0x21, 0x09, 0x7f, 0x36, 0x16, 0xe4, 0xc5,
+	0xea, 0xda, 0x55, 0x80, 0x63, 0x89, 0x61, 0xef, 0xa0, 0x72, 0xc7, 0x8b, 0x62, 0xe2, 0x94, 0x4c,
+	0x19, 0x66, 0x72, 0x42, 0xde, 0x98, 0x82, 0x8c, 0x16, 0x60, 0xc6, 0xce, 0x8e, 0x50, 0x29, 0x0e,
+	0x23, 0xe1, 0xa6, 0x65, 0xe0, 0xa5, 0xaf, 0x5e, 0x93, 0x1e, 0x13, 0xc0, 0x01, 0x8e, 0x29, 0x2f,
+	0xfb, 0x16, 0x1a, 0xea, 0x06, 0xfe, 0xeb, 0x5d, 0xe2, 0x0c, 0x99, 0xba, 0xac, 0xbe, 0x44, 0xe9,
+	0x65, 0xf8, 0x52, 0x71, 0x9c, 0x95, 0x60, 0xce, 0xd1, 0xfe, 0x08, 0x1a, 0x6e, 0xb0, 0x17, 0x2e,
+	0x9c, 0x61, 0x53, 0x1e, 0x56, 0xb9, 0x4f, 0x66, 0xb0, 0x0d, 0x80, 0x17, 0x61, 0xc1, 0x14, 0xbe,
+	0x73, 0xad, 0xd9, 0x0d, 0xb6, 0x9d, 0x11, 0x53, 0xdf, 0x79, 0x01, 0xc8, 0xe5, 0x7d, 0x67, 0x5a,
+	0x80, 0x19, 0x3b, 0xf7, 0x39, 0x74, 0x22, 0x6f, 0x8c, 0x0e, 0xd2, 0xba, 0xff, 0x5d, 0x0b, 0x4d,
+	0x68, 0x32, 0xb9, 0x71, 0xcf, 0x9d, 0x25, 0x64, 0xb7, 0xfd, 0x28, 0x0a, 0x23, 0x76, 0xe5, 0x59,
+	0x05, 0x41, 0x21, 0xe6, 0xaa, 0x45, 0xfa, 0x04, 0xc0, 0x6a, 0x4f, 0x29, 0xce, 0xa9, 0xe1, 0xfe,
+	0xc3, 0x12, 0x4a, 0x83, 0x53, 0xe5, 0xe3, 0x1f, 0x56, 0xdf, 0xc7, 0x3f, 0xd4, 0xe5, 0x59, 0x38,
+	0x70, 0x79, 0x02, 0xf6, 0xeb, 0x4b, 0x7e, 0x2b, 0xe9, 0x7d, 0x43, 0xe2, 0xc5, 0x6b, 0x0c, 0x8e,
+	0x25, 0x06, 0xe4, 0xb4, 0x22, 0xf0, 0x6e, 0x16, 0xb7, 0x8c, 0x49, 0x2d, 0x10, 0x7f, 0x4b, 0x91,
+	0x96, 0xd1, 0x38, 0x14, 0x61, 0x55, 0xcb, 0xe6, 0xb5, 0x95, 0xa6, 0x37, 0x9c, 0xe2, 0xd0, 0x0b,
+	0x17, 0xb7, 0xc4, 0x98, 0xcb, 0x23, 0xd8, 0x63, 0xdb, 0x61, 0xb2, 0x93, 0x00, 0x63, 0xc9, 0x32,
+	0xcf, 0xe7, 0x67, 0xf4, 0x48, 0x7c, 0x7e, 0x94, 0x48, 0xe9, 0xf2, 0xa0, 0x91, 0xd2, 0xfa, 0xe6,
+	0x3d, 0x32, 0x90, 0x95, 0xfc, 0x93, 0x45, 0x34, 0xfc, 0x32, 0x89, 0x44, 0x94, 0xd4, 0x0e, 0xfb,
+	0x37, 0x9b, 0xbf, 0x85, 0x63, 0x60, 0x51, 0x0e, 0xdf, 0x6d, 0xb3, 0xeb, 0xb7, 0xea, 0x8b, 0xe9,
+	0x39, 0x25, 0xbf, 0x5b, 0x45, 0x14, 0xe0, 0x14, 0x07, 0x2a, 0x34, 0xe0, 0xe6, 0xdc, 0x86, 0x60,
+	0x85, 0x8c, 0xeb, 0xef, 0x25, 0x51, 0x80, 0x53, 0x1c, 0xb0, 0x5f, 0x36, 0xfc, 0x64, 0xc3, 0x6b,
+	0x64, 0xfd, 0x33, 0x2e, 0x51, 0x28, 0xe6, 0xa5, 0xd4, 0x4e, 0xec, 0x27, 0x1b, 0x11, 0xa1, 0x76,
+	0x97, 0x9e, 0xac, 0x88, 0x97, 0x94, 0x32, 0xac, 0x61, 0xd2, 0x26, 0x85, 0xbc, 0x67, 0xce, 0x50,
+	0xa6, 0x49, 0xa2, 0x00, 0xa7, 0x38, 0x30, 0xff, 0xc1, 0x1e, 0xe0, 0xb7, 0x78, 0xd8, 0xa7, 0x32,
+	0xff, 0x17, 0x38, 0x1c, 0x4b, 0x0c, 0xc0, 0x86, 0x43, 0x1a, 0x76, 0x99, 0xec, 0x6b, 0xef, 0xeb,
+	0x1c, 0x8e, 0x25, 0x86, 0xfb, 0x32, 0x9a, 0x60, 0x2b, 0x79, 0xa1, 0xe5, 0xf9, 0xed, 0x4b, 0x0b,
+	0xf6, 0xc5, 0x9e, 0xd8, 0xe6, 0x27, 0x73, 0x62, 0x9b, 0x4f, 0x6a, 0x95, 0x7a, 0x63, 0x9c, 0xdd,
+	0x6f, 0x17, 0xd0, 0x88, 0x70, 0x40, 0xd0, 0x1c, 0x0c, 0xac, 0x23, 0x71, 0x30, 0xe8, 0xa0, 0x52,
+	0xdc, 0x21, 0x35, 0xa7, 0x60, 0xca, 0xac, 0x28, 0xda, 0x0e, 0x72, 0x7c, 0xba, 0x85, 0xc1, 0x2f,
+	0x4c, 0x39, 0xd9, 0x37, 0xd1, 0x50, 0xcc, 0x72, 0x98, 0x15, 0x4d, 0xdd, 0xa3, 0xf4, 0x47, 0xda,
+	0x15, 0x37, 0x4a, 0xfa, 0x1b, 0x73, 0x7e, 0xf0, 0x3a, 0xd0, 0x09, 0xf9, 0x5e, 0x3d, 0xec, 0x66,
+	0x15, 0x9f, 0xba, 0xda, 0xdc, 0x87, 0x61, 0x7e, 0x53, 0x1b, 0xe6, 0x57, 0xcc, 0x75, 0x59, 0xed,
+	0x47, 0xbf, 0x21, 0x77, 0xff, 0xc0, 0x42, 0x4e, 0x5e, 0x05, 0x08, 0xd0, 0xb2, 0x5f, 0xed, 0xe9,
+	0xfc, 0xdc, 0x80, 0xf1, 0xf4, 0x7e, 0xcc, 0xba, 0x2e, 0x97, 0x89, 0x80, 0x28, 0x1d, 0x7f, 0x43,
+	0x24, 0xe4, 0x37, 0x96, 0x76, 0x3b, 0xaf, 0x23, 0xe9, 0x61, 0xa5, 0x25, 0xfb, 0xff, 0xc3, 0x3e,
+	0xfd, 0x86, 0xa1, 0xb1, 0x5b, 0xe2, 0xb8, 0xb3, 0x4c, 0x19, 0xb6, 0x19, 0x8b, 0xfc, 0x73, 0xb3,
+	0x85, 0x86, 0x62, 0xea, 0x4d, 0x63, 0x2e, 0xc4, 0x91, 0x79, 0xe7, 0x70, 0xd3, 0x02, 0xfd, 0x1f,
+	0x73, 0x1e, 0xee, 0x7f, 0xb1, 0xd0, 0xb8, 0xe8, 0xf8, 0x7d, 0xf8, 0xc8, 0xa1, 0xfe, 0x91, 0x5f,
+	0x34, 0xf7, 0x91, 0xfb, 0x7c, 0xd8, 0xbd, 0x32, 0x9a, 0x16, 0x28, 0x32, 0xef, 0xfc, 0xa7, 0x2c,
+	0xe9, 0x41, 0x63, 0x99, 0x8a, 0xea, 0xcc, 0x32, 0x19, 0x24, 0xd7, 0x3b, 0x44, 0x1b, 0x68, 0x0e,
+	0x33, 0x05, 0x53, 0x19, 0x51, 0x7b, 0x5a, 0x73, 0x17, 0x89, 0xf0, 0x7f, 0xc9, 0x42, 0x88, 0xb5,
+	0x93, 0xbf, 0x74, 0x03, 0x6d, 0xdb, 0x3c, 0xb2, 0x91, 0x02, 0x26, 0xac, 0x69, 0x72, 0x83, 0x4c,
+	0x0b, 0xb0, 0xd2, 0x92, 0x7b, 0xc8, 0x70, 0x7f, 0xcf, 0xc9, 0xf5, 0x3f, 0x6f, 0xa1, 0xa9, 0x4c,
+	0x73, 0x73, 0xea, 0x6f, 0xe9, 0x61, 0xa1, 0x06, 0xce, 0x2d, 0xfd, 0xfd, 0x13, 0x55, 0x0f, 0xf1,
+	0x3d, 0x37, 0x5d, 0xc0, 0x74, 0xb7, 0x7a, 0x03, 0x8d, 0x0a, 0x25, 0x82, 0x98, 0xde, 0x2f, 0x9a,
+	0x33, 0xb7, 0xa7, 0x72, 0x94, 0x80, 0xc4, 0x38, 0xe5, 0x97, 0x71, 0xd0, 0x2b, 0x0c, 0xe4, 0xa0,
+	0xa7, 0x3d, 0x94, 0x52, 0xbc, 0xdf, 0x0f, 0xa5, 0xe4, 0x2b, 0x98, 0x4b, 0x47, 0xa2, 0x60, 0x3e,
+	0x63, 0x5c, 0xc1, 0xfc, 0xf0, 0x7d, 0x56, 0x30, 0x2b, 0xd6, 0xbe, 0xf2, 0x3d, 0x58, 0xfb, 0xde,
+	0x40, 0x27, 0x76, 0x52, 0xe9, 0x56, 0xce, 0x24, 0x9a, 0x2c, 0x69, 0xec, 0xc2, 0x93, 0xb9, 0x6a,
+	0x65, 0x90, 0xd4, 0xe3, 0x84, 0x04, 0x89, 0x22, 0x17, 0xa7, 0xbe, 0x81, 0x2f, 0xe7, 0x90, 0xc3,
+	0xb9, 0x4c, 0xb2, 0x66, 0x9b, 0xe1, 0x01, 0xcc, 0x36, 0x5f, 0x01, 0xc3, 0x57, 0x4f, 0x7c, 0x26,
+	0x5c, 0x11, 0x47, 0x4c, 0x29, 0x59, 0xe6, 0xf3, 0xc8, 0x73, 0xfb, 0x58, 0x5e, 0x11, 0xce, 0x6f,
+	0x10, 0xc4, 0xee, 0x08, 0x1b, 0x3a, 0xf3, 0x28, 0xcd, 0x37, 0x78, 0x7f, 0x31, 0xeb, 0x98, 0x83,
+	0xe8, 0xd0, 0x7f, 0xd8, 0xac, 0x58, 0x6f, 0xc0, 0x39, 0x67, 0xec, 0x1e, 0x9c, 0x73, 0x32, 0x36,
+	0xb4, 0x71, 0x43, 0x36, 0xb4, 0x00, 0x4d, 0xfb, 0x6d, 0xaf, 0x41, 0xd6, 0xbb, 0xad, 0x16, 0x0b,
+	0x18, 0x8b, 0x9d, 0x89, 0x73, 0xc5, 0x7e, 0xaa, 0x02, 0x30, 0x9f, 0xb6, 0x78, 0xa2, 0x2b, 0xe9,
+	0x4d, 0x2b, 0x03, 0xe3, 0xae, 0x64, 0x28, 0xe1, 0x1e, 0xda, 0x30, 0x61, 0x69, 0x1a, 0x6a, 0x92,
+	0xc0, 0x68, 0x53, 0x0f, 0x90, 0x91, 0xca, 0x94, 0x30, 0xd9, 0x70, 0x30, 0x56, 0x71, 0xec, 0x65,
+	0x34, 0x5a, 0x0f, 0x62, 0x1e, 0xe7, 0x3f, 0x45, 0x37, 0xb3, 0x77, 0xc1, 0x16, 0xb8, 0x78, 0xb5,
+	0x2a, 0x23, 0xfc, 0xcf, 0xe4, 0x64, 0x5b, 0x90, 0xe5, 0x38, 0xad, 0x6f, 0xaf, 0x52, 0x62, 0xfc,
+	0x5d, 0x54, 0xe6, 0x98, 0x71, 0xae, 0x8f, 0xe5, 0x67, 0xf1, 0xaa, 0x78, 0xd9, 0x75, 0x82, 0xb3,
+	0x63, 0x3f, 0x71, 0x4a, 0x01, 0xae, 0xff, 0x61, 0x00, 0xa9, 0x53, 0x9c, 0x63, 0xfa, 0xf5, 0x7f,
+	0x8d, 0x42, 0x31, 0x2f, 0x65, 0x4f, 0x1b, 0x24, 0x2d, 0x69, 0xe7, 0x3d, 0x6b, 0xec, 0x69, 0x83,
+	0xd4, 0x61, 0x92, 0xe7, 0x36, 0x4e, 0x01, 0x58, 0x65, 0x69, 0xaf, 0xf5, 0xb3, 0x77, 0x1f, 0xa7,
+	0x9b, 0xc6, 0xe1, 0xad, 0xd7, 0xaa, 0xe1, 0xf3, 0xc4, 0xbe, 0x86, 0xcf, 0x1e, 0x43, 0xed, 0xc9,
+	0x43, 0x18, 0x6a, 0x9b, 0x34, 0xe9, 0xfc, 0xa5, 0x05, 0xe7, 0x94, 0xa9, 0x1b, 0x0b, 0x4d, 0xb4,
+	0xc6, 0xf5, 0xeb, 0xf0, 0x2f, 0x66, 0x0c, 0xfa, 0xba, 0x6e, 0x9f, 0xbe, 0x6b, 0xd7, 0x6d, 0xd8,
+	0x9e, 0x53, 0x38, 0x7d, 0xbd, 0xa0, 0xcc, 0xb7, 0xe7, 0x14, 0x8c, 0x55, 0x9c, 0xac, 0xd9, 0xf3,
+	0xc1, 0x23, 0x33, 0x7b, 0xce, 0xdc, 0x07, 0xb3, 0xe7, 0x43, 0x03, 0x9b, 0x3d, 0xdf, 0x42, 0xc7,
+	0x3b, 0x61, 0x7d, 0xd1, 0x8f, 0xa3, 0x2e, 0x8d, 0xa0, 0xad, 0x74, 0xeb, 0x0d, 0x92, 0xf0, 0xf4,
+	0xdb, 0x17, 0xd4, 0x46, 0x76, 0xe8, 0x42, 0x9e, 0xdb, 0x79, 0x66, 0x93, 0x24, 0xec, 0x63, 0x66,
+	0x6b, 0x01, 0x55, 0xe6, 0x81, 0x9b, 0x53, 0x88, 0xf3, 0xf8, 0xa8, 0x56, 0xd7, 0x73, 0xf7, 0xc7,
+	0xea, 0xfa, 0x7e, 0x34, 0x12, 0x37, 0xbb, 0x49, 0x3d, 0xbc, 0x11, 0x50, 0xd3, 0xfa, 0x68, 0xe5,
+	0x51, 0xa9, 0x38, 0xe3, 0xf0, 0x3b, 0x90, 0x0b, 0x8c, 0xff, 0xaf, 0xe8, 0xcc, 0x38, 0xc4, 0xfe,
+	0x52, 0x9f, 0x70, 0x21, 0xf7, 0x28, 0xc3, 0x85, 0x4e, 0x1f, 0x2a, 0x54, 0x28, 0xcf, 0xb4, 0xfc,
+	0xc8, 0x0f, 0x9d, 0x69, 0xf9, 0x97, 0x2d, 0x34, 0xb1, 0xa3, 0x2a, 0x28, 0x9d, 0x47, 0x4d, 0xb9,
+	0xe1, 0x68, 0x7a, 0xcf, 0x8a, 0x0b, 0x9b, 0x9d, 0x06, 0xba, 0x93, 0x05, 0x60, 0xbd, 0x25, 0x39,
+	0x2e, 0x42, 0x8f, 0xbd, 0x5d, 0x2e, 0x42, 0x6f, 0xd1, 0xcd, 0x4c, 0xdc, 0x74, 0xa9, 0x4d, 0xdc,
+	0xac, 0x87, 0xb0, 0xd8, 0x18, 0x05, 0x00, 0xab, 0xfc, 0xc0, 0x7b, 0x76, 0x5a, 0x5c, 0xce, 0xb8,
+	0x81, 0x21, 0x76, 0x7e, 0xdc, 0x54, 0x23, 0xe4, 0x9d, 0x90, 0x7a, 0xd8, 0x6f, 0x64, 0xf8, 0xe0,
+	0x1e, 0xce, 0xb0, 0xb5, 0x4b, 0x97, 0xb2, 0x46, 0xec, 0x3c, 0x91, 0x0a, 0x32, 0xf3, 0x29, 0x18,
+	0xab, 0x38, 0xf6, 0xaf, 0x58, 0xa8, 0xdc, 0x0c, 0xc3, 0xed, 0xd8, 0x79, 0xd2, 0x54, 0xbe, 0x2a,
+	0x4d, 0x40, 0x85, 0x87, 0x3d, 0xb9, 0x46, 0xe4, 0x19, 0xa1, 0x40, 0xa2, 0xb0, 0x3b, 0x7b, 0xb3,
+	0x93, 0xda, 0xf3, 0x9f, 0xf1, 0xc7, 0xbf, 0xa3, 0x40, 0xb8, 0xca, 0x8e, 0x36, 0xcd, 0xfe, 0x45,
+	0x0b, 0x4d, 0xdf, 0xc8, 0x68, 0x35, 0x9c, 0x77, 0x98, 0x72, 0xd6, 0xcb, 0xea, 0x4b, 0xd8, 0x70,
+	0x67, 0xa1, 0xb8, 0xa7, 0x05, 0x19, 0x0f, 0x8b, 0x77, 0xfe, 0x88, 0x79, 0x58, 0xcc, 0x7c, 0x06,
+	0x5e, 0x6f, 0x96, 0x9f, 0x27, 0xa7, 0x2a, 0xd1, 0xd5, 0x2c, 0x06, 0x96, 0xb7, 0xf6, 0xc1, 0x55,
+	0x2d, 0xcb, 0xb7, 0x4e, 0xa3, 0x49, 0xdd, 0x76, 0x60, 0xbf, 0x5b, 0x7f, 0xb2, 0xee, 0x6c, 0xf6,
+	0xe5, 0xaa, 0x09, 0x81, 0xaf, 0xbd, 0x5e, 0xa5, 0x3d, 0x2f, 0x55, 0x38, 0xd2, 0xe7, 0xa5, 0x8a,
+	0xf7, 0xe7, 0x79, 0xa9, 0x69, 0x53, 0xcf, 0x4b, 0x7d, 0xdd, 0x42, 0x67, 0x7a, 0xa0, 0xeb, 0x24,
+	0xaa, 0x91, 0x20, 0xf1, 0x5b, 0x84, 0x09, 0xdd, 0x46, 0x74, 0xb5, 0x17, 0xf7, 0xe1, 0xc2, 0xd4,
+	0x33, 0xfb, 0x61, 0xe0, 0x7d, 0x5b, 0x69, 0xff, 0xb1, 0x85, 0x1e, 0x15, 0x3b, 0xdf, 0x7e, 0x64,
+	0x9c, 0x13, 0x74, 0x43, 0xbb, 0x65, 0xda, 0xa8, 0x35, 0xb7, 0x31, 0x00, 0x73, 0xb6, 0xe3, 0x3d,
+	0xc5, 0x3f, 0xda, 0xa3, 0x83, 0x54, 0xc1, 0x03, 0xf5, 0x4a, 0x7b, 0x24, 0xec, 0xd8, 0xa1, 0x1e,
+	0x09, 0x53, 0x32, 0x62, 0x96, 0x0e, 0xc8, 0x88, 0x39, 0x0f, 0xb6, 0x7a, 0x16, 0x7c, 0x45, 0xf8,
+	0xeb, 0x4f, 0xcc, 0x38, 0x7c, 0x9a, 0x57, 0x99, 0x5a, 0xd0, 0x8b, 0x71, 0x16, 0xdf, 0xfe, 0x8c,
+	0x85, 0xca, 0x41, 0x58, 0x97, 0x4a, 0xa7, 0x0f, 0x1a, 0xff, 0x0e, 0x94, 0x0f, 0x1b, 0x68, 0xe1,
+	0x73, 0x5e, 0xa6, 0xb0, 0x3b, 0xe2, 0x1f, 0xcc, 0x5a, 0x00, 0x8f, 0x2a, 0x84, 0x5b, 0x5b, 0xad,
+	0xd0, 0xab, 0xa7, 0x4f, 0xcd, 0x08, 0xeb, 0x35, 0x0b, 0xf1, 0x95, 0x8f, 0x2a, 0xac, 0xf5, 0xc1,
+	0xc3, 0x7d, 0x29, 0x80, 0xf2, 0x6a, 0x2a, 0x4e, 0xc2, 0x88, 0xd4, 0x53, 0x45, 0xdb, 0x28, 0xed,
+	0x33, 0x31, 0xde, 0xe7, 0xaa, 0xce, 0x87, 0xf5, 0x5e, 0x7e, 0x94, 0x4c, 0x29, 0xce, 0x36, 0xcb,
+	0x8e, 0xd0, 0xa9, 0x4e, 0x9e, 0x9e, 0x2f, 0x76, 0x86, 0x0f, 0xd4, 0x36, 0x8a, 0x0d, 0xf8, 0x54,
+	0xae, 0xa6, 0x30, 0xc6, 0x7d, 0x28, 0xab, 0x6f, 0x9c, 0x8d, 0xdc, 0x9f, 0x37, 0xce, 0x3e, 0x4a,
+	0x1f, 0xc3, 0x61, 0xf9, 0x84, 0x85, 0xe6, 0x68, 0xd9, 0x48, 0x40, 0x13, 0xa3, 0xa9, 0xbd, 0x80,
+	0xc3, 0xd9, 0x60, 0x85, 0x25, 0x6c, 0x51, 0x39, 0xcf, 0xf1, 0x31, 0xf5, 0x58, 0xc3, 0xf8, 0x9c,
+	0xf8, 0xa1, 0x7b, 0x92, 0xef, 0xd7, 0x2d, 0x34, 0xc3, 0x66, 0x5e, 0xf6, 0x52, 0x06, 0x22, 0xa1,
+	0x33, 0x79, 0x24, 0x0e, 0x0e, 0xd4, 0xd7, 0xab, 0xaa, 0x71, 0x05, 0x38, 0xde, 0xa7, 0x25, 0x60,
+	0x81, 0xeb, 0xb9, 0x0a, 0x4e, 0x99, 0x52, 0x38, 0xe7, 0x3f, 0xe5, 0x76, 0xfc, 0xf6, 0x20, 0xb7,
+	0xbf, 0x7f, 0xd0, 0x57, 0x1f, 0x6e, 0xd3, 0xe6, 0xfd, 0xd4, 0x11, 0xe9, 0xc3, 0xd5, 0xf7, 0xe6,
+	0x0e, 0xa3, 0x15, 0x9f, 0xf9, 0x27, 0x16, 0x7a, 0x72, 0xe0, 0x73, 0x31, 0x47, 0xd4, 0x4c, 0x74,
+	0x51, 0xf3, 0x88, 0x65, 0x10, 0x55, 0x0a, 0xfe, 0x94, 0xc5, 0x1e, 0xb3, 0xed, 0xdb, 0xb4, 0x4d,
+	0xbd, 0x69, 0x2b, 0x26, 0xdf, 0x3b, 0x53, 0x1b, 0xf2, 0x39, 0xc8, 0x52, 0x99, 0xb3, 0xbd, 0xe7,
+	0x34, 0xe9, 0xc3, 0x7a, 0x93, 0x0c, 0x5e, 0x35, 0xd5, 0x06, 0x19, 0x79, 0x5e, 0xcd, 0xfd, 0x83,
+	0x51, 0xc5, 0x7e, 0x0a, 0x7e, 0xd2, 0xa6, 0x1d, 0xb9, 0x03, 0x08, 0xf5, 0x06, 0x1d, 0xb0, 0x33,
+	0x61, 0x7a, 0x34, 0xc4, 0x9b, 0x9d, 0x40, 0x1d, 0x73, 0x2e, 0x6f, 0xb3, 0x39, 0x35, 0xfb, 0x1e,
+	0x71, 0xe9, 0xfe, 0xbf, 0x47, 0x7c, 0x03, 0x8d, 0xde, 0xf0, 0x93, 0x26, 0x75, 0x03, 0xe1, 0x56,
+	0x4a, 0x03, 0xa1, 0x96, 0x40, 0x2e, 0xed, 0xfb, 0x75, 0xc1, 0x00, 0xa7, 0xbc, 0xc0, 0xeb, 0x10,
+	0x7e, 0x50, 0xe7, 0xd6, 0xac, 0xd7, 0xe1, 0x75, 0x51, 0x80, 0x53, 0x1c, 0x18, 0xac, 0x71, 0xf8,
+	0x25, 0x92, 0x75, 0x39, 0xc3, 0xa6, 0x66, 0x88, 0xa0, 0xc8, 0x02, 0x9a, 0xaf, 0x2b, 0x3c, 0xb0,
+	0xc6, 0x51, 0x3e, 0xfa, 0x31, 0xd2, 0xf7, 0xd1, 0x8f, 0x37, 0xd9, 0xd3, 0x7d, 0x7e, 0xd0, 0x85,
+	0x94, 0xef, 0xa3, 0xa6, 0x36, 0x99, 0x05, 0x49, 0x93, 0x69, 0x11, 0xd2, 0xdf, 0x58, 0xe1, 0xa7,
+	0x18, 0x8b, 0xc6, 0xf6, 0x35, 0x16, 0xa5, 0x7a, 0xa2, 0x71, 0xe3, 0x7a, 0xa2, 0x84, 0x74, 0x8c,
+	0xe8, 0x89, 0x7e, 0xa8, 0x34, 0x1a, 0xff, 0xcf, 0x42, 0xb6, 0x14, 0x3a, 0xbc, 0x78, 0x9b, 0x3f,
+	0xc4, 0x7f, 0xf4, 0x0e, 0x8e, 0x1f, 0xb3, 0x10, 0x0a, 0xe4, 0xcb, 0xff, 0x66, 0x4f, 0x2d, 0x46,
+	0x33, 0x6d, 0x40, 0x0a, 0xc3, 0x0a, 0x4f, 0xf7, 0x7f, 0x5b, 0xe8, 0x54, 0x6f, 0xdf, 0xef, 0x83,
+	0xfb, 0xdb, 0xae, 0xee, 0xfe, 0xb6, 0x61, 0xd0, 0xde, 0x20, 0xbb, 0xd1, 0xc7, 0x11, 0xee, 0xfb,
+	0x05, 0x34, 0xa5, 0x22, 0x57, 0xc9, 0xfd, 0xf8, 0xd8, 0x37, 0x34, 0x6f, 0xd6, 0x97, 0xcc, 0xf6,
+	0xb7, 0xca, 0xcd, 0x56, 0x79, 0xbe, 0xc3, 0x1f, 0xcd, 0xf8, 0x0e, 0x5f, 0x37, 0xcf, 0x7a, 0x7f,
+	0x17, 0xe2, 0xff, 0x69, 0xa1, 0xe3, 0x99, 0x1a, 0xf7, 0x61, 0x82, 0xed, 0xe8, 0x13, 0xec, 0x9a,
+	0xf1, 0x5e, 0xf7, 0x99, 0x5d, 0xbf, 0x56, 0xe8, 0xe9, 0x2d, 0xbd, 0xc1, 0x7c, 0xd2, 0x42, 0xe5,
+	0xc4, 0x8b, 0xb7, 0x85, 0x27, 0xda, 0x87, 0x8f, 0x64, 0x06, 0xcc, 0xc1, 0xff, 0x7c, 0x77, 0x96,
+	0xed, 0xa3, 0x30, 0xcc, 0xb8, 0xcf, 0x7c, 0xc2, 0x42, 0x28, 0x45, 0x7a, 0xbb, 0x44, 0x56, 0xf7,
+	0x37, 0x0a, 0xe8, 0x64, 0xee, 0x34, 0xb2, 0x3f, 0x2d, 0xd5, 0x51, 0x96, 0x69, 0x3f, 0x4b, 0x8d,
+	0x91, 0xaa, 0x95, 0x9a, 0xd0, 0xb4, 0x52, 0x5c, 0x19, 0xf5, 0x76, 0x5d, 0x38, 0xf8, 0x36, 0xad,
+	0x0c, 0xd6, 0xef, 0x59, 0xa9, 0xeb, 0xae, 0x18, 0xcc, 0x3f, 0x8d, 0x71, 0x0e, 0xee, 0xf7, 0x95,
+	0x68, 0x03, 0xd1, 0xd1, 0xfb, 0xb0, 0x57, 0xdc, 0xd0, 0xf7, 0x0a, 0x6c, 0xde, 0xf8, 0xdd, 0x67,
+	0xb3, 0x78, 0x1d, 0xe5, 0x59, 0xc3, 0x07, 0x4b, 0x1c, 0xa9, 0xc5, 0xc4, 0x16, 0x06, 0x8e, 0x89,
+	0x9d, 0x40, 0x63, 0xaf, 0xf8, 0x32, 0x41, 0xaa, 0xbb, 0x8e, 0xc6, 0x5f, 0x89, 0x93, 0xba, 0xb9,
+	0xe4, 0x61, 0x95, 0xb9, 0x6f, 0x7c, 0xf7, 0xec, 0x03, 0xbf, 0xf3, 0xdd, 0xb3, 0x0f, 0x7c, 0xfb,
+	0xbb, 0x67, 0x1f, 0xf8, 0xd8, 0xed, 0xb3, 0xd6, 0x37, 0x6e, 0x9f, 0xb5, 0x7e, 0xe7, 0xf6, 0x59,
+	0xeb, 0xdb, 0xb7, 0xcf, 0x5a, 0xbf, 0x7b, 0xfb, 0xac, 0xf5, 0x0b, 0xdf, 0x3b, 0xfb, 0xc0, 0x2b,
+	0x23, 0x62, 0xa8, 0xfe, 0x64, 0x00, 0xdd, 0xb7, 0x69, 0xca, 0x2f, 0xe1, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -4413,6 +5364,18 @@ func (m *ArchiveStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Zstd != nil {
+		{
+			size, err := m.Zstd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Zip != nil {
 		{
 			size, err := m.Zip.MarshalToSizedBuffer(dAtA[:i])
@@ -4523,6 +5486,44 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SizeBytes))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x7a
+	i--
+	if m.Deduplicate {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
+	i--
+	if m.Deleted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x68
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i -= len(m.FromExpression)
 	copy(dAtA[i:], m.FromExpression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FromExpression)))
@@ -4599,6 +5600,34 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactGC) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4647,6 +5676,18 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -4809,6 +5850,18 @@ func (m *ArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -4973,6 +6026,98 @@ func (m *ArtifactRepositoryRefStatus) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SizeBytes))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArtifactTransfers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactTransfers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactTransfers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Saves) > 0 {
+		for iNdEx := len(m.Saves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Saves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Loads) > 0 {
+		for iNdEx := len(m.Loads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactoryArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5096,6 +6241,152 @@ func (m *ArtifactoryAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AzureArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Blob)
+	copy(dAtA[i:], m.Blob)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Blob)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureArtifactRepository) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifactRepository) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifactRepository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.BlobNameFormat)
+	copy(dAtA[i:], m.BlobNameFormat)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BlobNameFormat)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureBlobContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureBlobContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureBlobContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.UseSDKCreds {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if m.SASTokenSecret != nil {
+		{
+			size, err := m.SASTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AccountKeySecret != nil {
+		{
+			size, err := m.AccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Account)
+	copy(dAtA[i:], m.Account)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Account)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5208,6 +6499,30 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Artifact != nil {
+		{
+			size, err := m.Artifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMap != nil {
 		{
 			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
@@ -5223,6 +6538,32 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChunkTransformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkTransformation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChunkTransformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ChunkSize))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5496,6 +6837,42 @@ func (m *ContainerSetTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContainerTermination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerTermination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ExitCode))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5647,6 +7024,180 @@ func (m *CronWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronWorkflowBackfill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBackfill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBackfill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Parallelism))
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CronWorkflowBackfillStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowBackfillStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowBackfillStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Active) > 0 {
+		for iNdEx := len(m.Active) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Active[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Submitted))
+	i--
+	dAtA[i] = 0x18
+	if m.NextScheduledTime != nil {
+		{
+			size, err := m.NextScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.CronWorkflowBackfill.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CronWorkflowExclusions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowExclusions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowExclusions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dates) > 0 {
+		for iNdEx := len(m.Dates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dates[iNdEx])
+			copy(dAtA[i:], m.Dates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflowList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5694,6 +7245,44 @@ func (m *CronWorkflowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronWorkflowSkip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronWorkflowSkip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronWorkflowSkip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ScheduledTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5714,6 +7303,44 @@ func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x6a
+	if m.StopStrategy != nil {
+		{
+			size, err := m.StopStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Exclusions != nil {
+		{
+			size, err := m.Exclusions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Schedules[iNdEx])
+			copy(dAtA[i:], m.Schedules[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedules[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.WorkflowMetadata != nil {
 		{
 			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -5797,6 +7424,39 @@ func (m *CronWorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailed))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	i--
+	dAtA[i] = 0x30
+	if m.LastSkipped != nil {
+		{
+			size, err := m.LastSkipped.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Backfill != nil {
+		{
+			size, err := m.Backfill.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Conditions) > 0 {
 		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6122,6 +7782,68 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ArtifactPaths != nil {
 		{
 			size, err := m.ArtifactPaths.MarshalToSizedBuffer(dAtA[:i])
@@ -6137,6 +7859,38 @@ func (m *DataSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EstimatedDurationPercentiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimatedDurationPercentiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimatedDurationPercentiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Samples))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.P90))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.P50))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6459,6 +8213,34 @@ func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GroupByTransformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupByTransformation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupByTransformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HDFSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7235,6 +9017,11 @@ func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.CacheType)
+	copy(dAtA[i:], m.CacheType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheType)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.CacheName)
 	copy(dAtA[i:], m.CacheName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
@@ -7574,6 +9361,30 @@ func (m *NodeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ArtifactTransfers != nil {
+		{
+			size, err := m.ArtifactTransfers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SharedArtifactCache != nil {
+		{
+			size, err := m.SharedArtifactCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -7624,6 +9435,93 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Resources) > 0 {
+		keysForResources := make([]string, 0, len(m.Resources))
+		for k := range m.Resources {
+			keysForResources = append(keysForResources, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResources)
+		for iNdEx := len(keysForResources) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Resources[string(keysForResources[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForResources[iNdEx])
+			copy(dAtA[i:], keysForResources[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RetryDecision != nil {
+		{
+			size, err := m.RetryDecision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.Termination != nil {
+		{
+			size, err := m.Termination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.ArtifactTransfers != nil {
+		{
+			size, err := m.ArtifactTransfers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.SharedArtifactCache != nil {
+		{
+			size, err := m.SharedArtifactCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
 	i -= len(m.Progress)
 	copy(dAtA[i:], m.Progress)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
@@ -7866,6 +9764,64 @@ func (m *NodeSynchronizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeTermination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeTermination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Preempted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.NodeLost {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NoneStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8276,6 +10232,34 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParseTransformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParseTransformation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParseTransformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Plugin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8530,6 +10514,44 @@ func (m *ResourceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourcesSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcesSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcesSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Resource)
+	copy(dAtA[i:], m.Resource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resource)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryAffinity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8565,6 +10587,102 @@ func (m *RetryAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x12
+	i--
+	if m.Retried {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryMatcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryMatcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Preempted {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.NodeLost {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExitCodes) > 0 {
+		for iNdEx := len(m.ExitCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.ExitCodes[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryNodeAntiAffinity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8588,6 +10706,111 @@ func (m *RetryNodeAntiAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryResources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryResources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryResources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Max) > 0 {
+		keysForMax := make([]string, 0, len(m.Max))
+		for k := range m.Max {
+			keysForMax = append(keysForMax, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMax)
+		for iNdEx := len(keysForMax) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Max[k8s_io_api_core_v1.ResourceName(keysForMax[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMax[iNdEx])
+			copy(dAtA[i:], keysForMax[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMax[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Increase) > 0 {
+		keysForIncrease := make([]string, 0, len(m.Increase))
+		for k := range m.Increase {
+			keysForIncrease = append(keysForIncrease, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForIncrease)
+		for iNdEx := len(keysForIncrease) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Increase[k8s_io_api_core_v1.ResourceName(keysForIncrease[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForIncrease[iNdEx])
+			copy(dAtA[i:], keysForIncrease[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForIncrease[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Factor != nil {
+		{
+			size, err := m.Factor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EscalateOn != nil {
+		{
+			size, err := m.EscalateOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8608,6 +10831,42 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DoNotRetryOn != nil {
+		{
+			size, err := m.DoNotRetryOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RetryOn != nil {
+		{
+			size, err := m.RetryOn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -8900,6 +11159,34 @@ func (m *S3EncryptionOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScriptTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8958,6 +11245,28 @@ func (m *SemaphoreHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Permits) > 0 {
+		keysForPermits := make([]string, 0, len(m.Permits))
+		for k := range m.Permits {
+			keysForPermits = append(keysForPermits, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPermits)
+		for iNdEx := len(keysForPermits) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Permits[string(keysForPermits[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForPermits[iNdEx])
+			copy(dAtA[i:], keysForPermits[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPermits[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Holders) > 0 {
 		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Holders[iNdEx])
@@ -8995,6 +11304,42 @@ func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Permits != nil {
+		{
+			size, err := m.Permits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Database != nil {
+		{
+			size, err := m.Database.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -9125,6 +11470,129 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SharedArtifactCacheStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharedArtifactCacheStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharedArtifactCacheStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Misses) > 0 {
+		for iNdEx := len(m.Misses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Misses[iNdEx])
+			copy(dAtA[i:], m.Misses[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Misses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hits) > 0 {
+		for iNdEx := len(m.Hits) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hits[iNdEx])
+			copy(dAtA[i:], m.Hits[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Hits[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SortTransformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SortTransformation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SortTransformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Descending {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StopStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x22
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Submit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9331,6 +11799,62 @@ func (m *SuspendTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncDatabaseRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncDatabaseRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncDatabaseRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncHTTPRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncHTTPRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncHTTPRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Synchronization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9483,6 +12007,14 @@ func (m *TarStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.NoCompression {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
 	if m.CompressionLevel != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.CompressionLevel))
 		i--
@@ -10067,6 +12599,76 @@ func (m *TransformationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Chunk != nil {
+		{
+			size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GroupBy != nil {
+		{
+			size, err := m.GroupBy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Unique != nil {
+		{
+			size, err := m.Unique.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sort != nil {
+		{
+			size, err := m.Sort.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Parse != nil {
+		{
+			size, err := m.Parse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.JQ)
+	copy(dAtA[i:], m.JQ)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JQ)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.Expression)
 	copy(dAtA[i:], m.Expression)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
@@ -10075,6 +12677,34 @@ func (m *TransformationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UniqueTransformation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UniqueTransformation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UniqueTransformation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *UserContainer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11178,6 +13808,51 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TemplateEstimatedDurationPercentiles) > 0 {
+		keysForTemplateEstimatedDurationPercentiles := make([]string, 0, len(m.TemplateEstimatedDurationPercentiles))
+		for k := range m.TemplateEstimatedDurationPercentiles {
+			keysForTemplateEstimatedDurationPercentiles = append(keysForTemplateEstimatedDurationPercentiles, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTemplateEstimatedDurationPercentiles)
+		for iNdEx := len(keysForTemplateEstimatedDurationPercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.TemplateEstimatedDurationPercentiles[string(keysForTemplateEstimatedDurationPercentiles[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForTemplateEstimatedDurationPercentiles[iNdEx])
+			copy(dAtA[i:], keysForTemplateEstimatedDurationPercentiles[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForTemplateEstimatedDurationPercentiles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.EstimatedDurationPercentiles != nil {
+		{
+			size, err := m.EstimatedDurationPercentiles.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.ArtifactRepositoryRef != nil {
 		{
 			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
@@ -11983,6 +14658,34 @@ func (m *ZipStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ZstdStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZstdStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZstdStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompressionLevel != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.CompressionLevel))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -12023,6 +14726,10 @@ func (m *ArchiveStrategy) Size() (n int) {
 		l = m.Zip.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Zstd != nil {
+		l = m.Zstd.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12076,6 +14783,26 @@ func (m *Artifact) Size() (n int) {
 	n += 2
 	l = len(m.FromExpression)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	n += 2
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2 + sovGenerated(uint64(m.SizeBytes))
+	return n
+}
+
+func (m *ArtifactCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12131,6 +14858,10 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12174,6 +14905,10 @@ func (m *ArtifactRepository) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12208,6 +14943,41 @@ func (m *ArtifactRepositoryRefStatus) Size() (n int) {
 	return n
 }
 
+func (m *ArtifactTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SizeBytes))
+	l = m.Duration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ArtifactTransfers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Loads) > 0 {
+		for _, e := range m.Loads {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Saves) > 0 {
+		for _, e := range m.Saves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ArtifactoryArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12251,6 +15021,56 @@ func (m *ArtifactoryAuth) Size() (n int) {
 	return n
 }
 
+func (m *AzureArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Blob)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureArtifactRepository) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BlobNameFormat)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureBlobContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccountKeySecret != nil {
+		l = m.AccountKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SASTokenSecret != nil {
+		l = m.SASTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12295,6 +15115,24 @@ func (m *Cache) Size() (n int) {
 		l = m.ConfigMap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Artifact != nil {
+		l = m.Artifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ChunkTransformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ChunkSize))
 	return n
 }
 
@@ -12400,6 +15238,20 @@ func (m *ContainerSetTemplate) Size() (n int) {
 	return n
 }
 
+func (m *ContainerTermination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ExitCode))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ContinueOn) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12447,6 +15299,67 @@ func (m *CronWorkflow) Size() (n int) {
 	return n
 }
 
+func (m *CronWorkflowBackfill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.From.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Parallelism))
+	return n
+}
+
+func (m *CronWorkflowBackfillStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CronWorkflowBackfill.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.NextScheduledTime != nil {
+		l = m.NextScheduledTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Submitted))
+	if len(m.Active) > 0 {
+		for _, e := range m.Active {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CronWorkflowExclusions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dates) > 0 {
+		for _, s := range m.Dates {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *CronWorkflowList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12464,6 +15377,19 @@ func (m *CronWorkflowList) Size() (n int) {
 	return n
 }
 
+func (m *CronWorkflowSkip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *CronWorkflowSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12492,6 +15418,22 @@ func (m *CronWorkflowSpec) Size() (n int) {
 		l = m.WorkflowMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Schedules) > 0 {
+		for _, s := range m.Schedules {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Exclusions != nil {
+		l = m.Exclusions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.StopStrategy != nil {
+		l = m.StopStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -12517,6 +15459,17 @@ func (m *CronWorkflowStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Backfill != nil {
+		l = m.Backfill.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastSkipped != nil {
+		l = m.LastSkipped.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailed))
 	return n
 }
 
@@ -12627,6 +15580,40 @@ func (m *DataSource) Size() (n int) {
 		l = m.ArtifactPaths.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimatedDurationPercentiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.P50))
+	n += 1 + sovGenerated(uint64(m.P90))
+	n += 1 + sovGenerated(uint64(m.Samples))
 	return n
 }
 
@@ -12746,6 +15733,17 @@ func (m *GitArtifact) Size() (n int) {
 	return n
 }
 
+func (m *GroupByTransformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HDFSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13046,6 +16044,8 @@ func (m *MemoizationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheType)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13180,6 +16180,14 @@ func (m *NodeResult) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SharedArtifactCache != nil {
+		l = m.SharedArtifactCache.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactTransfers != nil {
+		l = m.ArtifactTransfers.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13261,6 +16269,31 @@ func (m *NodeStatus) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 2 + l + sovGenerated(uint64(l))
+	if m.SharedArtifactCache != nil {
+		l = m.SharedArtifactCache.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ArtifactTransfers != nil {
+		l = m.ArtifactTransfers.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Termination != nil {
+		l = m.Termination.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.RetryDecision != nil {
+		l = m.RetryDecision.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for k, v := range m.Resources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -13275,6 +16308,25 @@ func (m *NodeSynchronizationStatus) Size() (n int) {
 	return n
 }
 
+func (m *NodeTermination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	return n
+}
+
 func (m *NoneStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13437,6 +16489,17 @@ func (m *Parameter) Size() (n int) {
 	return n
 }
 
+func (m *ParseTransformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Plugin) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13533,6 +16596,21 @@ func (m *ResourceTemplate) Size() (n int) {
 	return n
 }
 
+func (m *ResourcesSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RetryAffinity) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13546,6 +16624,42 @@ func (m *RetryAffinity) Size() (n int) {
 	return n
 }
 
+func (m *RetryDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RetryMatcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExitCodes) > 0 {
+		for _, e := range m.ExitCodes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	return n
+}
+
 func (m *RetryNodeAntiAffinity) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13555,6 +16669,41 @@ func (m *RetryNodeAntiAffinity) Size() (n int) {
 	return n
 }
 
+func (m *RetryResources) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscalateOn != nil {
+		l = m.EscalateOn.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Factor != nil {
+		l = m.Factor.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Increase) > 0 {
+		for k, v := range m.Increase {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Max) > 0 {
+		for k, v := range m.Max {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *RetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13577,6 +16726,18 @@ func (m *RetryStrategy) Size() (n int) {
 	}
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.RetryOn != nil {
+		l = m.RetryOn.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DoNotRetryOn != nil {
+		l = m.DoNotRetryOn.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13663,6 +16824,17 @@ func (m *S3EncryptionOptions) Size() (n int) {
 	return n
 }
 
+func (m *SQLCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ScriptTemplate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13690,6 +16862,14 @@ func (m *SemaphoreHolding) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Permits) > 0 {
+		for k, v := range m.Permits {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -13703,6 +16883,18 @@ func (m *SemaphoreRef) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Database != nil {
+		l = m.Database.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Permits != nil {
+		l = m.Permits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13750,6 +16942,56 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *SharedArtifactCacheStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hits) > 0 {
+		for _, s := range m.Hits {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Misses) > 0 {
+		for _, s := range m.Misses {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SortTransformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *StopStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Submit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13825,6 +17067,28 @@ func (m *SuspendTemplate) Size() (n int) {
 	return n
 }
 
+func (m *SyncDatabaseRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncHTTPRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Synchronization) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13886,6 +17150,7 @@ func (m *TarStrategy) Size() (n int) {
 	if m.CompressionLevel != nil {
 		n += 1 + sovGenerated(uint64(*m.CompressionLevel))
 	}
+	n += 2
 	return n
 }
 
@@ -14073,6 +17338,41 @@ func (m *TransformationStep) Size() (n int) {
 	_ = l
 	l = len(m.Expression)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JQ)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Parse != nil {
+		l = m.Parse.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Sort != nil {
+		l = m.Sort.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Unique != nil {
+		l = m.Unique.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GroupBy != nil {
+		l = m.GroupBy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *UniqueTransformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -14519,6 +17819,19 @@ func (m *WorkflowStatus) Size() (n int) {
 		l = m.ArtifactRepositoryRef.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.EstimatedDurationPercentiles != nil {
+		l = m.EstimatedDurationPercentiles.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.TemplateEstimatedDurationPercentiles) > 0 {
+		for k, v := range m.TemplateEstimatedDurationPercentiles {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -14723,6 +18036,18 @@ func (m *ZipStrategy) Size() (n int) {
 	return n
 }
 
+func (m *ZstdStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompressionLevel != nil {
+		n += 1 + sovGenerated(uint64(*m.CompressionLevel))
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -14747,6 +18072,7 @@ func (this *ArchiveStrategy) String() string {
 		`Tar:` + strings.Replace(this.Tar.String(), "TarStrategy", "TarStrategy", 1) + `,`,
 		`None:` + strings.Replace(this.None.String(), "NoneStrategy", "NoneStrategy", 1) + `,`,
 		`Zip:` + strings.Replace(this.Zip.String(), "ZipStrategy", "ZipStrategy", 1) + `,`,
+		`Zstd:` + strings.Replace(this.Zstd.String(), "ZstdStrategy", "ZstdStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14788,6 +18114,21 @@ func (this *Artifact) String() string {
 		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`RecurseMode:` + fmt.Sprintf("%v", this.RecurseMode) + `,`,
 		`FromExpression:` + fmt.Sprintf("%v", this.FromExpression) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
+		`Deduplicate:` + fmt.Sprintf("%v", this.Deduplicate) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14816,6 +18157,7 @@ func (this *ArtifactLocation) String() string {
 		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14841,6 +18183,40 @@ func (this *ArtifactRepository) String() string {
 		`HDFS:` + strings.Replace(this.HDFS.String(), "HDFSArtifactRepository", "HDFSArtifactRepository", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifactRepository", "OSSArtifactRepository", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifactRepository", "GCSArtifactRepository", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifactRepository", "AzureArtifactRepository", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactTransfer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactTransfer{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactTransfers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLoads := "[]ArtifactTransfer{"
+	for _, f := range this.Loads {
+		repeatedStringForLoads += strings.Replace(strings.Replace(f.String(), "ArtifactTransfer", "ArtifactTransfer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForLoads += "}"
+	repeatedStringForSaves := "[]ArtifactTransfer{"
+	for _, f := range this.Saves {
+		repeatedStringForSaves += strings.Replace(strings.Replace(f.String(), "ArtifactTransfer", "ArtifactTransfer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSaves += "}"
+	s := strings.Join([]string{`&ArtifactTransfers{`,
+		`Loads:` + repeatedStringForLoads + `,`,
+		`Saves:` + repeatedStringForSaves + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14872,8 +18248,45 @@ func (this *ArtifactoryAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ArtifactoryAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifact{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`Blob:` + fmt.Sprintf("%v", this.Blob) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureArtifactRepository) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifactRepository{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`BlobNameFormat:` + fmt.Sprintf("%v", this.BlobNameFormat) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureBlobContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureBlobContainer{`,
+		`Account:` + fmt.Sprintf("%v", this.Account) + `,`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SASTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SASTokenSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14895,8 +18308,8 @@ func (this *BasicAuth) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&BasicAuth{`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14906,7 +18319,19 @@ func (this *Cache) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Cache{`,
-		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLCache", "SQLCache", 1) + `,`,
+		`Artifact:` + strings.Replace(this.Artifact.String(), "ArtifactCache", "ArtifactCache", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChunkTransformation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChunkTransformation{`,
+		`ChunkSize:` + fmt.Sprintf("%v", this.ChunkSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14916,7 +18341,7 @@ func (this *ClusterWorkflowTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ClusterWorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -14932,7 +18357,7 @@ func (this *ClusterWorkflowTemplateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ClusterWorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -14955,7 +18380,7 @@ func (this *ContainerNode) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ContainerNode{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
 		`}`,
 	}, "")
@@ -14994,6 +18419,18 @@ func (this *ContainerSetTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *ContainerTermination) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerTermination{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContinueOn) String() string {
 	if this == nil {
 		return "nil"
@@ -15030,13 +18467,56 @@ func (this *CronWorkflow) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&CronWorkflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "CronWorkflowSpec", "CronWorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "CronWorkflowStatus", "CronWorkflowStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *CronWorkflowBackfill) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowBackfill{`,
+		`From:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.From), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`To:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.To), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Parallelism:` + fmt.Sprintf("%v", this.Parallelism) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowBackfillStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActive := "[]ObjectReference{"
+	for _, f := range this.Active {
+		repeatedStringForActive += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForActive += "}"
+	s := strings.Join([]string{`&CronWorkflowBackfillStatus{`,
+		`CronWorkflowBackfill:` + strings.Replace(strings.Replace(this.CronWorkflowBackfill.String(), "CronWorkflowBackfill", "CronWorkflowBackfill", 1), `&`, ``, 1) + `,`,
+		`NextScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.NextScheduledTime), "Time", "v1.Time", 1) + `,`,
+		`Submitted:` + fmt.Sprintf("%v", this.Submitted) + `,`,
+		`Active:` + repeatedStringForActive + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronWorkflowExclusions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowExclusions{`,
+		`Dates:` + fmt.Sprintf("%v", this.Dates) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflowList) String() string {
 	if this == nil {
 		return "nil"
@@ -15047,12 +18527,23 @@ func (this *CronWorkflowList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&CronWorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
+func (this *CronWorkflowSkip) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronWorkflowSkip{`,
+		`ScheduledTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -15066,7 +18557,11 @@ func (this *CronWorkflowSpec) String() string {
 		`SuccessfulJobsHistoryLimit:` + valueToStringGenerated(this.SuccessfulJobsHistoryLimit) + `,`,
 		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
-		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v1.ObjectMeta", 1) + `,`,
+		`Schedules:` + fmt.Sprintf("%v", this.Schedules) + `,`,
+		`Exclusions:` + strings.Replace(this.Exclusions.String(), "CronWorkflowExclusions", "CronWorkflowExclusions", 1) + `,`,
+		`StopStrategy:` + strings.Replace(this.StopStrategy.String(), "StopStrategy", "StopStrategy", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15087,8 +18582,13 @@ func (this *CronWorkflowStatus) String() string {
 	repeatedStringForConditions += "}"
 	s := strings.Join([]string{`&CronWorkflowStatus{`,
 		`Active:` + repeatedStringForActive + `,`,
-		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v11.Time", 1) + `,`,
+		`LastScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScheduledTime), "Time", "v1.Time", 1) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
+		`Backfill:` + strings.Replace(this.Backfill.String(), "CronWorkflowBackfillStatus", "CronWorkflowBackfillStatus", 1) + `,`,
+		`LastSkipped:` + strings.Replace(this.LastSkipped.String(), "CronWorkflowSkip", "CronWorkflowSkip", 1) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`ConsecutiveFailed:` + fmt.Sprintf("%v", this.ConsecutiveFailed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15168,8 +18668,30 @@ func (this *DataSource) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForParameters := "[]Parameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "Parameter", "Parameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
 	s := strings.Join([]string{`&DataSource{`,
 		`ArtifactPaths:` + strings.Replace(this.ArtifactPaths.String(), "ArtifactPaths", "ArtifactPaths", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Resources:` + strings.Replace(this.Resources.String(), "ResourcesSource", "ResourcesSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EstimatedDurationPercentiles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EstimatedDurationPercentiles{`,
+		`P50:` + fmt.Sprintf("%v", this.P50) + `,`,
+		`P90:` + fmt.Sprintf("%v", this.P90) + `,`,
+		`Samples:` + fmt.Sprintf("%v", this.Samples) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15222,7 +18744,7 @@ func (this *GCSBucket) String() string {
 	}
 	s := strings.Join([]string{`&GCSBucket{`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`ServiceAccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ServiceAccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServiceAccountKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15247,9 +18769,9 @@ func (this *GitArtifact) String() string {
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`Depth:` + valueToStringGenerated(this.Depth) + `,`,
 		`Fetch:` + fmt.Sprintf("%v", this.Fetch) + `,`,
-		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UsernameSecret:` + strings.Replace(fmt.Sprintf("%v", this.UsernameSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`PasswordSecret:` + strings.Replace(fmt.Sprintf("%v", this.PasswordSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SSHPrivateKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SSHPrivateKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`InsecureIgnoreHostKey:` + fmt.Sprintf("%v", this.InsecureIgnoreHostKey) + `,`,
 		`DisableSubmodules:` + fmt.Sprintf("%v", this.DisableSubmodules) + `,`,
 		`SingleBranch:` + fmt.Sprintf("%v", this.SingleBranch) + `,`,
@@ -15258,6 +18780,16 @@ func (this *GitArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *GroupByTransformation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GroupByTransformation{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HDFSArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -15299,11 +18831,11 @@ func (this *HDFSKrbConfig) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HDFSKrbConfig{`,
-		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`KrbCCacheSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbCCacheSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`KrbKeytabSecret:` + strings.Replace(fmt.Sprintf("%v", this.KrbKeytabSecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`KrbUsername:` + fmt.Sprintf("%v", this.KrbUsername) + `,`,
 		`KrbRealm:` + fmt.Sprintf("%v", this.KrbRealm) + `,`,
-		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`KrbConfigConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.KrbConfigConfigMap), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`KrbServicePrincipalName:` + fmt.Sprintf("%v", this.KrbServicePrincipalName) + `,`,
 		`}`,
 	}, "")
@@ -15364,7 +18896,7 @@ func (this *HTTPHeaderSource) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&HTTPHeaderSource{`,
-		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15480,6 +19012,7 @@ func (this *MemoizationStatus) String() string {
 		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`CacheType:` + fmt.Sprintf("%v", this.CacheType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15604,6 +19137,8 @@ func (this *NodeResult) String() string {
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`Outputs:` + strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`SharedArtifactCache:` + strings.Replace(this.SharedArtifactCache.String(), "SharedArtifactCacheStatus", "SharedArtifactCacheStatus", 1) + `,`,
+		`ArtifactTransfers:` + strings.Replace(this.ArtifactTransfers.String(), "ArtifactTransfers", "ArtifactTransfers", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15622,6 +19157,16 @@ func (this *NodeStatus) String() string {
 		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForResourcesDuration += "}"
+	keysForResources := make([]string, 0, len(this.Resources))
+	for k := range this.Resources {
+		keysForResources = append(keysForResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResources)
+	mapStringForResources := "map[string]v11.ResourceRequirements{"
+	for _, k := range keysForResources {
+		mapStringForResources += fmt.Sprintf("%v: %v,", k, this.Resources[k])
+	}
+	mapStringForResources += "}"
 	s := strings.Join([]string{`&NodeStatus{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
@@ -15632,8 +19177,8 @@ func (this *NodeStatus) String() string {
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`BoundaryID:` + fmt.Sprintf("%v", this.BoundaryID) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`PodIP:` + fmt.Sprintf("%v", this.PodIP) + `,`,
 		`Daemoned:` + valueToStringGenerated(this.Daemoned) + `,`,
 		`Inputs:` + strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1) + `,`,
@@ -15647,6 +19192,11 @@ func (this *NodeStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`SynchronizationStatus:` + strings.Replace(this.SynchronizationStatus.String(), "NodeSynchronizationStatus", "NodeSynchronizationStatus", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`SharedArtifactCache:` + strings.Replace(this.SharedArtifactCache.String(), "SharedArtifactCacheStatus", "SharedArtifactCacheStatus", 1) + `,`,
+		`ArtifactTransfers:` + strings.Replace(this.ArtifactTransfers.String(), "ArtifactTransfers", "ArtifactTransfers", 1) + `,`,
+		`Termination:` + strings.Replace(this.Termination.String(), "NodeTermination", "NodeTermination", 1) + `,`,
+		`RetryDecision:` + strings.Replace(this.RetryDecision.String(), "RetryDecision", "RetryDecision", 1) + `,`,
+		`Resources:` + mapStringForResources + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15661,6 +19211,24 @@ func (this *NodeSynchronizationStatus) String() string {
 	}, "")
 	return s
 }
+func (this *NodeTermination) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]ContainerTermination{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "ContainerTermination", "ContainerTermination", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	s := strings.Join([]string{`&NodeTermination{`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`NodeLost:` + fmt.Sprintf("%v", this.NodeLost) + `,`,
+		`Preempted:` + fmt.Sprintf("%v", this.Preempted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NoneStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -15699,8 +19267,8 @@ func (this *OSSBucket) String() string {
 	s := strings.Join([]string{`&OSSBucket{`,
 		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
-		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`CreateBucketIfNotPresent:` + fmt.Sprintf("%v", this.CreateBucketIfNotPresent) + `,`,
 		`SecurityToken:` + fmt.Sprintf("%v", this.SecurityToken) + `,`,
 		`LifecycleRule:` + strings.Replace(this.LifecycleRule.String(), "OSSLifecycleRule", "OSSLifecycleRule", 1) + `,`,
@@ -15782,6 +19350,16 @@ func (this *Parameter) String() string {
 	}, "")
 	return s
 }
+func (this *ParseTransformation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParseTransformation{`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Plugin) String() string {
 	if this == nil {
 		return "nil"
@@ -15798,7 +19376,7 @@ func (this *PodGC) String() string {
 	}
 	s := strings.Join([]string{`&PodGC{`,
 		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
-		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v11.LabelSelector", 1) + `,`,
+		`LabelSelector:` + strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15850,6 +19428,18 @@ func (this *ResourceTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *ResourcesSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourcesSource{`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryAffinity) String() string {
 	if this == nil {
 		return "nil"
@@ -15860,6 +19450,31 @@ func (this *RetryAffinity) String() string {
 	}, "")
 	return s
 }
+func (this *RetryDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryDecision{`,
+		`Retried:` + fmt.Sprintf("%v", this.Retried) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RetryMatcher) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryMatcher{`,
+		`ExitCodes:` + fmt.Sprintf("%v", this.ExitCodes) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`NodeLost:` + fmt.Sprintf("%v", this.NodeLost) + `,`,
+		`Preempted:` + fmt.Sprintf("%v", this.Preempted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryNodeAntiAffinity) String() string {
 	if this == nil {
 		return "nil"
@@ -15869,6 +19484,39 @@ func (this *RetryNodeAntiAffinity) String() string {
 	}, "")
 	return s
 }
+func (this *RetryResources) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForIncrease := make([]string, 0, len(this.Increase))
+	for k := range this.Increase {
+		keysForIncrease = append(keysForIncrease, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForIncrease)
+	mapStringForIncrease := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForIncrease {
+		mapStringForIncrease += fmt.Sprintf("%v: %v,", k, this.Increase[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForIncrease += "}"
+	keysForMax := make([]string, 0, len(this.Max))
+	for k := range this.Max {
+		keysForMax = append(keysForMax, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMax)
+	mapStringForMax := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForMax {
+		mapStringForMax += fmt.Sprintf("%v: %v,", k, this.Max[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForMax += "}"
+	s := strings.Join([]string{`&RetryResources{`,
+		`EscalateOn:` + strings.Replace(this.EscalateOn.String(), "RetryMatcher", "RetryMatcher", 1) + `,`,
+		`Factor:` + strings.Replace(this.Factor.String(), "Amount", "Amount", 1) + `,`,
+		`Increase:` + mapStringForIncrease + `,`,
+		`Max:` + mapStringForMax + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -15879,6 +19527,9 @@ func (this *RetryStrategy) String() string {
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
 		`Affinity:` + strings.Replace(this.Affinity.String(), "RetryAffinity", "RetryAffinity", 1) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`RetryOn:` + strings.Replace(this.RetryOn.String(), "RetryMatcher", "RetryMatcher", 1) + `,`,
+		`DoNotRetryOn:` + strings.Replace(this.DoNotRetryOn.String(), "RetryMatcher", "RetryMatcher", 1) + `,`,
+		`Resources:` + strings.Replace(this.Resources.String(), "RetryResources", "RetryResources", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15915,8 +19566,8 @@ func (this *S3Bucket) String() string {
 		`Bucket:` + fmt.Sprintf("%v", this.Bucket) + `,`,
 		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
 		`Insecure:` + valueToStringGenerated(this.Insecure) + `,`,
-		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`AccessKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccessKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`SecretKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
 		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
 		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
 		`CreateBucketIfNotPresent:` + strings.Replace(this.CreateBucketIfNotPresent.String(), "CreateS3BucketOptions", "CreateS3BucketOptions", 1) + `,`,
@@ -15933,7 +19584,17 @@ func (this *S3EncryptionOptions) String() string {
 		`KmsKeyId:` + fmt.Sprintf("%v", this.KmsKeyId) + `,`,
 		`KmsEncryptionContext:` + fmt.Sprintf("%v", this.KmsEncryptionContext) + `,`,
 		`EnableEncryption:` + fmt.Sprintf("%v", this.EnableEncryption) + `,`,
-		`ServerSideCustomerKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServerSideCustomerKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ServerSideCustomerKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.ServerSideCustomerKeySecret), "SecretKeySelector", "v11.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SQLCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15943,7 +19604,7 @@ func (this *ScriptTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ScriptTemplate{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`}`,
 	}, "")
@@ -15953,9 +19614,20 @@ func (this *SemaphoreHolding) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForPermits := make([]string, 0, len(this.Permits))
+	for k := range this.Permits {
+		keysForPermits = append(keysForPermits, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPermits)
+	mapStringForPermits := "map[string]int32{"
+	for _, k := range keysForPermits {
+		mapStringForPermits += fmt.Sprintf("%v: %v,", k, this.Permits[k])
+	}
+	mapStringForPermits += "}"
 	s := strings.Join([]string{`&SemaphoreHolding{`,
 		`Semaphore:` + fmt.Sprintf("%v", this.Semaphore) + `,`,
 		`Holders:` + fmt.Sprintf("%v", this.Holders) + `,`,
+		`Permits:` + mapStringForPermits + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15965,7 +19637,10 @@ func (this *SemaphoreRef) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&SemaphoreRef{`,
-		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
+		`Database:` + strings.Replace(this.Database.String(), "SyncDatabaseRef", "SyncDatabaseRef", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "SyncHTTPRef", "SyncHTTPRef", 1) + `,`,
+		`Permits:` + strings.Replace(fmt.Sprintf("%v", this.Permits), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16004,6 +19679,41 @@ func (this *Sequence) String() string {
 	}, "")
 	return s
 }
+func (this *SharedArtifactCacheStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SharedArtifactCacheStatus{`,
+		`Hits:` + fmt.Sprintf("%v", this.Hits) + `,`,
+		`Misses:` + fmt.Sprintf("%v", this.Misses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SortTransformation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SortTransformation{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Descending:` + fmt.Sprintf("%v", this.Descending) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopStrategy{`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`EndTime:` + strings.Replace(fmt.Sprintf("%v", this.EndTime), "Time", "v1.Time", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Submit) String() string {
 	if this == nil {
 		return "nil"
@@ -16011,7 +19721,7 @@ func (this *Submit) String() string {
 	s := strings.Join([]string{`&Submit{`,
 		`WorkflowTemplateRef:` + strings.Replace(strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1), `&`, ``, 1) + `,`,
 		`Arguments:` + strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1) + `,`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16029,7 +19739,7 @@ func (this *SubmitOpts) String() string {
 		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`ServerDryRun:` + fmt.Sprintf("%v", this.ServerDryRun) + `,`,
 		`Labels:` + fmt.Sprintf("%v", this.Labels) + `,`,
-		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v11.OwnerReference", 1) + `,`,
+		`OwnerReference:` + strings.Replace(fmt.Sprintf("%v", this.OwnerReference), "OwnerReference", "v1.OwnerReference", 1) + `,`,
 		`Annotations:` + fmt.Sprintf("%v", this.Annotations) + `,`,
 		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
@@ -16056,6 +19766,26 @@ func (this *SuspendTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *SyncDatabaseRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncDatabaseRef{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncHTTPRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncHTTPRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Synchronization) String() string {
 	if this == nil {
 		return "nil"
@@ -16096,6 +19826,7 @@ func (this *TarStrategy) String() string {
 	}
 	s := strings.Join([]string{`&TarStrategy{`,
 		`CompressionLevel:` + valueToStringGenerated(this.CompressionLevel) + `,`,
+		`NoCompression:` + fmt.Sprintf("%v", this.NoCompression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16149,11 +19880,11 @@ func (this *Template) String() string {
 		`Inputs:` + strings.Replace(strings.Replace(this.Inputs.String(), "Inputs", "Inputs", 1), `&`, ``, 1) + `,`,
 		`Outputs:` + strings.Replace(strings.Replace(this.Outputs.String(), "Outputs", "Outputs", 1), `&`, ``, 1) + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "Metadata", "Metadata", 1), `&`, ``, 1) + `,`,
 		`Daemon:` + valueToStringGenerated(this.Daemon) + `,`,
 		`Steps:` + repeatedStringForSteps + `,`,
-		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1) + `,`,
+		`Container:` + strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1) + `,`,
 		`Script:` + strings.Replace(this.Script.String(), "ScriptTemplate", "ScriptTemplate", 1) + `,`,
 		`Resource:` + strings.Replace(this.Resource.String(), "ResourceTemplate", "ResourceTemplate", 1) + `,`,
 		`DAG:` + strings.Replace(this.DAG.String(), "DAGTemplate", "DAGTemplate", 1) + `,`,
@@ -16171,7 +19902,7 @@ func (this *Template) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`ServiceAccountName:` + fmt.Sprintf("%v", this.ServiceAccountName) + `,`,
 		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
 		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
 		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
@@ -16207,6 +19938,23 @@ func (this *TransformationStep) String() string {
 	}
 	s := strings.Join([]string{`&TransformationStep{`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`JQ:` + fmt.Sprintf("%v", this.JQ) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
+		`Parse:` + strings.Replace(this.Parse.String(), "ParseTransformation", "ParseTransformation", 1) + `,`,
+		`Sort:` + strings.Replace(this.Sort.String(), "SortTransformation", "SortTransformation", 1) + `,`,
+		`Unique:` + strings.Replace(this.Unique.String(), "UniqueTransformation", "UniqueTransformation", 1) + `,`,
+		`GroupBy:` + strings.Replace(this.GroupBy.String(), "GroupByTransformation", "GroupByTransformation", 1) + `,`,
+		`Chunk:` + strings.Replace(this.Chunk.String(), "ChunkTransformation", "ChunkTransformation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UniqueTransformation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UniqueTransformation{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16216,7 +19964,7 @@ func (this *UserContainer) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&UserContainer{`,
-		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v11.Container", 1), `&`, ``, 1) + `,`,
 		`MirrorVolumeMounts:` + valueToStringGenerated(this.MirrorVolumeMounts) + `,`,
 		`}`,
 	}, "")
@@ -16235,7 +19983,7 @@ func (this *ValueFrom) String() string {
 		`Supplied:` + strings.Replace(this.Supplied.String(), "SuppliedValueFrom", "SuppliedValueFrom", 1) + `,`,
 		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
 		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
-		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v11.ConfigMapKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16272,7 +20020,7 @@ func (this *Workflow) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&Workflow{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowStatus", "WorkflowStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -16284,7 +20032,7 @@ func (this *WorkflowEventBinding) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowEventBinding{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowEventBindingSpec", "WorkflowEventBindingSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -16300,7 +20048,7 @@ func (this *WorkflowEventBindingList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowEventBindingList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -16327,7 +20075,7 @@ func (this *WorkflowList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -16440,12 +20188,12 @@ func (this *WorkflowSpec) String() string {
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRef", "ArtifactRepositoryRef", 1) + `,`,
 		`Suspend:` + valueToStringGenerated(this.Suspend) + `,`,
 		`NodeSelector:` + mapStringForNodeSelector + `,`,
-		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v1.Affinity", 1) + `,`,
+		`Affinity:` + strings.Replace(fmt.Sprintf("%v", this.Affinity), "Affinity", "v11.Affinity", 1) + `,`,
 		`Tolerations:` + repeatedStringForTolerations + `,`,
 		`ImagePullSecrets:` + repeatedStringForImagePullSecrets + `,`,
 		`HostNetwork:` + valueToStringGenerated(this.HostNetwork) + `,`,
 		`DNSPolicy:` + valueToStringGenerated(this.DNSPolicy) + `,`,
-		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v1.PodDNSConfig", 1) + `,`,
+		`DNSConfig:` + strings.Replace(fmt.Sprintf("%v", this.DNSConfig), "PodDNSConfig", "v11.PodDNSConfig", 1) + `,`,
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
@@ -16454,7 +20202,7 @@ func (this *WorkflowSpec) String() string {
 		`PodPriorityClassName:` + fmt.Sprintf("%v", this.PodPriorityClassName) + `,`,
 		`PodPriority:` + valueToStringGenerated(this.PodPriority) + `,`,
 		`HostAliases:` + repeatedStringForHostAliases + `,`,
-		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v1.PodSecurityContext", 1) + `,`,
+		`SecurityContext:` + strings.Replace(fmt.Sprintf("%v", this.SecurityContext), "PodSecurityContext", "v11.PodSecurityContext", 1) + `,`,
 		`PodSpecPatch:` + fmt.Sprintf("%v", this.PodSpecPatch) + `,`,
 		`AutomountServiceAccountToken:` + valueToStringGenerated(this.AutomountServiceAccountToken) + `,`,
 		`Executor:` + strings.Replace(this.Executor.String(), "ExecutorConfig", "ExecutorConfig", 1) + `,`,
@@ -16520,10 +20268,20 @@ func (this *WorkflowStatus) String() string {
 		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForResourcesDuration += "}"
+	keysForTemplateEstimatedDurationPercentiles := make([]string, 0, len(this.TemplateEstimatedDurationPercentiles))
+	for k := range this.TemplateEstimatedDurationPercentiles {
+		keysForTemplateEstimatedDurationPercentiles = append(keysForTemplateEstimatedDurationPercentiles, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTemplateEstimatedDurationPercentiles)
+	mapStringForTemplateEstimatedDurationPercentiles := "map[string]EstimatedDurationPercentiles{"
+	for _, k := range keysForTemplateEstimatedDurationPercentiles {
+		mapStringForTemplateEstimatedDurationPercentiles += fmt.Sprintf("%v: %v,", k, this.TemplateEstimatedDurationPercentiles[k])
+	}
+	mapStringForTemplateEstimatedDurationPercentiles += "}"
 	s := strings.Join([]string{`&WorkflowStatus{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
-		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`CompressedNodes:` + fmt.Sprintf("%v", this.CompressedNodes) + `,`,
 		`Nodes:` + mapStringForNodes + `,`,
@@ -16538,6 +20296,8 @@ func (this *WorkflowStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
+		`EstimatedDurationPercentiles:` + strings.Replace(this.EstimatedDurationPercentiles.String(), "EstimatedDurationPercentiles", "EstimatedDurationPercentiles", 1) + `,`,
+		`TemplateEstimatedDurationPercentiles:` + mapStringForTemplateEstimatedDurationPercentiles + `,`,
 		`}`,
 	}, "")
 	return s
@@ -16583,7 +20343,7 @@ func (this *WorkflowTaskResult) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTaskResult{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NodeResult:` + strings.Replace(strings.Replace(this.NodeResult.String(), "NodeResult", "NodeResult", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -16599,7 +20359,7 @@ func (this *WorkflowTaskResultList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTaskResultList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -16610,7 +20370,7 @@ func (this *WorkflowTaskSet) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTaskSet{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowTaskSetSpec", "WorkflowTaskSetSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WorkflowTaskSetStatus", "WorkflowTaskSetStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -16627,7 +20387,7 @@ func (this *WorkflowTaskSetList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTaskSetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -16678,7 +20438,7 @@ func (this *WorkflowTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&WorkflowTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -16694,7 +20454,7 @@ func (this *WorkflowTemplateList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&WorkflowTemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -16720,6 +20480,16 @@ func (this *ZipStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *ZstdStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ZstdStrategy{`,
+		`CompressionLevel:` + valueToStringGenerated(this.CompressionLevel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -16947,59 +20717,9 @@ func (m *ArchiveStrategy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Arguments) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zstd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17026,42 +20746,128 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Zstd == nil {
+				m.Zstd = &ZstdStrategy{}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Zstd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Arguments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Arguments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Arguments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17436,6 +21242,215 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 			}
 			m.FromExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
+			}
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deduplicate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deduplicate = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17877,6 +21892,42 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Azure == nil {
+				m.Azure = &AzureArtifact{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18211,6 +22262,42 @@ func (m *ArtifactRepository) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Azure == nil {
+				m.Azure = &AzureArtifactRepository{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18517,7 +22604,7 @@ func (m *ArtifactRepositoryRefStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
+func (m *ArtifactTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18540,15 +22627,15 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18576,11 +22663,30 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18607,7 +22713,7 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18632,7 +22738,7 @@ func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *ArtifactTransfers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18655,15 +22761,15 @@ func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactTransfers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactTransfers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loads", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18690,15 +22796,16 @@ func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Loads = append(m.Loads, ArtifactTransfer{})
+			if err := m.Loads[len(m.Loads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Saves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18708,23 +22815,25 @@ func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Saves = append(m.Saves, ArtifactTransfer{})
+			if err := m.Saves[len(m.Saves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18747,7 +22856,7 @@ func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
+func (m *ArtifactoryArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18770,17 +22879,17 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactoryArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactoryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18790,31 +22899,27 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18841,10 +22946,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18869,7 +22971,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *ArtifactoryArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18892,15 +22994,48 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactoryArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactoryArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactoryAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactoryAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18928,11 +23063,61 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactoryAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactoryAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18959,18 +23144,18 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Factor == nil {
-				m.Factor = &intstr.IntOrString{}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v11.SecretKeySelector{}
 			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18980,23 +23165,27 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19019,7 +23208,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasicAuth) Unmarshal(dAtA []byte) error {
+func (m *AzureArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19042,15 +23231,15 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19077,18 +23266,15 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19098,27 +23284,23 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Blob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19141,7 +23323,7 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *AzureArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19164,15 +23346,15 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19199,13 +23381,42 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobNameFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlobNameFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19227,7 +23438,7 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19250,17 +23461,17 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureBlobContainer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureBlobContainer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19270,30 +23481,29 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19303,78 +23513,59 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19401,13 +23592,16 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccountKeySecret == nil {
+				m.AccountKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASTokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19434,11 +23628,33 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SASTokenSecret == nil {
+				m.SASTokenSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.SASTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19460,7 +23676,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19483,15 +23699,15 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19519,13 +23735,13 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19535,27 +23751,31 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			if m.Factor == nil {
+				m.Factor = &intstr.IntOrString{}
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19583,7 +23803,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19606,7 +23826,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerNode) Unmarshal(dAtA []byte) error {
+func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19629,15 +23849,15 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19664,15 +23884,18 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19682,23 +23905,27 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19721,7 +23948,7 @@ func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
+func (m *Cache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19744,17 +23971,17 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19764,27 +23991,31 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v11.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19811,10 +24042,46 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retries == nil {
-				m.Retries = &intstr.IntOrString{}
+			if m.SQL == nil {
+				m.SQL = &SQLCache{}
 			}
-			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Artifact == nil {
+				m.Artifact = &ArtifactCache{}
+			}
+			if err := m.Artifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19839,7 +24106,7 @@ func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
+func (m *ChunkTransformation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19862,17 +24129,17 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkTransformation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkTransformation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSize", wireType)
 			}
-			var msglen int
+			m.ChunkSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19882,29 +24149,64 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChunkSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
-			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19931,14 +24233,13 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, ContainerNode{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19965,10 +24266,7 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &ContainerSetRetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19993,7 +24291,7 @@ func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20016,17 +24314,17 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20036,17 +24334,30 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Error = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20056,12 +24367,26 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20083,7 +24408,7 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Counter) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20106,15 +24431,15 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20142,63 +24467,45 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20208,12 +24515,24 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ObjectLocking = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20235,7 +24554,7 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20258,15 +24577,15 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20293,48 +24612,15 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20344,24 +24630,23 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20384,7 +24669,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetRetryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20407,17 +24692,17 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetRetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20427,28 +24712,27 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20475,8 +24759,10 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retries == nil {
+				m.Retries = &intstr.IntOrString{}
+			}
+			if err := m.Retries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20501,7 +24787,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20524,15 +24810,15 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20559,15 +24845,16 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VolumeMounts = append(m.VolumeMounts, v11.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20577,27 +24864,115 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &ContainerSetRetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContainerTermination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerTermination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerTermination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20625,13 +25000,13 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			var v int
+			m.ExitCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20641,17 +25016,16 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExitCode |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Suspend = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20661,17 +25035,79 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20681,17 +25117,17 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
+			m.Error = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20701,15 +25137,65 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20737,13 +25223,63 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20753,28 +25289,12 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
-			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ObjectLocking = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20796,7 +25316,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20819,15 +25339,15 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20854,14 +25374,13 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20888,16 +25407,13 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
-			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20924,8 +25440,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20950,7 +25465,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowBackfill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20973,17 +25488,17 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowBackfill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowBackfill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20993,29 +25508,30 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21025,27 +25541,97 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowBackfillStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowBackfillStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowBackfillStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronWorkflowBackfill", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21072,13 +25658,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CronWorkflowBackfill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21105,18 +25691,18 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.NextScheduledTime == nil {
+				m.NextScheduledTime = &v1.Time{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
 			}
-			var stringLen uint64
+			m.Submitted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21126,27 +25712,14 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Submitted |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21173,16 +25746,16 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Active = append(m.Active, v11.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21192,27 +25765,28 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21239,307 +25813,10 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
-			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.When = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
-			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Hooks == nil {
-				m.Hooks = make(LifecycleHooks)
-			}
-			var mapkey LifecycleEvent
-			mapvalue := &LifecycleHook{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &LifecycleHook{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
 			}
-			if m.Inline == nil {
-				m.Inline = &Template{}
-			}
-			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21564,7 +25841,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowExclusions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21587,15 +25864,15 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowExclusions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowExclusions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21623,11 +25900,11 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.Dates = append(m.Dates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21654,32 +25931,13 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -21701,7 +25959,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Data) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21724,15 +25982,15 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Data: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21759,13 +26017,13 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21792,8 +26050,8 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transformation = append(m.Transformation, TransformationStep{})
-			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21818,7 +26076,7 @@ func (m *Data) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataSource) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowSkip) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21841,15 +26099,15 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowSkip: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowSkip: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21876,66 +26134,13 @@ func (m *DataSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArtifactPaths == nil {
-				m.ArtifactPaths = &ArtifactPaths{}
-			}
-			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21963,7 +26168,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21986,7 +26191,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22009,17 +26214,17 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22029,79 +26234,30 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22111,28 +26267,27 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22160,63 +26315,33 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22226,28 +26351,55 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.FailedJobsHistoryLimit = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22275,61 +26427,47 @@ func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.Timezone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v1.ObjectMeta{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22357,11 +26495,11 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.Schedules = append(m.Schedules, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclusions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22388,68 +26526,18 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceAccountKeySecret == nil {
-				m.ServiceAccountKeySecret = &v1.SecretKeySelector{}
+			if m.Exclusions == nil {
+				m.Exclusions = &CronWorkflowExclusions{}
 			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Exclusions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Gauge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22459,29 +26547,33 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.StopStrategy == nil {
+				m.StopStrategy = &StopStrategy{}
+			}
+			if err := m.StopStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22491,13 +26583,24 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Realtime = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22519,7 +26622,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22542,17 +26645,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22562,29 +26665,31 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Active = append(m.Active, v11.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22594,79 +26699,31 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Depth = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v1.Time{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22693,16 +26750,14 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfill", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22729,16 +26784,16 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
+			if m.Backfill == nil {
+				m.Backfill = &CronWorkflowBackfillStatus{}
 			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Backfill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSkipped", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22765,18 +26820,18 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			if m.LastSkipped == nil {
+				m.LastSkipped = &CronWorkflowSkip{}
 			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastSkipped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var v int
+			m.Succeeded = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22786,17 +26841,16 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Succeeded |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			var v int
+			m.Failed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22806,37 +26860,16 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Failed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableSubmodules = bool(v != 0)
-		case 10:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SingleBranch", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SingleBranch = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailed", wireType)
 			}
-			var stringLen uint64
+			m.ConsecutiveFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22846,24 +26879,11 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ConsecutiveFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22885,7 +26905,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22908,17 +26928,17 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22928,28 +26948,27 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22977,13 +26996,13 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22993,65 +27012,28 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23078,13 +27060,16 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23112,81 +27097,11 @@ func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathFormat = string(dAtA[iNdEx:postIndex])
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23213,13 +27128,14 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23247,13 +27163,13 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.WithParam = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23263,79 +27179,33 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23345,31 +27215,27 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23396,16 +27262,16 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
 			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23433,11 +27299,11 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23465,11 +27331,11 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			m.Depends = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23496,18 +27362,111 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
 			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey LifecycleEvent
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = LifecycleEvent(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Hooks[LifecycleEvent(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23517,23 +27476,27 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			if m.Inline == nil {
+				m.Inline = &Template{}
+			}
+			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23556,7 +27519,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTP) Unmarshal(dAtA []byte) error {
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23579,15 +27542,15 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23615,43 +27578,11 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23678,98 +27609,14 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, HTTPHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TimeoutSeconds = &v
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Body = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -23786,7 +27633,8 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.InsecureSkipVerify = bool(v != 0)
+			b := bool(v != 0)
+			m.FailFast = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23808,7 +27656,7 @@ func (m *HTTP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *Data) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23831,17 +27679,17 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Data: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Data: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23851,61 +27699,28 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transformation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23932,7 +27747,8 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transformation = append(m.Transformation, TransformationStep{})
+			if err := m.Transformation[len(m.Transformation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23957,7 +27773,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
+func (m *DataSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23980,17 +27796,17 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactPaths", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24000,29 +27816,33 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ArtifactPaths == nil {
+				m.ArtifactPaths = &ArtifactPaths{}
+			}
+			if err := m.ArtifactPaths.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24032,27 +27852,31 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24079,66 +27903,86 @@ func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &HTTPHeaderSource{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v11.SecretKeySelector{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.HTTP == nil {
+				m.HTTP = &HTTP{}
 			}
-			if iNdEx >= l {
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24165,10 +28009,10 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &v1.SecretKeySelector{}
+			if m.Resources == nil {
+				m.Resources = &ResourcesSource{}
 			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24193,7 +28037,7 @@ func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *EstimatedDurationPercentiles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24216,17 +28060,17 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimatedDurationPercentiles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimatedDurationPercentiles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P50", wireType)
 			}
-			var stringLen uint64
+			m.P50 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24236,29 +28080,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.P50 |= EstimatedDuration(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
 			}
-			var stringLen uint64
+			m.P90 = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24268,24 +28099,30 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.P90 |= EstimatedDuration(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24307,7 +28144,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24330,15 +28167,15 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24366,13 +28203,63 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24382,25 +28269,23 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, Amount{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24423,7 +28308,7 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Inputs) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24446,15 +28331,15 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24481,16 +28366,15 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24500,25 +28384,23 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24541,7 +28423,7 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Item) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24564,17 +28446,17 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24584,79 +28466,28 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LabelKeys) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24684,7 +28515,7 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24707,7 +28538,7 @@ func (m *LabelKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24730,15 +28561,15 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24766,7 +28597,43 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceAccountKeySecret == nil {
+				m.ServiceAccountKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24789,7 +28656,7 @@ func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LabelValues) Unmarshal(dAtA []byte) error {
+func (m *Gauge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24812,15 +28679,15 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24848,8 +28715,29 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Realtime = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24871,7 +28759,7 @@ func (m *LabelValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24894,15 +28782,15 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24930,11 +28818,95 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Depth = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24961,13 +28933,16 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v11.SecretKeySelector{}
+			}
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24994,16 +28969,112 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v11.SecretKeySelector{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureIgnoreHostKey = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableSubmodules", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableSubmodules = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleBranch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleBranch = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25031,7 +29102,7 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25054,7 +29125,7 @@ func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Link) Unmarshal(dAtA []byte) error {
+func (m *GroupByTransformation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25077,15 +29148,15 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+			return fmt.Errorf("proto: GroupByTransformation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GroupByTransformation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25113,13 +29184,63 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25129,27 +29250,28 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25177,8 +29299,28 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25200,7 +29342,7 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifactRepository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25223,17 +29365,17 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifactRepository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25243,15 +29385,28 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Hit = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25279,13 +29434,13 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.PathFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25295,24 +29450,12 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CacheName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25334,7 +29477,7 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Memoize) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25357,17 +29500,17 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25377,29 +29520,30 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25409,31 +29553,27 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cache == nil {
-				m.Cache = &Cache{}
-			}
-			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25461,7 +29601,7 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25484,7 +29624,7 @@ func (m *Memoize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25507,15 +29647,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25542,112 +29682,21 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v11.SecretKeySelector{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
@@ -25669,157 +29718,16 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v11.SecretKeySelector{}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MetricLabel) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25847,11 +29755,11 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25879,61 +29787,11 @@ func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Metrics) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25960,64 +29818,16 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prometheus = append(m.Prometheus, &Prometheus{})
-			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v11.ConfigMapKeySelector{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Mutex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26045,7 +29855,7 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26068,7 +29878,7 @@ func (m *Mutex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+func (m *HTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26091,15 +29901,15 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26127,11 +29937,11 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mutex = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26159,63 +29969,67 @@ func (m *MutexHolding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holder = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MutexStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TimeoutSeconds = &v
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26225,31 +30039,29 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, MutexHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26259,26 +30071,44 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, MutexHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26300,7 +30130,7 @@ func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeResult) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26323,15 +30153,15 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26359,13 +30189,13 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26375,27 +30205,29 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26422,45 +30254,10 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
-			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26482,7 +30279,7 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeStatus) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26505,15 +30302,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26541,11 +30338,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26573,13 +30370,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26589,91 +30386,81 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = NodeType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26700,16 +30487,66 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v11.SecretKeySelector{}
 			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26737,11 +30574,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26769,11 +30606,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BoundaryID = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26801,11 +30688,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26832,13 +30719,64 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, Amount{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Inputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26865,15 +30803,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26883,50 +30822,81 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodIP = string(dAtA[iNdEx:postIndex])
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := bool(v != 0)
-			m.Daemoned = &b
-		case 14:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Item) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26936,33 +30906,81 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inputs == nil {
-				m.Inputs = &Inputs{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 15:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26972,31 +30990,77 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Outputs == nil {
-				m.Outputs = &Outputs{}
-			}
-			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 16:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27024,43 +31088,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LabelValues) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 20:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelValues: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelValues: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27088,124 +31170,61 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourcesDuration == nil {
-				m.ResourcesDuration = make(ResourcesDuration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var mapkey k8s_io_api_core_v1.ResourceName
-			var mapvalue int64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
-			iNdEx = postIndex
-		case 22:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27233,11 +31252,11 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27264,35 +31283,13 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoizationStatus == nil {
-				m.MemoizationStatus = &MemoizationStatus{}
-			}
-			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
-			}
-			m.EstimatedDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 25:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27319,16 +31316,16 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SynchronizationStatus == nil {
-				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
 			}
-			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27356,7 +31353,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27379,7 +31376,7 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+func (m *Link) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27402,15 +31399,15 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27438,113 +31435,13 @@ func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27554,28 +31451,27 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27603,7 +31499,7 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27626,7 +31522,7 @@ func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27649,17 +31545,17 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoizationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hit", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27669,28 +31565,15 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Hit = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27718,14 +31601,78 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
@@ -27741,7 +31688,7 @@ func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+func (m *Memoize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27764,15 +31711,15 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: Memoize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Memoize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27800,43 +31747,11 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27863,18 +31778,18 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
+			if m.Cache == nil {
+				m.Cache = &Cache{}
 			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27884,53 +31799,79 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CreateBucketIfNotPresent = bool(v != 0)
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27940,27 +31881,122 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27987,12 +32023,103 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LifecycleRule == nil {
-				m.LifecycleRule = &OSSLifecycleRule{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28015,7 +32142,7 @@ func (m *OSSBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
+func (m *MetricLabel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28038,17 +32165,17 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricLabel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricLabel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.MarkInfrequentAccessAfterDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28058,16 +32185,29 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.MarkDeletionAfterDays = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28077,11 +32217,24 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28103,7 +32256,7 @@ func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Object) Unmarshal(dAtA []byte) error {
+func (m *Metrics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28126,12 +32279,46 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prometheus = append(m.Prometheus, &Prometheus{})
+			if err := m.Prometheus[len(m.Prometheus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28153,7 +32340,7 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Outputs) Unmarshal(dAtA []byte) error {
+func (m *Mutex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28176,17 +32363,17 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: Mutex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Mutex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28196,63 +32383,77 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MutexHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MutexHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MutexHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28280,12 +32481,11 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Result = &s
+			m.Mutex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28313,8 +32513,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ExitCode = &s
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28337,7 +32536,7 @@ func (m *Outputs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+func (m *MutexStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28360,15 +32559,15 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: MutexStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MutexStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28395,8 +32594,42 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, WorkflowStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holding = append(m.Holding, MutexHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = append(m.Waiting, MutexHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28421,7 +32654,7 @@ func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Parameter) Unmarshal(dAtA []byte) error {
+func (m *NodeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28444,15 +32677,15 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28480,11 +32713,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28512,45 +32745,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28577,16 +32776,16 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28614,13 +32813,13 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedArtifactCache", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28630,29 +32829,33 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			if m.SharedArtifactCache == nil {
+				m.SharedArtifactCache = &SharedArtifactCacheStatus{}
+			}
+			if err := m.SharedArtifactCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactTransfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28662,24 +32865,27 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			if m.ArtifactTransfers == nil {
+				m.ArtifactTransfers = &ArtifactTransfers{}
+			}
+			if err := m.ArtifactTransfers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28702,7 +32908,7 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plugin) Unmarshal(dAtA []byte) error {
+func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28725,17 +32931,17 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28745,78 +32951,27 @@ func (m *Plugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodGC) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28844,13 +32999,13 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28860,81 +33015,59 @@ func (m *PodGC) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LabelSelector == nil {
-				m.LabelSelector = &v11.LabelSelector{}
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Prometheus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Type = NodeType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28962,11 +33095,11 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28993,14 +33126,16 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, &MetricLabel{})
-			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29028,11 +33163,11 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Help = string(dAtA[iNdEx:postIndex])
+			m.Phase = NodePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29060,13 +33195,13 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.When = string(dAtA[iNdEx:postIndex])
+			m.BoundaryID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29076,31 +33211,27 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
-			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29127,16 +33258,13 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Histogram == nil {
-				m.Histogram = &Histogram{}
-			}
-			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29163,36 +33291,2927 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Counter == nil {
-				m.Counter = &Counter{}
-			}
-			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodIP", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RawArtifact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+			m.PodIP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Daemoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Daemoned = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inputs == nil {
+				m.Inputs = &Inputs{}
+			}
+			if err := m.Inputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Outputs == nil {
+				m.Outputs = &Outputs{}
+			}
+			if err := m.Outputs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundNodes = append(m.OutboundNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateScope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateScope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourcesDuration == nil {
+				m.ResourcesDuration = make(ResourcesDuration)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ResourcesDuration[k8s_io_api_core_v1.ResourceName(mapkey)] = ((ResourceDuration)(mapvalue))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostNodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostNodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoizationStatus == nil {
+				m.MemoizationStatus = &MemoizationStatus{}
+			}
+			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SynchronizationStatus == nil {
+				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			}
+			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedArtifactCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SharedArtifactCache == nil {
+				m.SharedArtifactCache = &SharedArtifactCacheStatus{}
+			}
+			if err := m.SharedArtifactCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactTransfers == nil {
+				m.ArtifactTransfers = &ArtifactTransfers{}
+			}
+			if err := m.ArtifactTransfers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Termination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Termination == nil {
+				m.Termination = &NodeTermination{}
+			}
+			if err := m.Termination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDecision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryDecision == nil {
+				m.RetryDecision = &RetryDecision{}
+			}
+			if err := m.RetryDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = make(map[string]v11.ResourceRequirements)
+			}
+			var mapkey string
+			mapvalue := &v11.ResourceRequirements{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.ResourceRequirements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Resources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeSynchronizationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeSynchronizationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Waiting = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeTermination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeTermination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeTermination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, ContainerTermination{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLost", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NodeLost = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preempted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoneStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoneStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoneStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSArtifactRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSArtifactRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSArtifactRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OSSBucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OSSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateBucketIfNotPresent = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleRule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LifecycleRule == nil {
+				m.LifecycleRule = &OSSLifecycleRule{}
+			}
+			if err := m.LifecycleRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OSSLifecycleRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OSSLifecycleRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OSSLifecycleRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkInfrequentAccessAfterDays", wireType)
+			}
+			m.MarkInfrequentAccessAfterDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkInfrequentAccessAfterDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkDeletionAfterDays", wireType)
+			}
+			m.MarkDeletionAfterDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkDeletionAfterDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Object) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Object: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Object: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Outputs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Outputs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Outputs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Result = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExitCode = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParallelSteps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParallelSteps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParallelSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, WorkflowStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Parameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Parameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Parameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Description = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParseTransformation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParseTransformation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParseTransformation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = ParseFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodGC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodGC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodGC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = PodGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelSelector == nil {
+				m.LabelSelector = &v1.LabelSelector{}
+			}
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, &MetricLabel{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Help", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Help = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Histogram == nil {
+				m.Histogram = &Histogram{}
+			}
+			if err := m.Histogram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Counter == nil {
+				m.Counter = &Counter{}
+			}
+			if err := m.Counter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -29214,15 +36233,529 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetOwnerReference = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourcesSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourcesSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourcesSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeAntiAffinity == nil {
+				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			}
+			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryDecision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retried", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retried = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29250,7 +36783,7 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29273,7 +36806,7 @@ func (m *RawArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
+func (m *RetryMatcher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29296,47 +36829,91 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryMatcher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ExitCodes = append(m.ExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitCodes) == 0 {
+					m.ExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitCodes = append(m.ExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Action = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29364,11 +36941,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeStrategy = string(dAtA[iNdEx:postIndex])
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29396,11 +36973,11 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifest = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetOwnerReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLost", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -29417,12 +36994,12 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.SetOwnerReference = bool(v != 0)
+			m.NodeLost = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29432,88 +37009,62 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Preempted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29535,7 +37086,7 @@ func (m *ResourceTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
+func (m *RetryResources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29558,15 +37109,15 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RetryAffinity: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryResources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryResources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscalateOn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29593,63 +37144,307 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAntiAffinity == nil {
-				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			if m.EscalateOn == nil {
+				m.EscalateOn = &RetryMatcher{}
 			}
-			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EscalateOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Factor == nil {
+				m.Factor = &Amount{}
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.Increase == nil {
+				m.Increase = make(k8s_io_api_core_v1.ResourceList)
 			}
-			if iNdEx >= l {
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Increase[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Max == nil {
+				m.Max = make(k8s_io_api_core_v1.ResourceList)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Max[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29872,6 +37667,114 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 			}
 			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryOn == nil {
+				m.RetryOn = &RetryMatcher{}
+			}
+			if err := m.RetryOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoNotRetryOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DoNotRetryOn == nil {
+				m.DoNotRetryOn = &RetryMatcher{}
+			}
+			if err := m.DoNotRetryOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = &RetryResources{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30303,9 +38206,425 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			m.Insecure = &b
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccessKeySecret == nil {
+				m.AccessKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeySecret == nil {
+				m.SecretKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateBucketIfNotPresent == nil {
+				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
+			}
+			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptionOptions == nil {
+				m.EncryptionOptions = &S3EncryptionOptions{}
+			}
+			if err := m.EncryptionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableEncryption = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServerSideCustomerKeySecret == nil {
+				m.ServerSideCustomerKeySecret = &v11.SecretKeySelector{}
+			}
+			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SQLCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30315,31 +38634,77 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKeySecret == nil {
-				m.AccessKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30366,16 +38731,13 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeySecret == nil {
-				m.SecretKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30403,13 +38765,63 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30419,17 +38831,29 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseSDKCreds = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketIfNotPresent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30439,31 +38863,27 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateBucketIfNotPresent == nil {
-				m.CreateBucketIfNotPresent = &CreateS3BucketOptions{}
-			}
-			if err := m.CreateBucketIfNotPresent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30490,12 +38910,89 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EncryptionOptions == nil {
-				m.EncryptionOptions = &S3EncryptionOptions{}
+			if m.Permits == nil {
+				m.Permits = make(map[string]int32)
 			}
-			if err := m.EncryptionOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Permits[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30518,7 +39015,7 @@ func (m *S3Bucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30541,17 +39038,17 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: S3EncryptionOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: S3EncryptionOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsKeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30561,29 +39058,33 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsKeyId = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KmsEncryptionContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30593,29 +39094,33 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KmsEncryptionContext = string(dAtA[iNdEx:postIndex])
+			if m.Database == nil {
+				m.Database = &SyncDatabaseRef{}
+			}
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableEncryption", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30625,15 +39130,31 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableEncryption = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &SyncHTTPRef{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerSideCustomerKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30660,10 +39181,10 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServerSideCustomerKeySecret == nil {
-				m.ServerSideCustomerKeySecret = &v1.SecretKeySelector{}
+			if m.Permits == nil {
+				m.Permits = &intstr.IntOrString{}
 			}
-			if err := m.ServerSideCustomerKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Permits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30688,7 +39209,7 @@ func (m *S3EncryptionOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
+func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30711,15 +39232,15 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScriptTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScriptTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30746,15 +39267,16 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Holding = append(m.Holding, SemaphoreHolding{})
+			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30764,23 +39286,25 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Waiting = append(m.Waiting, SemaphoreHolding{})
+			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30803,7 +39327,7 @@ func (m *ScriptTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
+func (m *Sequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30826,17 +39350,17 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreHolding: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Semaphore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30846,29 +39370,33 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Semaphore = string(dAtA[iNdEx:postIndex])
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30878,77 +39406,31 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Start == nil {
+				m.Start = &intstr.IntOrString{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30975,13 +39457,45 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			if m.End == nil {
+				m.End = &intstr.IntOrString{}
 			}
-			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31003,7 +39517,7 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
+func (m *SharedArtifactCacheStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31026,17 +39540,17 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SemaphoreStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SharedArtifactCacheStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SemaphoreStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SharedArtifactCacheStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hits", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31046,31 +39560,29 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holding = append(m.Holding, SemaphoreHolding{})
-			if err := m.Holding[len(m.Holding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hits = append(m.Hits, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Waiting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31080,25 +39592,23 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Waiting = append(m.Waiting, SemaphoreHolding{})
-			if err := m.Waiting[len(m.Waiting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Misses = append(m.Misses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31121,7 +39631,7 @@ func (m *SemaphoreStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sequence) Unmarshal(dAtA []byte) error {
+func (m *SortTransformation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31144,17 +39654,17 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortTransformation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortTransformation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31164,33 +39674,29 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
-			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31200,31 +39706,103 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Descending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &intstr.IntOrString{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31251,16 +39829,16 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.End == nil {
-				m.End = &intstr.IntOrString{}
+			if m.EndTime == nil {
+				m.EndTime = &v1.Time{}
 			}
-			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31288,7 +39866,7 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			m.Expression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31650,51 +40228,151 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ServerDryRun = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerReference", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OwnerReference == nil {
+				m.OwnerReference = &v1.OwnerReference{}
+			}
+			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DryRun = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerDryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ServerDryRun = bool(v != 0)
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPriorityClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31722,13 +40400,13 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = string(dAtA[iNdEx:postIndex])
+			m.PodPriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerReference", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31738,63 +40416,115 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Priority = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OwnerReference == nil {
-				m.OwnerReference = &v11.OwnerReference{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.OwnerReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuppliedValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuppliedValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotations = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPriorityClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31822,28 +40552,8 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPriorityClassName = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Priority = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31865,7 +40575,7 @@ func (m *SubmitOpts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
+func (m *SyncDatabaseRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31888,12 +40598,44 @@ func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuppliedValueFrom: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncDatabaseRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuppliedValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncDatabaseRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31915,7 +40657,7 @@ func (m *SuppliedValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
+func (m *SyncHTTPRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31938,15 +40680,15 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuspendTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncHTTPRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuspendTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncHTTPRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31974,7 +40716,7 @@ func (m *SuspendTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32400,6 +41142,26 @@ func (m *TarStrategy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CompressionLevel = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCompression", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoCompression = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32705,7 +41467,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+				m.Affinity = &v11.Affinity{}
 			}
 			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -32829,7 +41591,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Container == nil {
-				m.Container = &v1.Container{}
+				m.Container = &v11.Container{}
 			}
 			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -33008,7 +41770,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
+			m.Volumes = append(m.Volumes, v11.Volume{})
 			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -33238,7 +42000,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -33388,7 +42150,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			m.HostAliases = append(m.HostAliases, v11.HostAlias{})
 			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -33423,7 +42185,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+				m.SecurityContext = &v11.PodSecurityContext{}
 			}
 			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -33971,109 +42733,435 @@ func (m *TemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ClusterScope = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransformationStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransformationStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransformationStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransformationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransformationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransformationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JQ", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JQ = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Parse == nil {
+				m.Parse = &ParseTransformation{}
+			}
+			if err := m.Parse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sort == nil {
+				m.Sort = &SortTransformation{}
+			}
+			if err := m.Sort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unique == nil {
+				m.Unique = &UniqueTransformation{}
+			}
+			if err := m.Unique.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GroupBy == nil {
+				m.GroupBy = &GroupByTransformation{}
+			}
+			if err := m.GroupBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chunk == nil {
+				m.Chunk = &ChunkTransformation{}
+			}
+			if err := m.Chunk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UniqueTransformation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UniqueTransformation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UniqueTransformation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34520,7 +43608,7 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ConfigMapKeyRef == nil {
-				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+				m.ConfigMapKeyRef = &v11.ConfigMapKeySelector{}
 			}
 			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36175,7 +45263,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
+			m.Volumes = append(m.Volumes, v11.Volume{})
 			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -36209,7 +45297,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeClaimTemplates = append(m.VolumeClaimTemplates, v1.PersistentVolumeClaim{})
+			m.VolumeClaimTemplates = append(m.VolumeClaimTemplates, v11.PersistentVolumeClaim{})
 			if err := m.VolumeClaimTemplates[len(m.VolumeClaimTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -36448,7 +45536,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Affinity == nil {
-				m.Affinity = &v1.Affinity{}
+				m.Affinity = &v11.Affinity{}
 			}
 			if err := m.Affinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36483,7 +45571,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			m.Tolerations = append(m.Tolerations, v11.Toleration{})
 			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -36517,7 +45605,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, v1.LocalObjectReference{})
+			m.ImagePullSecrets = append(m.ImagePullSecrets, v11.LocalObjectReference{})
 			if err := m.ImagePullSecrets[len(m.ImagePullSecrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -36606,7 +45694,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.DNSConfig == nil {
-				m.DNSConfig = &v1.PodDNSConfig{}
+				m.DNSConfig = &v11.PodDNSConfig{}
 			}
 			if err := m.DNSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -36833,7 +45921,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAliases = append(m.HostAliases, v1.HostAlias{})
+			m.HostAliases = append(m.HostAliases, v11.HostAlias{})
 			if err := m.HostAliases[len(m.HostAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -36868,7 +45956,7 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
+				m.SecurityContext = &v11.PodSecurityContext{}
 			}
 			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -37911,7 +46999,7 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v1.Volume{})
+			m.PersistentVolumeClaims = append(m.PersistentVolumeClaims, v11.Volume{})
 			if err := m.PersistentVolumeClaims[len(m.PersistentVolumeClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -38255,14 +47343,173 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredWorkflowSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StoredWorkflowSpec == nil {
+				m.StoredWorkflowSpec = &WorkflowSpec{}
+			}
+			if err := m.StoredWorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Synchronization == nil {
+				m.Synchronization = &SynchronizationStatus{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactRepositoryRef == nil {
+				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
+			}
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredWorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDurationPercentiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38289,16 +47536,16 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StoredWorkflowSpec == nil {
-				m.StoredWorkflowSpec = &WorkflowSpec{}
+			if m.EstimatedDurationPercentiles == nil {
+				m.EstimatedDurationPercentiles = &EstimatedDurationPercentiles{}
 			}
-			if err := m.StoredWorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EstimatedDurationPercentiles.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Synchronization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateEstimatedDurationPercentiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38325,99 +47572,105 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &SynchronizationStatus{}
-			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
-			}
-			m.EstimatedDuration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			if m.TemplateEstimatedDurationPercentiles == nil {
+				m.TemplateEstimatedDurationPercentiles = make(map[string]EstimatedDurationPercentiles)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactRepositoryRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &EstimatedDurationPercentiles{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &EstimatedDurationPercentiles{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ArtifactRepositoryRef == nil {
-				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
-			}
-			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TemplateEstimatedDurationPercentiles[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -40232,6 +49485,76 @@ func (m *ZipStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ZstdStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZstdStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZstdStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionLevel", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompressionLevel = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,10 @@ type NodeResult struct {
 	Message  string    `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
 	Outputs  *Outputs  `json:"outputs,omitempty" protobuf:"bytes,3,opt,name=outputs"`
 	Progress Progress  `json:"progress,omitempty" protobuf:"bytes,4,opt,name=progress,casttype=Progress"`
+	// SharedArtifactCache is which input artifacts were read from the shared artifact cache
+	SharedArtifactCache *SharedArtifactCacheStatus `json:"sharedArtifactCache,omitempty" protobuf:"bytes,5,opt,name=sharedArtifactCache"`
+	// ArtifactTransfers are how long the artifacts took to load or save
+	ArtifactTransfers *ArtifactTransfers `json:"artifactTransfers,omitempty" protobuf:"bytes,6,opt,name=artifactTransfers"`
 }
 
 func (in NodeResult) Fulfilled() bool {
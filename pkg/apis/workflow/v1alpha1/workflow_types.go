@@ -570,6 +570,25 @@ func (wfs *WorkflowSpec) HasPodSpecPatch() bool {
 	return wfs.PodSpecPatch != ""
 }
 
+// HasArtifactGC returns true if the workflow, any of its templates, or any of their output artifacts
+// have an artifact GC strategy
+func (wfs *WorkflowSpec) HasArtifactGC() bool {
+	if wfs.ArtifactGC.GetStrategy() != ArtifactGCNever {
+		return true
+	}
+	for _, t := range wfs.Templates {
+		if t.ArtifactGC.GetStrategy() != ArtifactGCNever {
+			return true
+		}
+		for _, a := range t.Outputs.Artifacts {
+			if a.ArtifactGC.GetStrategy() != ArtifactGCNever {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Template is a reusable and composable unit of execution in a workflow
 type Template struct {
 	// Name is the name of the template
@@ -923,6 +942,25 @@ type Artifact struct {
 
 	// FromExpression, if defined, is evaluated to specify the value for the artifact
 	FromExpression string `json:"fromExpression,omitempty" protobuf:"bytes,11,opt,name=fromExpression"`
+
+	// ArtifactGC describes the strategy to use when to deleting an artifact from completed or deleted workflows.
+	// It overrides the strategy of the template and of the workflow.
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,12,opt,name=artifactGC"`
+
+	// Has this been deleted?
+	Deleted bool `json:"deleted,omitempty" protobuf:"varint,13,opt,name=deleted"`
+
+	// Deduplicate saves an output artifact under a key derived from its digest, and does not upload it if an artifact
+	// with the same digest has already been saved. Deduplicated artifacts may be shared by many workflows, so they are
+	// never garbage collected. Only supported by S3, GCS, OSS and Azure.
+	Deduplicate bool `json:"deduplicate,omitempty" protobuf:"varint,14,opt,name=deduplicate"`
+
+	// Digest of the saved file, as "sha256:<hex>". Set when an output artifact is saved, and verified when it is loaded
+	// as an input artifact.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,15,opt,name=digest"`
+
+	// SizeBytes is the size of the saved file. Set and verified with the digest.
+	SizeBytes int64 `json:"sizeBytes,omitempty" protobuf:"varint,16,opt,name=sizeBytes"`
 }
 
 // CleanPath validates and cleans the artifact path.
@@ -989,7 +1027,7 @@ type ArtifactGC struct {
 	Strategy ArtifactGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactGCStategy"`
 }
 
-// GetStrategy returns the VolumeClaimGCStrategy to use for the workflow
+// GetStrategy returns the ArtifactGCStrategy to use for the workflow
 func (agc *ArtifactGC) GetStrategy() ArtifactGCStrategy {
 	if agc != nil {
 		return agc.Strategy
@@ -1017,6 +1055,7 @@ type ArchiveStrategy struct {
 	Tar  *TarStrategy  `json:"tar,omitempty" protobuf:"bytes,1,opt,name=tar"`
 	None *NoneStrategy `json:"none,omitempty" protobuf:"bytes,2,opt,name=none"`
 	Zip  *ZipStrategy  `json:"zip,omitempty" protobuf:"bytes,3,opt,name=zip"`
+	Zstd *ZstdStrategy `json:"zstd,omitempty" protobuf:"bytes,4,opt,name=zstd"`
 }
 
 // TarStrategy will tar and gzip the file or directory when saving
@@ -1024,6 +1063,16 @@ type TarStrategy struct {
 	// CompressionLevel specifies the gzip compression level to use for the artifact.
 	// Defaults to gzip.DefaultCompression.
 	CompressionLevel *int32 `json:"compressionLevel,omitempty" protobuf:"varint,1,opt,name=compressionLevel"`
+	// NoCompression will tar the file or directory without gzipping it.
+	// It cannot be used with CompressionLevel.
+	NoCompression bool `json:"noCompression,omitempty" protobuf:"varint,2,opt,name=noCompression"`
+}
+
+// ZstdStrategy will tar and zstd compress the file or directory when saving
+type ZstdStrategy struct {
+	// CompressionLevel specifies the zstd compression level to use for the artifact, from 1 (fastest) to 22 (smallest).
+	// Defaults to 3.
+	CompressionLevel *int32 `json:"compressionLevel,omitempty" protobuf:"varint,1,opt,name=compressionLevel"`
 }
 
 // ZipStrategy will unzip zipped input artifacts
@@ -1071,6 +1120,9 @@ type ArtifactLocation struct {
 
 	// GCS contains GCS artifact location details
 	GCS *GCSArtifact `json:"gcs,omitempty" protobuf:"bytes,9,opt,name=gcs"`
+
+	// Azure contains Azure Blob Storage artifact location details
+	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,10,opt,name=azure"`
 }
 
 func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
@@ -1078,6 +1130,8 @@ func (a *ArtifactLocation) Get() (ArtifactLocationType, error) {
 		return nil, fmt.Errorf("key unsupported: cannot get key for artifact location, because it is invalid")
 	} else if a.Artifactory != nil {
 		return a.Artifactory, nil
+	} else if a.Azure != nil {
+		return a.Azure, nil
 	} else if a.Git != nil {
 		return a.Git, nil
 	} else if a.GCS != nil {
@@ -1102,6 +1156,8 @@ func (a *ArtifactLocation) SetType(x ArtifactLocationType) error {
 	switch v := x.(type) {
 	case *ArtifactoryArtifact:
 		a.Artifactory = &ArtifactoryArtifact{}
+	case *AzureArtifact:
+		a.Azure = &AzureArtifact{}
 	case *GCSArtifact:
 		a.GCS = &GCSArtifact{}
 	case *HDFSArtifact:
@@ -1430,6 +1486,26 @@ func (s *Synchronization) GetType() SynchronizationType {
 type SemaphoreRef struct {
 	// ConfigMapKeyRef is configmap selector for Semaphore configuration
 	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
+	// Database is a reference to the limit of the semaphore in the persistence database
+	Database *SyncDatabaseRef `json:"database,omitempty" protobuf:"bytes,2,opt,name=database"`
+	// HTTP is a reference to an HTTP endpoint that returns the limit of the semaphore
+	HTTP *SyncHTTPRef `json:"http,omitempty" protobuf:"bytes,3,opt,name=http"`
+	// Permits is the number of permits of the semaphore to acquire, defaults to 1.
+	// It may be an expression, e.g. "{{inputs.parameters.gpus}}".
+	Permits *intstr.IntOrString `json:"permits,omitempty" protobuf:"bytes,4,opt,name=permits"`
+}
+
+// SyncDatabaseRef is a reference to a semaphore limit stored in the "argo_sync_limit" table of the persistence database
+type SyncDatabaseRef struct {
+	// Key of the limit, the limit is read from the row named "<namespace>/<key>"
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+}
+
+// SyncHTTPRef is a reference to an HTTP endpoint that returns a semaphore limit
+type SyncHTTPRef struct {
+	// Name of the endpoint, which must be declared in the `synchronization.limitEndpoints` of the controller's
+	// ConfigMap. The endpoint returns the limit as a JSON number when requested with GET.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 // Mutex holds Mutex configuration
@@ -1569,6 +1645,15 @@ type WorkflowStatus struct {
 	// EstimatedDuration in seconds.
 	EstimatedDuration EstimatedDuration `json:"estimatedDuration,omitempty" protobuf:"varint,16,opt,name=estimatedDuration,casttype=EstimatedDuration"`
 
+	// EstimatedDurationPercentiles is the distribution of the durations of previous runs of the same workflow template
+	// or cron workflow. EstimatedDuration is their median.
+	EstimatedDurationPercentiles *EstimatedDurationPercentiles `json:"estimatedDurationPercentiles,omitempty" protobuf:"bytes,19,opt,name=estimatedDurationPercentiles"`
+
+	// TemplateEstimatedDurationPercentiles is the distribution of the durations of the nodes of each template in previous
+	// runs of the same workflow template or cron workflow, keyed by the name of the template, or by
+	// `<workflow template>/<template>` for templates referenced by templateRef
+	TemplateEstimatedDurationPercentiles map[string]EstimatedDurationPercentiles `json:"templateEstimatedDurationPercentiles,omitempty" protobuf:"bytes,20,rep,name=templateEstimatedDurationPercentiles"`
+
 	// Progress to completion
 	Progress Progress `json:"progress,omitempty" protobuf:"bytes,17,opt,name=progress,casttype=Progress"`
 
@@ -1668,6 +1753,53 @@ type RetryStrategy struct {
 	// Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not
 	// be retried and the retry strategy will be ignored
 	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
+
+	// RetryOn retries a node only if its last attempt failed in one of the given ways. It replaces RetryPolicy, so both
+	// cannot be set.
+	RetryOn *RetryMatcher `json:"retryOn,omitempty" protobuf:"bytes,6,opt,name=retryOn"`
+
+	// DoNotRetryOn never retries a node if its last attempt failed in one of the given ways
+	DoNotRetryOn *RetryMatcher `json:"doNotRetryOn,omitempty" protobuf:"bytes,7,opt,name=doNotRetryOn"`
+
+	// Resources escalates the resource requests and limits of the main containers on each retry
+	Resources *RetryResources `json:"resources,omitempty" protobuf:"bytes,8,opt,name=resources"`
+}
+
+// RetryResources escalates the resource requests and limits of the main containers of a pod on each retry. Each
+// escalation multiplies a request or limit by Factor and adds Increase, up to Max.
+type RetryResources struct {
+	// EscalateOn escalates the resources only for the attempts that failed in one of the given ways, e.g. with the
+	// reason OOMKilled. By default, each failed attempt escalates them.
+	EscalateOn *RetryMatcher `json:"escalateOn,omitempty" protobuf:"bytes,1,opt,name=escalateOn"`
+
+	// Factor multiplies the escalated requests and limits, e.g. 2 doubles them. Defaults to 1.
+	Factor *Amount `json:"factor,omitempty" protobuf:"bytes,2,opt,name=factor"`
+
+	// Increase is added to the escalated requests and limits, e.g. memory: 1Gi
+	Increase apiv1.ResourceList `json:"increase,omitempty" protobuf:"bytes,3,rep,name=increase,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+
+	// Max is the maximum of each escalated resource, e.g. memory: 16Gi. Only the resources in Max are escalated, and
+	// only if the container requests or limits them.
+	Max apiv1.ResourceList `json:"max" protobuf:"bytes,4,rep,name=max,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
+// RetryMatcher matches how the last attempt of a node failed. It matches if any of its fields matches.
+type RetryMatcher struct {
+	// ExitCodes matches the exit code of any container of the pod, e.g. 137
+	ExitCodes []int32 `json:"exitCodes,omitempty" protobuf:"varint,1,rep,name=exitCodes"`
+
+	// Reasons matches the reason any container of the pod terminated, e.g. OOMKilled, or the reason of the pod, e.g.
+	// Evicted
+	Reasons []string `json:"reasons,omitempty" protobuf:"bytes,2,rep,name=reasons"`
+
+	// Message is a regular expression that matches the message of the node
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+
+	// NodeLost matches a pod that was deleted, or whose Kubernetes node was lost, before it completed
+	NodeLost bool `json:"nodeLost,omitempty" protobuf:"varint,4,opt,name=nodeLost"`
+
+	// Preempted matches a pod that was preempted by the scheduler to run a pod of higher priority
+	Preempted bool `json:"preempted,omitempty" protobuf:"varint,5,opt,name=preempted"`
 }
 
 // The amount of requested resource * the duration that request was used.
@@ -1792,6 +1924,8 @@ const (
 	ConditionTypeSpecError ConditionType = "SpecError"
 	// ConditionTypeMetricsError is an error during metric emission
 	ConditionTypeMetricsError ConditionType = "MetricsError"
+	// ConditionTypeArtifactGCError is an error deleting one or more artifacts during artifact GC
+	ConditionTypeArtifactGCError ConditionType = "ArtifactGCError"
 )
 
 type Condition struct {
@@ -1893,6 +2027,87 @@ type NodeStatus struct {
 
 	// SynchronizationStatus is the synchronization status of the node
 	SynchronizationStatus *NodeSynchronizationStatus `json:"synchronizationStatus,omitempty" protobuf:"bytes,25,opt,name=synchronizationStatus"`
+
+	// SharedArtifactCache is which input artifacts of the pod were read from the shared artifact cache
+	SharedArtifactCache *SharedArtifactCacheStatus `json:"sharedArtifactCache,omitempty" protobuf:"bytes,28,opt,name=sharedArtifactCache"`
+
+	// ArtifactTransfers are how long the pod took to load and save its artifacts
+	ArtifactTransfers *ArtifactTransfers `json:"artifactTransfers,omitempty" protobuf:"bytes,29,opt,name=artifactTransfers"`
+
+	// Termination is how the pod of the node failed
+	Termination *NodeTermination `json:"termination,omitempty" protobuf:"bytes,30,opt,name=termination"`
+
+	// RetryDecision is whether the last attempt of a retry node was retried, and why
+	RetryDecision *RetryDecision `json:"retryDecision,omitempty" protobuf:"bytes,31,opt,name=retryDecision"`
+
+	// Resources are the resource requirements of the main containers of the pod, by container name, recorded when the
+	// retry strategy escalates them
+	Resources map[string]apiv1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,32,rep,name=resources"`
+}
+
+// NodeTermination is how the pod of a node failed
+type NodeTermination struct {
+	// Containers are the containers of the pod that terminated with a non-zero exit code
+	Containers []ContainerTermination `json:"containers,omitempty" protobuf:"bytes,1,rep,name=containers"`
+	// Reason is the reason of the pod, e.g. Evicted
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// NodeLost is whether the pod was deleted, or its Kubernetes node was lost, before it completed
+	NodeLost bool `json:"nodeLost,omitempty" protobuf:"varint,3,opt,name=nodeLost"`
+	// Preempted is whether the pod was preempted by the scheduler
+	Preempted bool `json:"preempted,omitempty" protobuf:"varint,4,opt,name=preempted"`
+}
+
+// ContainerTermination is how a container of a pod terminated
+type ContainerTermination struct {
+	// Name of the container
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ExitCode of the container
+	ExitCode int32 `json:"exitCode" protobuf:"varint,2,opt,name=exitCode"`
+	// Reason the container terminated, e.g. OOMKilled
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+}
+
+// RetryDecision is whether the last attempt of a retry node was retried, and why
+type RetryDecision struct {
+	// Retried is whether the last attempt was retried
+	Retried bool `json:"retried" protobuf:"varint,1,opt,name=retried"`
+	// Reason the last attempt was or was not retried
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+}
+
+// SharedArtifactCacheStatus is which input artifacts of a pod were read from the shared artifact cache, rather than
+// downloaded from the artifact repository
+type SharedArtifactCacheStatus struct {
+	// Hits are the names of the input artifacts read from the cache
+	Hits []string `json:"hits,omitempty" protobuf:"bytes,1,rep,name=hits"`
+	// Misses are the names of the input artifacts that were not in the cache, and so were downloaded
+	Misses []string `json:"misses,omitempty" protobuf:"bytes,2,rep,name=misses"`
+}
+
+// ArtifactTransfers are the transfers of a pod's artifacts to and from their artifact repositories
+type ArtifactTransfers struct {
+	// Loads are the transfers of the input artifacts that were downloaded
+	Loads []ArtifactTransfer `json:"loads,omitempty" protobuf:"bytes,1,rep,name=loads"`
+	// Saves are the transfers of the output artifacts that were uploaded
+	Saves []ArtifactTransfer `json:"saves,omitempty" protobuf:"bytes,2,rep,name=saves"`
+}
+
+// ArtifactTransfer is the size and duration of the transfer of an artifact
+type ArtifactTransfer struct {
+	// Name of the artifact
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// SizeBytes is the number of bytes transferred
+	SizeBytes int64 `json:"sizeBytes" protobuf:"varint,2,opt,name=sizeBytes"`
+	// Duration of the transfer
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+}
+
+// BytesPerSecond returns the throughput of the transfer
+func (t ArtifactTransfer) BytesPerSecond() float64 {
+	if t.Duration.Duration <= 0 {
+		return 0
+	}
+	return float64(t.SizeBytes) / t.Duration.Seconds()
 }
 
 // Fulfilled returns whether a phase is fulfilled, i.e. it completed execution or was skipped or omitted
@@ -2192,9 +2407,9 @@ type ArtifactoryArtifact struct {
 	ArtifactoryAuth `json:",inline" protobuf:"bytes,2,opt,name=artifactoryAuth"`
 }
 
-//func (a *ArtifactoryArtifact) String() string {
-//	return a.URL
-//}
+//	func (a *ArtifactoryArtifact) String() string {
+//		return a.URL
+//	}
 func (a *ArtifactoryArtifact) GetKey() (string, error) {
 	u, err := url.Parse(a.URL)
 	if err != nil {
@@ -2380,6 +2595,50 @@ func (g *GCSArtifact) HasLocation() bool {
 	return g != nil && g.Bucket != "" && g.Key != ""
 }
 
+// AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container
+type AzureBlobContainer struct {
+	// Account is the name of the storage account
+	Account string `json:"account,omitempty" protobuf:"bytes,1,opt,name=account"`
+
+	// Endpoint is the URL of the storage account's blob service, e.g. http://127.0.0.1:10000/devstoreaccount1 for
+	// Azurite. Defaults to https://<account>.blob.core.windows.net
+	Endpoint string `json:"endpoint,omitempty" protobuf:"bytes,2,opt,name=endpoint"`
+
+	// Container is the name of the container
+	Container string `json:"container,omitempty" protobuf:"bytes,3,opt,name=container"`
+
+	// AccountKeySecret is the secret selector to the storage account's shared key
+	AccountKeySecret *apiv1.SecretKeySelector `json:"accountKeySecret,omitempty" protobuf:"bytes,4,opt,name=accountKeySecret"`
+
+	// SASTokenSecret is the secret selector to a shared access signature token of the container
+	SASTokenSecret *apiv1.SecretKeySelector `json:"sasTokenSecret,omitempty" protobuf:"bytes,5,opt,name=sasTokenSecret"`
+
+	// UseSDKCreds tells the driver to use the Azure SDK's default credentials, e.g. workload identity, instead of a
+	// shared key or SAS token
+	UseSDKCreds bool `json:"useSDKCreds,omitempty" protobuf:"varint,6,opt,name=useSDKCreds"`
+}
+
+// AzureArtifact is the location of an Azure Blob Storage artifact
+type AzureArtifact struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=azureBlobContainer"`
+
+	// Blob is the name of the blob in the container where the artifact resides
+	Blob string `json:"blob" protobuf:"bytes,2,opt,name=blob"`
+}
+
+func (a *AzureArtifact) GetKey() (string, error) {
+	return a.Blob, nil
+}
+
+func (a *AzureArtifact) SetKey(key string) error {
+	a.Blob = key
+	return nil
+}
+
+func (a *AzureArtifact) HasLocation() bool {
+	return a != nil && a.Container != "" && a.Blob != ""
+}
+
 // OSSBucket contains the access information required for interfacing with an Alibaba Cloud OSS bucket
 type OSSBucket struct {
 	// Endpoint is the hostname of the bucket endpoint
@@ -2822,6 +3081,37 @@ func (a *Artifact) GetArchive() *ArchiveStrategy {
 	return a.Archive
 }
 
+// GetArtifactGCStrategy returns the strategy used to delete an output artifact of a node. The artifact's own strategy
+// takes precedence over the strategy of the node's template, which takes precedence over the workflow's strategy.
+// Deduplicated artifacts are never deleted.
+func (wf *Workflow) GetArtifactGCStrategy(node NodeStatus, a *Artifact) ArtifactGCStrategy {
+	if a.Deduplicate {
+		return ArtifactGCNever
+	}
+	if a.ArtifactGC != nil {
+		return a.ArtifactGC.GetStrategy()
+	}
+	if tmpl := wf.getNodeTemplate(node); tmpl != nil && tmpl.ArtifactGC != nil {
+		return tmpl.ArtifactGC.GetStrategy()
+	}
+	return wf.GetExecSpec().ArtifactGC.GetStrategy()
+}
+
+// getNodeTemplate returns the template of a node, which is stored if it is referenced by a templateRef or called
+// inside a WorkflowTemplate, and otherwise is in the spec the workflow executes, e.g. that of its workflowTemplateRef
+func (wf *Workflow) getNodeTemplate(node NodeStatus) *Template {
+	scope, resourceName := node.GetTemplateScope()
+	if tmpl := wf.GetStoredTemplate(scope, resourceName, &node); tmpl != nil || node.TemplateRef != nil {
+		return tmpl
+	}
+	for _, t := range wf.GetExecSpec().Templates {
+		if t.Name == node.TemplateName {
+			return &t
+		}
+	}
+	return nil
+}
+
 // GetTemplateByName retrieves a defined template by its name
 func (wf *Workflow) GetTemplateByName(name string) *Template {
 	for _, t := range wf.Spec.Templates {
@@ -3121,12 +3411,45 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache that was used. Empty means a ConfigMap-based cache.
+	CacheType string `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType"`
 }
 
 // Cache is the configuration for the type of cache to be used
 type Cache struct {
 	// ConfigMap sets a ConfigMap-based cache
-	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// SQL sets a cache stored in the persistence database. Requires persistence to be configured.
+	SQL *SQLCache `json:"sql,omitempty" protobuf:"bytes,2,opt,name=sql"`
+	// Artifact sets a cache stored as objects in the controller's default artifact repository
+	Artifact *ArtifactCache `json:"artifact,omitempty" protobuf:"bytes,3,opt,name=artifact"`
+}
+
+// GetName returns the name of the cache
+func (c *Cache) GetName() string {
+	switch {
+	case c == nil:
+		return ""
+	case c.SQL != nil:
+		return c.SQL.Name
+	case c.Artifact != nil:
+		return c.Artifact.Name
+	case c.ConfigMap != nil:
+		return c.ConfigMap.Name
+	}
+	return ""
+}
+
+// SQLCache is a memoization cache stored in the persistence database
+type SQLCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
+// ArtifactCache is a memoization cache stored as objects in the controller's default artifact repository
+type ArtifactCache struct {
+	// Name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 type SynchronizationAction interface {
@@ -3141,6 +3464,16 @@ type SemaphoreHolding struct {
 	// Holders stores the list of current holder names in the workflow.
 	// +listType=atomic
 	Holders []string `json:"holders,omitempty" protobuf:"bytes,2,opt,name=holders"`
+	// Permits stores the number of permits of the holders that acquired more than one permit.
+	Permits map[string]int32 `json:"permits,omitempty" protobuf:"bytes,3,rep,name=permits"`
+}
+
+// GetPermits returns the number of permits held by the holder
+func (sh SemaphoreHolding) GetPermits(holderName string) int32 {
+	if permits, ok := sh.Permits[holderName]; ok {
+		return permits
+	}
+	return 1
 }
 
 type SemaphoreStatus struct {
@@ -3199,6 +3532,25 @@ func (ss *SemaphoreStatus) LockAcquired(holderKey, lockKey string, currentHolder
 	return false
 }
 
+// LockPermits records the number of permits the holder acquired, if it is more than one
+func (ss *SemaphoreStatus) LockPermits(holderKey, lockKey string, permits int32) bool {
+	i, semaphoreHolding := ss.GetHolding(lockKey)
+	items := strings.Split(holderKey, "/")
+	if i < 0 || permits <= 1 {
+		return false
+	}
+	holdingName := items[len(items)-1]
+	if semaphoreHolding.Permits[holdingName] == permits {
+		return false
+	}
+	if semaphoreHolding.Permits == nil {
+		semaphoreHolding.Permits = make(map[string]int32)
+	}
+	semaphoreHolding.Permits[holdingName] = permits
+	ss.Holding[i] = semaphoreHolding
+	return true
+}
+
 func (ss *SemaphoreStatus) LockReleased(holderKey, lockKey string) bool {
 	i, semaphoreHolding := ss.GetHolding(lockKey)
 	items := strings.Split(holderKey, "/")
@@ -3208,6 +3560,10 @@ func (ss *SemaphoreStatus) LockReleased(holderKey, lockKey string) bool {
 	holdingName := items[len(items)-1]
 	if i >= 0 {
 		semaphoreHolding.Holders = slice.RemoveString(semaphoreHolding.Holders, holdingName)
+		delete(semaphoreHolding.Permits, holdingName)
+		if len(semaphoreHolding.Permits) == 0 {
+			semaphoreHolding.Permits = nil
+		}
 		ss.Holding[i] = semaphoreHolding
 		return true
 	}
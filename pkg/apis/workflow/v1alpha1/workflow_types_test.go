@@ -264,6 +264,16 @@ func TestGCSArtifact(t *testing.T) {
 	assert.Equal(t, "my-key", key)
 }
 
+func TestAzureArtifact(t *testing.T) {
+	a := &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Container: "my-container"}}
+	assert.True(t, a.HasLocation())
+	assert.NoError(t, a.SetKey("my-key"))
+	key, err := a.GetKey()
+	assert.NoError(t, err)
+	assert.Equal(t, "my-key", a.Blob)
+	assert.Equal(t, "my-key", key)
+}
+
 func TestHDFSArtifact(t *testing.T) {
 	a := &HDFSArtifact{HDFSConfig: HDFSConfig{Addresses: []string{"my-address"}}}
 	assert.True(t, a.HasLocation())
@@ -352,6 +362,9 @@ func TestArtifactLocation_Get(t *testing.T) {
 	v, _ = (&ArtifactLocation{GCS: &GCSArtifact{}}).Get()
 	assert.IsType(t, &GCSArtifact{}, v)
 
+	v, _ = (&ArtifactLocation{Azure: &AzureArtifact{}}).Get()
+	assert.IsType(t, &AzureArtifact{}, v)
+
 	v, _ = (&ArtifactLocation{HDFS: &HDFSArtifact{}}).Get()
 	assert.IsType(t, &HDFSArtifact{}, v)
 
@@ -378,6 +391,11 @@ func TestArtifactLocation_SetType(t *testing.T) {
 		assert.NoError(t, l.SetType(&ArtifactoryArtifact{}))
 		assert.NotNil(t, l.Artifactory)
 	})
+	t.Run("Azure", func(t *testing.T) {
+		l := &ArtifactLocation{}
+		assert.NoError(t, l.SetType(&AzureArtifact{}))
+		assert.NotNil(t, l.Azure)
+	})
 	t.Run("GCS", func(t *testing.T) {
 		l := &ArtifactLocation{}
 		assert.NoError(t, l.SetType(&GCSArtifact{}))
@@ -1254,3 +1272,54 @@ func TestGetExecSpec(t *testing.T) {
 
 	assert.Equal(t, wf.GetExecSpec().Templates[0].Name, "spec-template")
 }
+
+func TestWorkflowSpec_HasArtifactGC(t *testing.T) {
+	assert.False(t, (&WorkflowSpec{}).HasArtifactGC())
+	assert.True(t, (&WorkflowSpec{ArtifactGC: &ArtifactGC{Strategy: ArtifactGCOnWorkflowCompletion}}).HasArtifactGC())
+	assert.True(t, (&WorkflowSpec{Templates: []Template{{ArtifactGC: &ArtifactGC{Strategy: ArtfactGCOnWorkflowDeletion}}}}).HasArtifactGC())
+	assert.True(t, (&WorkflowSpec{Templates: []Template{{Outputs: Outputs{Artifacts: Artifacts{{ArtifactGC: &ArtifactGC{Strategy: ArtfactGCOnWorkflowDeletion}}}}}}}).HasArtifactGC())
+}
+
+func TestWorkflow_GetArtifactGCStrategy(t *testing.T) {
+	wf := &Workflow{Spec: WorkflowSpec{
+		ArtifactGC: &ArtifactGC{Strategy: ArtifactGCOnWorkflowCompletion},
+		Templates: []Template{
+			{Name: "inherit"},
+			{Name: "override", ArtifactGC: &ArtifactGC{Strategy: ArtfactGCOnWorkflowDeletion}},
+		},
+	}}
+	t.Run("Workflow", func(t *testing.T) {
+		assert.Equal(t, ArtifactGCOnWorkflowCompletion, wf.GetArtifactGCStrategy(NodeStatus{TemplateName: "inherit"}, &Artifact{}))
+	})
+	t.Run("Template", func(t *testing.T) {
+		assert.Equal(t, ArtfactGCOnWorkflowDeletion, wf.GetArtifactGCStrategy(NodeStatus{TemplateName: "override"}, &Artifact{}))
+	})
+	t.Run("Artifact", func(t *testing.T) {
+		assert.Equal(t, ArtifactGCNever, wf.GetArtifactGCStrategy(NodeStatus{TemplateName: "override"}, &Artifact{ArtifactGC: &ArtifactGC{}}))
+	})
+	t.Run("Deduplicated", func(t *testing.T) {
+		assert.Equal(t, ArtifactGCNever, wf.GetArtifactGCStrategy(NodeStatus{TemplateName: "inherit"}, &Artifact{Deduplicate: true, ArtifactGC: &ArtifactGC{Strategy: ArtifactGCOnWorkflowCompletion}}))
+	})
+	t.Run("WorkflowTemplateRef", func(t *testing.T) {
+		wf := &Workflow{
+			Spec: WorkflowSpec{WorkflowTemplateRef: &WorkflowTemplateRef{Name: "my-wftmpl"}},
+			Status: WorkflowStatus{StoredWorkflowSpec: &WorkflowSpec{
+				ArtifactGC: &ArtifactGC{Strategy: ArtifactGCOnWorkflowCompletion},
+				Templates:  []Template{{Name: "override", ArtifactGC: &ArtifactGC{Strategy: ArtfactGCOnWorkflowDeletion}}},
+			}},
+		}
+		assert.Equal(t, ArtfactGCOnWorkflowDeletion, wf.GetArtifactGCStrategy(NodeStatus{TemplateName: "override"}, &Artifact{}))
+	})
+	t.Run("TemplateRef", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Status.StoredTemplates = map[string]Template{
+			"namespaced/my-wftmpl/inherit": {Name: "inherit", ArtifactGC: &ArtifactGC{Strategy: ArtfactGCOnWorkflowDeletion}},
+			"namespaced/my-wftmpl/main":    {Name: "main"},
+		}
+		// the template of the WorkflowTemplate is used, rather than the workflow's template with the same name
+		assert.Equal(t, ArtfactGCOnWorkflowDeletion, wf.GetArtifactGCStrategy(NodeStatus{TemplateRef: &TemplateRef{Name: "my-wftmpl", Template: "inherit"}}, &Artifact{}))
+		// templates called inside the WorkflowTemplate are stored too
+		assert.Equal(t, ArtifactGCOnWorkflowCompletion, wf.GetArtifactGCStrategy(NodeStatus{TemplateName: "main", TemplateScope: "namespaced/my-wftmpl"}, &Artifact{}))
+		assert.Equal(t, ArtfactGCOnWorkflowDeletion, wf.GetArtifactGCStrategy(NodeStatus{TemplateName: "inherit", TemplateScope: "namespaced/my-wftmpl"}, &Artifact{}))
+	})
+}
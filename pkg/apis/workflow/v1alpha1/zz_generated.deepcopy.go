@@ -49,6 +49,11 @@ func (in *ArchiveStrategy) DeepCopyInto(out *ArchiveStrategy) {
 		*out = new(ZipStrategy)
 		**out = **in
 	}
+	if in.Zstd != nil {
+		in, out := &in.Zstd, &out.Zstd
+		*out = new(ZstdStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -106,6 +111,11 @@ func (in *Artifact) DeepCopyInto(out *Artifact) {
 		*out = new(ArchiveStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ArtifactGC != nil {
+		in, out := &in.ArtifactGC, &out.ArtifactGC
+		*out = new(ArtifactGC)
+		**out = **in
+	}
 	return
 }
 
@@ -119,6 +129,22 @@ func (in *Artifact) DeepCopy() *Artifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactCache) DeepCopyInto(out *ArtifactCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactCache.
+func (in *ArtifactCache) DeepCopy() *ArtifactCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactGC) DeepCopyInto(out *ArtifactGC) {
 	*out = *in
@@ -183,6 +209,11 @@ func (in *ArtifactLocation) DeepCopyInto(out *ArtifactLocation) {
 		*out = new(GCSArtifact)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureArtifact)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -246,6 +277,11 @@ func (in *ArtifactRepository) DeepCopyInto(out *ArtifactRepository) {
 		*out = new(GCSArtifactRepository)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureArtifactRepository)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -297,6 +333,49 @@ func (in *ArtifactRepositoryRefStatus) DeepCopy() *ArtifactRepositoryRefStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactTransfer) DeepCopyInto(out *ArtifactTransfer) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactTransfer.
+func (in *ArtifactTransfer) DeepCopy() *ArtifactTransfer {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactTransfer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactTransfers) DeepCopyInto(out *ArtifactTransfers) {
+	*out = *in
+	if in.Loads != nil {
+		in, out := &in.Loads, &out.Loads
+		*out = make([]ArtifactTransfer, len(*in))
+		copy(*out, *in)
+	}
+	if in.Saves != nil {
+		in, out := &in.Saves, &out.Saves
+		*out = make([]ArtifactTransfer, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactTransfers.
+func (in *ArtifactTransfers) DeepCopy() *ArtifactTransfers {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactTransfers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArtifactoryArtifact) DeepCopyInto(out *ArtifactoryArtifact) {
 	*out = *in
@@ -379,6 +458,66 @@ func (in Artifacts) DeepCopy() Artifacts {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureArtifact) DeepCopyInto(out *AzureArtifact) {
+	*out = *in
+	in.AzureBlobContainer.DeepCopyInto(&out.AzureBlobContainer)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureArtifact.
+func (in *AzureArtifact) DeepCopy() *AzureArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureArtifactRepository) DeepCopyInto(out *AzureArtifactRepository) {
+	*out = *in
+	in.AzureBlobContainer.DeepCopyInto(&out.AzureBlobContainer)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureArtifactRepository.
+func (in *AzureArtifactRepository) DeepCopy() *AzureArtifactRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureArtifactRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobContainer) DeepCopyInto(out *AzureBlobContainer) {
+	*out = *in
+	if in.AccountKeySecret != nil {
+		in, out := &in.AccountKeySecret, &out.AccountKeySecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASTokenSecret != nil {
+		in, out := &in.SASTokenSecret, &out.SASTokenSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobContainer.
+func (in *AzureBlobContainer) DeepCopy() *AzureBlobContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -434,6 +573,16 @@ func (in *Cache) DeepCopyInto(out *Cache) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLCache)
+		**out = **in
+	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ArtifactCache)
+		**out = **in
+	}
 	return
 }
 
@@ -447,6 +596,22 @@ func (in *Cache) DeepCopy() *Cache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChunkTransformation) DeepCopyInto(out *ChunkTransformation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChunkTransformation.
+func (in *ChunkTransformation) DeepCopy() *ChunkTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(ChunkTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterWorkflowTemplate) DeepCopyInto(out *ClusterWorkflowTemplate) {
 	*out = *in
@@ -643,6 +808,22 @@ func (in *ContainerSetTemplate) DeepCopy() *ContainerSetTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerTermination) DeepCopyInto(out *ContainerTermination) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerTermination.
+func (in *ContainerTermination) DeepCopy() *ContainerTermination {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerTermination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContinueOn) DeepCopyInto(out *ContinueOn) {
 	*out = *in
@@ -719,6 +900,81 @@ func (in *CronWorkflow) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowBackfill) DeepCopyInto(out *CronWorkflowBackfill) {
+	*out = *in
+	in.From.DeepCopyInto(&out.From)
+	in.To.DeepCopyInto(&out.To)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowBackfill.
+func (in *CronWorkflowBackfill) DeepCopy() *CronWorkflowBackfill {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowBackfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowBackfillStatus) DeepCopyInto(out *CronWorkflowBackfillStatus) {
+	*out = *in
+	in.CronWorkflowBackfill.DeepCopyInto(&out.CronWorkflowBackfill)
+	if in.NextScheduledTime != nil {
+		in, out := &in.NextScheduledTime, &out.NextScheduledTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowBackfillStatus.
+func (in *CronWorkflowBackfillStatus) DeepCopy() *CronWorkflowBackfillStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowBackfillStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowExclusions) DeepCopyInto(out *CronWorkflowExclusions) {
+	*out = *in
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowExclusions.
+func (in *CronWorkflowExclusions) DeepCopy() *CronWorkflowExclusions {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowExclusions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflowList) DeepCopyInto(out *CronWorkflowList) {
 	*out = *in
@@ -752,6 +1008,23 @@ func (in *CronWorkflowList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronWorkflowSkip) DeepCopyInto(out *CronWorkflowSkip) {
+	*out = *in
+	in.ScheduledTime.DeepCopyInto(&out.ScheduledTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronWorkflowSkip.
+func (in *CronWorkflowSkip) DeepCopy() *CronWorkflowSkip {
+	if in == nil {
+		return nil
+	}
+	out := new(CronWorkflowSkip)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 	*out = *in
@@ -776,6 +1049,21 @@ func (in *CronWorkflowSpec) DeepCopyInto(out *CronWorkflowSpec) {
 		*out = new(metav1.ObjectMeta)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = new(CronWorkflowExclusions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StopStrategy != nil {
+		in, out := &in.StopStrategy, &out.StopStrategy
+		*out = new(StopStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -806,6 +1094,16 @@ func (in *CronWorkflowStatus) DeepCopyInto(out *CronWorkflowStatus) {
 		*out = make(Conditions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Backfill != nil {
+		in, out := &in.Backfill, &out.Backfill
+		*out = new(CronWorkflowBackfillStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSkipped != nil {
+		in, out := &in.LastSkipped, &out.LastSkipped
+		*out = new(CronWorkflowSkip)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -910,7 +1208,9 @@ func (in *Data) DeepCopyInto(out *Data) {
 	if in.Transformation != nil {
 		in, out := &in.Transformation, &out.Transformation
 		*out = make(Transformation, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -933,6 +1233,33 @@ func (in *DataSource) DeepCopyInto(out *DataSource) {
 		*out = new(ArtifactPaths)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]Parameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(ResourcesSource)
+		**out = **in
+	}
 	return
 }
 
@@ -946,6 +1273,22 @@ func (in *DataSource) DeepCopy() *DataSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EstimatedDurationPercentiles) DeepCopyInto(out *EstimatedDurationPercentiles) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EstimatedDurationPercentiles.
+func (in *EstimatedDurationPercentiles) DeepCopy() *EstimatedDurationPercentiles {
+	if in == nil {
+		return nil
+	}
+	out := new(EstimatedDurationPercentiles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -1095,6 +1438,22 @@ func (in *GitArtifact) DeepCopy() *GitArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupByTransformation) DeepCopyInto(out *GroupByTransformation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupByTransformation.
+func (in *GroupByTransformation) DeepCopy() *GroupByTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupByTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HDFSArtifact) DeepCopyInto(out *HDFSArtifact) {
 	*out = *in
@@ -1667,6 +2026,16 @@ func (in *NodeResult) DeepCopyInto(out *NodeResult) {
 		*out = new(Outputs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SharedArtifactCache != nil {
+		in, out := &in.SharedArtifactCache, &out.SharedArtifactCache
+		*out = new(SharedArtifactCacheStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactTransfers != nil {
+		in, out := &in.ArtifactTransfers, &out.ArtifactTransfers
+		*out = new(ArtifactTransfers)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1732,6 +2101,33 @@ func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 		*out = new(NodeSynchronizationStatus)
 		**out = **in
 	}
+	if in.SharedArtifactCache != nil {
+		in, out := &in.SharedArtifactCache, &out.SharedArtifactCache
+		*out = new(SharedArtifactCacheStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ArtifactTransfers != nil {
+		in, out := &in.ArtifactTransfers, &out.ArtifactTransfers
+		*out = new(ArtifactTransfers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Termination != nil {
+		in, out := &in.Termination, &out.Termination
+		*out = new(NodeTermination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryDecision != nil {
+		in, out := &in.RetryDecision, &out.RetryDecision
+		*out = new(RetryDecision)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(map[string]v1.ResourceRequirements, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -1761,6 +2157,27 @@ func (in *NodeSynchronizationStatus) DeepCopy() *NodeSynchronizationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTermination) DeepCopyInto(out *NodeTermination) {
+	*out = *in
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerTermination, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTermination.
+func (in *NodeTermination) DeepCopy() *NodeTermination {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTermination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Nodes) DeepCopyInto(out *Nodes) {
 	{
@@ -2005,6 +2422,22 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParseTransformation) DeepCopyInto(out *ParseTransformation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParseTransformation.
+func (in *ParseTransformation) DeepCopy() *ParseTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(ParseTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Plugin) DeepCopyInto(out *Plugin) {
 	*out = *in
@@ -2144,6 +2577,22 @@ func (in ResourcesDuration) DeepCopy() ResourcesDuration {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcesSource) DeepCopyInto(out *ResourcesSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesSource.
+func (in *ResourcesSource) DeepCopy() *ResourcesSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcesSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryAffinity) DeepCopyInto(out *RetryAffinity) {
 	*out = *in
@@ -2165,6 +2614,48 @@ func (in *RetryAffinity) DeepCopy() *RetryAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryDecision) DeepCopyInto(out *RetryDecision) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryDecision.
+func (in *RetryDecision) DeepCopy() *RetryDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryMatcher) DeepCopyInto(out *RetryMatcher) {
+	*out = *in
+	if in.ExitCodes != nil {
+		in, out := &in.ExitCodes, &out.ExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryMatcher.
+func (in *RetryMatcher) DeepCopy() *RetryMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryNodeAntiAffinity) DeepCopyInto(out *RetryNodeAntiAffinity) {
 	*out = *in
@@ -2181,6 +2672,46 @@ func (in *RetryNodeAntiAffinity) DeepCopy() *RetryNodeAntiAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryResources) DeepCopyInto(out *RetryResources) {
+	*out = *in
+	if in.EscalateOn != nil {
+		in, out := &in.EscalateOn, &out.EscalateOn
+		*out = new(RetryMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(Amount)
+		**out = **in
+	}
+	if in.Increase != nil {
+		in, out := &in.Increase, &out.Increase
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryResources.
+func (in *RetryResources) DeepCopy() *RetryResources {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 	*out = *in
@@ -2199,6 +2730,21 @@ func (in *RetryStrategy) DeepCopyInto(out *RetryStrategy) {
 		*out = new(RetryAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = new(RetryMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DoNotRetryOn != nil {
+		in, out := &in.DoNotRetryOn, &out.DoNotRetryOn
+		*out = new(RetryMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(RetryResources)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2308,6 +2854,22 @@ func (in *S3EncryptionOptions) DeepCopy() *S3EncryptionOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLCache) DeepCopyInto(out *SQLCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLCache.
+func (in *SQLCache) DeepCopy() *SQLCache {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScriptTemplate) DeepCopyInto(out *ScriptTemplate) {
 	*out = *in
@@ -2333,6 +2895,13 @@ func (in *SemaphoreHolding) DeepCopyInto(out *SemaphoreHolding) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Permits != nil {
+		in, out := &in.Permits, &out.Permits
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2354,6 +2923,21 @@ func (in *SemaphoreRef) DeepCopyInto(out *SemaphoreRef) {
 		*out = new(v1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(SyncDatabaseRef)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(SyncHTTPRef)
+		**out = **in
+	}
+	if in.Permits != nil {
+		in, out := &in.Permits, &out.Permits
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	return
 }
 
@@ -2428,6 +3012,68 @@ func (in *Sequence) DeepCopy() *Sequence {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedArtifactCacheStatus) DeepCopyInto(out *SharedArtifactCacheStatus) {
+	*out = *in
+	if in.Hits != nil {
+		in, out := &in.Hits, &out.Hits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Misses != nil {
+		in, out := &in.Misses, &out.Misses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedArtifactCacheStatus.
+func (in *SharedArtifactCacheStatus) DeepCopy() *SharedArtifactCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedArtifactCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SortTransformation) DeepCopyInto(out *SortTransformation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SortTransformation.
+func (in *SortTransformation) DeepCopy() *SortTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(SortTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StopStrategy) DeepCopyInto(out *StopStrategy) {
+	*out = *in
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StopStrategy.
+func (in *StopStrategy) DeepCopy() *StopStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(StopStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Submit) DeepCopyInto(out *Submit) {
 	*out = *in
@@ -2514,6 +3160,38 @@ func (in *SuspendTemplate) DeepCopy() *SuspendTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncDatabaseRef) DeepCopyInto(out *SyncDatabaseRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncDatabaseRef.
+func (in *SyncDatabaseRef) DeepCopy() *SyncDatabaseRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncDatabaseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncHTTPRef) DeepCopyInto(out *SyncHTTPRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncHTTPRef.
+func (in *SyncHTTPRef) DeepCopy() *SyncHTTPRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncHTTPRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Synchronization) DeepCopyInto(out *Synchronization) {
 	*out = *in
@@ -2827,7 +3505,9 @@ func (in Transformation) DeepCopyInto(out *Transformation) {
 	{
 		in := &in
 		*out = make(Transformation, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 		return
 	}
 }
@@ -2845,6 +3525,31 @@ func (in Transformation) DeepCopy() Transformation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransformationStep) DeepCopyInto(out *TransformationStep) {
 	*out = *in
+	if in.Parse != nil {
+		in, out := &in.Parse, &out.Parse
+		*out = new(ParseTransformation)
+		**out = **in
+	}
+	if in.Sort != nil {
+		in, out := &in.Sort, &out.Sort
+		*out = new(SortTransformation)
+		**out = **in
+	}
+	if in.Unique != nil {
+		in, out := &in.Unique, &out.Unique
+		*out = new(UniqueTransformation)
+		**out = **in
+	}
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = new(GroupByTransformation)
+		**out = **in
+	}
+	if in.Chunk != nil {
+		in, out := &in.Chunk, &out.Chunk
+		*out = new(ChunkTransformation)
+		**out = **in
+	}
 	return
 }
 
@@ -2858,6 +3563,22 @@ func (in *TransformationStep) DeepCopy() *TransformationStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UniqueTransformation) DeepCopyInto(out *UniqueTransformation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UniqueTransformation.
+func (in *UniqueTransformation) DeepCopy() *UniqueTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(UniqueTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserContainer) DeepCopyInto(out *UserContainer) {
 	*out = *in
@@ -3329,6 +4050,18 @@ func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 	*out = *in
 	in.StartedAt.DeepCopyInto(&out.StartedAt)
 	in.FinishedAt.DeepCopyInto(&out.FinishedAt)
+	if in.EstimatedDurationPercentiles != nil {
+		in, out := &in.EstimatedDurationPercentiles, &out.EstimatedDurationPercentiles
+		*out = new(EstimatedDurationPercentiles)
+		**out = **in
+	}
+	if in.TemplateEstimatedDurationPercentiles != nil {
+		in, out := &in.TemplateEstimatedDurationPercentiles, &out.TemplateEstimatedDurationPercentiles
+		*out = make(map[string]EstimatedDurationPercentiles, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Nodes != nil {
 		in, out := &in.Nodes, &out.Nodes
 		*out = make(Nodes, len(*in))
@@ -3748,3 +4481,24 @@ func (in *ZipStrategy) DeepCopy() *ZipStrategy {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZstdStrategy) DeepCopyInto(out *ZstdStrategy) {
+	*out = *in
+	if in.CompressionLevel != nil {
+		in, out := &in.CompressionLevel, &out.CompressionLevel
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZstdStrategy.
+func (in *ZstdStrategy) DeepCopy() *ZstdStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ZstdStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -2,11 +2,14 @@ package artifacts
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"path"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -197,6 +200,13 @@ func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWrit
 
 	key, _ := art.GetKey()
 	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(key)))
+	if art.Digest != "" {
+		// RFC 3230, so that clients can verify the artifact
+		if digest, err := hex.DecodeString(strings.TrimPrefix(art.Digest, "sha256:")); err == nil {
+			w.Header().Add("Digest", "sha-256="+base64.StdEncoding.EncodeToString(digest))
+			w.Header().Add("Content-Length", strconv.FormatInt(art.SizeBytes, 10))
+		}
+	}
 
 	_, err = io.Copy(w, stream)
 	if err != nil {
@@ -89,6 +89,16 @@ func newServer() *ArtifactServer {
 									},
 								},
 							},
+							{
+								Name: "my-digested-artifact",
+								ArtifactLocation: wfv1.ArtifactLocation{
+									S3: &wfv1.S3Artifact{
+										Key: "my-wf/my-node/my-digested-artifact.tgz",
+									},
+								},
+								Digest:    "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385",
+								SizeBytes: 7,
+							},
 							{
 								Name: "my-gcs-artifact",
 								ArtifactLocation: wfv1.ArtifactLocation{
@@ -185,6 +195,23 @@ func TestArtifactServer_GetOutputArtifact(t *testing.T) {
 	}
 }
 
+func TestArtifactServer_GetOutputArtifactWithDigest(t *testing.T) {
+	s := newServer()
+	r := &http.Request{}
+	r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-digested-artifact")
+	recorder := httptest.NewRecorder()
+
+	s.GetOutputArtifact(recorder, r)
+	if assert.Equal(t, 200, recorder.Result().StatusCode) {
+		assert.Equal(t, "sha-256=wLgRSoCdlLVI4/CYtLdrFYno6mKX3HlbE3ffLJkFU4U=", recorder.Header().Get("Digest"))
+		assert.Equal(t, "7", recorder.Header().Get("Content-Length"))
+	}
+	r.URL = mustParse("/artifacts/my-ns/my-wf/my-node/my-s3-artifact")
+	recorder = httptest.NewRecorder()
+	s.GetOutputArtifact(recorder, r)
+	assert.Empty(t, recorder.Header().Get("Digest"))
+}
+
 func TestArtifactServer_GetInputArtifact(t *testing.T) {
 	s := newServer()
 
@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/server/auth"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
+	"github.com/argoproj/argo-workflows/v3/workflow/cron"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
@@ -112,6 +116,32 @@ func (c *cronWorkflowServiceServer) SuspendCronWorkflow(ctx context.Context, req
 	return setCronWorkflowSuspend(ctx, true, req.Namespace, req.Name)
 }
 
+// BackfillCronWorkflow starts a backfill of the schedules of a CronWorkflow from one time to another, which the
+// controller runs. The end of the backfill defaults to the time of the server, as that of the client may be ahead of it
+func (c *cronWorkflowServiceServer) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.CronWorkflowBackfillRequest) (*v1alpha1.CronWorkflow, error) {
+	cronWf, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	backfill := v1alpha1.CronWorkflowBackfill{To: metav1.NewTime(now), Parallelism: req.Parallelism}
+	if req.From != nil {
+		backfill.From = *req.From
+	}
+	if req.To != nil {
+		backfill.To = *req.To
+	}
+	backfillStatus, err := cron.NewBackfillStatus(cronWf, backfill, now)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	data, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"backfill": cron.BackfillStatusPatch(backfillStatus)}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshall cron workflow patch data: %w", err)
+	}
+	return auth.GetWfClient(ctx).ArgoprojV1alpha1().CronWorkflows(req.Namespace).Patch(ctx, req.Name, types.MergePatchType, data, metav1.PatchOptions{})
+}
+
 func setCronWorkflowSuspend(ctx context.Context, setTo bool, namespace, name string) (*v1alpha1.CronWorkflow, error) {
 	data, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"suspend": setTo}})
 	if err != nil {
@@ -3,9 +3,13 @@ package cronworkflow
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v3/jwt"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cronworkflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -112,6 +116,29 @@ metadata:
 			assert.Error(t, err)
 		})
 	})
+	t.Run("BackfillCronWorkflow", func(t *testing.T) {
+		from := metav1.NewTime(time.Now().Add(-time.Hour))
+		t.Run("Invalid", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Namespace: "my-ns", Name: "my-name"})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+		t.Run("Labelled", func(t *testing.T) {
+			// the end of the backfill defaults to now
+			cronWf, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Namespace: "my-ns", Name: "my-name", From: &from, Parallelism: 2})
+			if assert.NoError(t, err) && assert.NotNil(t, cronWf.Status.Backfill) {
+				assert.True(t, cronWf.Status.Backfill.Running())
+				assert.Equal(t, int32(2), cronWf.Status.Backfill.Parallelism)
+				assert.WithinDuration(t, time.Now(), cronWf.Status.Backfill.To.Time, time.Minute)
+			}
+			to := metav1.Now()
+			_, err = server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Namespace: "my-ns", Name: "my-name", From: &from, To: &to})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "a backfill is already running")
+		})
+		t.Run("Unlabelled", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.CronWorkflowBackfillRequest{Namespace: "my-ns", Name: "unlabelled"})
+			assert.Error(t, err)
+		})
+	})
 	t.Run("DeleteCronWorkflow", func(t *testing.T) {
 		t.Run("Labelled", func(t *testing.T) {
 			_, err := server.DeleteCronWorkflow(ctx, &cronworkflowpkg.DeleteCronWorkflowRequest{Name: "my-name", Namespace: "my-ns"})
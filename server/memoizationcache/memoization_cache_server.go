@@ -0,0 +1,242 @@
+package memoizationcache
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
+)
+
+var cacheTypes = []cache.CacheType{cache.ConfigMapCache, cache.SQLCache, cache.ArtifactCache}
+
+type memoizationCacheServer struct {
+	session          sqlbuilder.Database
+	clusterName      string
+	location         *wfv1.ArtifactLocation
+	artDriverFactory artifact.NewDriverFunc
+}
+
+// NewMemoizationCacheServer returns a new memoizationCacheServer. The session and location are those the controller
+// uses for SQL and artifact caches, either may be nil if that type of cache is not configured.
+func NewMemoizationCacheServer(session sqlbuilder.Database, clusterName string, location *wfv1.ArtifactLocation) memoizationcachepkg.MemoizationCacheServiceServer {
+	return newMemoizationCacheServer(session, clusterName, location, artifact.NewDriver)
+}
+
+func newMemoizationCacheServer(session sqlbuilder.Database, clusterName string, location *wfv1.ArtifactLocation, artDriverFactory artifact.NewDriverFunc) *memoizationCacheServer {
+	return &memoizationCacheServer{session: session, clusterName: clusterName, location: location, artDriverFactory: artDriverFactory}
+}
+
+func (s *memoizationCacheServer) ListMemoizationCacheEntries(ctx context.Context, req *memoizationcachepkg.ListMemoizationCacheEntriesRequest) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	if err := canI(ctx, "list", req.Namespace); err != nil {
+		return nil, err
+	}
+	items, err := s.listEntries(ctx, req.Namespace, req.CacheType, req.CacheName)
+	if err != nil {
+		return nil, err
+	}
+	return &memoizationcachepkg.MemoizationCacheEntryList{Items: items}, nil
+}
+
+func (s *memoizationCacheServer) GetMemoizationCacheEntry(ctx context.Context, req *memoizationcachepkg.GetMemoizationCacheEntryRequest) (*memoizationcachepkg.MemoizationCacheEntry, error) {
+	if req.CacheName == "" || req.Key == "" {
+		return nil, status.Error(codes.InvalidArgument, "cache name and key are required")
+	}
+	if err := canI(ctx, "get", req.Namespace); err != nil {
+		return nil, err
+	}
+	items, err := s.listEntries(ctx, req.Namespace, req.CacheType, req.CacheName)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Key == req.Key {
+			return item, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, fmt.Sprintf("entry %q not found in cache %q", req.Key, req.CacheName))
+}
+
+func (s *memoizationCacheServer) DeleteMemoizationCacheEntries(ctx context.Context, req *memoizationcachepkg.DeleteMemoizationCacheEntriesRequest) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	if req.CacheName == "" {
+		return nil, status.Error(codes.InvalidArgument, "cache name is required")
+	}
+	if len(req.Keys) == 0 && req.KeyRegex == "" {
+		return nil, status.Error(codes.InvalidArgument, "either keys or a key regex is required")
+	}
+	var keyRegex *regexp.Regexp
+	if req.KeyRegex != "" {
+		var err error
+		keyRegex, err = regexp.Compile(req.KeyRegex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid key regex: %v", err))
+		}
+	}
+	keys := make(map[string]bool, len(req.Keys))
+	for _, key := range req.Keys {
+		keys[key] = true
+	}
+	return s.deleteEntries(ctx, req.Namespace, req.CacheType, req.CacheName, func(item *memoizationcachepkg.MemoizationCacheEntry) bool {
+		return keys[item.Key] || (keyRegex != nil && keyRegex.MatchString(item.Key))
+	})
+}
+
+func (s *memoizationCacheServer) PruneMemoizationCacheEntries(ctx context.Context, req *memoizationcachepkg.PruneMemoizationCacheEntriesRequest) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	olderThan, err := time.ParseDuration(req.OlderThan)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid duration %q: %v", req.OlderThan, err))
+	}
+	createdBefore := time.Now().Add(-olderThan)
+	return s.deleteEntries(ctx, req.Namespace, req.CacheType, req.CacheName, func(item *memoizationcachepkg.MemoizationCacheEntry) bool {
+		return item.CreationTimestamp == nil || item.CreationTimestamp.Time.Before(createdBefore)
+	})
+}
+
+// deleteEntries deletes the entries that match, and returns them
+func (s *memoizationCacheServer) deleteEntries(ctx context.Context, namespace, cacheType, cacheName string, matches func(*memoizationcachepkg.MemoizationCacheEntry) bool) (*memoizationcachepkg.MemoizationCacheEntryList, error) {
+	// entries of ConfigMap caches are deleted by updating the config map
+	if err := canI(ctx, "update", namespace); err != nil {
+		return nil, err
+	}
+	items, err := s.listEntries(ctx, namespace, cacheType, cacheName)
+	if err != nil {
+		return nil, err
+	}
+	factory := s.newCacheFactory(ctx, namespace)
+	keys := make(map[cache.CacheType]map[string][]string)
+	deleted := make([]*memoizationcachepkg.MemoizationCacheEntry, 0)
+	for _, item := range items {
+		if !matches(item) {
+			continue
+		}
+		ct := cache.CacheType(item.CacheType)
+		if keys[ct] == nil {
+			keys[ct] = make(map[string][]string)
+		}
+		keys[ct][item.CacheName] = append(keys[ct][item.CacheName], item.Key)
+		deleted = append(deleted, item)
+	}
+	for ct, caches := range keys {
+		for name, cacheKeys := range caches {
+			if err := factory.GetCache(ct, name).Delete(ctx, cacheKeys); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &memoizationcachepkg.MemoizationCacheEntryList{Items: deleted}, nil
+}
+
+// listEntries returns the entries of the caches of the given type and name, or of all of them if they are empty.
+// Entries are sorted by cache type, cache name and key.
+func (s *memoizationCacheServer) listEntries(ctx context.Context, namespace, cacheType, cacheName string) ([]*memoizationcachepkg.MemoizationCacheEntry, error) {
+	types, err := s.cacheTypes(cacheType)
+	if err != nil {
+		return nil, err
+	}
+	factory := s.newCacheFactory(ctx, namespace)
+	items := make([]*memoizationcachepkg.MemoizationCacheEntry, 0)
+	for _, ct := range types {
+		names := []string{cacheName}
+		if cacheName == "" {
+			names, err = factory.ListCacheNames(ctx, ct)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, name := range names {
+			c := factory.GetCache(ct, name)
+			if c == nil {
+				continue
+			}
+			entries, err := c.List(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for key, entry := range entries {
+				items = append(items, newEntry(ct, name, key, entry))
+			}
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		x, y := items[i], items[j]
+		if x.CacheType != y.CacheType {
+			return x.CacheType < y.CacheType
+		}
+		if x.CacheName != y.CacheName {
+			return x.CacheName < y.CacheName
+		}
+		return x.Key < y.Key
+	})
+	return items, nil
+}
+
+// cacheTypes returns the configured cache types matching the requested type, which may be empty to mean all of them
+func (s *memoizationCacheServer) cacheTypes(cacheType string) ([]cache.CacheType, error) {
+	var types []cache.CacheType
+	for _, ct := range cacheTypes {
+		if cacheType != "" && cacheType != string(ct) {
+			continue
+		}
+		if (ct == cache.SQLCache && s.session == nil) || (ct == cache.ArtifactCache && s.location == nil) {
+			if cacheType != "" {
+				return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%s is not configured", ct))
+			}
+			continue
+		}
+		types = append(types, ct)
+	}
+	if cacheType != "" && len(types) == 0 {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown cache type %q", cacheType))
+	}
+	return types, nil
+}
+
+// newCacheFactory returns a factory that accesses caches using the user's Kubernetes client
+func (s *memoizationCacheServer) newCacheFactory(ctx context.Context, namespace string) cache.Factory {
+	kubeClient := auth.GetKubeClient(ctx)
+	factory := cache.NewCacheFactory(kubeClient, namespace)
+	if s.session != nil {
+		factory.SetSQLSession(s.session, s.clusterName)
+	}
+	if s.location != nil {
+		factory.SetArtifactLocation(s.location, s.artDriverFactory, resources{kubeClient, namespace})
+	}
+	return factory
+}
+
+func newEntry(ct cache.CacheType, name, key string, entry *cache.Entry) *memoizationcachepkg.MemoizationCacheEntry {
+	creationTimestamp := entry.CreationTimestamp
+	lastHitTimestamp := entry.LastHitTimestamp
+	return &memoizationcachepkg.MemoizationCacheEntry{
+		CacheType:         string(ct),
+		CacheName:         name,
+		Key:               key,
+		NodeID:            entry.NodeID,
+		Outputs:           entry.Outputs,
+		CreationTimestamp: &creationTimestamp,
+		LastHitTimestamp:  &lastHitTimestamp,
+	}
+}
+
+// canI checks the user may perform the verb on config maps in the namespace, as memoization caches are config maps
+// unless they are stored in the database or artifact repository
+func canI(ctx context.Context, verb, namespace string) error {
+	allowed, err := auth.CanI(ctx, verb, "configmaps", namespace, "")
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Permission denied, you are not allowed to %s memoization caches (configmaps) in namespace \"%s\"", verb, namespace))
+	}
+	return nil
+}
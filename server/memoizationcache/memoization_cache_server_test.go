@@ -0,0 +1,124 @@
+package memoizationcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	memoizationcachepkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/memoizationcache"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func newCacheConfigMap(name string, entries map[string]time.Time) *apiv1.ConfigMap {
+	data := make(map[string]string)
+	for key, created := range entries {
+		data[key] = `{"nodeID":"node-` + key + `","outputs":{"parameters":[{"name":"hello","value":"world"}]},"creationTimestamp":"` + created.UTC().Format(time.RFC3339) + `","lastHitTimestamp":"` + created.UTC().Format(time.RFC3339) + `"}`
+	}
+	return &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "my-ns",
+			Labels:    map[string]string{common.LabelKeyConfigMapType: common.LabelValueTypeConfigMapCache},
+		},
+		Data: data,
+	}
+}
+
+func keys(list *memoizationcachepkg.MemoizationCacheEntryList) []string {
+	var keys []string
+	for _, item := range list.Items {
+		keys = append(keys, item.CacheName+"/"+item.Key)
+	}
+	return keys
+}
+
+func Test_memoizationCacheServer(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(-time.Hour)
+	kubeClient := kubefake.NewSimpleClientset(
+		newCacheConfigMap("cache-a", map[string]time.Time{"key-1": old, "key-2": recent, "other": recent}),
+		newCacheConfigMap("cache-b", map[string]time.Time{"key-1": old}),
+	)
+	allowed := true
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+	s := NewMemoizationCacheServer(nil, "", nil)
+
+	t.Run("ListMemoizationCacheEntries", func(t *testing.T) {
+		allowed = false
+		_, err := s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "my-ns"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		list, err := s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"cache-a/key-1", "cache-a/key-2", "cache-a/other", "cache-b/key-1"}, keys(list))
+			assert.Equal(t, "ConfigMapCache", list.Items[0].CacheType)
+			assert.Equal(t, "node-key-1", list.Items[0].NodeID)
+		}
+		list, err = s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "my-ns", CacheName: "cache-b"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"cache-b/key-1"}, keys(list))
+		}
+		_, err = s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "my-ns", CacheType: "SQLCache"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "my-ns", CacheType: "Unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("GetMemoizationCacheEntry", func(t *testing.T) {
+		entry, err := s.GetMemoizationCacheEntry(ctx, &memoizationcachepkg.GetMemoizationCacheEntryRequest{Namespace: "my-ns", CacheName: "cache-a", Key: "key-2"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "node-key-2", entry.NodeID)
+			assert.Equal(t, "world", entry.Outputs.Parameters[0].Value.String())
+		}
+		_, err = s.GetMemoizationCacheEntry(ctx, &memoizationcachepkg.GetMemoizationCacheEntryRequest{Namespace: "my-ns", CacheName: "cache-a", Key: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("DeleteMemoizationCacheEntries", func(t *testing.T) {
+		_, err := s.DeleteMemoizationCacheEntries(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntriesRequest{Namespace: "my-ns", CacheName: "cache-a"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		allowed = false
+		_, err = s.DeleteMemoizationCacheEntries(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntriesRequest{Namespace: "my-ns", CacheName: "cache-a", Keys: []string{"other"}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		allowed = true
+		list, err := s.DeleteMemoizationCacheEntries(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntriesRequest{Namespace: "my-ns", CacheName: "cache-a", Keys: []string{"other"}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"cache-a/other"}, keys(list))
+		}
+		list, err = s.DeleteMemoizationCacheEntries(ctx, &memoizationcachepkg.DeleteMemoizationCacheEntriesRequest{Namespace: "my-ns", CacheName: "cache-a", KeyRegex: "^key-2$"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"cache-a/key-2"}, keys(list))
+		}
+		cm, err := kubeClient.CoreV1().ConfigMaps("my-ns").Get(ctx, "cache-a", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Len(t, cm.Data, 1)
+			assert.Contains(t, cm.Data, "key-1")
+		}
+	})
+	t.Run("PruneMemoizationCacheEntries", func(t *testing.T) {
+		_, err := s.PruneMemoizationCacheEntries(ctx, &memoizationcachepkg.PruneMemoizationCacheEntriesRequest{Namespace: "my-ns", OlderThan: "a while"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		list, err := s.PruneMemoizationCacheEntries(ctx, &memoizationcachepkg.PruneMemoizationCacheEntriesRequest{Namespace: "my-ns", OlderThan: "24h"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"cache-a/key-1", "cache-b/key-1"}, keys(list))
+		}
+		list, err = s.ListMemoizationCacheEntries(ctx, &memoizationcachepkg.ListMemoizationCacheEntriesRequest{Namespace: "my-ns"})
+		if assert.NoError(t, err) {
+			assert.Empty(t, list.Items)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package workflow
+
+import (
+	"bufio"
+	"context"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// logArchive reads the logs of deleted pods from the log sink, and from the main logs artifacts of pods whose logs are
+// not in the sink. Artifacts do not have the times of lines, so their lines are timed at the start of their node. The
+// artifacts of live pods are not read.
+type logArchive struct {
+	hydrator             hydrator.Interface
+	logSink              sink.Sink
+	artifactRepositories artifactrepositories.Interface
+	artDriverFactory     artifact.NewDriverFunc
+}
+
+func (a *logArchive) Read(ctx context.Context, wf *wfv1.Workflow, query sink.Query, livePods map[string]bool) ([]sink.Entry, error) {
+	var entries []sink.Entry
+	if a.logSink != nil {
+		var err error
+		entries, err = a.logSink.Read(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if a.artifactRepositories == nil || query.Container != common.MainContainerName {
+		return entries, nil
+	}
+	inSink := make(map[string]bool)
+	for _, e := range entries {
+		inSink[e.PodName] = true
+	}
+	wf = wf.DeepCopy()
+	if err := a.hydrator.Hydrate(wf); err != nil {
+		return nil, err
+	}
+	podNameVersion := util.GetWorkflowPodNameVersion(wf)
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || node.Outputs == nil {
+			continue
+		}
+		art := node.Outputs.GetArtifactByName(wfv1.MainLogsArtifactName)
+		if art == nil {
+			continue
+		}
+		templateName := node.TemplateName
+		if node.TemplateRef != nil {
+			templateName = node.TemplateRef.Template
+		}
+		podName := util.PodName(wf.Name, node.Name, templateName, node.ID, podNameVersion)
+		if inSink[podName] || livePods[podName] || query.PodName != "" && podName != query.PodName || !node.FinishedAt.IsZero() && node.FinishedAt.Time.Before(query.Since) {
+			continue
+		}
+		lines, err := a.readArtifact(ctx, wf, art.DeepCopy())
+		if err != nil {
+			// e.g. the artifact was garbage collected
+			log.WithError(err).WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "podName": podName}).Warn("failed to read log artifact")
+			continue
+		}
+		for _, line := range lines {
+			entries = append(entries, sink.Entry{Time: node.StartedAt.Time, Namespace: wf.Namespace, Workflow: wf.Name, PodName: podName, Container: common.MainContainerName, Content: line})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+	return entries, nil
+}
+
+func (a *logArchive) readArtifact(ctx context.Context, wf *wfv1.Workflow, art *wfv1.Artifact) ([]string, error) {
+	ar, err := a.artifactRepositories.Get(ctx, wf.Status.ArtifactRepositoryRef)
+	if err != nil {
+		return nil, err
+	}
+	if err := art.Relocate(ar.ToArtifactLocation()); err != nil {
+		return nil, err
+	}
+	driver, err := a.artDriverFactory(ctx, art, resources{auth.GetKubeClient(ctx), wf.Namespace})
+	if err != nil {
+		return nil, err
+	}
+	stream, err := driver.OpenStream(art)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stream.Close() }()
+	var lines []string
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,158 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	v1alpha "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/server/auth"
+	"github.com/argoproj/argo-workflows/v3/util/instanceid"
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+	armocks "github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories/mocks"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// fakeLogArtifactDriver opens artifacts from memory, keyed by their key
+type fakeLogArtifactDriver struct {
+	artifactscommon.ArtifactDriver
+	data map[string]string
+}
+
+func (d *fakeLogArtifactDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	key, _ := a.GetKey()
+	data, ok := d.data[key]
+	if !ok {
+		return nil, errors.New(errors.CodeNotFound, "no such key: "+key)
+	}
+	return io.NopCloser(bytes.NewBufferString(data)), nil
+}
+
+type fakeLogSink struct {
+	entries []sink.Entry
+}
+
+func (s *fakeLogSink) Write(context.Context, []sink.Entry) error { return nil }
+
+func (s *fakeLogSink) Read(_ context.Context, query sink.Query) ([]sink.Entry, error) {
+	var entries []sink.Entry
+	for _, e := range s.entries {
+		if query.PodName == "" || e.PodName == query.PodName {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func newLogArtifactWorkflow(started time.Time) *wfv1.Workflow {
+	logs := func(key string) *wfv1.Outputs {
+		return &wfv1.Outputs{Artifacts: wfv1.Artifacts{{Name: wfv1.MainLogsArtifactName, ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: key}}}}}
+	}
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", UID: "my-uid"},
+		Status: wfv1.WorkflowStatus{Nodes: wfv1.Nodes{
+			"my-wf":         {ID: "my-wf", Name: "my-wf", Type: wfv1.NodeTypeSteps},
+			"my-wf-1":       {ID: "my-wf-1", Name: "my-wf[0].one", Type: wfv1.NodeTypePod, StartedAt: metav1.NewTime(started), FinishedAt: metav1.NewTime(started.Add(time.Minute)), Outputs: logs("my-wf/my-wf-1/main.log")},
+			"my-wf-2":       {ID: "my-wf-2", Name: "my-wf[1].two", Type: wfv1.NodeTypePod, StartedAt: metav1.NewTime(started.Add(time.Minute)), FinishedAt: metav1.NewTime(started.Add(2 * time.Minute)), Outputs: logs("my-wf/my-wf-2/main.log")},
+			"my-wf-3":       {ID: "my-wf-3", Name: "my-wf[2].three", Type: wfv1.NodeTypePod, StartedAt: metav1.NewTime(started.Add(2 * time.Minute)), FinishedAt: metav1.NewTime(started.Add(3 * time.Minute)), Outputs: logs("my-wf/my-wf-3/main.log")},
+			"my-wf-no-logs": {ID: "my-wf-no-logs", Name: "my-wf[3].no-logs", Type: wfv1.NodeTypePod},
+		}},
+	}
+}
+
+func newLogArchiveServer(logSink sink.Sink, wfArchive *sqldbmocks.WorkflowArchive) *workflowServer {
+	driver := &fakeLogArtifactDriver{data: map[string]string{
+		"my-wf/my-wf-1/main.log": "one\n",
+		"my-wf/my-wf-2/main.log": "two\nthree\n",
+		// my-wf-3's artifact was garbage collected
+	}}
+	artifactRepositories := armocks.DummyArtifactRepositories(&wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}})
+	return newWorkflowServer(instanceid.NewService(""), &sqldbmocks.OffloadNodeStatusRepo{}, wfArchive, logSink, artifactRepositories, func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return driver, nil
+	})
+}
+
+func TestLogArchive(t *testing.T) {
+	started := time.Unix(1700000000, 0)
+	wf := newLogArtifactWorkflow(started)
+	ctx := context.WithValue(context.Background(), auth.KubeKey, fake.NewSimpleClientset())
+	read := func(logSink sink.Sink, query sink.Query) []sink.Entry {
+		entries, err := newLogArchiveServer(logSink, nil).logArchive.Read(ctx, wf, query, nil)
+		assert.NoError(t, err)
+		return entries
+	}
+	entry := func(podName string, t time.Time, content string) sink.Entry {
+		return sink.Entry{Time: t, Namespace: "my-ns", Workflow: "my-wf", PodName: podName, Container: "main", Content: content}
+	}
+
+	assert.Equal(t, []sink.Entry{
+		entry("my-wf-1", started, "one"),
+		entry("my-wf-2", started.Add(time.Minute), "two"),
+		entry("my-wf-2", started.Add(time.Minute), "three"),
+	}, read(nil, sink.Query{Namespace: "my-ns", Workflow: "my-wf", Container: "main"}), "lines of artifacts are timed at the start of their nodes")
+	assert.Equal(t, []sink.Entry{
+		entry("my-wf-2", started.Add(time.Minute), "two"),
+		entry("my-wf-2", started.Add(time.Minute), "three"),
+	}, read(nil, sink.Query{Namespace: "my-ns", Workflow: "my-wf", PodName: "my-wf-2", Container: "main"}))
+	assert.Equal(t, []sink.Entry{
+		entry("my-wf-2", started.Add(time.Minute), "two"),
+		entry("my-wf-2", started.Add(time.Minute), "three"),
+	}, read(nil, sink.Query{Namespace: "my-ns", Workflow: "my-wf", Container: "main", Since: started.Add(90 * time.Second)}), "nodes that finished before since are skipped")
+	assert.Empty(t, read(nil, sink.Query{Namespace: "my-ns", Workflow: "my-wf", Container: "sidecar"}), "only the main container's logs are archived")
+	entries, err := newLogArchiveServer(nil, nil).logArchive.Read(ctx, wf, sink.Query{Namespace: "my-ns", Workflow: "my-wf", Container: "main"}, map[string]bool{"my-wf-2": true})
+	assert.NoError(t, err)
+	assert.Equal(t, []sink.Entry{entry("my-wf-1", started, "one")}, entries, "the artifacts of live pods are not read")
+
+	logSink := &fakeLogSink{entries: []sink.Entry{entry("my-wf-2", started.Add(61*time.Second), "two from the sink")}}
+	assert.Equal(t, []sink.Entry{
+		entry("my-wf-1", started, "one"),
+		entry("my-wf-2", started.Add(61*time.Second), "two from the sink"),
+	}, read(logSink, sink.Query{Namespace: "my-ns", Workflow: "my-wf", Container: "main"}), "artifacts are not read for pods with logs in the sink")
+}
+
+type collectingPodLogsServer struct {
+	testServerStream
+	entries []*workflowpkg.LogEntry
+}
+
+func (s *collectingPodLogsServer) Send(entry *workflowpkg.LogEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func TestPodLogsOfArchivedWorkflow(t *testing.T) {
+	wf := newLogArtifactWorkflow(time.Unix(1700000000, 0))
+	wfArchive := &sqldbmocks.WorkflowArchive{}
+	wfArchive.On("ListWorkflows", "my-ns", "my-wf", "", time.Time{}, time.Time{}, mock.Anything, mock.Anything, 1, 0).Return(wfv1.Workflows{{ObjectMeta: metav1.ObjectMeta{UID: wf.UID}}}, nil)
+	wfArchive.On("ListWorkflows", "my-ns", "missing", "", time.Time{}, time.Time{}, mock.Anything, mock.Anything, 1, 0).Return(wfv1.Workflows{}, nil)
+	wfArchive.On("GetWorkflow", "my-uid").Return(wf, nil)
+	server := newLogArchiveServer(nil, wfArchive)
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: true}}, nil
+	})
+	ctx := context.WithValue(context.WithValue(context.Background(), auth.WfKey, v1alpha.NewSimpleClientset()), auth.KubeKey, kubeClient)
+
+	stream := &collectingPodLogsServer{testServerStream: testServerStream{ctx}}
+	err := server.PodLogs(&workflowpkg.WorkflowLogRequest{Namespace: "my-ns", Name: "my-wf", Grep: "t"}, stream)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*workflowpkg.LogEntry{{PodName: "my-wf-2", Content: "two"}, {PodName: "my-wf-2", Content: "three"}}, stream.entries)
+	}
+	err = server.PodLogs(&workflowpkg.WorkflowLogRequest{Namespace: "my-ns", Name: "missing"}, &collectingPodLogsServer{testServerStream: testServerStream{ctx}})
+	assert.Error(t, err)
+}
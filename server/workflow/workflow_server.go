@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +27,9 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/fields"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/util/logs"
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/creator"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
@@ -36,13 +42,27 @@ type workflowServer struct {
 	instanceIDService     instanceid.Service
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
+	wfArchive             sqldb.WorkflowArchive
+	logArchive            logs.Archive
 }
 
 const latestAlias = "@latest"
 
-// NewWorkflowServer returns a new workflowServer
-func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo) workflowpkg.WorkflowServiceServer {
-	return &workflowServer{instanceIDService, offloadNodeStatusRepo, hydrator.New(offloadNodeStatusRepo)}
+// NewWorkflowServer returns a new workflowServer. The log sink and artifact repositories are optional, the logs of
+// deleted pods are read from them.
+func NewWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, logSink sink.Sink, artifactRepositories artifactrepositories.Interface) workflowpkg.WorkflowServiceServer {
+	return newWorkflowServer(instanceIDService, offloadNodeStatusRepo, wfArchive, logSink, artifactRepositories, artifact.NewDriver)
+}
+
+func newWorkflowServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, logSink sink.Sink, artifactRepositories artifactrepositories.Interface, artDriverFactory artifact.NewDriverFunc) *workflowServer {
+	wfHydrator := hydrator.New(offloadNodeStatusRepo)
+	return &workflowServer{
+		instanceIDService:     instanceIDService,
+		offloadNodeStatusRepo: offloadNodeStatusRepo,
+		hydrator:              wfHydrator,
+		wfArchive:             wfArchive,
+		logArchive:            &logArchive{wfHydrator, logSink, artifactRepositories, artDriverFactory},
+	}
 }
 
 func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.WorkflowCreateRequest) (*wfv1.Workflow, error) {
@@ -541,6 +561,19 @@ func (s *workflowServer) PodLogs(req *workflowpkg.WorkflowLogRequest, ws workflo
 	wfClient := auth.GetWfClient(ctx)
 	kubeClient := auth.GetKubeClient(ctx)
 	wf, err := s.getWorkflow(ctx, wfClient, req.Namespace, req.Name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) && req.Name != latestAlias {
+		// the workflow may have been archived, its logs can only be read from the log archive
+		archived, archivedErr := s.getArchivedWorkflow(ctx, req.Namespace, req.Name)
+		if archivedErr != nil {
+			return archivedErr
+		}
+		if archived != nil {
+			if err := ws.SendHeader(metadata.MD{}); err != nil {
+				return err
+			}
+			return logs.ArchivedWorkflowLogs(ctx, archived, s.logArchive, req, ws)
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -556,13 +589,29 @@ func (s *workflowServer) PodLogs(req *workflowpkg.WorkflowLogRequest, ws workflo
 		return err
 	}
 
-	return logs.WorkflowLogs(ctx, wfClient, kubeClient, req, ws)
+	return logs.WorkflowLogs(ctx, wfClient, kubeClient, s.logArchive, req, ws)
 }
 
 func (s *workflowServer) WorkflowLogs(req *workflowpkg.WorkflowLogRequest, ws workflowpkg.WorkflowService_WorkflowLogsServer) error {
 	return s.PodLogs(req, ws)
 }
 
+// getArchivedWorkflow returns the most recently started archived workflow with the name, or nil if there is none
+func (s *workflowServer) getArchivedWorkflow(ctx context.Context, namespace, name string) (*wfv1.Workflow, error) {
+	wfs, err := s.wfArchive.ListWorkflows(namespace, name, "", time.Time{}, time.Time{}, nil, nil, 1, 0)
+	if err != nil || len(wfs) == 0 {
+		return nil, err
+	}
+	allowed, err := auth.CanI(ctx, "get", workflow.WorkflowPlural, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return s.wfArchive.GetWorkflow(string(wfs[0].UID))
+}
+
 func (s *workflowServer) getWorkflow(ctx context.Context, wfClient versioned.Interface, namespace string, name string, options metav1.GetOptions) (*wfv1.Workflow, error) {
 	if name == latestAlias {
 		latest, err := getLatestWorkflow(ctx, wfClient, namespace)
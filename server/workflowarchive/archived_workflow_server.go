@@ -82,6 +82,10 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 	if err != nil {
 		return nil, err
 	}
+	query, err := sqldb.ParseQuery(req.Query)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	allowed, err := auth.CanI(ctx, "list", workflow.WorkflowPlural, namespace, "")
 	if err != nil {
@@ -102,7 +106,7 @@ func (w *archivedWorkflowServer) ListArchivedWorkflows(ctx context.Context, req
 		limitWithMore = limit + 1
 	}
 
-	items, err := w.wfArchive.ListWorkflows(namespace, name, namePrefix, minStartedAt, maxStartedAt, requirements, limitWithMore, offset)
+	items, err := w.wfArchive.ListWorkflows(namespace, name, namePrefix, minStartedAt, maxStartedAt, requirements, query, limitWithMore, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -17,7 +17,7 @@ type Persistence struct {
 
 func newPersistence(kubeClient kubernetes.Interface, wcConfig *config.Config) *Persistence {
 	persistence := wcConfig.Persistence
-	if persistence != nil {
+	if persistence != nil && persistence.HasDatabase() {
 		if persistence.PostgreSQL != nil {
 			persistence.PostgreSQL.Host = "localhost"
 		}
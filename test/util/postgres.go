@@ -0,0 +1,38 @@
+//go:build postgres
+
+package util
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"upper.io/db.v3/lib/sqlbuilder"
+	"upper.io/db.v3/postgresql"
+)
+
+// NewPostgresSession returns a session of a new schema of the Postgres database started by `make start PROFILE=postgres`,
+// which is dropped when the test ends. Tests that use it are built with the postgres tag, e.g.
+// `go test -tags postgres ./persist/... ./workflow/controller/cache/...`.
+func NewPostgresSession(t *testing.T) sqlbuilder.Database {
+	t.Helper()
+	url := postgresql.ConnectionURL{Host: "localhost:5432", User: "postgres", Password: "password", Database: "postgres"}
+	admin, err := postgresql.Open(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = admin.Close() })
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	if _, err := admin.Exec("create schema " + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _, _ = admin.Exec("drop schema " + schema + " cascade") })
+	// every connection of the session uses the schema
+	url.Options = map[string]string{"search_path": schema}
+	session, err := postgresql.Open(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
@@ -433,6 +433,12 @@ export const execSpec = (w: Workflow) => Object.assign({}, w.status.storedWorkfl
 
 export type NodeType = 'Pod' | 'Container' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend';
 
+export interface EstimatedDurationPercentiles {
+    p50?: number;
+    p90?: number;
+    samples?: number;
+}
+
 export interface NodeStatus {
     /**
      * ID is a unique identifier of a node within the worklow
@@ -596,6 +602,17 @@ export interface WorkflowStatus {
      */
     estimatedDuration?: number;
 
+    /**
+     * Percentiles of the durations of previous runs, in seconds.
+     */
+    estimatedDurationPercentiles?: EstimatedDurationPercentiles;
+
+    /**
+     * Percentiles of the durations of the nodes of each template in previous runs, in seconds, keyed by the name of the
+     * template, or by `<workflow template>/<template>` for templates referenced by templateRef.
+     */
+    templateEstimatedDurationPercentiles?: {[key: string]: EstimatedDurationPercentiles};
+
     /**
      * Progress as numerator/denominator.
      */
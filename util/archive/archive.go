@@ -2,23 +2,85 @@ package archive
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
 	"compress/gzip"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/klauspost/compress/zstd"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util"
+	"github.com/argoproj/argo-workflows/v3/util/file"
 )
 
 type flusher interface {
 	Flush() error
 }
 
+// Compression is the compression of a tarball
+type Compression string
+
+const (
+	Gzip Compression = "gzip"
+	Zstd Compression = "zstd"
+	None Compression = "none"
+)
+
+// DefaultZstdCompression is the zstd compression level used if the strategy does not specify one
+const DefaultZstdCompression = 3
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// StrategyCompression returns the compression and level of the tarball of an artifact with the archive strategy
+func StrategyCompression(strategy *wfv1.ArchiveStrategy) (Compression, int) {
+	switch {
+	case strategy == nil:
+		return Gzip, gzip.DefaultCompression
+	case strategy.Tar != nil && strategy.Tar.NoCompression:
+		return None, 0
+	case strategy.Tar != nil && strategy.Tar.CompressionLevel != nil:
+		return Gzip, int(*strategy.Tar.CompressionLevel)
+	case strategy.Tar != nil:
+		return Gzip, gzip.DefaultCompression
+	case strategy.Zstd != nil && strategy.Zstd.CompressionLevel != nil:
+		return Zstd, int(*strategy.Zstd.CompressionLevel)
+	case strategy.Zstd != nil:
+		return Zstd, DefaultZstdCompression
+	default:
+		// the artifact is not archived, but is still tarred to copy it out of the main container
+		return Gzip, gzip.NoCompression
+	}
+}
+
+// Extension returns the file extension of tarballs with the compression
+func (c Compression) Extension() string {
+	switch c {
+	case Zstd:
+		return ".tar.zst"
+	case None:
+		return ".tar"
+	default:
+		return ".tgz"
+	}
+}
+
 // TarGzToWriter tar.gz's the source path to the supplied writer
 func TarGzToWriter(sourcePath string, level int, w io.Writer) error {
+	return TarToWriter(sourcePath, Gzip, level, w)
+}
+
+// TarToWriter tars the source path to the supplied writer, compressing it with the compression at the level
+func TarToWriter(sourcePath string, compression Compression, level int, w io.Writer) error {
 	sourcePath, err := filepath.Abs(sourcePath)
 	if err != nil {
 		return errors.InternalErrorf("getting absolute path: %v", err)
@@ -37,12 +99,12 @@ func TarGzToWriter(sourcePath string, level int, w io.Writer) error {
 	if flush, ok := w.(flusher); ok {
 		defer func() { _ = flush.Flush() }()
 	}
-	gzw, err := gzip.NewWriterLevel(w, level)
+	cw, err := newCompressor(w, compression, level)
 	if err != nil {
 		return errors.InternalWrapError(err)
 	}
-	defer util.Close(gzw)
-	tw := tar.NewWriter(gzw)
+	defer util.Close(cw)
+	tw := tar.NewWriter(cw)
 	defer util.Close(tw)
 
 	if sourceFi.IsDir() {
@@ -51,6 +113,61 @@ func TarGzToWriter(sourcePath string, level int, w io.Writer) error {
 	return tarFile(sourcePath, tw)
 }
 
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func newCompressor(w io.Writer, compression Compression, level int) (io.WriteCloser, error) {
+	switch compression {
+	case Gzip:
+		return gzip.NewWriterLevel(w, level)
+	case Zstd:
+		if level < 1 || level > 22 {
+			return nil, fmt.Errorf("zstd: invalid compression level: %d", level)
+		}
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	case None:
+		return nopWriteCloser{w}, nil
+	default:
+		return nil, fmt.Errorf("unknown compression %q", compression)
+	}
+}
+
+// DetectCompression returns the compression of a tarball from its first bytes. Content that is not compressed is
+// assumed to be an uncompressed tarball.
+func DetectCompression(r *bufio.Reader) Compression {
+	// a short read is not an error, the content is just too short to be compressed
+	magic, _ := r.Peek(len(zstdMagic))
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return Gzip
+	case bytes.HasPrefix(magic, zstdMagic):
+		return Zstd
+	default:
+		return None
+	}
+}
+
+// NewDecompressor returns a reader of the decompressed content of a tarball, detecting its compression, so that
+// tarballs can be read whichever strategy archived them
+func NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	switch DetectCompression(br) {
+	case Gzip:
+		return file.GetGzipReader(br)
+	case Zstd:
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return io.NopCloser(br), nil
+	}
+}
+
 func tarDir(sourcePath string, tw *tar.Writer) error {
 	baseName := filepath.Base(sourcePath)
 	count := 0
@@ -84,6 +201,7 @@ func tarDir(sourcePath string, tw *tar.Writer) error {
 			}
 		}
 		header.Name = nameInArchive
+		normalizeHeader(header)
 
 		err = tw.WriteHeader(header)
 		if err != nil {
@@ -112,6 +230,16 @@ func tarDir(sourcePath string, tw *tar.Writer) error {
 	return err
 }
 
+// normalizeHeader clears the times and ownership of a header, so that archives of the same files are identical, and
+// so have the same digest, wherever and whenever they are made
+func normalizeHeader(header *tar.Header) {
+	header.ModTime = time.Unix(0, 0)
+	header.AccessTime = time.Time{}
+	header.ChangeTime = time.Time{}
+	header.Uid, header.Gid = 0, 0
+	header.Uname, header.Gname = "", ""
+}
+
 func tarFile(sourcePath string, tw *tar.Writer) error {
 	f, err := os.Open(filepath.Clean(sourcePath))
 	if err != nil {
@@ -127,6 +255,7 @@ func tarFile(sourcePath string, tw *tar.Writer) error {
 		return errors.InternalWrapError(err)
 	}
 	header.Name = filepath.Base(sourcePath)
+	normalizeHeader(header)
 	err = tw.WriteHeader(header)
 	if err != nil {
 		return errors.InternalWrapError(err)
@@ -1,16 +1,23 @@
 package archive
 
 import (
+	"archive/tar"
 	"bufio"
+	"bytes"
 	"compress/gzip"
 	"crypto/rand"
 	"encoding/hex"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func tempFile(dir, prefix, suffix string) (*os.File, error) {
@@ -139,3 +146,84 @@ func TestTarFile(t *testing.T) {
 		})
 	}
 }
+
+func TestStrategyCompression(t *testing.T) {
+	level := int32(9)
+	tests := []struct {
+		name        string
+		strategy    *wfv1.ArchiveStrategy
+		compression Compression
+		level       int
+	}{
+		{"default", nil, Gzip, gzip.DefaultCompression},
+		{"tar", &wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{}}, Gzip, gzip.DefaultCompression},
+		{"tar_level", &wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{CompressionLevel: &level}}, Gzip, 9},
+		{"tar_no_compression", &wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{NoCompression: true}}, None, 0},
+		{"zstd", &wfv1.ArchiveStrategy{Zstd: &wfv1.ZstdStrategy{}}, Zstd, DefaultZstdCompression},
+		{"zstd_level", &wfv1.ArchiveStrategy{Zstd: &wfv1.ZstdStrategy{CompressionLevel: &level}}, Zstd, 9},
+		{"none", &wfv1.ArchiveStrategy{None: &wfv1.NoneStrategy{}}, Gzip, gzip.NoCompression},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compression, level := StrategyCompression(tt.strategy)
+			assert.Equal(t, tt.compression, compression)
+			assert.Equal(t, tt.level, level)
+		})
+	}
+}
+
+func TestTarToWriter(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "my-dir")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "my-file"), bytes.Repeat([]byte("hello world "), 100), 0o600))
+	for _, tt := range []struct {
+		compression Compression
+		level       int
+	}{
+		{Gzip, gzip.BestSpeed},
+		{Zstd, DefaultZstdCompression},
+		{Zstd, 22},
+		{None, 0},
+	} {
+		t.Run(string(tt.compression), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, TarToWriter(dir, tt.compression, tt.level, buf))
+			assert.Equal(t, tt.compression, DetectCompression(bufio.NewReader(bytes.NewReader(buf.Bytes()))))
+
+			r, err := NewDecompressor(buf)
+			require.NoError(t, err)
+			defer func() { _ = r.Close() }()
+			tr := tar.NewReader(r)
+			var names []string
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				names = append(names, header.Name)
+			}
+			assert.Equal(t, []string{"my-dir", "my-dir/my-file"}, names)
+		})
+	}
+	t.Run("InvalidZstdLevel", func(t *testing.T) {
+		assert.Error(t, TarToWriter(dir, Zstd, 23, &bytes.Buffer{}))
+	})
+}
+
+func TestTarToWriterIsReproducible(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "my-dir")
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	file := filepath.Join(dir, "my-file")
+	require.NoError(t, os.WriteFile(file, []byte("hello world"), 0o600))
+	tarDir := func() []byte {
+		buf := &bytes.Buffer{}
+		require.NoError(t, TarToWriter(dir, Gzip, gzip.DefaultCompression, buf))
+		return buf.Bytes()
+	}
+	first := tarDir()
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(file, later, later))
+	require.NoError(t, os.Chtimes(dir, later, later))
+	assert.Equal(t, first, tarDir(), "archives of the same files have the same digest")
+}
@@ -0,0 +1,100 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// Archive reads the logs of a workflow's pods after the pods have been deleted, e.g. from a log sink or log artifacts
+type Archive interface {
+	// Read returns the entries of the workflow matching the query, oldest first. The logs of the live pods are read
+	// from the pods, so they need not be returned.
+	Read(ctx context.Context, wf *wfv1.Workflow, query sink.Query, livePods map[string]bool) ([]sink.Entry, error)
+}
+
+// ArchivedWorkflowLogs sends the logs of a workflow that is no longer in the cluster, e.g. one that has been archived
+func ArchivedWorkflowLogs(ctx context.Context, wf *wfv1.Workflow, archive Archive, req request, sender sender) error {
+	if req.GetSelector() != "" {
+		return fmt.Errorf("the logs of workflows that are no longer in the cluster cannot be selected by labels")
+	}
+	rx, err := regexp.Compile(req.GetGrep())
+	if err != nil {
+		return fmt.Errorf("failed to compile %q: %w", req.GetGrep(), err)
+	}
+	logOptions := req.GetLogOptions()
+	if logOptions == nil {
+		logOptions = &corev1.PodLogOptions{}
+	}
+	entries, err := archivedLogs(ctx, archive, wf, req.GetPodName(), logOptions, nil, rx)
+	if err != nil {
+		return err
+	}
+	sort.Stable(logEntries(entries))
+	for _, e := range entries {
+		if err := sender.Send(&workflowpkg.LogEntry{Content: e.content, PodName: e.podName}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archivedLogs returns the log entries of the workflow's pods that are not live from the archive, filtered by the log
+// options and grep in the same way as the logs of live pods
+func archivedLogs(ctx context.Context, archive Archive, wf *wfv1.Workflow, podName string, logOptions *corev1.PodLogOptions, livePods map[string]bool, rx *regexp.Regexp) ([]logEntry, error) {
+	container := logOptions.Container
+	if container == "" {
+		container = common.MainContainerName
+	}
+	since := wf.CreationTimestamp.Time
+	if t := logOptions.SinceTime; t != nil && t.After(since) {
+		since = t.Time
+	}
+	if s := logOptions.SinceSeconds; s != nil {
+		if t := time.Now().Add(-time.Duration(*s) * time.Second); t.After(since) {
+			since = t
+		}
+	}
+	entries, err := archive.Read(ctx, wf, sink.Query{Namespace: wf.Namespace, Workflow: wf.Name, PodName: podName, Container: container, Since: since}, livePods)
+	if err != nil {
+		return nil, err
+	}
+	var podNames []string
+	byPod := make(map[string][]sink.Entry)
+	for _, e := range entries {
+		if livePods[e.PodName] {
+			continue
+		}
+		if _, ok := byPod[e.PodName]; !ok {
+			podNames = append(podNames, e.PodName)
+		}
+		byPod[e.PodName] = append(byPod[e.PodName], e)
+	}
+	var out []logEntry
+	for _, podName := range podNames {
+		podEntries := byPod[podName]
+		// like Kubernetes, the lines are tailed before they are grepped
+		if n := logOptions.TailLines; n != nil && int64(len(podEntries)) > *n {
+			podEntries = podEntries[int64(len(podEntries))-*n:]
+		}
+		for _, e := range podEntries {
+			content := e.Content
+			if logOptions.Timestamps {
+				content = e.Time.UTC().Format(time.RFC3339Nano) + " " + content
+			}
+			if rx.MatchString(content) {
+				out = append(out, logEntry{timestamp: e.Time, podName: e.PodName, content: content})
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,91 @@
+package logs
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+)
+
+type fakeArchive struct {
+	query   sink.Query
+	entries []sink.Entry
+}
+
+func (a *fakeArchive) Read(_ context.Context, _ *wfv1.Workflow, query sink.Query, _ map[string]bool) ([]sink.Entry, error) {
+	a.query = query
+	return a.entries, nil
+}
+
+type fakeSender struct {
+	entries []*workflowpkg.LogEntry
+}
+
+func (s *fakeSender) Send(entry *workflowpkg.LogEntry) error {
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func Test_archivedLogs(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", CreationTimestamp: metav1.Time{Time: created}}}
+	s := &fakeArchive{entries: []sink.Entry{
+		{Time: created.Add(time.Second), PodName: "my-pod-1", Content: "one"},
+		{Time: created.Add(2 * time.Second), PodName: "my-pod-2", Content: "two"},
+		{Time: created.Add(3 * time.Second), PodName: "my-pod-1", Content: "three"},
+		{Time: created.Add(4 * time.Second), PodName: "my-live-pod", Content: "live"},
+	}}
+	contents := func(entries []logEntry) []string {
+		var contents []string
+		for _, e := range entries {
+			contents = append(contents, e.content)
+		}
+		return contents
+	}
+	live := map[string]bool{"my-live-pod": true}
+	everything := regexp.MustCompile("")
+
+	entries, err := archivedLogs(context.Background(), s, wf, "", &corev1.PodLogOptions{}, live, everything)
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, []string{"one", "two", "three"}, contents(entries), "the logs of live pods are streamed from the pods")
+		assert.Equal(t, sink.Query{Namespace: "my-ns", Workflow: "my-wf", Container: "main", Since: created}, s.query)
+	}
+	since := metav1.NewTime(created.Add(time.Minute))
+	_, err = archivedLogs(context.Background(), s, wf, "my-pod-1", &corev1.PodLogOptions{Container: "sidecar", SinceTime: &since}, live, everything)
+	if assert.NoError(t, err) {
+		assert.Equal(t, sink.Query{Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod-1", Container: "sidecar", Since: since.Time}, s.query)
+	}
+	entries, err = archivedLogs(context.Background(), s, wf, "", &corev1.PodLogOptions{TailLines: pointer.Int64(1)}, live, regexp.MustCompile("o"))
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, []string{"two"}, contents(entries), "lines are tailed for each pod, then grepped")
+	}
+	entries, err = archivedLogs(context.Background(), s, wf, "", &corev1.PodLogOptions{Timestamps: true}, live, everything)
+	if assert.NoError(t, err) && assert.Len(t, entries, 3) {
+		assert.Equal(t, "2023-11-14T22:13:21Z one", entries[0].content)
+	}
+}
+
+func TestArchivedWorkflowLogs(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	wf := &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-wf", CreationTimestamp: metav1.Time{Time: created}}}
+	archive := &fakeArchive{entries: []sink.Entry{
+		{Time: created.Add(2 * time.Second), PodName: "my-pod-2", Content: "two"},
+		{Time: created.Add(time.Second), PodName: "my-pod-1", Content: "one"},
+	}}
+	sender := &fakeSender{}
+	err := ArchivedWorkflowLogs(context.Background(), wf, archive, &workflowpkg.WorkflowLogRequest{Namespace: "my-ns", Name: "my-wf"}, sender)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []*workflowpkg.LogEntry{{PodName: "my-pod-1", Content: "one"}, {PodName: "my-pod-2", Content: "two"}}, sender.entries)
+	}
+	err = ArchivedWorkflowLogs(context.Background(), wf, archive, &workflowpkg.WorkflowLogRequest{Namespace: "my-ns", Name: "my-wf", Selector: "foo=bar"}, sender)
+	assert.Error(t, err)
+}
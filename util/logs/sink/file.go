@@ -0,0 +1,164 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// fileSink writes the entries of each container as JSON lines to "<path>/<namespace>/<workflow>/<pod>.<container>.log",
+// rotating the file to ".log.1", ".log.2" and so on when it reaches the maximum size
+type fileSink struct {
+	config.FileLogSinkConfig
+	mu sync.Mutex
+}
+
+func newFileSink(cfg config.FileLogSinkConfig) (*fileSink, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("file log sink path must not be empty")
+	}
+	return &fileSink{FileLogSinkConfig: cfg}, nil
+}
+
+func (s *fileSink) filename(e Entry) string {
+	return filepath.Join(s.Path, e.Namespace, e.Workflow, e.PodName+"."+e.Container+".log")
+}
+
+func (s *fileSink) Write(_ context.Context, entries []Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var filenames []string
+	byFile := make(map[string][]Entry)
+	for _, e := range entries {
+		filename := s.filename(e)
+		if _, ok := byFile[filename]; !ok {
+			filenames = append(filenames, filename)
+		}
+		byFile[filename] = append(byFile[filename], e)
+	}
+	for _, filename := range filenames {
+		if err := s.write(filename, byFile[filename]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fileSink) write(filename string, entries []Entry) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+	var size int64
+	if info, err := os.Stat(filename); err == nil {
+		size = info.Size()
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	var f *os.File
+	defer func() {
+		if f != nil {
+			_ = f.Close()
+		}
+	}()
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+		if size > 0 && size+int64(len(line)) > s.GetMaxSizeBytes() {
+			if f != nil {
+				if err := f.Close(); err != nil {
+					return err
+				}
+				f = nil
+			}
+			if err := s.rotate(filename); err != nil {
+				return err
+			}
+			size = 0
+		}
+		if f == nil {
+			if f, err = os.OpenFile(filename, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644); err != nil {
+				return err
+			}
+		}
+		n, err := f.Write(line)
+		size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+	if f == nil {
+		return nil
+	}
+	err := f.Close()
+	f = nil
+	return err
+}
+
+// rotate renames the file to ".1", after renaming ".1" to ".2" and so on, deleting the oldest file
+func (s *fileSink) rotate(filename string) error {
+	maxFiles := s.GetMaxFiles()
+	if err := os.Remove(fmt.Sprintf("%s.%d", filename, maxFiles)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := maxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(fmt.Sprintf("%s.%d", filename, i), fmt.Sprintf("%s.%d", filename, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(filename, filename+".1")
+}
+
+func (s *fileSink) Read(_ context.Context, query Query) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pattern := "*.log*"
+	if query.PodName != "" {
+		pattern = query.PodName + ".*.log*"
+	}
+	filenames, err := filepath.Glob(filepath.Join(s.Path, query.Namespace, query.Workflow, pattern))
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	for _, filename := range filenames {
+		fileEntries, err := readFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range fileEntries {
+			if query.matches(e) {
+				entries = append(entries, e)
+			}
+		}
+	}
+	sortEntries(entries)
+	return entries, nil
+}
+
+func readFile(filename string) ([]Entry, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		// the last line is incomplete if the writer was killed while writing it
+		if err := json.Unmarshal(scanner.Bytes(), &e); err == nil {
+			entries = append(entries, e)
+		}
+	}
+	return entries, scanner.Err()
+}
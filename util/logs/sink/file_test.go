@@ -0,0 +1,54 @@
+package sink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(config.LogSinkConfig{File: &config.FileLogSinkConfig{Path: dir}}, "")
+	if assert.NoError(t, err) {
+		testSink(t, s)
+		assert.FileExists(t, filepath.Join(dir, "my-ns", "my-wf", "my-pod-1.main.log"))
+	}
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	dir := t.TempDir()
+	s, err := New(config.LogSinkConfig{File: &config.FileLogSinkConfig{Path: dir, MaxSizeBytes: 300, MaxFiles: 2}}, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	ctx := context.Background()
+	start := time.Unix(1700000000, 0)
+	for i := 0; i < 10; i++ {
+		e := Entry{Time: start.Add(time.Duration(i) * time.Second), Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod", Container: "main", Content: string(rune('a' + i))}
+		assert.NoError(t, s.Write(ctx, []Entry{e}))
+	}
+	filenames, err := filepath.Glob(filepath.Join(dir, "my-ns", "my-wf", "*"))
+	if assert.NoError(t, err) {
+		assert.Len(t, filenames, 3, "the log and two rotated files")
+	}
+	for _, filename := range filenames {
+		info, err := os.Stat(filename)
+		if assert.NoError(t, err) {
+			assert.LessOrEqual(t, info.Size(), int64(300))
+		}
+	}
+	entries, err := s.Read(ctx, Query{Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod"})
+	if assert.NoError(t, err) && assert.NotEmpty(t, entries) {
+		assert.Less(t, len(entries), 10, "the oldest entries are deleted")
+		assert.Equal(t, "j", entries[len(entries)-1].Content)
+		for i := 1; i < len(entries); i++ {
+			assert.True(t, entries[i-1].Time.Before(entries[i].Time))
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// the maximum number of entries read by each request
+var httpSinkPageSize = 1000
+
+// httpSink pushes entries to the HTTP API of Loki or Elasticsearch, and reads them back from it
+type httpSink struct {
+	config.HTTPLogSinkConfig
+	authorization string
+	client        *http.Client
+}
+
+func newHTTPSink(cfg config.HTTPLogSinkConfig, authorization string) (*httpSink, error) {
+	switch cfg.Format {
+	case config.HTTPLogSinkLoki, config.HTTPLogSinkElasticsearch:
+	default:
+		return nil, fmt.Errorf("unknown http log sink format %q, must be %q or %q", cfg.Format, config.HTTPLogSinkLoki, config.HTTPLogSinkElasticsearch)
+	}
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return nil, fmt.Errorf("invalid http log sink url: %w", err)
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	return &httpSink{cfg, authorization, &http.Client{Timeout: 30 * time.Second}}, nil
+}
+
+func (s *httpSink) Write(ctx context.Context, entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if s.Format == config.HTTPLogSinkLoki {
+		return s.lokiPush(ctx, entries)
+	}
+	return s.elasticsearchBulk(ctx, entries)
+}
+
+func (s *httpSink) Read(ctx context.Context, query Query) ([]Entry, error) {
+	if s.Format == config.HTTPLogSinkLoki {
+		return s.lokiQueryRange(ctx, query)
+	}
+	return s.elasticsearchSearch(ctx, query)
+}
+
+// do sends the request, and decodes the response body into v, if v is not nil
+func (s *httpSink) do(ctx context.Context, method, path string, contentType string, body []byte, v interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.URL+path, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if s.authorization != "" {
+		req.Header.Set("Authorization", s.authorization)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(data)))
+	}
+	if v == nil {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, json.Unmarshal(data, v)
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func lokiLabels(e Entry) map[string]string {
+	return map[string]string{"namespace": e.Namespace, "workflow": e.Workflow, "pod": e.PodName, "container": e.Container}
+}
+
+// lokiPush pushes the entries as one stream for each container, https://grafana.com/docs/loki/latest/reference/loki-http-api/#ingest-logs
+func (s *httpSink) lokiPush(ctx context.Context, entries []Entry) error {
+	var streams []*lokiStream
+	byContainer := make(map[[3]string]*lokiStream)
+	for _, e := range entries {
+		key := [3]string{e.Workflow, e.PodName, e.Container}
+		stream, ok := byContainer[key]
+		if !ok {
+			stream = &lokiStream{Stream: lokiLabels(e)}
+			byContainer[key] = stream
+			streams = append(streams, stream)
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(e.Time.UnixNano(), 10), e.Content})
+	}
+	body, err := json.Marshal(map[string]interface{}{"streams": streams})
+	if err != nil {
+		return err
+	}
+	_, err = s.do(ctx, http.MethodPost, "/loki/api/v1/push", "application/json", body, nil)
+	return err
+}
+
+func lokiSelector(query Query) string {
+	matchers := []string{"namespace=" + strconv.Quote(query.Namespace), "workflow=" + strconv.Quote(query.Workflow)}
+	if query.PodName != "" {
+		matchers = append(matchers, "pod="+strconv.Quote(query.PodName))
+	}
+	if query.Container != "" {
+		matchers = append(matchers, "container="+strconv.Quote(query.Container))
+	}
+	return "{" + strings.Join(matchers, ",") + "}"
+}
+
+// lokiQueryRange reads the entries a page at a time, https://grafana.com/docs/loki/latest/reference/loki-http-api/#query-logs-within-a-range-of-time
+func (s *httpSink) lokiQueryRange(ctx context.Context, query Query) ([]Entry, error) {
+	var entries []Entry
+	start := query.Since.UnixNano()
+	end := strconv.FormatInt(time.Now().UnixNano(), 10)
+	// the entries at the start of the page, which were at the end of the previous page
+	seen := make(map[Entry]bool)
+	for {
+		params := url.Values{
+			"query":     {lokiSelector(query)},
+			"start":     {strconv.FormatInt(start, 10)},
+			"end":       {end},
+			"limit":     {strconv.Itoa(httpSinkPageSize)},
+			"direction": {"forward"},
+		}
+		var resp struct {
+			Data struct {
+				Result []lokiStream `json:"result"`
+			} `json:"data"`
+		}
+		if _, err := s.do(ctx, http.MethodGet, "/loki/api/v1/query_range?"+params.Encode(), "", nil, &resp); err != nil {
+			return nil, err
+		}
+		var page []Entry
+		for _, stream := range resp.Data.Result {
+			for _, value := range stream.Values {
+				ns, err := strconv.ParseInt(value[0], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid loki timestamp %q: %w", value[0], err)
+				}
+				page = append(page, Entry{
+					Time:      time.Unix(0, ns),
+					Namespace: stream.Stream["namespace"],
+					Workflow:  stream.Stream["workflow"],
+					PodName:   stream.Stream["pod"],
+					Container: stream.Stream["container"],
+					Content:   value[1],
+				})
+			}
+		}
+		sortEntries(page)
+		n := len(entries)
+		for _, e := range page {
+			if !seen[e] {
+				entries = append(entries, e)
+			}
+		}
+		if len(page) < httpSinkPageSize {
+			return entries, nil
+		}
+		last := page[len(page)-1].Time
+		seen = make(map[Entry]bool)
+		if len(entries) == n {
+			// a page of entries all at the same time, Loki cannot page through them, so any more at that time are skipped;
+			// the executor times each entry of a pod after the last, so this only happens with entries written otherwise
+			start = last.UnixNano() + 1
+			continue
+		}
+		// the next page starts at the time of the last entry, as there may be more entries at that time
+		start = last.UnixNano()
+		for _, e := range page {
+			if e.Time.Equal(last) {
+				seen[e] = true
+			}
+		}
+	}
+}
+
+type elasticsearchDocument struct {
+	Timestamp time.Time `json:"@timestamp"`
+	Namespace string    `json:"namespace"`
+	Workflow  string    `json:"workflow"`
+	PodName   string    `json:"pod"`
+	Container string    `json:"container"`
+	Message   string    `json:"message"`
+}
+
+// elasticsearchBulk indexes the entries as one document each, https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-bulk.html
+func (s *httpSink) elasticsearchBulk(ctx context.Context, entries []Entry) error {
+	action, err := json.Marshal(map[string]interface{}{"index": map[string]string{"_index": s.GetIndex()}})
+	if err != nil {
+		return err
+	}
+	body := &bytes.Buffer{}
+	for _, e := range entries {
+		doc, err := json.Marshal(elasticsearchDocument{e.Time, e.Namespace, e.Workflow, e.PodName, e.Container, e.Content})
+		if err != nil {
+			return err
+		}
+		body.Write(action)
+		body.WriteByte('\n')
+		body.Write(doc)
+		body.WriteByte('\n')
+	}
+	var resp struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Error *struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error"`
+		} `json:"items"`
+	}
+	if _, err := s.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", body.Bytes(), &resp); err != nil {
+		return err
+	}
+	if !resp.Errors {
+		return nil
+	}
+	for _, item := range resp.Items {
+		for _, result := range item {
+			if result.Error != nil {
+				return fmt.Errorf("failed to index log entries: %s: %s", result.Error.Type, result.Error.Reason)
+			}
+		}
+	}
+	return fmt.Errorf("failed to index log entries")
+}
+
+// elasticsearchSearch reads the entries a page at a time, https://www.elastic.co/guide/en/elasticsearch/reference/current/paginate-search-results.html#search-after
+func (s *httpSink) elasticsearchSearch(ctx context.Context, query Query) ([]Entry, error) {
+	// the fields may be mapped as text, so phrases are matched and then the entries are filtered exactly
+	filter := []interface{}{
+		map[string]interface{}{"match_phrase": map[string]string{"namespace": query.Namespace}},
+		map[string]interface{}{"match_phrase": map[string]string{"workflow": query.Workflow}},
+		map[string]interface{}{"range": map[string]interface{}{"@timestamp": map[string]string{"gte": query.Since.UTC().Format(time.RFC3339Nano)}}},
+	}
+	if query.PodName != "" {
+		filter = append(filter, map[string]interface{}{"match_phrase": map[string]string{"pod": query.PodName}})
+	}
+	if query.Container != "" {
+		filter = append(filter, map[string]interface{}{"match_phrase": map[string]string{"container": query.Container}})
+	}
+	var entries []Entry
+	var searchAfter []json.RawMessage
+	for {
+		search := map[string]interface{}{
+			"size":  httpSinkPageSize,
+			"query": map[string]interface{}{"bool": map[string]interface{}{"filter": filter}},
+			"sort":  []interface{}{map[string]string{"@timestamp": "asc"}, map[string]string{"_doc": "asc"}},
+		}
+		if searchAfter != nil {
+			search["search_after"] = searchAfter
+		}
+		body, err := json.Marshal(search)
+		if err != nil {
+			return nil, err
+		}
+		var resp struct {
+			Hits struct {
+				Hits []struct {
+					Source elasticsearchDocument `json:"_source"`
+					Sort   []json.RawMessage     `json:"sort"`
+				} `json:"hits"`
+			} `json:"hits"`
+		}
+		status, err := s.do(ctx, http.MethodPost, "/"+url.PathEscape(s.GetIndex())+"/_search", "application/json", body, &resp)
+		if status == http.StatusNotFound {
+			// nothing has been written to the index yet
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range resp.Hits.Hits {
+			doc := hit.Source
+			e := Entry{Time: doc.Timestamp, Namespace: doc.Namespace, Workflow: doc.Workflow, PodName: doc.PodName, Container: doc.Container, Content: doc.Message}
+			if query.matches(e) {
+				entries = append(entries, e)
+			}
+			searchAfter = hit.Sort
+		}
+		if len(resp.Hits.Hits) < httpSinkPageSize {
+			return entries, nil
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+func testEntries(start time.Time) []Entry {
+	return []Entry{
+		{Time: start, Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod-1", Container: "main", Content: "one"},
+		{Time: start.Add(time.Second), Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod-2", Container: "main", Content: "two"},
+		{Time: start.Add(time.Second), Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod-1", Container: "main", Content: "three"},
+		{Time: start.Add(2 * time.Second), Namespace: "my-ns", Workflow: "my-wf-2", PodName: "my-pod-3", Container: "main", Content: "other workflow"},
+		{Time: start.Add(3 * time.Second), Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod-1", Container: "sidecar", Content: "four"},
+	}
+}
+
+func contents(entries []Entry) []string {
+	var contents []string
+	for _, e := range entries {
+		contents = append(contents, e.Content)
+	}
+	return contents
+}
+
+// testSink tests writing and reading the entries of testEntries
+func testSink(t *testing.T, s Sink) {
+	ctx := context.Background()
+	start := time.Unix(1700000000, 0)
+	assert.NoError(t, s.Write(ctx, testEntries(start)[:2]))
+	assert.NoError(t, s.Write(ctx, testEntries(start)[2:]))
+
+	entries, err := s.Read(ctx, Query{Namespace: "my-ns", Workflow: "my-wf", Since: start})
+	if assert.NoError(t, err) && assert.Len(t, entries, 4) {
+		assert.Equal(t, "one", entries[0].Content)
+		assert.ElementsMatch(t, []string{"two", "three"}, contents(entries[1:3]))
+		assert.Equal(t, "four", entries[3].Content)
+		assert.True(t, start.Equal(entries[0].Time))
+		assert.Equal(t, Entry{Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod-1", Container: "sidecar", Content: "four"}, Entry{Namespace: entries[3].Namespace, Workflow: entries[3].Workflow, PodName: entries[3].PodName, Container: entries[3].Container, Content: entries[3].Content})
+	}
+	entries, err = s.Read(ctx, Query{Namespace: "my-ns", Workflow: "my-wf", PodName: "my-pod-1", Container: "main", Since: start})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"one", "three"}, contents(entries))
+	}
+	entries, err = s.Read(ctx, Query{Namespace: "my-ns", Workflow: "my-wf", Since: start.Add(2 * time.Second)})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"four"}, contents(entries))
+	}
+	entries, err = s.Read(ctx, Query{Namespace: "other-ns", Workflow: "my-wf", Since: start})
+	if assert.NoError(t, err) {
+		assert.Empty(t, entries)
+	}
+}
+
+// lokiStandIn implements the push and query range APIs of Loki
+type lokiStandIn struct {
+	mu      sync.Mutex
+	streams []lokiStream
+}
+
+var lokiMatcher = regexp.MustCompile(`(\w+)="((?:[^"\\]|\\.)*)"`)
+
+func (l *lokiStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if r.Header.Get("Authorization") != "Bearer my-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch r.URL.Path {
+	case "/loki/api/v1/push":
+		var req struct {
+			Streams []lokiStream `json:"streams"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		l.streams = append(l.streams, req.Streams...)
+		w.WriteHeader(http.StatusNoContent)
+	case "/loki/api/v1/query_range":
+		if r.URL.Query().Get("direction") != "forward" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		start, _ := strconv.ParseInt(r.URL.Query().Get("start"), 10, 64)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		matchers := map[string]string{}
+		for _, m := range lokiMatcher.FindAllStringSubmatch(r.URL.Query().Get("query"), -1) {
+			matchers[m[1]], _ = strconv.Unquote(`"` + m[2] + `"`)
+		}
+		type value struct {
+			labels map[string]string
+			value  [2]string
+		}
+		var values []value
+		for _, stream := range l.streams {
+			matches := true
+			for k, v := range matchers {
+				matches = matches && stream.Stream[k] == v
+			}
+			for _, v := range stream.Values {
+				ns, _ := strconv.ParseInt(v[0], 10, 64)
+				if matches && ns >= start {
+					values = append(values, value{stream.Stream, v})
+				}
+			}
+		}
+		sort.SliceStable(values, func(i, j int) bool { return values[i].value[0] < values[j].value[0] })
+		if len(values) > limit {
+			values = values[:limit]
+		}
+		var result []lokiStream
+		for _, v := range values {
+			result = append(result, lokiStream{Stream: v.labels, Values: [][2]string{v.value}})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": "success", "data": map[string]interface{}{"resultType": "streams", "result": result}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// elasticsearchStandIn implements the bulk and search APIs of Elasticsearch, for one index
+type elasticsearchStandIn struct {
+	mu   sync.Mutex
+	docs []elasticsearchDocument
+}
+
+func (e *elasticsearchStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch r.URL.Path {
+	case "/_bulk":
+		if r.Header.Get("Content-Type") != "application/x-ndjson" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var action map[string]map[string]string
+			_ = json.Unmarshal(scanner.Bytes(), &action)
+			scanner.Scan()
+			var doc elasticsearchDocument
+			_ = json.Unmarshal(scanner.Bytes(), &doc)
+			if action["index"]["_index"] != "my-index" || doc.Message == "" {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": true, "items": []interface{}{map[string]interface{}{"index": map[string]interface{}{"error": map[string]string{"type": "mapper_parsing_exception", "reason": "invalid"}}}}})
+				return
+			}
+			e.docs = append(e.docs, doc)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": false})
+	case "/my-index/_search":
+		var search struct {
+			Size  int `json:"size"`
+			Query struct {
+				Bool struct {
+					Filter []struct {
+						MatchPhrase map[string]string `json:"match_phrase"`
+						Range       map[string]struct {
+							Gte time.Time `json:"gte"`
+						} `json:"range"`
+					} `json:"filter"`
+				} `json:"bool"`
+			} `json:"query"`
+			SearchAfter []int `json:"search_after"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&search); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		type hit struct {
+			Source elasticsearchDocument `json:"_source"`
+			Sort   []int                 `json:"sort"`
+		}
+		var hits []hit
+		for i, doc := range e.docs {
+			fields := map[string]string{"namespace": doc.Namespace, "workflow": doc.Workflow, "pod": doc.PodName, "container": doc.Container}
+			matches := true
+			for _, f := range search.Query.Bool.Filter {
+				for k, v := range f.MatchPhrase {
+					// phrases match text that contains them
+					matches = matches && regexp.MustCompile(`\b`+regexp.QuoteMeta(v)+`\b`).MatchString(fields[k])
+				}
+				if r, ok := f.Range["@timestamp"]; ok {
+					matches = matches && !doc.Timestamp.Before(r.Gte)
+				}
+			}
+			if matches {
+				hits = append(hits, hit{doc, []int{int(doc.Timestamp.UnixMilli()), i}})
+			}
+		}
+		sort.SliceStable(hits, func(i, j int) bool { return hits[i].Sort[0] < hits[j].Sort[0] })
+		if after := search.SearchAfter; after != nil {
+			for len(hits) > 0 && (hits[0].Sort[0] < after[0] || hits[0].Sort[0] == after[0] && hits[0].Sort[1] <= after[1]) {
+				hits = hits[1:]
+			}
+		}
+		if len(hits) > search.Size {
+			hits = hits[:search.Size]
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"hits": map[string]interface{}{"hits": hits}})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func withPageSize(t *testing.T, size int) {
+	pageSize := httpSinkPageSize
+	httpSinkPageSize = size
+	t.Cleanup(func() { httpSinkPageSize = pageSize })
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(config.LogSinkConfig{}, "")
+	assert.Error(t, err)
+	_, err = New(config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{}, File: &config.FileLogSinkConfig{}}, "")
+	assert.Error(t, err)
+	_, err = New(config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{Format: "splunk", URL: "http://splunk"}}, "")
+	assert.Error(t, err)
+	_, err = New(config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{Format: config.HTTPLogSinkLoki}}, "")
+	assert.Error(t, err)
+	_, err = New(config.LogSinkConfig{File: &config.FileLogSinkConfig{}}, "")
+	assert.Error(t, err)
+}
+
+func TestLokiSink(t *testing.T) {
+	withPageSize(t, 2)
+	server := httptest.NewServer(&lokiStandIn{})
+	defer server.Close()
+	s, err := New(config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{Format: config.HTTPLogSinkLoki, URL: server.URL + "/"}}, "Bearer my-token")
+	if assert.NoError(t, err) {
+		testSink(t, s)
+	}
+	s, err = New(config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{Format: config.HTTPLogSinkLoki, URL: server.URL}}, "")
+	if assert.NoError(t, err) {
+		assert.Error(t, s.Write(context.Background(), testEntries(time.Now())), "unauthorized")
+	}
+}
+
+func TestElasticsearchSink(t *testing.T) {
+	withPageSize(t, 2)
+	server := httptest.NewServer(&elasticsearchStandIn{})
+	defer server.Close()
+	s, err := New(config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{Format: config.HTTPLogSinkElasticsearch, URL: server.URL, Index: "my-index"}}, "")
+	if assert.NoError(t, err) {
+		testSink(t, s)
+		assert.EqualError(t, s.Write(context.Background(), []Entry{{Time: time.Now()}}), "failed to index log entries: mapper_parsing_exception: invalid")
+	}
+	s, err = New(config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{Format: config.HTTPLogSinkElasticsearch, URL: server.URL}}, "")
+	if assert.NoError(t, err) {
+		entries, err := s.Read(context.Background(), Query{Namespace: "my-ns", Workflow: "my-wf"})
+		assert.NoError(t, err, "missing indices have no entries")
+		assert.Empty(t, entries)
+	}
+}
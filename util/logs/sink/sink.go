@@ -0,0 +1,63 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+// Entry is a line of the log of a container of a workflow's pod
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Namespace string    `json:"namespace"`
+	Workflow  string    `json:"workflow"`
+	PodName   string    `json:"podName"`
+	Container string    `json:"container"`
+	Content   string    `json:"content"`
+}
+
+// Query selects the entries of a workflow. The pod name and container are optional.
+type Query struct {
+	Namespace string
+	Workflow  string
+	PodName   string
+	Container string
+	// Since is the time of the earliest entry, sinks that are queried by time range, such as Loki, require it
+	Since time.Time
+}
+
+func (q Query) matches(e Entry) bool {
+	return e.Namespace == q.Namespace &&
+		e.Workflow == q.Workflow &&
+		(q.PodName == "" || e.PodName == q.PodName) &&
+		(q.Container == "" || e.Container == q.Container) &&
+		!e.Time.Before(q.Since)
+}
+
+// Sink stores the logs of containers, so that they can be read after their pods are deleted
+type Sink interface {
+	// Write writes the entries
+	Write(ctx context.Context, entries []Entry) error
+	// Read returns the entries matching the query, oldest first
+	Read(ctx context.Context, query Query) ([]Entry, error)
+}
+
+// New returns the sink configured, the authorization is the value of the Authorization header of HTTP sinks
+func New(cfg config.LogSinkConfig, authorization string) (Sink, error) {
+	switch {
+	case cfg.HTTP != nil && cfg.File != nil:
+		return nil, fmt.Errorf("only one of http or file log sinks can be configured")
+	case cfg.HTTP != nil:
+		return newHTTPSink(*cfg.HTTP, authorization)
+	case cfg.File != nil:
+		return newFileSink(*cfg.File)
+	}
+	return nil, fmt.Errorf("no log sink configured")
+}
+
+func sortEntries(entries []Entry) {
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+}
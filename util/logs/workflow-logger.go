@@ -41,9 +41,11 @@ type sender interface {
 	Send(entry *workflowpkg.LogEntry) error
 }
 
-func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient kubernetes.Interface, req request, sender sender) error {
+// WorkflowLogs streams the logs of the workflow's pods. If there is an archive, the logs of pods that have been deleted
+// are read from it.
+func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient kubernetes.Interface, archive Archive, req request, sender sender) error {
 	wfInterface := wfClient.ArgoprojV1alpha1().Workflows(req.GetNamespace())
-	_, err := wfInterface.Get(ctx, req.GetName(), metav1.GetOptions{})
+	wf, err := wfInterface.Get(ctx, req.GetName(), metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
@@ -167,6 +169,31 @@ func WorkflowLogs(ctx context.Context, wfClient versioned.Interface, kubeClient
 		ensureWeAreStreaming(&pod)
 	}
 
+	// the archive does not have the pods' labels, so it cannot be read when there is a selector
+	if archive != nil && req.GetSelector() == "" {
+		livePods := make(map[string]bool)
+		for _, pod := range list.Items {
+			livePods[pod.Name] = true
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logCtx.Debug("Reading archived logs")
+			entries, err := archivedLogs(ctx, archive, wf, req.GetPodName(), logOptions, livePods, rx)
+			if err != nil {
+				logCtx.WithError(err).Error("failed to read archived logs")
+				return
+			}
+			for _, e := range entries {
+				select {
+				case <-ctx.Done():
+					return
+				case unsortedEntries <- e:
+				}
+			}
+		}()
+	}
+
 	if logOptions.Follow {
 		wfListOptions := metav1.ListOptions{FieldSelector: "metadata.name=" + req.GetName(), ResourceVersion: "0"}
 		wfWatch, err := wfInterface.Watch(ctx, wfListOptions)
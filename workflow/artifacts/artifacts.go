@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/azure"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/gcs"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/git"
@@ -82,6 +83,7 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 			KmsEncryptionContext:  kmsEncryptionContext,
 			EnableEncryption:      enableEncryption,
 			ServerSideCustomerKey: serverSideCustomerKey,
+			TransferOptions:       common.TransferOptionsFromEnv(),
 		}
 
 		return &driver, nil
@@ -174,16 +176,17 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		}
 
 		driver := oss.ArtifactDriver{
-			Endpoint:      art.OSS.Endpoint,
-			AccessKey:     accessKey,
-			SecretKey:     secretKey,
-			SecurityToken: art.OSS.SecurityToken,
+			Endpoint:        art.OSS.Endpoint,
+			AccessKey:       accessKey,
+			SecretKey:       secretKey,
+			SecurityToken:   art.OSS.SecurityToken,
+			TransferOptions: common.TransferOptionsFromEnv(),
 		}
 		return &driver, nil
 	}
 
 	if art.GCS != nil {
-		driver := gcs.ArtifactDriver{}
+		driver := gcs.ArtifactDriver{TransferOptions: common.TransferOptionsFromEnv()}
 		if art.GCS.ServiceAccountKeySecret != nil && art.GCS.ServiceAccountKeySecret.Name != "" {
 			serviceAccountKeyBytes, err := ri.GetSecret(ctx, art.GCS.ServiceAccountKeySecret.Name, art.GCS.ServiceAccountKeySecret.Key)
 			if err != nil {
@@ -196,5 +199,30 @@ func newDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		return &driver, nil
 	}
 
+	if art.Azure != nil {
+		driver := azure.ArtifactDriver{
+			Account:         art.Azure.Account,
+			Endpoint:        art.Azure.Endpoint,
+			Container:       art.Azure.Container,
+			UseSDKCreds:     art.Azure.UseSDKCreds,
+			TransferOptions: common.TransferOptionsFromEnv(),
+		}
+		if art.Azure.AccountKeySecret != nil && art.Azure.AccountKeySecret.Name != "" {
+			accountKey, err := ri.GetSecret(ctx, art.Azure.AccountKeySecret.Name, art.Azure.AccountKeySecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.AccountKey = accountKey
+		}
+		if art.Azure.SASTokenSecret != nil && art.Azure.SASTokenSecret.Name != "" {
+			sasToken, err := ri.GetSecret(ctx, art.Azure.SASTokenSecret.Name, art.Azure.SASTokenSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.SASToken = sasToken
+		}
+		return &driver, nil
+	}
+
 	return nil, ErrUnsupportedDriver
 }
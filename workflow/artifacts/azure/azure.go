@@ -0,0 +1,337 @@
+package azure
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/argoproj/pkg/file"
+	log "github.com/sirupsen/logrus"
+
+	argoerrs "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	waitutil "github.com/argoproj/argo-workflows/v3/util/wait"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	executorretry "github.com/argoproj/argo-workflows/v3/workflow/executor/retry"
+)
+
+// ArtifactDriver is a driver for Azure Blob Storage. Directories are saved as one blob per file, named by the path of
+// the file relative to the directory. Requests are retried by the Azure SDK's client, and loads and saves that still
+// fail are retried, resuming partially downloaded blobs and skipping the files of directories that were transferred.
+type ArtifactDriver struct {
+	Account         string
+	Endpoint        string
+	Container       string
+	AccountKey      string
+	SASToken        string
+	UseSDKCreds     bool
+	TransferOptions common.TransferOptions
+}
+
+var _ common.ArtifactDriver = &ArtifactDriver{}
+
+// containerURL returns the URL of the container, e.g. https://<account>.blob.core.windows.net/<container>
+func (d *ArtifactDriver) containerURL() (string, error) {
+	endpoint := d.Endpoint
+	if endpoint == "" {
+		if d.Account == "" {
+			return "", fmt.Errorf("either the account or the endpoint of an Azure artifact must be set")
+		}
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", d.Account)
+	}
+	return url.JoinPath(endpoint, d.Container)
+}
+
+func (d *ArtifactDriver) newContainerClient() (*container.Client, error) {
+	containerURL, err := d.containerURL()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case d.AccountKey != "":
+		cred, err := container.NewSharedKeyCredential(d.Account, d.AccountKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Azure shared key: %v", err)
+		}
+		return container.NewClientWithSharedKeyCredential(containerURL, cred, nil)
+	case d.SASToken != "":
+		return container.NewClientWithNoCredential(containerURL+"?"+strings.TrimPrefix(d.SASToken, "?"), nil)
+	case d.UseSDKCreds:
+		// e.g. workload identity or a managed identity
+		cred, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the Azure SDK's default credentials: %v", err)
+		}
+		return container.NewClient(containerURL, cred, nil)
+	default:
+		// a public container
+		return container.NewClientWithNoCredential(containerURL, nil)
+	}
+}
+
+// isTransientAzureErr returns whether an error is worth retrying, i.e. it is not an error response from the service
+// other than a timeout, throttling or server error
+func isTransientAzureErr(err error) bool {
+	if err == nil || argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+		return false
+	}
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode == http.StatusRequestTimeout || respErr.StatusCode == http.StatusTooManyRequests || respErr.StatusCode >= 500
+	}
+	return true
+}
+
+// dirPrefix returns the prefix of the names of the blobs of a directory
+func dirPrefix(blob string) string {
+	return strings.TrimSuffix(blob, "/") + "/"
+}
+
+// listBlobs lists the blobs whose names start with the prefix
+func listBlobs(ctx context.Context, c *container.Client, prefix string) ([]*container.BlobItem, error) {
+	var items []*container.BlobItem
+	pager := c.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name != nil {
+				items = append(items, item)
+			}
+		}
+	}
+	return items, nil
+}
+
+// Load downloads a blob, or the blobs of a directory, from Azure Blob Storage
+func (d *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	transfer := d.TransferOptions.NewTransfer()
+	return waitutil.Backoff(executorretry.ExecutorRetry,
+		func() (bool, error) {
+			err := d.load(inputArtifact.Azure.Blob, path, transfer)
+			return !isTransientAzureErr(err), err
+		})
+}
+
+func (d *ArtifactDriver) load(blob, path string, transfer *common.Transfer) error {
+	log.Infof("Azure Load path: %s, container: %s, blob: %s", path, d.Container, blob)
+	ctx := context.Background()
+	c, err := d.newContainerClient()
+	if err != nil {
+		return err
+	}
+	err = d.downloadBlob(ctx, c, blob, path)
+	if !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return err
+	}
+	// the blob might be a directory
+	items, err := listBlobs(ctx, c, dirPrefix(blob))
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return argoerrs.New(argoerrs.CodeNotFound, fmt.Sprintf("no blob or directory named %s in container %s", blob, d.Container))
+	}
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = *item.Name
+	}
+	return transfer.Run(names, func(name string) error {
+		return d.downloadBlob(ctx, c, name, filepath.Join(path, filepath.FromSlash(strings.TrimPrefix(name, dirPrefix(blob)))))
+	})
+}
+
+// downloadBlob downloads a blob to a file, resuming the download of a previous attempt
+func (d *ArtifactDriver) downloadBlob(ctx context.Context, c *container.Client, name, path string) error {
+	b := c.NewBlobClient(name)
+	return d.TransferOptions.ResumeDownload(path, "azure://"+d.Container+"/"+name, func() (int64, error) {
+		props, err := b.GetProperties(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		if props.ContentLength == nil {
+			return 0, fmt.Errorf("blob %s has no content length", name)
+		}
+		return *props.ContentLength, nil
+	}, func(offset int64) (io.ReadCloser, error) {
+		resp, err := b.DownloadStream(ctx, &blob.DownloadStreamOptions{Range: blob.HTTPRange{Offset: offset}})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Body, nil
+	})
+}
+
+// OpenStream opens a blob for reading. Directories are streamed as a tarball of their blobs.
+func (d *ArtifactDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
+	blob := a.Azure.Blob
+	log.Infof("Azure OpenStream: container: %s, blob: %s", d.Container, blob)
+	ctx := context.Background()
+	c, err := d.newContainerClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.NewBlobClient(blob).DownloadStream(ctx, nil)
+	if err == nil {
+		return resp.Body, nil
+	}
+	if !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return nil, err
+	}
+	items, err := listBlobs(ctx, c, dirPrefix(blob))
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, argoerrs.New(argoerrs.CodeNotFound, fmt.Sprintf("no blob or directory named %s in container %s", blob, d.Container))
+	}
+	r, w := io.Pipe()
+	go func() {
+		_ = w.CloseWithError(writeTar(ctx, c, dirPrefix(blob), items, w))
+	}()
+	return r, nil
+}
+
+// writeTar writes the blobs to a tarball, named by their names relative to the prefix
+func writeTar(ctx context.Context, c *container.Client, prefix string, items []*container.BlobItem, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	for _, item := range items {
+		resp, err := c.NewBlobClient(*item.Name).DownloadStream(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if resp.ContentLength == nil {
+			_ = resp.Body.Close()
+			return fmt.Errorf("the size of blob %s is unknown", *item.Name)
+		}
+		header := &tar.Header{Name: strings.TrimPrefix(*item.Name, prefix), Mode: 0o644, Size: *resp.ContentLength}
+		if resp.LastModified != nil {
+			header.ModTime = *resp.LastModified
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			_ = resp.Body.Close()
+			return err
+		}
+		_, err = io.Copy(tw, resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+// Save uploads a file, or the files of a directory, to Azure Blob Storage. Files are uploaded in blocks of the part
+// size.
+func (d *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	transfer := d.TransferOptions.NewTransfer()
+	return waitutil.Backoff(executorretry.ExecutorRetry,
+		func() (bool, error) {
+			err := d.save(path, outputArtifact.Azure.Blob, transfer)
+			return !isTransientAzureErr(err), err
+		})
+}
+
+func (d *ArtifactDriver) save(path, blob string, transfer *common.Transfer) error {
+	log.Infof("Azure Save path: %s, container: %s, blob: %s", path, d.Container, blob)
+	ctx := context.Background()
+	c, err := d.newContainerClient()
+	if err != nil {
+		return err
+	}
+	isDir, err := file.IsDirectory(path)
+	if err != nil {
+		return fmt.Errorf("failed to test if %s is a directory: %v", path, err)
+	}
+	if !isDir {
+		return d.uploadBlob(ctx, c, blob, path)
+	}
+	var files []string
+	err = filepath.Walk(path, func(localPath string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			files = append(files, localPath)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return transfer.Run(files, func(localPath string) error {
+		rel, err := filepath.Rel(path, localPath)
+		if err != nil {
+			return err
+		}
+		return d.uploadBlob(ctx, c, dirPrefix(blob)+filepath.ToSlash(rel), localPath)
+	})
+}
+
+func (d *ArtifactDriver) uploadBlob(ctx context.Context, c *container.Client, blob, path string) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	opts := &blockblob.UploadFileOptions{BlockSize: d.TransferOptions.PartSize, Concurrency: uint16(d.TransferOptions.Parallelism)}
+	if _, err := c.NewBlockBlobClient(blob).UploadFile(ctx, f, opts); err != nil {
+		return fmt.Errorf("failed to upload %s to blob %s: %w", path, blob, err)
+	}
+	return nil
+}
+
+// Delete deletes a blob, or the blobs of a directory, from Azure Blob Storage. Deleting a blob that does not exist is
+// not an error.
+func (d *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	blob := artifact.Azure.Blob
+	log.Infof("Azure Delete: container: %s, blob: %s", d.Container, blob)
+	ctx := context.Background()
+	c, err := d.newContainerClient()
+	if err != nil {
+		return err
+	}
+	_, err = c.NewBlobClient(blob).Delete(ctx, nil)
+	if !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return err
+	}
+	items, err := listBlobs(ctx, c, dirPrefix(blob))
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if _, err := c.NewBlobClient(*item.Name).Delete(ctx, nil); err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListObjects lists the names of the blobs of a directory
+func (d *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	c, err := d.newContainerClient()
+	if err != nil {
+		return nil, err
+	}
+	items, err := listBlobs(context.Background(), c, dirPrefix(artifact.Azure.Blob))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = *item.Name
+	}
+	return names, nil
+}
@@ -0,0 +1,197 @@
+package azure
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argoerrors "github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// the well-known account and key of Azurite
+	azuriteAccount    = "devstoreaccount1"
+	azuriteAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+// fakeBlobService implements the parts of the Blob service API used by the driver, keeping the blobs of one container
+// in memory
+type fakeBlobService struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func (s *fakeBlobService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// the path is /<account>/<container>[/<blob>]
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 3)
+	if len(parts) < 2 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(parts) == 2 {
+		s.list(w, r.URL.Query().Get("prefix"))
+		return
+	}
+	name := parts[2]
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		s.blobs[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodGet:
+		data, ok := s.blobs[name]
+		if !ok {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		if _, ok := s.blobs[name]; !ok {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeBlobService) list(w http.ResponseWriter, prefix string) {
+	type blob struct {
+		Name string `xml:"Name"`
+	}
+	result := struct {
+		XMLName xml.Name `xml:"EnumerationResults"`
+		Blobs   []blob   `xml:"Blobs>Blob"`
+	}{}
+	for name := range s.blobs {
+		if strings.HasPrefix(name, prefix) {
+			result.Blobs = append(result.Blobs, blob{Name: name})
+		}
+	}
+	sort.Slice(result.Blobs, func(i, j int) bool { return result.Blobs[i].Name < result.Blobs[j].Name })
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+// newTestDriver returns a driver for a fake Blob service, or for Azurite if AZURITE_ENDPOINT is set, e.g. to
+// http://127.0.0.1:10000/devstoreaccount1. The container must already exist in Azurite.
+func newTestDriver(t *testing.T) *ArtifactDriver {
+	endpoint := os.Getenv("AZURITE_ENDPOINT")
+	if endpoint == "" {
+		server := httptest.NewServer(&fakeBlobService{blobs: map[string][]byte{}})
+		t.Cleanup(server.Close)
+		endpoint = server.URL + "/" + azuriteAccount
+	}
+	return &ArtifactDriver{Account: azuriteAccount, Endpoint: endpoint, Container: "my-container", AccountKey: azuriteAccountKey}
+}
+
+func azureArtifact(blob string) *wfv1.Artifact {
+	return &wfv1.Artifact{ArtifactLocation: wfv1.ArtifactLocation{Azure: &wfv1.AzureArtifact{AzureBlobContainer: wfv1.AzureBlobContainer{Container: "my-container"}, Blob: blob}}}
+}
+
+func TestArtifactDriver_containerURL(t *testing.T) {
+	u, err := (&ArtifactDriver{Account: "my-account", Container: "my-container"}).containerURL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://my-account.blob.core.windows.net/my-container", u)
+
+	u, err = (&ArtifactDriver{Endpoint: "http://127.0.0.1:10000/devstoreaccount1", Container: "my-container"}).containerURL()
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:10000/devstoreaccount1/my-container", u)
+
+	_, err = (&ArtifactDriver{Container: "my-container"}).containerURL()
+	assert.Error(t, err)
+}
+
+func TestArtifactDriver_File(t *testing.T) {
+	driver := newTestDriver(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "my-file")
+	require.NoError(t, os.WriteFile(path, []byte("my-data"), 0o600))
+	art := azureArtifact("my-wf/my-file")
+
+	require.NoError(t, driver.Save(path, art))
+
+	loadedPath := filepath.Join(dir, "loaded")
+	require.NoError(t, driver.Load(art, loadedPath))
+	data, err := os.ReadFile(loadedPath)
+	require.NoError(t, err)
+	assert.Equal(t, "my-data", string(data))
+
+	stream, err := driver.OpenStream(art)
+	require.NoError(t, err)
+	data, err = io.ReadAll(stream)
+	require.NoError(t, err)
+	assert.NoError(t, stream.Close())
+	assert.Equal(t, "my-data", string(data))
+
+	require.NoError(t, driver.Delete(art))
+	err = driver.Load(art, loadedPath)
+	assert.True(t, argoerrors.IsCode(argoerrors.CodeNotFound, err), "deleted blobs are not found")
+	_, err = driver.OpenStream(art)
+	assert.True(t, argoerrors.IsCode(argoerrors.CodeNotFound, err))
+	assert.NoError(t, driver.Delete(art), "deleting a blob that does not exist is not an error")
+}
+
+func TestArtifactDriver_Directory(t *testing.T) {
+	driver := newTestDriver(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "my-dir")
+	require.NoError(t, os.MkdirAll(filepath.Join(path, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(path, "a.txt"), []byte("a"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(path, "sub", "b.txt"), []byte("bb"), 0o600))
+	art := azureArtifact("my-wf/my-dir")
+
+	require.NoError(t, driver.Save(path, art))
+
+	names, err := driver.ListObjects(art)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"my-wf/my-dir/a.txt", "my-wf/my-dir/sub/b.txt"}, names)
+
+	loadedPath := filepath.Join(dir, "loaded")
+	require.NoError(t, driver.Load(art, loadedPath))
+	data, err := os.ReadFile(filepath.Join(loadedPath, "sub", "b.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "bb", string(data))
+
+	stream, err := driver.OpenStream(art)
+	require.NoError(t, err)
+	files := map[string]string{}
+	tr := tar.NewReader(stream)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(data)
+	}
+	assert.NoError(t, stream.Close())
+	assert.Equal(t, map[string]string{"a.txt": "a", "sub/b.txt": "bb"}, files, "directories are streamed as tarballs")
+
+	require.NoError(t, driver.Delete(art))
+	names, err = driver.ListObjects(art)
+	require.NoError(t, err)
+	assert.Empty(t, names, "deleting a directory deletes all of its blobs")
+}
@@ -0,0 +1,168 @@
+package common
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	wfcommon "github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// TransferOptions configures how drivers transfer artifacts
+type TransferOptions struct {
+	// Parallelism is the number of files of a directory, or parts of a file, that are transferred at the same time
+	Parallelism int
+	// PartSize is the size in bytes of the parts that large files are transferred in, or zero for the driver's default
+	PartSize int64
+	// PartialDownloadsDir is the directory that partial downloads are kept in, so that the retries of the node resume
+	// them, or empty to keep them next to the files
+	PartialDownloadsDir string
+}
+
+// TransferOptionsFromEnv returns the transfer options that the controller configured the executor with
+func TransferOptionsFromEnv() TransferOptions {
+	o := TransferOptions{
+		Parallelism:         env.LookupEnvIntOr(wfcommon.EnvVarArtifactTransferParallelism, 1),
+		PartSize:            int64(env.LookupEnvIntOr(wfcommon.EnvVarArtifactPartSize, 0)),
+		PartialDownloadsDir: os.Getenv(wfcommon.EnvVarArtifactPartialDownloads),
+	}
+	if o.Parallelism < 1 {
+		o.Parallelism = 1
+	}
+	return o
+}
+
+// PartSizeOr returns the part size, or the driver's default if it is not set
+func (o TransferOptions) PartSizeOr(defaultPartSize int64) int64 {
+	if o.PartSize > 0 {
+		return o.PartSize
+	}
+	return defaultPartSize
+}
+
+// Transfer transfers the files of a directory in parallel. It remembers which files were transferred, so that retrying
+// a failed transfer with the same Transfer only transfers the files that were not.
+type Transfer struct {
+	parallelism int
+	mutex       sync.Mutex
+	done        map[string]bool
+}
+
+func (o TransferOptions) NewTransfer() *Transfer {
+	return &Transfer{parallelism: max(o.Parallelism, 1), done: make(map[string]bool)}
+}
+
+// Run calls transfer for each file that has not been transferred yet, and returns the first error
+func (t *Transfer) Run(files []string, transfer func(file string) error) error {
+	g := errgroup.Group{}
+	g.SetLimit(t.parallelism)
+	for _, file := range files {
+		file := file
+		t.mutex.Lock()
+		done := t.done[file]
+		t.mutex.Unlock()
+		if done {
+			continue
+		}
+		g.Go(func() error {
+			if err := transfer(file); err != nil {
+				return err
+			}
+			t.mutex.Lock()
+			defer t.mutex.Unlock()
+			t.done[file] = true
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// ResumeDownload downloads an object to a file via a partial file that is kept if the download fails, so that retrying
+// it only downloads the rest of the object. The partial file is kept in the partial downloads directory if there is one,
+// so that the retries of the node, which run in new pods, resume it too. size returns the size of the object, and is only
+// called to resume, and open returns the content of the object from the offset.
+func (o TransferOptions) ResumeDownload(path, object string, size func() (int64, error), open func(offset int64) (io.ReadCloser, error)) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	partPath := path + ".part"
+	if o.PartialDownloadsDir != "" {
+		partPath = filepath.Join(o.PartialDownloadsDir, fmt.Sprintf("%x.part", sha256.Sum256([]byte(object))))
+	}
+	out, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	err = download(out, size, open)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return moveFile(partPath, path)
+}
+
+// download appends the rest of the object to the partial file
+func download(out *os.File, size func() (int64, error), open func(offset int64) (io.ReadCloser, error)) error {
+	offset, err := out.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		n, err := size()
+		if err != nil {
+			return err
+		}
+		if offset == n {
+			// an earlier attempt downloaded all of it, and asking for the range from the end would fail
+			return nil
+		}
+		if offset > n {
+			// the object was replaced by a smaller one since, so start again
+			if err := out.Truncate(0); err != nil {
+				return err
+			}
+			if offset, err = out.Seek(0, io.SeekStart); err != nil {
+				return err
+			}
+		}
+	}
+	in, err := open(offset)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// moveFile moves a file, copying it if it is on another volume
+func moveFile(from, to string) error {
+	if err := os.Rename(from, to); err == nil {
+		return nil
+	}
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.Create(to)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Remove(from)
+}
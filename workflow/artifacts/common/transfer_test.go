@@ -0,0 +1,137 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransfer_Run(t *testing.T) {
+	transfer := TransferOptions{Parallelism: 2}.NewTransfer()
+	files := []string{"a", "b", "c", "d"}
+	var mutex sync.Mutex
+	var running, maxRunning int32
+	transferred := map[string]int{}
+	fn := func(file string) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if n > maxRunning {
+			maxRunning = n
+		}
+		transferred[file]++
+		if file == "c" && transferred[file] == 1 {
+			return fmt.Errorf("failed to transfer c")
+		}
+		return nil
+	}
+
+	assert.EqualError(t, transfer.Run(files, fn), "failed to transfer c")
+	assert.LessOrEqual(t, maxRunning, int32(2))
+
+	require.NoError(t, transfer.Run(files, fn), "retrying transfers the failed file")
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 2, "d": 1}, transferred, "retrying does not transfer files again")
+}
+
+func TestResumeDownload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "my-file")
+	content := "my-content"
+	size := func() (int64, error) { return int64(len(content)), nil }
+	o := TransferOptions{}
+
+	err := o.ResumeDownload(path, "my-object", size, func(offset int64) (io.ReadCloser, error) {
+		// fails after the first 3 bytes
+		return io.NopCloser(io.MultiReader(strings.NewReader(content[:3]), &failingReader{})), nil
+	})
+	require.Error(t, err)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "the file is not created until it is downloaded")
+
+	var offsets []int64
+	err = o.ResumeDownload(path, "my-object", size, func(offset int64) (io.ReadCloser, error) {
+		offsets = append(offsets, offset)
+		return io.NopCloser(strings.NewReader(content[offset:])), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, offsets, "the download resumes from the partial file")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data))
+}
+
+func TestResumeDownload_PartialDownloadsDir(t *testing.T) {
+	o := TransferOptions{PartialDownloadsDir: t.TempDir()}
+	content := "my-content"
+	size := func() (int64, error) { return int64(len(content)), nil }
+	download := func(path string, read func(offset int64) io.Reader) ([]int64, error) {
+		var offsets []int64
+		err := o.ResumeDownload(path, "my-object", size, func(offset int64) (io.ReadCloser, error) {
+			offsets = append(offsets, offset)
+			return io.NopCloser(read(offset)), nil
+		})
+		return offsets, err
+	}
+
+	// each attempt of the node runs in a pod of its own, so downloads to a directory of its own
+	_, err := download(filepath.Join(t.TempDir(), "my-file"), func(offset int64) io.Reader {
+		return io.MultiReader(strings.NewReader(content[:3]), &failingReader{})
+	})
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "my-file")
+	offsets, err := download(path, func(offset int64) io.Reader {
+		return strings.NewReader(content[offset:])
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, offsets, "the next attempt resumes the partial file")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data))
+	files, err := os.ReadDir(o.PartialDownloadsDir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "the partial file is moved once it is downloaded")
+}
+
+func TestResumeDownload_Complete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my-file")
+	require.NoError(t, os.WriteFile(path+".part", []byte("my-content"), 0o644))
+
+	err := TransferOptions{}.ResumeDownload(path, "my-object", func() (int64, error) { return 10, nil }, func(offset int64) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("the range %d- is not satisfiable", offset)
+	})
+	require.NoError(t, err, "a partial file of the size of the object is not resumed")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "my-content", string(data))
+}
+
+func TestResumeDownload_Replaced(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "my-file")
+	require.NoError(t, os.WriteFile(path+".part", []byte("my-old-content"), 0o644))
+
+	var offsets []int64
+	err := TransferOptions{}.ResumeDownload(path, "my-object", func() (int64, error) { return 3, nil }, func(offset int64) (io.ReadCloser, error) {
+		offsets = append(offsets, offset)
+		return io.NopCloser(strings.NewReader("new"[offset:])), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0}, offsets, "a partial file larger than the object is downloaded again")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, fmt.Errorf("connection reset")
+}
@@ -29,6 +29,7 @@ import (
 // ArtifactDriver is a driver for GCS
 type ArtifactDriver struct {
 	ServiceAccountKey string
+	TransferOptions   common.TransferOptions
 }
 
 var (
@@ -99,6 +100,7 @@ func newGCSClientDefault() (*storage.Client, error) {
 
 // Load function downloads objects from GCS
 func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	transfer := g.TransferOptions.NewTransfer()
 	err := waitutil.Backoff(defaultRetry,
 		func() (bool, error) {
 			log.Infof("GCS Load path: %s, key: %s", path, inputArtifact.GCS.Key)
@@ -108,7 +110,7 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 				return !isTransientGCSErr(err), err
 			}
 			defer gcsClient.Close()
-			err = downloadObjects(gcsClient, inputArtifact.GCS.Bucket, inputArtifact.GCS.Key, path)
+			err = g.downloadObjects(gcsClient, inputArtifact.GCS.Bucket, inputArtifact.GCS.Key, path, transfer)
 			if err != nil {
 				log.Warnf("Failed to download objects from GCS: %v", err)
 				return !isTransientGCSErr(err), err
@@ -118,8 +120,8 @@ func (g *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	return err
 }
 
-// download all the objects of a key from the bucket
-func downloadObjects(client *storage.Client, bucket, key, path string) error {
+// download all the objects of a key from the bucket, skipping the objects the transfer already downloaded
+func (g *ArtifactDriver) downloadObjects(client *storage.Client, bucket, key, path string, transfer *common.Transfer) error {
 	objNames, err := listByPrefix(client, bucket, key, "")
 	if err != nil {
 		return err
@@ -128,17 +130,13 @@ func downloadObjects(client *storage.Client, bucket, key, path string) error {
 		msg := fmt.Sprintf("no results for key: %s", key)
 		return errors.New(errors.CodeNotFound, msg)
 	}
-	for _, objName := range objNames {
-		err = downloadObject(client, bucket, key, objName, path)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return transfer.Run(objNames, func(objName string) error {
+		return g.downloadObject(client, bucket, key, objName, path)
+	})
 }
 
 // download an object from the bucket
-func downloadObject(client *storage.Client, bucket, key, objName, path string) error {
+func (g *ArtifactDriver) downloadObject(client *storage.Client, bucket, key, objName, path string) error {
 	objPrefix := filepath.Clean(key)
 	if os.PathSeparator == '\\' {
 		objPrefix = strings.ReplaceAll(objPrefix, "\\", "/")
@@ -152,29 +150,24 @@ func downloadObject(client *storage.Client, bucket, key, objName, path string) e
 			return fmt.Errorf("mkdir %s: %v", objectDir, err)
 		}
 	}
-	ctx := context.Background()
-	rc, err := client.Bucket(bucket).Object(objName).NewReader(ctx)
-	if err != nil {
+	// a partially downloaded object is resumed by the next attempt
+	obj := client.Bucket(bucket).Object(objName)
+	return g.TransferOptions.ResumeDownload(localPath, "gs://"+bucket+"/"+objName, func() (int64, error) {
+		attrs, err := obj.Attrs(context.Background())
+		if err != nil {
+			return 0, fmt.Errorf("get object attributes: %v", err)
+		}
+		return attrs.Size, nil
+	}, func(offset int64) (io.ReadCloser, error) {
+		rc, err := obj.NewRangeReader(context.Background(), offset, -1)
 		if err == storage.ErrObjectNotExist {
-			return errors.New(errors.CodeNotFound, err.Error())
+			return nil, errors.New(errors.CodeNotFound, err.Error())
 		}
-		return fmt.Errorf("new bucket reader: %v", err)
-	}
-	defer rc.Close()
-	out, err := os.Create(localPath)
-	if err != nil {
-		return fmt.Errorf("os create %s: %v", localPath, err)
-	}
-	defer func() {
-		if err := out.Close(); err != nil {
-			log.Fatalf("Error closing file[%s]: %v", localPath, err)
+		if err != nil {
+			return nil, fmt.Errorf("new bucket reader: %v", err)
 		}
-	}()
-	_, err = io.Copy(out, rc)
-	if err != nil {
-		return fmt.Errorf("io copy: %v", err)
-	}
-	return nil
+		return rc, nil
+	})
 }
 
 // list all the object names of the prefix in the bucket
@@ -207,6 +200,7 @@ func (g *ArtifactDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, error) {
 
 // Save an artifact to GCS compliant storage, e.g., uploading a local file to GCS bucket
 func (g *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	transfer := g.TransferOptions.NewTransfer()
 	err := waitutil.Backoff(defaultRetry,
 		func() (bool, error) {
 			log.Infof("GCS Save path: %s, key: %s", path, outputArtifact.GCS.Key)
@@ -215,7 +209,7 @@ func (g *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error
 				return !isTransientGCSErr(err), err
 			}
 			defer client.Close()
-			err = uploadObjects(client, outputArtifact.GCS.Bucket, outputArtifact.GCS.Key, path)
+			err = uploadObjects(client, outputArtifact.GCS.Bucket, outputArtifact.GCS.Key, path, g.TransferOptions.PartSize, transfer)
 			if err != nil {
 				return !isTransientGCSErr(err), err
 			}
@@ -247,8 +241,8 @@ func listFileRelPaths(path string, relPath string) ([]string, error) {
 	return results, nil
 }
 
-// upload a local file or dir to GCS
-func uploadObjects(client *storage.Client, bucket, key, path string) error {
+// upload a local file or dir to GCS, skipping the files of the dir the transfer already uploaded
+func uploadObjects(client *storage.Client, bucket, key, path string, partSize int64, transfer *common.Transfer) error {
 	isDir, err := file.IsDirectory(path)
 	if err != nil {
 		return fmt.Errorf("test if %s is a dir: %v", path, err)
@@ -260,23 +254,24 @@ func uploadObjects(client *storage.Client, bucket, key, path string) error {
 		if err != nil {
 			return err
 		}
-		for _, relPath := range fileRelPaths {
+		return transfer.Run(fileRelPaths, func(relPath string) error {
 			fullKey := keyPrefix + relPath
 			if os.PathSeparator == '\\' {
 				fullKey = strings.ReplaceAll(fullKey, "\\", "/")
 			}
 
-			err = uploadObject(client, bucket, fullKey, dirName+relPath)
+			err := uploadObject(client, bucket, fullKey, dirName+relPath, partSize)
 			if err != nil {
 				return fmt.Errorf("upload %s: %v", dirName+relPath, err)
 			}
-		}
+			return nil
+		})
 	} else {
 		objectKey := filepath.Clean(key)
 		if os.PathSeparator == '\\' {
 			objectKey = strings.ReplaceAll(objectKey, "\\", "/")
 		}
-		err = uploadObject(client, bucket, objectKey, path)
+		err = uploadObject(client, bucket, objectKey, path, partSize)
 		if err != nil {
 			return fmt.Errorf("upload %s: %v", path, err)
 		}
@@ -284,8 +279,8 @@ func uploadObjects(client *storage.Client, bucket, key, path string) error {
 	return nil
 }
 
-// upload an object to GCS
-func uploadObject(client *storage.Client, bucket, key, localPath string) error {
+// upload an object to GCS, in chunks of the part size if it is set, each of which is retried on its own
+func uploadObject(client *storage.Client, bucket, key, localPath string, partSize int64) error {
 	f, err := os.Open(filepath.Clean(localPath))
 	if err != nil {
 		return fmt.Errorf("os open: %v", err)
@@ -297,6 +292,9 @@ func uploadObject(client *storage.Client, bucket, key, localPath string) error {
 	}()
 	ctx := context.Background()
 	wc := client.Bucket(bucket).Object(key).NewWriter(ctx)
+	if partSize > 0 {
+		wc.ChunkSize = int(partSize)
+	}
 	if _, err = io.Copy(wc, f); err != nil {
 		return fmt.Errorf("io copy: %v", err)
 	}
@@ -23,10 +23,11 @@ import (
 
 // ArtifactDriver is a driver for OSS
 type ArtifactDriver struct {
-	Endpoint      string
-	AccessKey     string
-	SecretKey     string
-	SecurityToken string
+	Endpoint        string
+	AccessKey       string
+	SecretKey       string
+	SecurityToken   string
+	TransferOptions common.TransferOptions
 }
 
 var (
@@ -36,6 +37,8 @@ var (
 	// OSS error code reference: https://error-center.alibabacloud.com/status/product/Oss
 	ossTransientErrorCodes = []string{"RequestTimeout", "QuotaExceeded.Refresh", "Default", "ServiceUnavailable", "Throttling", "RequestTimeTooSkewed", "SocketException", "SocketTimeout", "ServiceBusy", "DomainNetWorkVisitedException", "ConnectionTimeout", "CachedTimeTooLarge"}
 	bucketLogFilePrefix    = "bucket-log-"
+	// files larger than a part are transferred in parts, which are resumed from checkpoint files by later attempts
+	defaultPartSize int64 = 100 * 1024 * 1024
 )
 
 func (ossDriver *ArtifactDriver) newOSSClient() (*oss.Client, error) {
@@ -52,6 +55,7 @@ func (ossDriver *ArtifactDriver) newOSSClient() (*oss.Client, error) {
 
 // Load downloads artifacts from OSS compliant storage, e.g., downloading an artifact into local path
 func (ossDriver *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	transfer := ossDriver.TransferOptions.NewTransfer()
 	err := waitutil.Backoff(defaultRetry,
 		func() (bool, error) {
 			log.Infof("OSS Load path: %s, key: %s", path, inputArtifact.OSS.Key)
@@ -69,24 +73,27 @@ func (ossDriver *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string)
 				return !isTransientOSSErr(err), err
 			}
 			objectName := inputArtifact.OSS.Key
-			origErr := bucket.GetObjectToFile(objectName, path)
-			if origErr == nil {
-				return true, nil
+			exists, err := bucket.IsObjectExist(objectName)
+			if err != nil {
+				return !isTransientOSSErr(err), fmt.Errorf("failed to test if %s/%s exists: %w", bucketName, objectName, err)
 			}
-			if !IsOssErrCode(origErr, "NoSuchKey") {
-				return !isTransientOSSErr(origErr), fmt.Errorf("failed to get file: %w", origErr)
+			if exists {
+				if err := ossDriver.getFile(bucket, objectName, path); err != nil {
+					return !isTransientOSSErr(err), fmt.Errorf("failed to get file: %w", err)
+				}
+				return true, nil
 			}
-			// If we get here, the error was a NoSuchKey. The key might be a oss "directory"
+			// The key might be a oss "directory"
 			isDir, err := IsOssDirectory(bucket, objectName)
 			if err != nil {
 				return !isTransientOSSErr(err), fmt.Errorf("failed to test if %s/%s is a directory: %w", bucketName, objectName, err)
 			}
 			if !isDir {
-				// It's neither a file, nor a directory. Return the original NoSuchKey error
-				return false, origErr
+				// It's neither a file, nor a directory
+				return true, errors.New(errors.CodeNotFound, fmt.Sprintf("no such key: %s/%s", bucketName, objectName))
 			}
 
-			if err = GetOssDirectory(bucket, objectName, path); err != nil {
+			if err = ossDriver.getDirectory(bucket, objectName, path, transfer); err != nil {
 				return !isTransientOSSErr(err), fmt.Errorf("failed get directory: %v", err)
 			}
 			return true, nil
@@ -101,6 +108,7 @@ func (ossDriver *ArtifactDriver) OpenStream(a *wfv1.Artifact) (io.ReadCloser, er
 
 // Save stores an artifact to OSS compliant storage, e.g., uploading a local file to OSS bucket
 func (ossDriver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	transfer := ossDriver.TransferOptions.NewTransfer()
 	err := waitutil.Backoff(defaultRetry,
 		func() (bool, error) {
 			log.Infof("OSS Save path: %s, key: %s", path, outputArtifact.OSS.Key)
@@ -140,12 +148,12 @@ func (ossDriver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact
 				return !isTransientOSSErr(err), err
 			}
 			if isDir {
-				if err = putDirectory(bucket, objectName, path); err != nil {
+				if err = ossDriver.putDirectory(bucket, objectName, path, transfer); err != nil {
 					log.Warnf("failed to put directory: %v", err)
 					return !isTransientOSSErr(err), err
 				}
 			} else {
-				if err = putFile(bucket, objectName, path); err != nil {
+				if err = ossDriver.putFile(bucket, objectName, path); err != nil {
 					log.Warnf("failed to put file: %v", err)
 					return !isTransientOSSErr(err), err
 				}
@@ -260,13 +268,33 @@ func isTransientOSSErr(err error) bool {
 	return false
 }
 
-func putFile(bucket *oss.Bucket, objectName, path string) error {
+// transferOptions returns the options of resumable transfers, whose checkpoint files are kept in the temporary
+// directory because the directories of the files might not be writable
+func (ossDriver *ArtifactDriver) transferOptions() []oss.Option {
+	return []oss.Option{oss.Routines(ossDriver.TransferOptions.Parallelism), oss.CheckpointDir(true, os.TempDir())}
+}
+
+func (ossDriver *ArtifactDriver) putFile(bucket *oss.Bucket, objectName, path string) error {
 	log.Debugf("putFile from %s to %s", path, objectName)
-	return bucket.PutObjectFromFile(objectName, path)
+	partSize := ossDriver.TransferOptions.PartSizeOr(defaultPartSize)
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() <= partSize {
+		return bucket.PutObjectFromFile(objectName, path)
+	}
+	return bucket.UploadFile(objectName, path, partSize, ossDriver.transferOptions()...)
+}
+
+func (ossDriver *ArtifactDriver) getFile(bucket *oss.Bucket, objectName, path string) error {
+	return bucket.DownloadFile(objectName, path, ossDriver.TransferOptions.PartSizeOr(defaultPartSize), ossDriver.transferOptions()...)
 }
 
-func putDirectory(bucket *oss.Bucket, objectName, dir string) error {
-	return filepath.Walk(dir, func(fpath string, info os.FileInfo, err error) error {
+// putDirectory uploads the files of a directory, skipping the files the transfer already uploaded
+func (ossDriver *ArtifactDriver) putDirectory(bucket *oss.Bucket, objectName, dir string, transfer *common.Transfer) error {
+	var files []string
+	err := filepath.Walk(dir, func(fpath string, info os.FileInfo, err error) error {
 		if err != nil {
 			return errors.InternalWrapError(err)
 		}
@@ -287,15 +315,20 @@ func putDirectory(bucket *oss.Bucket, objectName, dir string) error {
 				return err
 			}
 		}
-		if !info.Mode().IsRegular() {
-			return nil
+		if info.Mode().IsRegular() {
+			files = append(files, fpath)
 		}
-
-		err = putFile(bucket, fObjectName, fpath)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return transfer.Run(files, func(fpath string) error {
+		nameInDir, err := filepath.Rel(dir, fpath)
 		if err != nil {
-			return err
+			return errors.InternalWrapError(err)
 		}
-		return nil
+		return ossDriver.putFile(bucket, filepath.Join(objectName, nameInDir), fpath)
 	})
 }
 
@@ -328,13 +361,13 @@ func IsOssDirectory(bucket *oss.Bucket, objectName string) (bool, error) {
 	return false, nil
 }
 
-// GetOssDirectory download an OSS "directory" to local path
-func GetOssDirectory(bucket *oss.Bucket, objectName, path string) error {
+// getDirectory downloads an OSS "directory" to local path, skipping the files the transfer already downloaded
+func (ossDriver *ArtifactDriver) getDirectory(bucket *oss.Bucket, objectName, path string, transfer *common.Transfer) error {
 	files, err := ListOssDirectory(bucket, objectName)
 	if err != nil {
 		return err
 	}
-	for _, f := range files {
+	return transfer.Run(files, func(f string) error {
 		innerName, err := filepath.Rel(objectName, f)
 		if err != nil {
 			return fmt.Errorf("get Rel path from %s to %s error: %w", f, objectName, err)
@@ -345,7 +378,7 @@ func GetOssDirectory(bucket *oss.Bucket, objectName, path string) error {
 			if err != nil {
 				return fmt.Errorf("mkdir %s error: %w", fpath, err)
 			}
-			continue
+			return nil
 		}
 		dirPath := filepath.Dir(fpath)
 		err = os.MkdirAll(dirPath, 0o700)
@@ -353,13 +386,13 @@ func GetOssDirectory(bucket *oss.Bucket, objectName, path string) error {
 			return fmt.Errorf("mkdir %s error: %w", dirPath, err)
 		}
 
-		err = bucket.GetObjectToFile(f, fpath)
+		err = ossDriver.getFile(bucket, f, fpath)
 		if err != nil {
 			log.Warnf("failed to load object %s to %s error: %v", f, fpath, err)
 			return err
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 // ListOssDirectory lists all the files which are the descendants of the specified objectKey, if a file has suffix '/', then it is an OSS directory
@@ -0,0 +1,120 @@
+package s3
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	argos3 "github.com/argoproj/pkg/s3"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	log "github.com/sirupsen/logrus"
+
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// transferClient applies the transfer options to the files that the S3 client of argoproj/pkg uploads and downloads,
+// which it leaves to the defaults of minio: files are uploaded in parts of the part size, and downloaded via partial
+// files in the partial downloads directory, so that the retries of the node resume them
+type transferClient struct {
+	argos3.S3Client
+	ctx             context.Context
+	minioClient     *minio.Client
+	encryptOpts     argos3.EncryptOpts
+	transferOptions artifactscommon.TransferOptions
+}
+
+// newTransferClient returns a client that applies the transfer options, or the client of argoproj/pkg if they are its
+// defaults
+func newTransferClient(ctx context.Context, opts argos3.S3ClientOpts, transferOptions artifactscommon.TransferOptions) (argos3.S3Client, error) {
+	s3cli, err := argos3.NewS3Client(ctx, opts)
+	if err != nil || (transferOptions.PartSize == 0 && transferOptions.PartialDownloadsDir == "") {
+		return s3cli, err
+	}
+	credentials, err := argos3.GetCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := minio.New(opts.Endpoint, &minio.Options{Creds: credentials, Secure: opts.Secure, Region: opts.Region})
+	if err != nil {
+		return nil, err
+	}
+	if opts.Trace {
+		minioClient.TraceOn(log.StandardLogger().Out)
+	}
+	return &transferClient{
+		S3Client:        s3cli,
+		ctx:             ctx,
+		minioClient:     minioClient,
+		encryptOpts:     opts.EncryptOpts,
+		transferOptions: transferOptions,
+	}, nil
+}
+
+// PutFile uploads a file in parts of the part size, the parallelism of which are uploaded at the same time
+func (c *transferClient) PutFile(bucket, key, path string) error {
+	log.WithFields(log.Fields{"bucket": bucket, "key": key, "path": path, "partSize": c.transferOptions.PartSize}).Info("Saving file to s3")
+	sse, err := c.serverSideEncryption(bucket, key)
+	if err != nil {
+		return err
+	}
+	_, err = c.minioClient.FPutObject(c.ctx, bucket, key, path, minio.PutObjectOptions{
+		ServerSideEncryption: sse,
+		PartSize:             uint64(c.transferOptions.PartSize),
+		NumThreads:           uint(c.transferOptions.Parallelism),
+	})
+	return err
+}
+
+// GetFile downloads a file via a partial file that a later attempt resumes
+func (c *transferClient) GetFile(bucket, key, path string) error {
+	log.WithFields(log.Fields{"bucket": bucket, "key": key, "path": path}).Info("Getting file from s3")
+	sse, err := c.serverSideEncryption(bucket, key)
+	if err != nil {
+		return err
+	}
+	return c.transferOptions.ResumeDownload(path, "s3://"+bucket+"/"+key, func() (int64, error) {
+		info, err := c.minioClient.StatObject(c.ctx, bucket, key, minio.StatObjectOptions{ServerSideEncryption: sse})
+		return info.Size, err
+	}, func(offset int64) (io.ReadCloser, error) {
+		opts := minio.GetObjectOptions{ServerSideEncryption: sse}
+		if offset > 0 {
+			if err := opts.SetRange(offset, 0); err != nil {
+				return nil, err
+			}
+		}
+		obj, err := c.minioClient.GetObject(c.ctx, bucket, key, opts)
+		if err != nil {
+			return nil, err
+		}
+		// errors, e.g. that the key does not exist, are only returned once the object is read
+		if _, err := obj.Stat(); err != nil {
+			_ = obj.Close()
+			return nil, err
+		}
+		return obj, nil
+	})
+}
+
+// serverSideEncryption returns the encryption of an object, as the client of argoproj/pkg does
+func (c *transferClient) serverSideEncryption(bucket, key string) (encrypt.ServerSide, error) {
+	e := c.encryptOpts
+	switch {
+	case !e.Enabled:
+		return nil, nil
+	case e.ServerSideCustomerKey != "":
+		return encrypt.DefaultPBKDF([]byte(e.ServerSideCustomerKey), []byte(bucket+key)), nil
+	case e.KmsKeyId != "" && e.KmsEncryptionContext != "":
+		context, err := json.Marshal(json.RawMessage(e.KmsEncryptionContext))
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal KMS encryption context: %w", err)
+		}
+		encoded := base64.StdEncoding.EncodeToString(context)
+		return encrypt.NewSSEKMS(e.KmsKeyId, &encoded)
+	case e.KmsKeyId != "":
+		return encrypt.NewSSEKMS(e.KmsKeyId, nil)
+	}
+	return encrypt.NewSSE(), nil
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/argoproj/pkg/file"
 	argos3 "github.com/argoproj/pkg/s3"
@@ -34,6 +37,7 @@ type ArtifactDriver struct {
 	KmsEncryptionContext  string
 	EnableEncryption      bool
 	ServerSideCustomerKey string
+	TransferOptions       artifactscommon.TransferOptions
 }
 
 var _ artifactscommon.ArtifactDriver = &ArtifactDriver{}
@@ -57,7 +61,7 @@ func (s3Driver *ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Clien
 		},
 	}
 
-	return argos3.NewS3Client(ctx, opts)
+	return newTransferClient(ctx, opts, s3Driver.TransferOptions)
 }
 
 // Load downloads artifacts from S3 compliant storage
@@ -65,6 +69,7 @@ func (s3Driver *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	transfer := s3Driver.newTransfer()
 	err := waitutil.Backoff(executorretry.ExecutorRetry,
 		func() (bool, error) {
 			log.Infof("S3 Load path: %s, key: %s", path, inputArtifact.S3.Key)
@@ -72,16 +77,25 @@ func (s3Driver *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string)
 			if err != nil {
 				return !isTransientS3Err(err), fmt.Errorf("failed to create new S3 client: %v", err)
 			}
-			return loadS3Artifact(s3cli, inputArtifact, path)
+			return loadS3Artifact(s3cli, inputArtifact, path, transfer)
 		})
 
 	return err
 }
 
+// newTransfer returns a transfer for the files of directories, or nil if they are transferred one at a time. Files are
+// downloaded via partial files that later attempts resume, and uploaded in parts of the part size.
+func (s3Driver *ArtifactDriver) newTransfer() *artifactscommon.Transfer {
+	if s3Driver.TransferOptions.Parallelism <= 1 {
+		return nil
+	}
+	return s3Driver.TransferOptions.NewTransfer()
+}
+
 // loadS3Artifact downloads artifacts from an S3 compliant storage
 // returns true if the download is completed or can't be retried (non-transient error)
 // returns false if it can be retried (transient error)
-func loadS3Artifact(s3cli argos3.S3Client, inputArtifact *wfv1.Artifact, path string) (bool, error) {
+func loadS3Artifact(s3cli argos3.S3Client, inputArtifact *wfv1.Artifact, path string, transfer *artifactscommon.Transfer) (bool, error) {
 	origErr := s3cli.GetFile(inputArtifact.S3.Bucket, inputArtifact.S3.Key, path)
 	if origErr == nil {
 		return true, nil
@@ -99,12 +113,50 @@ func loadS3Artifact(s3cli argos3.S3Client, inputArtifact *wfv1.Artifact, path st
 		return true, errors.New(errors.CodeNotFound, origErr.Error())
 	}
 
-	if err = s3cli.GetDirectory(inputArtifact.S3.Bucket, inputArtifact.S3.Key, path); err != nil {
+	if err = getDirectory(s3cli, inputArtifact.S3.Bucket, inputArtifact.S3.Key, path, transfer); err != nil {
 		return !isTransientS3Err(err), fmt.Errorf("failed to get directory: %v", err)
 	}
 	return true, nil
 }
 
+// getDirectory downloads the files of a directory, in parallel if there is a transfer
+func getDirectory(s3cli argos3.S3Client, bucket, key, path string, transfer *artifactscommon.Transfer) error {
+	if transfer == nil {
+		return s3cli.GetDirectory(bucket, key, path)
+	}
+	keys, err := s3cli.ListDirectory(bucket, key)
+	if err != nil {
+		return err
+	}
+	return transfer.Run(keys, func(objKey string) error {
+		return s3cli.GetFile(bucket, objKey, filepath.Join(path, strings.TrimPrefix(objKey, key)))
+	})
+}
+
+// putDirectory uploads the files of a directory, in parallel if there is a transfer
+func putDirectory(s3cli argos3.S3Client, bucket, key, dir string, transfer *artifactscommon.Transfer) error {
+	if transfer == nil {
+		return s3cli.PutDirectory(bucket, key, dir)
+	}
+	var files []string
+	err := filepath.Walk(dir, func(localPath string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			files = append(files, localPath)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return transfer.Run(files, func(localPath string) error {
+		rel, err := filepath.Rel(dir, localPath)
+		if err != nil {
+			return err
+		}
+		return s3cli.PutFile(bucket, path.Join(key, filepath.ToSlash(rel)), localPath)
+	})
+}
+
 // OpenStream opens a stream reader for an artifact from S3 compliant storage
 func (s3Driver *ArtifactDriver) OpenStream(inputArtifact *wfv1.Artifact) (io.ReadCloser, error) {
 	log.Infof("S3 OpenStream: key: %s", inputArtifact.S3.Key)
@@ -144,6 +196,7 @@ func (s3Driver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	transfer := s3Driver.newTransfer()
 	err := waitutil.Backoff(executorretry.ExecutorRetry,
 		func() (bool, error) {
 			log.Infof("S3 Save path: %s, key: %s", path, outputArtifact.S3.Key)
@@ -151,7 +204,7 @@ func (s3Driver *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact)
 			if err != nil {
 				return !isTransientS3Err(err), fmt.Errorf("failed to create new S3 client: %v", err)
 			}
-			return saveS3Artifact(s3cli, path, outputArtifact)
+			return saveS3Artifact(s3cli, path, outputArtifact, transfer)
 		})
 	return err
 }
@@ -176,7 +229,7 @@ func (s3Driver *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
 // saveS3Artifact uploads artifacts to an S3 compliant storage
 // returns true if the upload is completed or can't be retried (non-transient error)
 // returns false if it can be retried (transient error)
-func saveS3Artifact(s3cli argos3.S3Client, path string, outputArtifact *wfv1.Artifact) (bool, error) {
+func saveS3Artifact(s3cli argos3.S3Client, path string, outputArtifact *wfv1.Artifact, transfer *artifactscommon.Transfer) (bool, error) {
 	isDir, err := file.IsDirectory(path)
 	if err != nil {
 		return true, fmt.Errorf("failed to test if %s is a directory: %v", path, err)
@@ -195,7 +248,7 @@ func saveS3Artifact(s3cli argos3.S3Client, path string, outputArtifact *wfv1.Art
 	}
 
 	if isDir {
-		if err = s3cli.PutDirectory(outputArtifact.S3.Bucket, outputArtifact.S3.Key, path); err != nil {
+		if err = putDirectory(s3cli, outputArtifact.S3.Bucket, outputArtifact.S3.Key, path, transfer); err != nil {
 			return !isTransientS3Err(err), fmt.Errorf("failed to put directory: %v", err)
 		}
 	} else {
@@ -381,7 +381,7 @@ func TestLoadS3Artifact(t *testing.T) {
 						Key: tc.key,
 					},
 				},
-			}, tc.localPath)
+			}, tc.localPath, nil)
 			assert.Equal(t, tc.done, success)
 			if err != nil {
 				assert.Equal(t, tc.errMsg, err.Error())
@@ -516,7 +516,7 @@ func TestSaveS3Artifact(t *testing.T) {
 							Key: tc.key,
 						},
 					},
-				})
+				}, nil)
 			assert.Equal(t, tc.done, success)
 			if err != nil {
 				assert.Equal(t, tc.errMsg, err.Error())
@@ -45,6 +45,10 @@ const (
 	// AnnotationKeyProgress is N/M progress for the node
 	AnnotationKeyProgress = workflow.WorkflowFullName + "/progress"
 
+	// FinalizerArtifactGC is the finalizer added to workflows that delete their artifacts, so that artifacts are
+	// deleted before the workflow itself is removed
+	FinalizerArtifactGC = workflow.WorkflowFullName + "/artifact-gc"
+
 	// LabelKeyControllerInstanceID is the label the controller will carry forward to workflows/pod labels
 	// for the purposes of workflow segregation
 	LabelKeyControllerInstanceID = workflow.WorkflowFullName + "/controller-instanceid"
@@ -73,6 +77,8 @@ const (
 	LabelKeyPreviousWorkflowName = workflow.WorkflowFullName + "/resubmitted-from-workflow"
 	// LabelKeyCronWorkflow is a label applied to Workflows that are started by a CronWorkflow
 	LabelKeyCronWorkflow = workflow.WorkflowFullName + "/cron-workflow"
+	// LabelKeyCronWorkflowBackfill is a label applied to Workflows that are started by the backfill of a CronWorkflow
+	LabelKeyCronWorkflowBackfill = workflow.WorkflowFullName + "/cron-workflow-backfill"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from Workflowtemplate
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
@@ -92,6 +98,16 @@ const (
 	// as well as artifact collection by the wait container.
 	ExecutorMainFilesystemDir = "/mainctrfs"
 
+	// ExecutorSharedArtifactCacheDir is where the volume of the shared artifact cache is mounted on the init and wait
+	// containers
+	ExecutorSharedArtifactCacheDir = "/argo/shared-artifact-cache"
+	// ExecutorPartialDownloadsDir is where the volume of partial downloads is mounted on the init container
+	ExecutorPartialDownloadsDir = "/argo/partial-downloads"
+
+	// ContentAddressedKeyPrefix is the prefix of the keys of deduplicated artifacts, which are saved as
+	// "<prefix>/<sha256 hex>/<file name>"
+	ContentAddressedKeyPrefix = "argo-content/sha256"
+
 	// ExecutorStagingEmptyDir is the path of the emptydir which is used as a staging area to transfer a file between init/main container for script/resource templates
 	ExecutorStagingEmptyDir = "/argo/staging"
 	// ExecutorScriptSourcePath is the path which init will write the script source file to for script templates
@@ -135,6 +151,18 @@ const (
 	EnvVarProgressFileTickDuration = "ARGO_PROGRESS_FILE_TICK_DURATION"
 	// EnvVarProgressFile is the file watched for reporting progress
 	EnvVarProgressFile = "ARGO_PROGRESS_FILE"
+	// EnvVarLogSink is the JSON of the log sink the wait container streams the logs of the main containers to
+	EnvVarLogSink = "ARGO_LOG_SINK"
+	// EnvVarLogSinkAuthorization is the value of the Authorization header of HTTP log sinks
+	EnvVarLogSinkAuthorization = "ARGO_LOG_SINK_AUTHORIZATION"
+	// EnvVarSharedArtifactCache is the directory of the shared artifact cache, if there is one
+	EnvVarSharedArtifactCache = "ARGO_SHARED_ARTIFACT_CACHE"
+	// EnvVarArtifactTransferParallelism is the number of files, or parts of files, that artifact drivers transfer at once
+	EnvVarArtifactTransferParallelism = "ARGO_ARTIFACT_TRANSFER_PARALLELISM"
+	// EnvVarArtifactPartSize is the size in bytes of the parts that artifact drivers transfer large files in
+	EnvVarArtifactPartSize = "ARGO_ARTIFACT_PART_SIZE"
+	// EnvVarArtifactPartialDownloads is the directory that artifact drivers keep partial downloads in, if there is one
+	EnvVarArtifactPartialDownloads = "ARGO_ARTIFACT_PARTIAL_DOWNLOADS"
 	// EnvVarDefaultRequeueTime is the default requeue time for Workflow Informers. For more info, see rate_limiters.go
 	EnvVarDefaultRequeueTime = "DEFAULT_REQUEUE_TIME"
 	// EnvAgentTaskWorkers is the number of task workers for the agent pod
@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
+	"github.com/argoproj/argo-workflows/v3/util/slice"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+var (
+	artifactGCWorkers    = env.LookupEnvIntOr("ARTIFACT_GC_WORKERS", 4)
+	artifactGCMaxRetries = env.LookupEnvIntOr("ARTIFACT_GC_MAX_RETRIES", 5)
+)
+
+// artifactResources provides the artifact drivers with the secrets and config maps of a namespace
+type artifactResources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r artifactResources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r artifactResources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
+
+// addArtifactGCFinalizer adds the artifact GC finalizer to the workflow, so its artifacts are deleted
+// before the workflow itself is removed
+func (woc *wfOperationCtx) addArtifactGCFinalizer() {
+	if !woc.execWf.Spec.HasArtifactGC() || slice.ContainsString(woc.wf.Finalizers, common.FinalizerArtifactGC) {
+		return
+	}
+	woc.log.Info("Adding artifact GC finalizer")
+	woc.wf.Finalizers = append(woc.wf.Finalizers, common.FinalizerArtifactGC)
+	woc.updated = true
+}
+
+// queueArtifactGC queues a completed workflow for artifact GC
+func (woc *wfOperationCtx) queueArtifactGC() {
+	if !woc.wf.Status.Phase.Completed() {
+		return
+	}
+	if !slice.ContainsString(woc.wf.Finalizers, common.FinalizerArtifactGC) && !woc.wf.GetExecSpec().HasArtifactGC() {
+		return
+	}
+	woc.controller.artifactGCQueue.Add(woc.wf.Namespace + "/" + woc.wf.Name)
+}
+
+func (wfc *WorkflowController) queueArtifactGCForDeletedWorkflow(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.WithError(err).Error("failed to get key for object")
+		return
+	}
+	wfc.artifactGCQueue.Add(key)
+}
+
+func isDeletedWithArtifactGCFinalizer(obj interface{}) bool {
+	un, ok := obj.(*unstructured.Unstructured)
+	return ok && un.GetDeletionTimestamp() != nil && slice.ContainsString(un.GetFinalizers(), common.FinalizerArtifactGC)
+}
+
+func (wfc *WorkflowController) runArtifactGC(ctx context.Context) {
+	for wfc.processNextArtifactGCItem(ctx) {
+	}
+}
+
+func (wfc *WorkflowController) processNextArtifactGCItem(ctx context.Context) bool {
+	key, quit := wfc.artifactGCQueue.Get()
+	if quit {
+		return false
+	}
+	defer wfc.artifactGCQueue.Done(key)
+
+	lastAttempt := wfc.artifactGCQueue.NumRequeues(key) >= artifactGCMaxRetries
+	logCtx := log.WithField("key", key)
+	err := wfc.garbageCollectArtifacts(ctx, key.(string), lastAttempt)
+	if err != nil && !lastAttempt {
+		logCtx.WithError(err).Warn("failed to garbage collect artifacts, will retry")
+		wfc.artifactGCQueue.AddRateLimited(key)
+		return true
+	}
+	if err != nil {
+		logCtx.WithError(err).Error("failed to garbage collect artifacts, giving up")
+	}
+	wfc.artifactGCQueue.Forget(key)
+	return true
+}
+
+// garbageCollectArtifacts deletes the artifacts of a workflow whose strategy is due, records any failures in the
+// workflow's conditions, and removes the finalizer once there is nothing left to delete. On the last attempt, a
+// workflow that is being deleted has its finalizer removed regardless of failures, so it is not stuck forever.
+func (wfc *WorkflowController) garbageCollectArtifacts(ctx context.Context, key string, lastAttempt bool) error {
+	wfc.workflowKeyLock.Lock(key)
+	defer wfc.workflowKeyLock.Unlock(key)
+
+	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	wf, err := util.FromUnstructured(un)
+	if err != nil {
+		return err
+	}
+	deleting := wf.DeletionTimestamp != nil
+	if !deleting && !wf.Status.Phase.Completed() {
+		return nil
+	}
+	if err := wfc.hydrator.Hydrate(wf); err != nil {
+		return fmt.Errorf("failed to hydrate workflow: %w", err)
+	}
+
+	strategies := map[wfv1.ArtifactGCStrategy]bool{wfv1.ArtifactGCOnWorkflowCompletion: true}
+	if deleting {
+		strategies[wfv1.ArtfactGCOnWorkflowDeletion] = true
+	}
+
+	var location *wfv1.ArtifactLocation
+	if wf.Status.ArtifactRepositoryRef != nil {
+		repo, err := wfc.artifactRepositories.Get(ctx, wf.Status.ArtifactRepositoryRef)
+		if err != nil {
+			return fmt.Errorf("failed to get artifact repository: %w", err)
+		}
+		location = repo.ToArtifactLocation()
+	}
+
+	logCtx := log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name})
+	updated := false
+	pending := 0
+	var failures []string
+	for nodeID, node := range wf.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for i, a := range node.Outputs.Artifacts {
+			strategy := wf.GetArtifactGCStrategy(node, &a)
+			if a.Deleted || strategy == wfv1.ArtifactGCNever {
+				continue
+			}
+			if !strategies[strategy] {
+				pending++
+				continue
+			}
+			if err := wfc.deleteArtifact(ctx, wf.Namespace, a.DeepCopy(), location); err != nil {
+				logCtx.WithError(err).WithFields(log.Fields{"nodeId": nodeID, "artifact": a.Name}).Warn("failed to delete artifact")
+				failures = append(failures, fmt.Sprintf("%s/%s: %v", nodeID, a.Name, err))
+				pending++
+				continue
+			}
+			logCtx.WithFields(log.Fields{"nodeId": nodeID, "artifact": a.Name}).Info("deleted artifact")
+			node.Outputs.Artifacts[i].Deleted = true
+			updated = true
+		}
+		wf.Status.Nodes[nodeID] = node
+	}
+
+	if len(failures) > 0 {
+		wf.Status.Conditions.UpsertCondition(wfv1.Condition{
+			Type:    wfv1.ConditionTypeArtifactGCError,
+			Status:  metav1.ConditionTrue,
+			Message: strings.Join(failures, ", "),
+		})
+		updated = true
+	} else if hasCondition(wf.Status.Conditions, wfv1.ConditionTypeArtifactGCError) {
+		wf.Status.Conditions.RemoveCondition(wfv1.ConditionTypeArtifactGCError)
+		updated = true
+	}
+
+	if slice.ContainsString(wf.Finalizers, common.FinalizerArtifactGC) && (pending == 0 || (deleting && lastAttempt)) {
+		logCtx.WithField("pending", pending).Info("Removing artifact GC finalizer")
+		wf.Finalizers = slice.RemoveString(wf.Finalizers, common.FinalizerArtifactGC)
+		updated = true
+	}
+
+	if updated {
+		if err := wfc.hydrator.Dehydrate(wf); err != nil {
+			return fmt.Errorf("failed to dehydrate workflow: %w", err)
+		}
+		_, err := wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Update(ctx, wf, metav1.UpdateOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return fmt.Errorf("failed to update workflow: %w", err)
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to delete %d artifact(s)", len(failures))
+	}
+	return nil
+}
+
+func (wfc *WorkflowController) deleteArtifact(ctx context.Context, namespace string, a *wfv1.Artifact, location *wfv1.ArtifactLocation) error {
+	if err := a.Relocate(location); err != nil {
+		return err
+	}
+	driver, err := wfc.artDriverFactory(ctx, a, artifactResources{wfc.kubeclientset, namespace})
+	if err != nil {
+		return err
+	}
+	return driver.Delete(a)
+}
+
+func hasCondition(conditions wfv1.Conditions, conditionType wfv1.ConditionType) bool {
+	for _, c := range conditions {
+		if c.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+type fakeArtifactGCDriver struct {
+	artifactscommon.ArtifactDriver
+	deleted []string
+	err     error
+}
+
+func (d *fakeArtifactGCDriver) Delete(a *wfv1.Artifact) error {
+	if d.err != nil {
+		return d.err
+	}
+	key, _ := a.GetKey()
+	d.deleted = append(d.deleted, key)
+	return nil
+}
+
+var artifactGCWorkflow = `
+metadata:
+  name: my-wf
+  namespace: my-ns
+  finalizers:
+    - workflows.argoproj.io/artifact-gc
+spec:
+  entrypoint: main
+  artifactGC:
+    strategy: OnWorkflowCompletion
+  templates:
+    - name: main
+      container:
+        image: my-image
+status:
+  phase: Succeeded
+  artifactRepositoryRef:
+    default: true
+  nodes:
+    my-wf:
+      id: my-wf
+      name: my-wf
+      templateName: main
+      type: Pod
+      phase: Succeeded
+      outputs:
+        artifacts:
+          - name: on-completion
+            s3:
+              key: on-completion.tgz
+          - name: on-deletion
+            artifactGC:
+              strategy: OnWorkflowDeletion
+            s3:
+              key: on-deletion.tgz
+`
+
+func newArtifactGCController(wf *wfv1.Workflow, driver *fakeArtifactGCDriver) (context.CancelFunc, *WorkflowController) {
+	return newController(wf, func(controller *WorkflowController) {
+		controller.artDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+			return driver, nil
+		}
+	})
+}
+
+func TestGarbageCollectArtifacts(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OnWorkflowCompletion", func(t *testing.T) {
+		driver := &fakeArtifactGCDriver{}
+		cancel, controller := newArtifactGCController(wfv1.MustUnmarshalWorkflow(artifactGCWorkflow), driver)
+		defer cancel()
+		assert.NoError(t, controller.garbageCollectArtifacts(ctx, "my-ns/my-wf", false))
+		assert.Equal(t, []string{"on-completion.tgz"}, driver.deleted)
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			artifacts := wf.Status.Nodes["my-wf"].Outputs.Artifacts
+			assert.True(t, artifacts[0].Deleted)
+			assert.False(t, artifacts[1].Deleted)
+			assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		}
+	})
+	t.Run("OnWorkflowDeletion", func(t *testing.T) {
+		driver := &fakeArtifactGCDriver{}
+		wf := wfv1.MustUnmarshalWorkflow(artifactGCWorkflow)
+		now := metav1.Now()
+		wf.DeletionTimestamp = &now
+		cancel, controller := newArtifactGCController(wf, driver)
+		defer cancel()
+		assert.NoError(t, controller.garbageCollectArtifacts(ctx, "my-ns/my-wf", false))
+		assert.ElementsMatch(t, []string{"on-completion.tgz", "on-deletion.tgz"}, driver.deleted)
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		driver := &fakeArtifactGCDriver{err: fmt.Errorf("access denied")}
+		wf := wfv1.MustUnmarshalWorkflow(artifactGCWorkflow)
+		now := metav1.Now()
+		wf.DeletionTimestamp = &now
+		cancel, controller := newArtifactGCController(wf, driver)
+		defer cancel()
+		assert.EqualError(t, controller.garbageCollectArtifacts(ctx, "my-ns/my-wf", false), "failed to delete 2 artifact(s)")
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+			if assert.Len(t, wf.Status.Conditions, 1) {
+				assert.Equal(t, wfv1.ConditionTypeArtifactGCError, wf.Status.Conditions[0].Type)
+				assert.Contains(t, wf.Status.Conditions[0].Message, "my-wf/on-completion: access denied")
+			}
+		}
+		assert.Error(t, controller.garbageCollectArtifacts(ctx, "my-ns/my-wf", true))
+		wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("my-ns").Get(ctx, "my-wf", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		}
+	})
+}
+
+func TestAddArtifactGCFinalizer(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(`
+metadata:
+  name: my-wf
+spec:
+  entrypoint: main
+  templates:
+    - name: main
+      container:
+        image: my-image
+      outputs:
+        artifacts:
+          - name: my-art
+            path: /tmp/my-art
+            artifactGC:
+              strategy: OnWorkflowDeletion
+`)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(context.Background())
+	assert.Contains(t, woc.wf.Finalizers, common.FinalizerArtifactGC)
+}
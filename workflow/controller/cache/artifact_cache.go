@@ -0,0 +1,251 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
+)
+
+// artifactCacheKeyPrefix is the key, within the artifact repository, under which entries are stored as
+// "<prefix>/<namespace>/<cache name>/<key>.json"
+const artifactCacheKeyPrefix = "memoization-cache"
+
+type artifactCache struct {
+	location  *wfv1.ArtifactLocation
+	newDriver artifact.NewDriverFunc
+	resources resource.Interface
+	namespace string
+	name      string
+	lock      sync.Mutex
+}
+
+func NewArtifactCache(location *wfv1.ArtifactLocation, newDriver artifact.NewDriverFunc, ri resource.Interface, ns, n string) MemoizationCache {
+	return &artifactCache{
+		location:  location,
+		newDriver: newDriver,
+		resources: ri,
+		namespace: ns,
+		name:      n,
+	}
+}
+
+func (c *artifactCache) logCtx() *log.Entry {
+	return log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name, "cacheType": ArtifactCache})
+}
+
+func (c *artifactCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	a, driver, err := newArtifactCacheDriver(ctx, c.location, c.newDriver, c.resources, path.Join(artifactCacheKeyPrefix, c.namespace, c.name, key+".json"))
+	if err != nil {
+		return nil, err
+	}
+	entry, err := loadArtifactCacheEntry(driver, a)
+	if errors.IsCode(errors.CodeNotFound, err) {
+		c.logCtx().WithField("key", key).Info("artifact cache miss: entry does not exist")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load artifact cache entry: %w", err)
+	}
+
+	entry.LastHitTimestamp = metav1.Time{Time: time.Now()}
+	if err := saveArtifactCacheEntry(driver, a, entry); err != nil {
+		return nil, fmt.Errorf("error updating last hit timestamp on cache: %w", err)
+	}
+	return entry, nil
+}
+
+func (c *artifactCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.logCtx().WithFields(log.Fields{"key": key, "nodeId": nodeId}).Info("Saving artifact cache entry")
+
+	a, driver, err := newArtifactCacheDriver(ctx, c.location, c.newDriver, c.resources, path.Join(artifactCacheKeyPrefix, c.namespace, c.name, key+".json"))
+	if err != nil {
+		return err
+	}
+	creationTime := metav1.Time{Time: time.Now()}
+	err = saveArtifactCacheEntry(driver, a, &Entry{
+		NodeID:            nodeId,
+		Outputs:           value,
+		CreationTimestamp: creationTime,
+		LastHitTimestamp:  creationTime,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating cache entry: %w", err)
+	}
+	return nil
+}
+
+func (c *artifactCache) List(ctx context.Context) (map[string]*Entry, error) {
+	a, driver, err := newArtifactCacheDriver(ctx, c.location, c.newDriver, c.resources, path.Join(artifactCacheKeyPrefix, c.namespace, c.name))
+	if err != nil {
+		return nil, err
+	}
+	objects, err := driver.ListObjects(a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache entries: %w", err)
+	}
+	entries := make(map[string]*Entry, len(objects))
+	for _, object := range objects {
+		a, driver, err := newArtifactCacheDriver(ctx, c.location, c.newDriver, c.resources, object)
+		if err != nil {
+			return nil, err
+		}
+		entry, err := loadArtifactCacheEntry(driver, a)
+		if err != nil {
+			return nil, fmt.Errorf("could not load artifact cache entry %s: %w", object, err)
+		}
+		entries[strings.TrimSuffix(path.Base(object), ".json")] = entry
+	}
+	return entries, nil
+}
+
+func (c *artifactCache) Delete(ctx context.Context, keys []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.logCtx().WithField("keys", keys).Info("Deleting artifact cache entries")
+	for _, key := range keys {
+		a, driver, err := newArtifactCacheDriver(ctx, c.location, c.newDriver, c.resources, path.Join(artifactCacheKeyPrefix, c.namespace, c.name, key+".json"))
+		if err != nil {
+			return err
+		}
+		if err := driver.Delete(a); err != nil && !errors.IsCode(errors.CodeNotFound, err) {
+			return fmt.Errorf("error deleting cache entry %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func listArtifactCacheNames(ctx context.Context, location *wfv1.ArtifactLocation, newDriver artifact.NewDriverFunc, ri resource.Interface, namespace string) ([]string, error) {
+	prefix := path.Join(artifactCacheKeyPrefix, namespace)
+	a, driver, err := newArtifactCacheDriver(ctx, location, newDriver, ri, prefix)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := driver.ListObjects(a)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache entries: %w", err)
+	}
+	var names []string
+	seen := make(map[string]bool)
+	for _, object := range objects {
+		name := strings.SplitN(strings.TrimPrefix(object, prefix+"/"), "/", 2)[0]
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// newArtifactCacheDriver returns the artifact for a key in the repository, and a driver for it
+func newArtifactCacheDriver(ctx context.Context, location *wfv1.ArtifactLocation, newDriver artifact.NewDriverFunc, ri resource.Interface, key string) (*wfv1.Artifact, common.ArtifactDriver, error) {
+	a := &wfv1.Artifact{ArtifactLocation: *location.DeepCopy()}
+	if err := a.SetKey(key); err != nil {
+		return nil, nil, fmt.Errorf("artifact repository does not support keys: %w", err)
+	}
+	driver, err := newDriver(ctx, a, ri)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create artifact driver: %w", err)
+	}
+	return a, driver, nil
+}
+
+func loadArtifactCacheEntry(driver common.ArtifactDriver, a *wfv1.Artifact) (*Entry, error) {
+	dir, err := os.MkdirTemp("", "memoization-cache")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	file := filepath.Join(dir, "entry.json")
+	if err := driver.Load(a, file); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	entry := &Entry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+	return entry, nil
+}
+
+func saveArtifactCacheEntry(driver common.ArtifactDriver, a *wfv1.Artifact, entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	dir, err := os.MkdirTemp("", "memoization-cache")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+	file := filepath.Join(dir, "entry.json")
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		return err
+	}
+	return driver.Save(file, a)
+}
+
+// deleteArtifactCacheEntriesNotHitSince lists the entries of every cache in the namespace, and deletes the ones
+// that have not been hit since the given time
+func deleteArtifactCacheEntriesNotHitSince(ctx context.Context, location *wfv1.ArtifactLocation, newDriver artifact.NewDriverFunc, ri resource.Interface, namespace string, notHitSince time.Time) error {
+	a, driver, err := newArtifactCacheDriver(ctx, location, newDriver, ri, path.Join(artifactCacheKeyPrefix, namespace))
+	if err != nil {
+		return err
+	}
+	keys, err := driver.ListObjects(a)
+	if err != nil {
+		return fmt.Errorf("failed to list cache entries: %w", err)
+	}
+	for _, key := range keys {
+		logCtx := log.WithFields(log.Fields{"key": key, "cacheType": ArtifactCache})
+		a, driver, err := newArtifactCacheDriver(ctx, location, newDriver, ri, key)
+		if err != nil {
+			return err
+		}
+		entry, err := loadArtifactCacheEntry(driver, a)
+		if err != nil {
+			logCtx.WithError(err).Warn("Unable to load cache entry")
+			continue
+		}
+		if entry.LastHitTimestamp.Time.After(notHitSince) {
+			continue
+		}
+		logCtx.Info("Deleting cache entry since it's not been hit")
+		if err := driver.Delete(a); err != nil {
+			return fmt.Errorf("failed to delete cache entry %s: %w", key, err)
+		}
+	}
+	return nil
+}
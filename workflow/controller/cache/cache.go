@@ -2,13 +2,19 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"strings"
+	"sync"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"upper.io/db.v3/lib/sqlbuilder"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 )
 
 var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
@@ -16,6 +22,10 @@ var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 type MemoizationCache interface {
 	Load(ctx context.Context, key string) (*Entry, error)
 	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error
+	// List returns the entries of the cache by key. Unlike Load, it does not update the last hit timestamps.
+	List(ctx context.Context) (map[string]*Entry, error)
+	// Delete deletes the entries with the given keys, ignoring the ones that do not exist
+	Delete(ctx context.Context, keys []string) error
 }
 
 type Entry struct {
@@ -51,39 +61,157 @@ type cacheFactory struct {
 	caches     map[string]MemoizationCache
 	kubeclient kubernetes.Interface
 	namespace  string
+	lock       sync.Mutex
+	// session and clusterName are used by SQL caches
+	session     sqlbuilder.Database
+	clusterName string
+	// location, newDriver and resources are used by artifact caches
+	location  *wfv1.ArtifactLocation
+	newDriver artifact.NewDriverFunc
+	resources resource.Interface
 }
 
 type Factory interface {
 	GetCache(ct CacheType, name string) MemoizationCache
+	// ListCacheNames returns the names of the caches of a type that have entries
+	ListCacheNames(ctx context.Context, ct CacheType) ([]string, error)
+	// SetSQLSession sets the persistence session used by SQL caches. A nil session disables them.
+	SetSQLSession(session sqlbuilder.Database, clusterName string)
+	// SetArtifactLocation sets the artifact repository location used by artifact caches. A nil location disables them.
+	SetArtifactLocation(location *wfv1.ArtifactLocation, newDriver artifact.NewDriverFunc, ri resource.Interface)
+	// GarbageCollect deletes the entries of SQL and artifact caches that have not been hit since the given time.
+	// ConfigMap caches are garbage-collected by the controller using its informer.
+	GarbageCollect(ctx context.Context, notHitSince time.Time) error
 }
 
 func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
 	return &cacheFactory{
-		make(map[string]MemoizationCache),
-		ki,
-		ns,
+		caches:     make(map[string]MemoizationCache),
+		kubeclient: ki,
+		namespace:  ns,
 	}
 }
 
 type CacheType string
 
 const (
-	// Only config maps are currently supported for caching
 	ConfigMapCache CacheType = "ConfigMapCache"
+	// SQLCache stores entries in the persistence database
+	SQLCache CacheType = "SQLCache"
+	// ArtifactCache stores entries as objects in the default artifact repository
+	ArtifactCache CacheType = "ArtifactCache"
 )
 
+// GetCacheType returns the type of the cache configured by a template
+func GetCacheType(c *wfv1.Cache) CacheType {
+	switch {
+	case c != nil && c.SQL != nil:
+		return SQLCache
+	case c != nil && c.Artifact != nil:
+		return ArtifactCache
+	}
+	return ConfigMapCache
+}
+
+// GetStatusCacheType returns the type of the cache recorded in a memoized node's status
+func GetStatusCacheType(s *wfv1.MemoizationStatus) CacheType {
+	if s == nil || s.CacheType == "" {
+		return ConfigMapCache
+	}
+	return CacheType(s.CacheType)
+}
+
 // Returns a cache if it exists and creates it otherwise
 func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
 	idx := string(ct) + "." + name
 	if c := cf.caches[idx]; c != nil {
 		return c
 	}
+	var c MemoizationCache
 	switch ct {
 	case ConfigMapCache:
-		c := NewConfigMapCache(cf.namespace, cf.kubeclient, name)
-		cf.caches[idx] = c
-		return c
+		c = NewConfigMapCache(cf.namespace, cf.kubeclient, name)
+	case SQLCache:
+		if cf.session == nil {
+			return nil
+		}
+		c = NewSQLCache(cf.session, cf.clusterName, cf.namespace, name)
+	case ArtifactCache:
+		if cf.location == nil {
+			return nil
+		}
+		c = NewArtifactCache(cf.location, cf.newDriver, cf.resources, cf.namespace, name)
 	default:
 		return nil
 	}
+	cf.caches[idx] = c
+	return c
+}
+
+func (cf *cacheFactory) ListCacheNames(ctx context.Context, ct CacheType) ([]string, error) {
+	cf.lock.Lock()
+	session, clusterName := cf.session, cf.clusterName
+	location, newDriver, ri := cf.location, cf.newDriver, cf.resources
+	cf.lock.Unlock()
+	switch ct {
+	case ConfigMapCache:
+		return listConfigMapCacheNames(ctx, cf.kubeclient, cf.namespace)
+	case SQLCache:
+		if session == nil {
+			return nil, nil
+		}
+		return listSQLCacheNames(session, clusterName, cf.namespace)
+	case ArtifactCache:
+		if location == nil {
+			return nil, nil
+		}
+		return listArtifactCacheNames(ctx, location, newDriver, ri, cf.namespace)
+	}
+	return nil, fmt.Errorf("unknown cache type %s", ct)
+}
+
+func (cf *cacheFactory) SetSQLSession(session sqlbuilder.Database, clusterName string) {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
+	cf.session = session
+	cf.clusterName = clusterName
+	cf.forget(SQLCache)
+}
+
+func (cf *cacheFactory) SetArtifactLocation(location *wfv1.ArtifactLocation, newDriver artifact.NewDriverFunc, ri resource.Interface) {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
+	cf.location = location
+	cf.newDriver = newDriver
+	cf.resources = ri
+	cf.forget(ArtifactCache)
+}
+
+// forget removes the caches of a type, so they are re-created with the new configuration
+func (cf *cacheFactory) forget(ct CacheType) {
+	for idx := range cf.caches {
+		if strings.HasPrefix(idx, string(ct)+".") {
+			delete(cf.caches, idx)
+		}
+	}
+}
+
+func (cf *cacheFactory) GarbageCollect(ctx context.Context, notHitSince time.Time) error {
+	cf.lock.Lock()
+	session, clusterName := cf.session, cf.clusterName
+	location, newDriver, ri := cf.location, cf.newDriver, cf.resources
+	cf.lock.Unlock()
+	if session != nil {
+		if err := deleteSQLCacheEntriesNotHitSince(session, clusterName, cf.namespace, notHitSince); err != nil {
+			return fmt.Errorf("failed to garbage collect SQL caches: %w", err)
+		}
+	}
+	if location != nil {
+		if err := deleteArtifactCacheEntriesNotHitSince(ctx, location, newDriver, ri, cf.namespace, notHitSince); err != nil {
+			return fmt.Errorf("failed to garbage collect artifact caches: %w", err)
+		}
+	}
+	return nil
 }
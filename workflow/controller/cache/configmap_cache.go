@@ -145,3 +145,68 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 	}
 	return nil
 }
+
+func (c *configMapCache) List(ctx context.Context) (map[string]*Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return map[string]*Entry{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load config map cache: %w", err)
+	}
+	entries := make(map[string]*Entry, len(cm.Data))
+	for key, rawEntry := range cm.Data {
+		entry := &Entry{}
+		if err := json.Unmarshal([]byte(rawEntry), entry); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", key, err)
+		}
+		entries[key] = entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(ctx context.Context, keys []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not load config map cache: %w", err)
+	}
+	modified := false
+	for _, key := range keys {
+		if _, ok := cm.Data[key]; ok {
+			delete(cm.Data, key)
+			modified = true
+		}
+	}
+	if !modified {
+		return nil
+	}
+	c.logInfo(log.Fields{"keys": keys}, "Deleting ConfigMap cache entries")
+	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("error deleting cache entries: %w", err)
+	}
+	return nil
+}
+
+func listConfigMapCacheNames(ctx context.Context, kubeClient kubernetes.Interface, namespace string) ([]string, error) {
+	list, err := kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeyConfigMapType + "=" + common.LabelValueTypeConfigMapCache,
+	})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(list.Items))
+	for i, cm := range list.Items {
+		names[i] = cm.Name
+	}
+	return names, nil
+}
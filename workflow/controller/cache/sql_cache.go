@@ -0,0 +1,213 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// sqlCacheTableName is created by the persistence migration
+const sqlCacheTableName = "argo_memoization_cache"
+
+type sqlCacheRecord struct {
+	ClusterName string `db:"clustername"`
+	Namespace   string `db:"namespace"`
+	Name        string `db:"name"`
+	// Why is this called "cachekey" not "key"? Key is an SQL reserved word.
+	Key       string    `db:"cachekey"`
+	NodeID    string    `db:"nodeid"`
+	Outputs   string    `db:"outputs"`
+	CreatedAt time.Time `db:"createdat"`
+	LastHitAt time.Time `db:"lasthitat"`
+}
+
+type sqlCache struct {
+	session     sqlbuilder.Database
+	clusterName string
+	namespace   string
+	name        string
+	lock        sync.Mutex
+}
+
+func NewSQLCache(session sqlbuilder.Database, clusterName, ns, n string) MemoizationCache {
+	return &sqlCache{
+		session:     session,
+		clusterName: clusterName,
+		namespace:   ns,
+		name:        n,
+	}
+}
+
+func (c *sqlCache) logCtx() *log.Entry {
+	return log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name, "cacheType": SQLCache})
+}
+
+func (c *sqlCache) where(key string) db.Cond {
+	return db.Cond{"clustername": c.clusterName, "namespace": c.namespace, "name": c.name, "cachekey": key}
+}
+
+func (c *sqlCache) Load(ctx context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	record := &sqlCacheRecord{}
+	err := c.session.SelectFrom(sqlCacheTableName).Where(c.where(key)).One(record)
+	if err == db.ErrNoMoreRows {
+		c.logCtx().WithField("key", key).Info("SQL cache miss: entry does not exist")
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not load SQL cache entry: %w", err)
+	}
+
+	var outputs *wfv1.Outputs
+	if err := json.Unmarshal([]byte(record.Outputs), &outputs); err != nil {
+		return nil, fmt.Errorf("malformed cache entry: could not unmarshal JSON; unable to parse: %w", err)
+	}
+
+	hitTime := time.Now().UTC()
+	_, err = c.session.Update(sqlCacheTableName).Set("lasthitat", hitTime).Where(c.where(key)).Exec()
+	if err != nil {
+		return nil, fmt.Errorf("error updating last hit timestamp on cache: %w", err)
+	}
+
+	return &Entry{
+		NodeID:            record.NodeID,
+		Outputs:           outputs,
+		CreationTimestamp: metav1.Time{Time: record.CreatedAt},
+		LastHitTimestamp:  metav1.Time{Time: hitTime},
+	}, nil
+}
+
+func (c *sqlCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.logCtx().WithFields(log.Fields{"key": key, "nodeId": nodeId}).Info("Saving SQL cache entry")
+
+	outputs, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache entry: %w", err)
+	}
+	creationTime := time.Now().UTC()
+	return c.session.Tx(ctx, func(sess sqlbuilder.Tx) error {
+		_, err := sess.DeleteFrom(sqlCacheTableName).Where(c.where(key)).Exec()
+		if err != nil {
+			return fmt.Errorf("error deleting previous cache entry: %w", err)
+		}
+		_, err = sess.Collection(sqlCacheTableName).Insert(&sqlCacheRecord{
+			ClusterName: c.clusterName,
+			Namespace:   c.namespace,
+			Name:        c.name,
+			Key:         key,
+			NodeID:      nodeId,
+			Outputs:     string(outputs),
+			CreatedAt:   creationTime,
+			LastHitAt:   creationTime,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating cache entry: %w", err)
+		}
+		return nil
+	})
+}
+
+func (c *sqlCache) List(ctx context.Context) (map[string]*Entry, error) {
+	var records []sqlCacheRecord
+	err := c.session.
+		SelectFrom(sqlCacheTableName).
+		Where(db.Cond{"clustername": c.clusterName, "namespace": c.namespace, "name": c.name}).
+		All(&records)
+	if err != nil {
+		return nil, fmt.Errorf("could not list SQL cache entries: %w", err)
+	}
+	entries := make(map[string]*Entry, len(records))
+	for _, record := range records {
+		var outputs *wfv1.Outputs
+		if err := json.Unmarshal([]byte(record.Outputs), &outputs); err != nil {
+			return nil, fmt.Errorf("malformed cache entry %s: could not unmarshal JSON; unable to parse: %w", record.Key, err)
+		}
+		entries[record.Key] = &Entry{
+			NodeID:            record.NodeID,
+			Outputs:           outputs,
+			CreationTimestamp: metav1.Time{Time: record.CreatedAt},
+			LastHitTimestamp:  metav1.Time{Time: record.LastHitAt},
+		}
+	}
+	return entries, nil
+}
+
+func (c *sqlCache) Delete(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.logCtx().WithField("keys", keys).Info("Deleting SQL cache entries")
+	_, err := c.session.
+		DeleteFrom(sqlCacheTableName).
+		Where(db.Cond{"clustername": c.clusterName, "namespace": c.namespace, "name": c.name}).
+		And(db.Cond{"cachekey IN": keys}).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("error deleting cache entries: %w", err)
+	}
+	return nil
+}
+
+func listSQLCacheNames(session sqlbuilder.Database, clusterName, namespace string) ([]string, error) {
+	var records []struct {
+		Name string `db:"name"`
+	}
+	err := session.
+		Select().
+		Distinct("name").
+		From(sqlCacheTableName).
+		Where(db.Cond{"clustername": clusterName}).
+		And(db.Cond{"namespace": namespace}).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(records))
+	for i, r := range records {
+		names[i] = r.Name
+	}
+	return names, nil
+}
+
+func deleteSQLCacheEntriesNotHitSince(session sqlbuilder.Database, clusterName, namespace string, notHitSince time.Time) error {
+	rs, err := session.
+		DeleteFrom(sqlCacheTableName).
+		Where(db.Cond{"clustername": clusterName}).
+		And(db.Cond{"namespace": namespace}).
+		And(db.Cond{"lasthitat <": notHitSince.UTC()}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"rowsAffected": rowsAffected, "cacheType": SQLCache}).Info("Deleted cache entries since they have not been hit")
+	return nil
+}
@@ -0,0 +1,146 @@
+//go:build postgres
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	testutil "github.com/argoproj/argo-workflows/v3/test/util"
+)
+
+// newSQLCacheSession returns a session of a Postgres database with the tables created by the persistence migration
+func newSQLCacheSession(t *testing.T) sqlbuilder.Database {
+	session := testutil.NewPostgresSession(t)
+	require.NoError(t, sqldb.NewMigrate(session, "default", "argo_workflows").Exec(context.Background()))
+	return session
+}
+
+var sqlCacheOutputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("foobar")}}}
+
+func TestSQLCacheLoadMiss(t *testing.T) {
+	c := NewSQLCache(newSQLCacheSession(t), "default", "my-ns", "my-cache")
+	entry, err := c.Load(context.Background(), "hi-there-world")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+	assert.False(t, entry.Hit())
+}
+
+func TestSQLCacheSaveAndLoad(t *testing.T) {
+	ctx := context.Background()
+	c := NewSQLCache(newSQLCacheSession(t), "default", "my-ns", "my-cache")
+
+	assert.NoError(t, c.Save(ctx, "hi-there-world", "my-node", sqlCacheOutputs))
+	entry, err := c.Load(ctx, "hi-there-world")
+	require.NoError(t, err)
+	assert.True(t, entry.Hit())
+	assert.Equal(t, "my-node", entry.NodeID)
+	assert.Equal(t, sqlCacheOutputs, entry.Outputs)
+	assert.False(t, entry.LastHitTimestamp.Time.Before(entry.CreationTimestamp.Time))
+
+	// saving again replaces the entry
+	assert.NoError(t, c.Save(ctx, "hi-there-world", "my-other-node", &wfv1.Outputs{}))
+	entry, err = c.Load(ctx, "hi-there-world")
+	require.NoError(t, err)
+	assert.Equal(t, "my-other-node", entry.NodeID)
+
+	_, err = c.Load(ctx, "-invalid")
+	assert.EqualError(t, err, "invalid cache key: -invalid")
+	assert.EqualError(t, c.Save(ctx, "-invalid", "my-node", sqlCacheOutputs), "invalid cache key: -invalid")
+}
+
+func TestSQLCacheIsolation(t *testing.T) {
+	ctx := context.Background()
+	session := newSQLCacheSession(t)
+	c := NewSQLCache(session, "default", "my-ns", "my-cache")
+	assert.NoError(t, c.Save(ctx, "hi-there-world", "my-node", sqlCacheOutputs))
+
+	for _, other := range []MemoizationCache{
+		NewSQLCache(session, "other-cluster", "my-ns", "my-cache"),
+		NewSQLCache(session, "default", "other-ns", "my-cache"),
+		NewSQLCache(session, "default", "my-ns", "other-cache"),
+	} {
+		entry, err := other.Load(ctx, "hi-there-world")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	}
+
+	names, err := listSQLCacheNames(session, "default", "my-ns")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"my-cache"}, names)
+}
+
+func TestSQLCacheListAndDelete(t *testing.T) {
+	ctx := context.Background()
+	c := NewSQLCache(newSQLCacheSession(t), "default", "my-ns", "my-cache")
+	assert.NoError(t, c.Save(ctx, "one", "my-node-1", sqlCacheOutputs))
+	assert.NoError(t, c.Save(ctx, "two", "my-node-2", sqlCacheOutputs))
+
+	entries, err := c.List(ctx)
+	require.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "my-node-1", entries["one"].NodeID)
+		assert.Equal(t, "my-node-2", entries["two"].NodeID)
+	}
+
+	assert.NoError(t, c.Delete(ctx, []string{"one", "missing"}))
+	entries, err = c.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Contains(t, entries, "two")
+}
+
+func TestSQLCacheExpiry(t *testing.T) {
+	ctx := context.Background()
+	session := newSQLCacheSession(t)
+	c := NewSQLCache(session, "default", "my-ns", "my-cache")
+	assert.NoError(t, c.Save(ctx, "hi-there-world", "my-node", sqlCacheOutputs))
+
+	entry, err := c.Load(ctx, "hi-there-world")
+	require.NoError(t, err)
+	outputs, ok := entry.GetOutputsWithMaxAge(time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, sqlCacheOutputs, outputs)
+
+	_, err = session.Update(sqlCacheTableName).Set("createdat", time.Now().UTC().Add(-2*time.Hour)).Where(db.Cond{"cachekey": "hi-there-world"}).Exec()
+	require.NoError(t, err)
+	entry, err = c.Load(ctx, "hi-there-world")
+	require.NoError(t, err)
+	_, ok = entry.GetOutputsWithMaxAge(time.Hour)
+	assert.False(t, ok)
+}
+
+func TestSQLCacheGarbageCollect(t *testing.T) {
+	ctx := context.Background()
+	session := newSQLCacheSession(t)
+	cf := NewCacheFactory(nil, "my-ns")
+	cf.SetSQLSession(session, "default")
+	c := cf.GetCache(SQLCache, "my-cache")
+	assert.NoError(t, c.Save(ctx, "stale", "my-node-1", sqlCacheOutputs))
+	assert.NoError(t, c.Save(ctx, "fresh", "my-node-2", sqlCacheOutputs))
+	other := NewSQLCache(session, "default", "other-ns", "my-cache")
+	assert.NoError(t, other.Save(ctx, "stale", "my-node-3", sqlCacheOutputs))
+
+	twoHoursAgo := time.Now().UTC().Add(-2 * time.Hour)
+	_, err := session.Update(sqlCacheTableName).Set("lasthitat", twoHoursAgo).Where(db.Cond{"cachekey": "stale"}).Exec()
+	require.NoError(t, err)
+
+	assert.NoError(t, cf.GarbageCollect(ctx, time.Now().Add(-time.Hour)))
+
+	entries, err := c.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Contains(t, entries, "fresh")
+	// only the entries of the namespace of the factory are garbage-collected
+	entries, err = other.List(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, entries, "stale")
+}
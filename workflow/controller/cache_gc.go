@@ -25,6 +25,10 @@ func init() {
 
 // syncAllCacheForGC syncs all cache for GC
 func (wfc *WorkflowController) syncAllCacheForGC(ctx context.Context) {
+	if err := wfc.cacheFactory.GarbageCollect(ctx, time.Now().Add(-gcAfterNotHitDuration)); err != nil {
+		log.WithError(err).Error("Failed to garbage collect caches")
+	}
+
 	configMaps, err := wfc.configMapInformer.GetIndexer().ByIndex(indexes.ConfigMapLabelsIndex, common.LabelValueTypeConfigMapCache)
 	if err != nil {
 		log.WithError(err).Error("Failed to get configmaps from informer")
@@ -2,13 +2,20 @@ package controller
 
 import (
 	"context"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
+	artifactscommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/artifacts/resource"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 )
 
@@ -98,3 +105,131 @@ func TestConfigMapCacheSave(t *testing.T) {
 	wfv1.MustUnmarshal([]byte(cm.Data["hi-there-world"]), &entry)
 	assert.Equal(t, entry.LastHitTimestamp.Time, entry.CreationTimestamp.Time)
 }
+
+// fakeArtifactCacheDriver stores artifacts in memory, keyed by their key
+type fakeArtifactCacheDriver struct {
+	artifactscommon.ArtifactDriver
+	objects map[string][]byte
+}
+
+func (d *fakeArtifactCacheDriver) Load(a *wfv1.Artifact, path string) error {
+	key, _ := a.GetKey()
+	data, ok := d.objects[key]
+	if !ok {
+		return errors.New(errors.CodeNotFound, "no such key: "+key)
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+func (d *fakeArtifactCacheDriver) Save(path string, a *wfv1.Artifact) error {
+	key, _ := a.GetKey()
+	data, err := os.ReadFile(path)
+	d.objects[key] = data
+	return err
+}
+
+func (d *fakeArtifactCacheDriver) Delete(a *wfv1.Artifact) error {
+	key, _ := a.GetKey()
+	delete(d.objects, key)
+	return nil
+}
+
+func (d *fakeArtifactCacheDriver) ListObjects(a *wfv1.Artifact) ([]string, error) {
+	prefix, _ := a.GetKey()
+	var keys []string
+	for key := range d.objects {
+		if strings.HasPrefix(key, prefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func newFakeArtifactCacheDriver() (*fakeArtifactCacheDriver, artifact.NewDriverFunc) {
+	driver := &fakeArtifactCacheDriver{objects: map[string][]byte{}}
+	return driver, func(context.Context, *wfv1.Artifact, resource.Interface) (artifactscommon.ArtifactDriver, error) {
+		return driver, nil
+	}
+}
+
+var artifactCacheLocation = &wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}}
+
+func TestArtifactCache(t *testing.T) {
+	ctx := context.Background()
+	driver, newDriver := newFakeArtifactCacheDriver()
+	c := cache.NewArtifactCache(artifactCacheLocation, newDriver, nil, "default", "whalesay-cache")
+
+	entry, err := c.Load(ctx, "hi-there-world")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	err = c.Save(ctx, "hi-there-world", "my-node", &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("foobar")}}})
+	assert.NoError(t, err)
+	assert.Contains(t, driver.objects, "memoization-cache/default/whalesay-cache/hi-there-world.json")
+
+	entry, err = c.Load(ctx, "hi-there-world")
+	if assert.NoError(t, err) && assert.True(t, entry.Hit()) {
+		assert.Equal(t, "my-node", entry.NodeID)
+		assert.Equal(t, "foobar", entry.GetOutputs().Parameters[0].Value.String())
+		assert.False(t, entry.LastHitTimestamp.Time.Before(entry.CreationTimestamp.Time))
+	}
+
+	_, err = c.Load(ctx, "hi_there_world")
+	assert.EqualError(t, err, "invalid cache key: hi_there_world")
+}
+
+func TestCacheFactoryArtifactCache(t *testing.T) {
+	ctx := context.Background()
+	driver, newDriver := newFakeArtifactCacheDriver()
+	factory := cache.NewCacheFactory(nil, "default")
+	assert.Nil(t, factory.GetCache(cache.ArtifactCache, "whalesay-cache"))
+	assert.Nil(t, factory.GetCache(cache.SQLCache, "whalesay-cache"))
+
+	factory.SetArtifactLocation(artifactCacheLocation, newDriver, nil)
+	c := factory.GetCache(cache.ArtifactCache, "whalesay-cache")
+	if assert.NotNil(t, c) {
+		assert.NoError(t, c.Save(ctx, "new", "my-node", nil))
+	}
+	driver.objects["memoization-cache/default/whalesay-cache/old.json"] = []byte(`{"nodeID":"my-node","lastHitTimestamp":"2020-09-21T18:12:56Z"}`)
+
+	err := factory.GarbageCollect(ctx, time.Now().Add(-time.Hour))
+	if assert.NoError(t, err) {
+		assert.Contains(t, driver.objects, "memoization-cache/default/whalesay-cache/new.json")
+		assert.NotContains(t, driver.objects, "memoization-cache/default/whalesay-cache/old.json")
+	}
+}
+
+func TestArtifactCacheListAndDelete(t *testing.T) {
+	ctx := context.Background()
+	_, newDriver := newFakeArtifactCacheDriver()
+	factory := cache.NewCacheFactory(nil, "default")
+	factory.SetArtifactLocation(artifactCacheLocation, newDriver, nil)
+	c := factory.GetCache(cache.ArtifactCache, "whalesay-cache")
+	assert.NoError(t, c.Save(ctx, "key-1", "my-node-1", nil))
+	assert.NoError(t, c.Save(ctx, "key-2", "my-node-2", nil))
+	assert.NoError(t, factory.GetCache(cache.ArtifactCache, "other-cache").Save(ctx, "key-1", "my-node-3", nil))
+
+	names, err := factory.ListCacheNames(ctx, cache.ArtifactCache)
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, []string{"whalesay-cache", "other-cache"}, names)
+	}
+	entries, err := c.List(ctx)
+	if assert.NoError(t, err) && assert.Len(t, entries, 2) {
+		assert.Equal(t, "my-node-1", entries["key-1"].NodeID)
+	}
+
+	assert.NoError(t, c.Delete(ctx, []string{"key-1", "missing"}))
+	entries, err = c.List(ctx)
+	if assert.NoError(t, err) {
+		assert.Len(t, entries, 1)
+		assert.Contains(t, entries, "key-2")
+	}
+}
+
+func TestGetCacheType(t *testing.T) {
+	assert.Equal(t, cache.ConfigMapCache, cache.GetCacheType(&wfv1.Cache{ConfigMap: &apiv1.ConfigMapKeySelector{}}))
+	assert.Equal(t, cache.SQLCache, cache.GetCacheType(&wfv1.Cache{SQL: &wfv1.SQLCache{}}))
+	assert.Equal(t, cache.ArtifactCache, cache.GetCacheType(&wfv1.Cache{Artifact: &wfv1.ArtifactCache{}}))
+	assert.Equal(t, cache.ConfigMapCache, cache.GetStatusCacheType(&wfv1.MemoizationStatus{}))
+	assert.Equal(t, cache.SQLCache, cache.GetStatusCacheType(&wfv1.MemoizationStatus{CacheType: "SQLCache"}))
+}
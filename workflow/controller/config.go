@@ -11,7 +11,9 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-workflows/v3"
+	"github.com/argoproj/argo-workflows/v3/persist/objectstorage"
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/util/instanceid"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
@@ -25,29 +27,47 @@ func (wfc *WorkflowController) updateConfig() error {
 	log.Info("Configuration:\n" + string(bytes))
 	wfc.session = nil
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
+	wfc.cacheFactory.SetSQLSession(nil, "")
+	if location := wfc.artifactRepositoryLocation(); location != nil {
+		wfc.cacheFactory.SetArtifactLocation(location, wfc.artDriverFactory, artifactResources{wfc.kubeclientset, wfc.namespace})
+	} else {
+		wfc.cacheFactory.SetArtifactLocation(nil, nil, nil)
+	}
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
 	wfc.archiveLabelSelector = labels.Everything()
 	persistence := wfc.Config.Persistence
 	if persistence != nil {
 		log.Info("Persistence configuration enabled")
-		session, tableName, err := sqldb.CreateDBSession(wfc.kubeclientset, wfc.namespace, persistence)
-		if err != nil {
-			return err
-		}
-		log.Info("Persistence Session created successfully")
-		if !persistence.SkipMigration {
-			err = sqldb.NewMigrate(session, persistence.GetClusterName(), tableName).Exec(context.Background())
+		var tableName string
+		if persistence.HasDatabase() {
+			session, name, err := sqldb.CreateDBSession(wfc.kubeclientset, wfc.namespace, persistence)
 			if err != nil {
 				return err
 			}
-		} else {
-			log.Info("DB migration is disabled")
-		}
+			log.Info("Persistence Session created successfully")
+			if !persistence.SkipMigration {
+				err = sqldb.NewMigrate(session, persistence.GetClusterName(), name).Exec(context.Background())
+				if err != nil {
+					return err
+				}
+			} else {
+				log.Info("DB migration is disabled")
+			}
 
-		wfc.session = session
+			wfc.session = session
+			tableName = name
+			wfc.cacheFactory.SetSQLSession(session, persistence.GetClusterName())
+		}
 		if persistence.NodeStatusOffload {
-			wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
+			switch {
+			case persistence.ObjectStorage != nil:
+				wfc.offloadNodeStatusRepo, err = objectstorage.NewOffloadNodeStatusRepo(wfc.kubeclientset, wfc.namespace, wfc.artifactRepositoryLocation(), persistence.GetClusterName(), persistence.ObjectStorage)
+			case wfc.session != nil:
+				wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(wfc.session, persistence.GetClusterName(), tableName)
+			default:
+				err = fmt.Errorf("node status offloading requires a database or object storage")
+			}
 			if err != nil {
 				return err
 			}
@@ -62,7 +82,17 @@ func (wfc *WorkflowController) updateConfig() error {
 			if err != nil {
 				return err
 			}
-			wfc.wfArchive = sqldb.NewWorkflowArchive(session, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
+			switch {
+			case persistence.ObjectStorage != nil:
+				wfc.wfArchive, err = objectstorage.NewWorkflowArchive(wfc.kubeclientset, wfc.namespace, wfc.artifactRepositoryLocation(), persistence.GetClusterName(), wfc.managedNamespace, instanceIDService, persistence.ObjectStorage)
+			case wfc.session != nil:
+				wfc.wfArchive = sqldb.NewWorkflowArchive(wfc.session, persistence.GetClusterName(), wfc.managedNamespace, instanceIDService)
+			default:
+				err = fmt.Errorf("workflow archiving requires a database or object storage")
+			}
+			if err != nil {
+				return err
+			}
 			log.Info("Workflow archiving is enabled")
 		} else {
 			log.Info("Workflow archiving is disabled")
@@ -81,6 +111,14 @@ func (wfc *WorkflowController) updateConfig() error {
 	return nil
 }
 
+// artifactRepositoryLocation returns the location of the default artifact repository, or nil if there is none
+func (wfc *WorkflowController) artifactRepositoryLocation() *wfv1.ArtifactLocation {
+	if wfc.Config.ArtifactRepository.Get() == nil {
+		return nil
+	}
+	return wfc.Config.ArtifactRepository.ToArtifactLocation()
+}
+
 func (wfc *WorkflowController) newRateLimiter() *rate.Limiter {
 	return rate.NewLimiter(rate.Limit(wfc.Config.GetResourceRateLimit().Limit), wfc.Config.GetResourceRateLimit().Burst)
 }
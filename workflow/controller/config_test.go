@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
 func TestUpdateConfig(t *testing.T) {
@@ -16,3 +19,25 @@ func TestUpdateConfig(t *testing.T) {
 	assert.NotNil(t, controller.wfArchive)
 	assert.NotNil(t, controller.offloadNodeStatusRepo)
 }
+
+func TestUpdateConfigObjectStorage(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	controller.Config.Persistence = &config.PersistConfig{NodeStatusOffload: true, ObjectStorage: &config.ObjectStorageConfig{}}
+	controller.Config.ArtifactRepository = wfv1.ArtifactRepository{}
+	assert.Error(t, controller.updateConfig(), "an artifact repository is required")
+
+	controller.Config.ArtifactRepository = wfv1.ArtifactRepository{S3: &wfv1.S3ArtifactRepository{S3Bucket: wfv1.S3Bucket{Bucket: "my-bucket"}}}
+	if assert.NoError(t, controller.updateConfig()) {
+		assert.True(t, controller.offloadNodeStatusRepo.IsEnabled())
+		assert.Nil(t, controller.session)
+	}
+
+	controller.Config.Persistence.Archive = true
+	if assert.NoError(t, controller.updateConfig()) {
+		assert.True(t, controller.wfArchive.IsEnabled())
+	}
+
+	controller.Config.Persistence.ObjectStorage = nil
+	assert.EqualError(t, controller.updateConfig(), "node status offloading requires a database or object storage")
+}
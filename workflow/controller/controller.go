@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"syscall"
 	"time"
@@ -46,6 +47,7 @@ import (
 	"github.com/argoproj/argo-workflows/v3/util/env"
 	errorsutil "github.com/argoproj/argo-workflows/v3/util/errors"
 	"github.com/argoproj/argo-workflows/v3/workflow/artifactrepositories"
+	artifact "github.com/argoproj/argo-workflows/v3/workflow/artifacts"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	controllercache "github.com/argoproj/argo-workflows/v3/workflow/controller/cache"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/entrypoint"
@@ -75,6 +77,8 @@ type WorkflowController struct {
 	Config config.Config
 	// get the artifact repository
 	artifactRepositories artifactrepositories.Interface
+	// create artifact drivers, used to delete artifacts
+	artDriverFactory artifact.NewDriverFunc
 	// get images
 	entrypoint entrypoint.Interface
 
@@ -100,6 +104,7 @@ type WorkflowController struct {
 	configMapInformer     cache.SharedIndexInformer
 	wfQueue               workqueue.RateLimitingInterface
 	podCleanupQueue       workqueue.RateLimitingInterface // pods to be deleted or labelled depend on GC strategy
+	artifactGCQueue       workqueue.RateLimitingInterface // workflows whose artifacts need to be deleted
 	throttler             sync.Throttler
 	workflowKeyLock       syncpkg.KeyLock // used to lock workflows for exclusive modification or access
 	session               sqlbuilder.Database
@@ -135,6 +140,10 @@ const (
 
 var cacheGCPeriod = env.LookupEnvDurationOr("CACHE_GC_PERIOD", 0)
 
+// semaphoreLimitRefreshPeriod is how often the limits of semaphores stored in the database or returned by HTTP endpoints
+// are refreshed
+var semaphoreLimitRefreshPeriod = env.LookupEnvDurationOr("SEMAPHORE_LIMIT_REFRESH_PERIOD", time.Minute)
+
 func init() {
 	if cacheGCPeriod != 0 {
 		log.WithField("cacheGCPeriod", cacheGCPeriod).Info("GC for memoization caches will be performed every")
@@ -161,6 +170,7 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		configController:           config.NewController(namespace, configMap, kubeclientset),
 		workflowKeyLock:            syncpkg.NewKeyLock(),
 		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
+		artDriverFactory:           artifact.NewDriver,
 		eventRecorderManager:       events.NewEventRecorderManager(kubeclientset),
 		progressPatchTickDuration:  env.LookupEnvDurationOr(common.EnvVarProgressPatchTickDuration, 1*time.Minute),
 		progressFileTickDuration:   env.LookupEnvDurationOr(common.EnvVarProgressFileTickDuration, 3*time.Second),
@@ -179,6 +189,7 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 	wfc.wfQueue = wfc.metrics.RateLimiterWithBusyWorkers(&fixedItemIntervalRateLimiter{}, "workflow_queue")
 	wfc.throttler = wfc.newThrottler()
 	wfc.podCleanupQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "pod_cleanup_queue")
+	wfc.artifactGCQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "artifact_gc_queue")
 
 	return &wfc, nil
 }
@@ -205,7 +216,7 @@ func (wfc *WorkflowController) runGCcontroller(ctx context.Context, workflowTTLW
 func (wfc *WorkflowController) runCronController(ctx context.Context) {
 	defer runtimeutil.HandleCrash(runtimeutil.PanicHandlers...)
 
-	cronController := cron.NewCronController(wfc.wfclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
+	cronController := cron.NewCronController(wfc.wfclientset, wfc.kubeclientset, wfc.dynamicInterface, wfc.namespace, wfc.GetManagedNamespace(), wfc.Config.InstanceID, wfc.metrics, wfc.eventRecorderManager)
 	cronController.Run(ctx)
 }
 
@@ -228,6 +239,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 
 	defer wfc.wfQueue.ShutDown()
 	defer wfc.podCleanupQueue.ShutDown()
+	defer wfc.artifactGCQueue.ShutDown()
 
 	log.WithField("version", argo.GetVersion().Version).
 		WithField("defaultRequeueTime", GetRequeueTime()).
@@ -235,6 +247,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	log.WithField("workflow", wfWorkers).
 		WithField("workflowTtl", workflowTTLWorkers).
 		WithField("podCleanup", podCleanupWorkers).
+		WithField("artifactGC", artifactGCWorkers).
 		Info("Current Worker Numbers")
 
 	wfc.wfInformer = util.NewWorkflowInformer(wfc.dynamicInterface, wfc.GetManagedNamespace(), workflowResyncPeriod, wfc.tweakListOptions, indexers)
@@ -284,6 +297,9 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	for i := 0; i < podCleanupWorkers; i++ {
 		go wait.UntilWithContext(ctx, wfc.runPodCleanup, time.Second)
 	}
+	for i := 0; i < artifactGCWorkers; i++ {
+		go wait.UntilWithContext(ctx, wfc.runArtifactGC, time.Second)
+	}
 	go wfc.workflowGarbageCollector(ctx.Done())
 	go wfc.archivedWorkflowGarbageCollector(ctx.Done())
 
@@ -293,6 +309,8 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	go wait.Until(wfc.syncPodPhaseMetrics, 15*time.Second, ctx.Done())
 
 	go wait.Until(wfc.syncManager.CheckWorkflowExistence, workflowExistenceCheckPeriod, ctx.Done())
+	go wfc.syncManager.Run(ctx)
+	go wait.Until(wfc.syncManager.RefreshLimits, semaphoreLimitRefreshPeriod, ctx.Done())
 
 	for i := 0; i < wfWorkers; i++ {
 		go wait.Until(wfc.runWorker, time.Second, ctx.Done())
@@ -305,11 +323,26 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 
 // Create and the Synchronization Manager
 func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context) {
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+	getExternalSyncLimit := sync.NewCachedSyncLimit(func(lockKey string) (int, error) {
+		lockName, err := sync.DecodeLockName(lockKey)
+		if err != nil {
+			return 0, err
+		}
+		if lockName.Kind == sync.LockKindDatabase {
+			return sync.GetDatabaseSyncLimit(wfc.session, lockName)
+		}
+		return sync.GetHTTPSyncLimit(ctx, httpClient, wfc.Config.Synchronization, lockName)
+	}, semaphoreLimitRefreshPeriod)
+
 	getSyncLimit := func(lockKey string) (int, error) {
 		lockName, err := sync.DecodeLockName(lockKey)
 		if err != nil {
 			return 0, err
 		}
+		if lockName.Kind == sync.LockKindDatabase || lockName.Kind == sync.LockKindHTTP {
+			return getExternalSyncLimit(lockKey)
+		}
 		configMap, err := wfc.kubeclientset.CoreV1().ConfigMaps(lockName.Namespace).Get(ctx, lockName.ResourceName, metav1.GetOptions{})
 		if err != nil {
 			return 0, err
@@ -336,6 +369,15 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 	}
 
 	wfc.syncManager = sync.NewLockManager(getSyncLimit, nextWorkflow, isWFDeleted)
+
+	if syncConfig := wfc.Config.Synchronization; syncConfig != nil && syncConfig.Redis != nil {
+		client, err := sync.NewRedisClient(ctx, wfc.kubeclientset, wfc.namespace, syncConfig.Redis)
+		if err != nil {
+			log.WithError(err).Fatal("Failed to create the Redis client for synchronization")
+		}
+		wfc.syncManager.UseRedis(client, syncConfig.Redis)
+		log.WithField("address", syncConfig.Redis.Address).Info("Semaphores and mutexes are kept in Redis")
+	}
 }
 
 // list all running workflows to initialize throttler and syncManager
@@ -865,11 +907,22 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 		},
 	},
 	)
+	wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		// no need to check the `common.LabelKeyCompleted`, as completed workflows may still have artifacts to delete
+		FilterFunc: isDeletedWithArtifactGCFinalizer,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: wfc.queueArtifactGCForDeletedWorkflow,
+			UpdateFunc: func(_, obj interface{}) {
+				wfc.queueArtifactGCForDeletedWorkflow(obj)
+			},
+		},
+	})
 	wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		DeleteFunc: func(obj interface{}) {
 			wf, ok := obj.(*unstructured.Unstructured)
 			if ok { // maybe cache.DeletedFinalStateUnknown
 				wfc.metrics.StopRealtimeMetricsForKey(string(wf.GetUID()))
+				wfc.estimatorFactory.DeleteWorkflow(wf)
 			}
 		},
 	})
@@ -15,3 +15,13 @@ func (e *dummyEstimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
 func (e *dummyEstimator) EstimateNodeDuration(string) wfv1.EstimatedDuration {
 	return wfv1.NewEstimatedDuration(time.Second)
 }
+
+func (e *dummyEstimator) EstimateWorkflowDurationPercentiles() *wfv1.EstimatedDurationPercentiles {
+	return &wfv1.EstimatedDurationPercentiles{P50: wfv1.NewEstimatedDuration(time.Second), P90: wfv1.NewEstimatedDuration(time.Second), Samples: 1}
+}
+
+func (e *dummyEstimator) EstimateTemplateDurationPercentiles() map[string]wfv1.EstimatedDurationPercentiles {
+	return map[string]wfv1.EstimatedDurationPercentiles{
+		"pod": {P50: wfv1.NewEstimatedDuration(time.Second), P90: wfv1.NewEstimatedDuration(time.Second), Samples: 1},
+	}
+}
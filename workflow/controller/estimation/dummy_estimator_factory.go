@@ -1,6 +1,8 @@
 package estimation
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
@@ -10,4 +12,6 @@ func (d dummyEstimatorFactory) NewEstimator(*wfv1.Workflow) (Estimator, error) {
 	return &dummyEstimator{}, nil
 }
 
+func (d dummyEstimatorFactory) DeleteWorkflow(*unstructured.Unstructured) {}
+
 var DummyEstimatorFactory EstimatorFactory = &dummyEstimatorFactory{}
@@ -1,7 +1,10 @@
 package estimation
 
 import (
+	"math"
+	"sort"
 	"strings"
+	"time"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -10,24 +13,128 @@ import (
 type Estimator interface {
 	EstimateWorkflowDuration() wfv1.EstimatedDuration
 	EstimateNodeDuration(nodeName string) wfv1.EstimatedDuration
+	// EstimateWorkflowDurationPercentiles returns nil if there are no previous runs to estimate from
+	EstimateWorkflowDurationPercentiles() *wfv1.EstimatedDurationPercentiles
+	// EstimateTemplateDurationPercentiles returns nil if no nodes completed in previous runs, and is keyed by templateKey
+	EstimateTemplateDurationPercentiles() map[string]wfv1.EstimatedDurationPercentiles
+}
+
+// durations are the percentiles of the durations of previous runs of a workflow, of their nodes keyed by their name
+// without the workflow name prefix, and of the nodes of each template
+type durations struct {
+	workflow  *wfv1.EstimatedDurationPercentiles
+	nodes     map[string]*wfv1.EstimatedDurationPercentiles
+	templates map[string]wfv1.EstimatedDurationPercentiles
+}
+
+// templateKey returns the name of the template of a node, or `<workflow template>/<template>` for a template
+// referenced by templateRef
+func templateKey(node wfv1.NodeStatus) string {
+	if node.TemplateRef != nil {
+		return node.TemplateRef.Name + "/" + node.TemplateRef.Template
+	}
+	return node.TemplateName
+}
+
+// newDurations calculates the percentiles of the durations of the successful nodes of the baseline workflows
+func newDurations(baselineWFs []*wfv1.Workflow) *durations {
+	var workflowDurations []time.Duration
+	nodeDurations := make(map[string][]time.Duration)
+	templateDurations := make(map[string][]time.Duration)
+	for _, baselineWF := range baselineWFs {
+		if d := baselineWF.Status.GetDuration(); d > 0 {
+			workflowDurations = append(workflowDurations, d)
+		}
+		for _, node := range baselineWF.Status.Nodes {
+			if node.Phase != wfv1.NodeSucceeded || node.FinishedAt.IsZero() {
+				continue
+			}
+			name := strings.TrimPrefix(node.Name, baselineWF.Name)
+			nodeDurations[name] = append(nodeDurations[name], node.GetDuration())
+			// the groups and retries of a template's nodes would count their durations twice
+			switch node.Type {
+			case wfv1.NodeTypeStepGroup, wfv1.NodeTypeTaskGroup, wfv1.NodeTypeRetry:
+				continue
+			}
+			if key := templateKey(node); key != "" && key != "/" {
+				templateDurations[key] = append(templateDurations[key], node.GetDuration())
+			}
+		}
+	}
+	d := &durations{
+		workflow: newPercentiles(workflowDurations),
+		nodes:    make(map[string]*wfv1.EstimatedDurationPercentiles, len(nodeDurations)),
+	}
+	for name, values := range nodeDurations {
+		d.nodes[name] = newPercentiles(values)
+	}
+	if len(templateDurations) > 0 {
+		d.templates = make(map[string]wfv1.EstimatedDurationPercentiles, len(templateDurations))
+		for key, values := range templateDurations {
+			d.templates[key] = *newPercentiles(values)
+		}
+	}
+	return d
+}
+
+func newPercentiles(values []time.Duration) *wfv1.EstimatedDurationPercentiles {
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	return &wfv1.EstimatedDurationPercentiles{
+		P50:     wfv1.NewEstimatedDuration(percentile(values, 0.5)),
+		P90:     wfv1.NewEstimatedDuration(percentile(values, 0.9)),
+		Samples: int32(len(values)),
+	}
+}
+
+// percentile uses the nearest-rank method, values must be sorted
+func percentile(values []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p * float64(len(values))))
+	if rank < 1 {
+		rank = 1
+	}
+	return values[rank-1]
 }
 
 type estimator struct {
-	wf         *wfv1.Workflow
-	baselineWF *wfv1.Workflow
+	wf        *wfv1.Workflow
+	durations *durations
 }
 
 func (e *estimator) EstimateWorkflowDuration() wfv1.EstimatedDuration {
-	if e.baselineWF == nil {
+	return p50(e.EstimateWorkflowDurationPercentiles())
+}
+
+func (e *estimator) EstimateNodeDuration(nodeName string) wfv1.EstimatedDuration {
+	if e.durations == nil {
 		return 0
 	}
-	return wfv1.NewEstimatedDuration(e.baselineWF.Status.GetDuration())
+	return p50(e.durations.nodes[strings.TrimPrefix(nodeName, e.wf.Name)])
 }
 
-func (e *estimator) EstimateNodeDuration(nodeName string) wfv1.EstimatedDuration {
-	if e.baselineWF == nil {
+func (e *estimator) EstimateWorkflowDurationPercentiles() *wfv1.EstimatedDurationPercentiles {
+	if e.durations == nil {
+		return nil
+	}
+	return e.durations.workflow.DeepCopy()
+}
+
+func (e *estimator) EstimateTemplateDurationPercentiles() map[string]wfv1.EstimatedDurationPercentiles {
+	if e.durations == nil || e.durations.templates == nil {
+		return nil
+	}
+	templates := make(map[string]wfv1.EstimatedDurationPercentiles, len(e.durations.templates))
+	for key, p := range e.durations.templates {
+		templates[key] = p
+	}
+	return templates
+}
+
+func p50(p *wfv1.EstimatedDurationPercentiles) wfv1.EstimatedDuration {
+	if p == nil {
 		return 0
 	}
-	oldNodeID := e.baselineWF.NodeID(strings.Replace(nodeName, e.wf.Name, e.baselineWF.Name, 1))
-	return wfv1.NewEstimatedDuration(e.baselineWF.Status.Nodes[oldNodeID].GetDuration())
+	return p.P50
 }
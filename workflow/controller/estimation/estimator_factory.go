@@ -2,88 +2,217 @@ package estimation
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/env"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	"github.com/argoproj/argo-workflows/v3/workflow/hydrator"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 	"github.com/argoproj/argo-workflows/v3/workflow/util"
 )
 
 type EstimatorFactory interface {
 	// ALWAYS return as estimator, even if it also returns an error.
 	NewEstimator(wf *wfv1.Workflow) (Estimator, error)
+	// DeleteWorkflow forgets the durations of the workflow templates and cron workflows of a deleted workflow, and
+	// deletes their metrics, once none of their workflows are left
+	DeleteWorkflow(un *unstructured.Unstructured)
+}
+
+// source is a label that workflows created from the same workflow template or cron workflow share
+type source struct {
+	labelName string
+	indexName string
+	kind      string
+}
+
+var sources = []source{
+	{common.LabelKeyWorkflowTemplate, indexes.WorkflowTemplateIndex, workflow.WorkflowTemplateKind},
+	{common.LabelKeyClusterWorkflowTemplate, indexes.ClusterWorkflowTemplateIndex, workflow.ClusterWorkflowTemplateKind},
+	{common.LabelKeyCronWorkflow, indexes.CronWorkflowIndex, workflow.CronWorkflowKind},
+}
+
+type cachedDurations struct {
+	durations *durations
+	expiresAt time.Time
 }
 
 type estimatorFactory struct {
 	wfInformer cache.SharedIndexInformer
 	hydrator   hydrator.Interface
 	wfArchive  sqldb.WorkflowArchive
+	// samples is the maximum number of previous runs durations are estimated from
+	samples int
+	// durations are cached for ttl, as calculating them may need several queries of the archive
+	ttl       time.Duration
+	lock      sync.Mutex
+	durations map[string]cachedDurations
 }
 
 var _ EstimatorFactory = &estimatorFactory{}
 
 func NewEstimatorFactory(wfInformer cache.SharedIndexInformer, hydrator hydrator.Interface, wfArchive sqldb.WorkflowArchive) EstimatorFactory {
-	return &estimatorFactory{wfInformer, hydrator, wfArchive}
+	return &estimatorFactory{
+		wfInformer: wfInformer,
+		hydrator:   hydrator,
+		wfArchive:  wfArchive,
+		samples:    env.LookupEnvIntOr("DURATION_ESTIMATION_SAMPLES", 10),
+		ttl:        env.LookupEnvDurationOr("DURATION_ESTIMATION_CACHE_TTL", 5*time.Minute),
+		durations:  make(map[string]cachedDurations),
+	}
 }
 
 func (f *estimatorFactory) NewEstimator(wf *wfv1.Workflow) (Estimator, error) {
 	defaultEstimator := &estimator{wf: wf}
-	for labelName, indexName := range map[string]string{
-		common.LabelKeyWorkflowTemplate:        indexes.WorkflowTemplateIndex,
-		common.LabelKeyClusterWorkflowTemplate: indexes.ClusterWorkflowTemplateIndex,
-		common.LabelKeyCronWorkflow:            indexes.CronWorkflowIndex,
-	} {
-		labelValue, exists := wf.Labels[labelName]
-		if exists {
-			objs, err := f.wfInformer.GetIndexer().ByIndex(indexName, indexes.MetaNamespaceLabelIndex(wf.Namespace, labelValue))
-			if err != nil {
-				return defaultEstimator, fmt.Errorf("failed to list workflows by index: %v", err)
-			}
-			var newestUn *unstructured.Unstructured
-			for _, obj := range objs {
-				un, ok := obj.(*unstructured.Unstructured)
-				if !ok {
-					return defaultEstimator, fmt.Errorf("failed convert object to unstructured")
-				}
-				if un.GetLabels()[common.LabelKeyPhase] != string(wfv1.NodeSucceeded) {
-					continue
-				}
-				// we use `creationTimestamp` because it's fast
-				if newestUn == nil || un.GetCreationTimestamp().After(newestUn.GetCreationTimestamp().Time) {
-					newestUn = un
-				}
-			}
-			if newestUn != nil {
-				newestWf, err := util.FromUnstructured(newestUn)
-				if err != nil {
-					return defaultEstimator, fmt.Errorf("failed convert unstructured to workflow: %w", err)
-				}
-				err = f.hydrator.Hydrate(newestWf)
-				if err != nil {
-					return defaultEstimator, fmt.Errorf("failed hydrate last workflow: %w", err)
-				}
-				return &estimator{wf, newestWf}, nil
-			}
-			// we failed to find a base-line in the live set, so we now look in the archive
-			requirements, err := labels.ParseToRequirements(common.LabelKeyPhase + "=" + string(wfv1.NodeSucceeded) + "," + labelName + "=" + labelValue)
-			if err != nil {
-				return defaultEstimator, fmt.Errorf("failed to parse selector to requirements: %v", err)
-			}
-			workflows, err := f.wfArchive.ListWorkflows(wf.Namespace, "", "", time.Time{}, time.Time{}, requirements, 1, 0)
-			if err != nil {
-				return defaultEstimator, fmt.Errorf("failed to list archived workflows: %v", err)
-			}
-			if len(workflows) > 0 {
-				return &estimator{wf, &workflows[0]}, nil
-			}
+	for _, s := range sources {
+		labelValue, exists := wf.Labels[s.labelName]
+		if !exists {
+			continue
+		}
+		d, err := f.getDurations(wf.Namespace, s, labelValue)
+		if err != nil {
+			return defaultEstimator, err
+		}
+		if d.workflow != nil {
+			return &estimator{wf, d}, nil
 		}
 	}
 	return defaultEstimator, nil
 }
+
+func (f *estimatorFactory) DeleteWorkflow(un *unstructured.Unstructured) {
+	for _, s := range sources {
+		labelValue, exists := un.GetLabels()[s.labelName]
+		if !exists {
+			continue
+		}
+		objs, err := f.wfInformer.GetIndexer().ByIndex(s.indexName, indexes.MetaNamespaceLabelIndex(un.GetNamespace(), labelValue))
+		if err != nil {
+			log.WithError(err).Warn("failed to list workflows by index")
+			continue
+		}
+		left := false
+		for _, obj := range objs {
+			if other, ok := obj.(*unstructured.Unstructured); ok && other.GetUID() != un.GetUID() {
+				left = true
+				break
+			}
+		}
+		if left {
+			continue
+		}
+		f.lock.Lock()
+		delete(f.durations, un.GetNamespace()+"/"+s.labelName+"="+labelValue)
+		f.lock.Unlock()
+		metrics.EstimatedDurationMetric.DeletePartialMatch(prometheus.Labels{"namespace": un.GetNamespace(), "kind": s.kind, "name": labelValue})
+	}
+}
+
+// getDurations returns the cached durations of previous runs, calculating them if they have expired
+func (f *estimatorFactory) getDurations(namespace string, s source, labelValue string) (*durations, error) {
+	key := namespace + "/" + s.labelName + "=" + labelValue
+	f.lock.Lock()
+	cached, ok := f.durations[key]
+	f.lock.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.durations, nil
+	}
+	baselineWFs, err := f.getBaselineWorkflows(namespace, s, labelValue)
+	if err != nil {
+		return nil, err
+	}
+	d := newDurations(baselineWFs)
+	if d.workflow != nil {
+		for quantile, value := range map[float64]wfv1.EstimatedDuration{0.5: d.workflow.P50, 0.9: d.workflow.P90} {
+			metrics.EstimatedDurationMetric.WithLabelValues(namespace, s.kind, labelValue, strconv.FormatFloat(quantile, 'f', -1, 64)).Set(float64(value))
+		}
+	}
+	f.lock.Lock()
+	f.durations[key] = cachedDurations{durations: d, expiresAt: time.Now().Add(f.ttl)}
+	f.lock.Unlock()
+	return d, nil
+}
+
+// getBaselineWorkflows returns the most recent successful workflows with the label, looking in the live set first and
+// then in the archive
+func (f *estimatorFactory) getBaselineWorkflows(namespace string, s source, labelValue string) ([]*wfv1.Workflow, error) {
+	objs, err := f.wfInformer.GetIndexer().ByIndex(s.indexName, indexes.MetaNamespaceLabelIndex(namespace, labelValue))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows by index: %v", err)
+	}
+	var uns []*unstructured.Unstructured
+	for _, obj := range objs {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("failed convert object to unstructured")
+		}
+		if un.GetLabels()[common.LabelKeyPhase] != string(wfv1.NodeSucceeded) {
+			continue
+		}
+		uns = append(uns, un)
+	}
+	// we use `creationTimestamp` because it's fast
+	sort.Slice(uns, func(i, j int) bool {
+		return uns[i].GetCreationTimestamp().After(uns[j].GetCreationTimestamp().Time)
+	})
+	var baselineWFs []*wfv1.Workflow
+	seen := make(map[types.UID]bool)
+	for _, un := range uns {
+		if len(baselineWFs) == f.samples {
+			return baselineWFs, nil
+		}
+		baselineWF, err := util.FromUnstructured(un)
+		if err != nil {
+			return nil, fmt.Errorf("failed convert unstructured to workflow: %w", err)
+		}
+		err = f.hydrator.Hydrate(baselineWF)
+		if err != nil {
+			return nil, fmt.Errorf("failed hydrate last workflow: %w", err)
+		}
+		baselineWFs = append(baselineWFs, baselineWF)
+		seen[baselineWF.UID] = true
+	}
+	if len(baselineWFs) == f.samples {
+		return baselineWFs, nil
+	}
+	// we failed to find enough base-lines in the live set, so we now look in the archive
+	requirements, err := labels.ParseToRequirements(common.LabelKeyPhase + "=" + string(wfv1.NodeSucceeded) + "," + s.labelName + "=" + labelValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse selector to requirements: %v", err)
+	}
+	workflows, err := f.wfArchive.ListWorkflows(namespace, "", "", time.Time{}, time.Time{}, requirements, nil, f.samples, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived workflows: %v", err)
+	}
+	for i := range workflows {
+		if len(baselineWFs) == f.samples {
+			break
+		}
+		summary := &workflows[i]
+		if seen[summary.UID] {
+			continue
+		}
+		// listing only returns the workflow's metadata, so we get it to know how long its nodes took
+		baselineWF, err := f.wfArchive.GetWorkflow(string(summary.UID))
+		if err != nil || baselineWF == nil {
+			log.WithError(err).WithField("uid", summary.UID).Warn("failed to get archived workflow, only its duration will be used for estimation")
+			baselineWF = summary
+		}
+		baselineWFs = append(baselineWFs, baselineWF)
+	}
+	return baselineWFs, nil
+}
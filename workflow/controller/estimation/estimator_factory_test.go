@@ -8,12 +8,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/argoproj/argo-workflows/v3/persist/sqldb"
 	sqldbmocks "github.com/argoproj/argo-workflows/v3/persist/sqldb/mocks"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	testutil "github.com/argoproj/argo-workflows/v3/test/util"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
 	hydratorfake "github.com/argoproj/argo-workflows/v3/workflow/hydrator/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 )
 
 func Test_estimatorFactory(t *testing.T) {
@@ -25,6 +27,9 @@ metadata:
   name: bad-baseline
   labels:
     workflows.argoproj.io/phase: Failed
+status:
+  startedAt: "2021-01-01T00:00:00Z"
+  finishedAt: "2021-01-01T01:00:00Z"
 `)
 	informer.Indexer.SetByIndex(indexes.ClusterWorkflowTemplateIndex, "my-ns/my-cwft", testutil.MustUnmarshalUnstructured(`
 apiVersion: argoproj.io/v1alpha1
@@ -33,6 +38,9 @@ metadata:
   name: my-cwft-baseline
   labels:
     workflows.argoproj.io/phase: Succeeded
+status:
+  startedAt: "2021-01-01T00:00:00Z"
+  finishedAt: "2021-01-01T00:00:10Z"
 `), wfFailed)
 	informer.Indexer.SetByIndex(indexes.CronWorkflowIndex, "my-ns/my-cwf", testutil.MustUnmarshalUnstructured(`
 apiVersion: argoproj.io/v1alpha1
@@ -41,40 +49,99 @@ metadata:
   name: my-cwf-baseline
   labels:
     workflows.argoproj.io/phase: Succeeded
+status:
+  startedAt: "2021-01-01T00:00:00Z"
+  finishedAt: "2021-01-01T00:00:20Z"
 `), wfFailed)
 	informer.Indexer.SetByIndex(indexes.WorkflowTemplateIndex, "my-ns/my-wftmpl", testutil.MustUnmarshalUnstructured(`
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
 metadata:
-  name: my-wftmpl-baseline
+  name: my-wftmpl-baseline-1
+  creationTimestamp: "2021-01-01T00:00:00Z"
   labels:
     workflows.argoproj.io/phase: Succeeded
+status:
+  startedAt: "2021-01-01T00:00:00Z"
+  finishedAt: "2021-01-01T00:00:30Z"
+  nodes:
+    my-wftmpl-baseline-1:
+      name: my-wftmpl-baseline-1
+      phase: Succeeded
+      startedAt: "2021-01-01T00:00:00Z"
+      finishedAt: "2021-01-01T00:00:30Z"
+`), testutil.MustUnmarshalUnstructured(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wftmpl-baseline-2
+  creationTimestamp: "2021-01-02T00:00:00Z"
+  labels:
+    workflows.argoproj.io/phase: Succeeded
+status:
+  startedAt: "2021-01-02T00:00:00Z"
+  finishedAt: "2021-01-02T00:00:40Z"
+  nodes:
+    my-wftmpl-baseline-2:
+      name: my-wftmpl-baseline-2
+      phase: Succeeded
+      startedAt: "2021-01-02T00:00:00Z"
+      finishedAt: "2021-01-02T00:00:40Z"
 `), wfFailed)
 	wfArchive := &sqldbmocks.WorkflowArchive{}
+	// there are fewer live base-lines than samples, so the archive is always listed
+	for labelName, labelValue := range map[string]string{common.LabelKeyWorkflowTemplate: "my-wftmpl", common.LabelKeyClusterWorkflowTemplate: "my-cwft", common.LabelKeyCronWorkflow: "my-cwf"} {
+		r, err := labels.ParseToRequirements("workflows.argoproj.io/phase=Succeeded," + labelName + "=" + labelValue)
+		assert.NoError(t, err)
+		wfArchive.On("ListWorkflows", "my-ns", "", "", time.Time{}, time.Time{}, labels.Requirements(r), sqldb.Query(nil), 10, 0).Return(wfv1.Workflows{}, nil)
+	}
 	r, err := labels.ParseToRequirements("workflows.argoproj.io/phase=Succeeded,workflows.argoproj.io/workflow-template=my-archived-wftmpl")
 	assert.NoError(t, err)
-	wfArchive.On("ListWorkflows", "my-ns", "", "", time.Time{}, time.Time{}, labels.Requirements(r), 1, 0).Return(wfv1.Workflows{
+	wfArchive.On("ListWorkflows", "my-ns", "", "", time.Time{}, time.Time{}, labels.Requirements(r), sqldb.Query(nil), 10, 0).Return(wfv1.Workflows{
+		*testutil.MustUnmarshalWorkflow(`
+metadata:
+  name: my-archived-wftmpl-baseline-1
+  uid: archived-1
+status:
+  startedAt: "2021-01-01T00:00:00Z"
+  finishedAt: "2021-01-01T00:00:50Z"`),
 		*testutil.MustUnmarshalWorkflow(`
 metadata:
-  name: my-archived-wftmpl-baseline`),
-	}, nil)
+  name: my-archived-wftmpl-baseline-2
+  uid: archived-2
+status:
+  startedAt: "2021-01-01T00:00:00Z"
+  finishedAt: "2021-01-01T00:01:00Z"`),
+	}, nil).Once()
+	wfArchive.On("GetWorkflow", "archived-1").Return(testutil.MustUnmarshalWorkflow(`
+metadata:
+  name: my-archived-wftmpl-baseline-1
+  uid: archived-1
+status:
+  startedAt: "2021-01-01T00:00:00Z"
+  finishedAt: "2021-01-01T00:00:50Z"
+  nodes:
+    my-archived-wftmpl-baseline-1:
+      name: my-archived-wftmpl-baseline-1
+      phase: Succeeded
+      startedAt: "2021-01-01T00:00:00Z"
+      finishedAt: "2021-01-01T00:00:50Z"`), nil)
+	wfArchive.On("GetWorkflow", "archived-2").Return(nil, nil)
 	f := NewEstimatorFactory(informer, hydratorfake.Always, wfArchive)
 	t.Run("None", func(t *testing.T) {
 		p, err := f.NewEstimator(&wfv1.Workflow{})
 		if assert.NoError(t, err) && assert.NotNil(t, p) {
 			e := p.(*estimator)
-			assert.Nil(t, e.baselineWF)
+			assert.Nil(t, e.durations)
 		}
 	})
 	t.Run("WorkflowTemplate", func(t *testing.T) {
 		p, err := f.NewEstimator(&wfv1.Workflow{
-			ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-wftmpl"}},
+			ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-wftmpl"}},
 		})
 		if assert.NoError(t, err) && assert.NotNil(t, p) {
-			e := p.(*estimator)
-			if assert.NotNil(t, e) && assert.NotNil(t, e.baselineWF) {
-				assert.Equal(t, "my-wftmpl-baseline", e.baselineWF.Name)
-			}
+			assert.Equal(t, wfv1.EstimatedDuration(30), p.EstimateWorkflowDuration())
+			assert.Equal(t, &wfv1.EstimatedDurationPercentiles{P50: 30, P90: 40, Samples: 2}, p.EstimateWorkflowDurationPercentiles())
 		}
 	})
 	t.Run("ClusterWorkflowTemplate", func(t *testing.T) {
@@ -82,10 +149,7 @@ metadata:
 			ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Labels: map[string]string{common.LabelKeyClusterWorkflowTemplate: "my-cwft"}},
 		})
 		if assert.NoError(t, err) && assert.NotNil(t, p) {
-			e := p.(*estimator)
-			if assert.NotNil(t, e) && assert.NotNil(t, e.baselineWF) {
-				assert.Equal(t, "my-cwft-baseline", e.baselineWF.Name)
-			}
+			assert.Equal(t, wfv1.EstimatedDuration(10), p.EstimateWorkflowDuration())
 		}
 	})
 	t.Run("CronWorkflowTemplate", func(t *testing.T) {
@@ -93,21 +157,46 @@ metadata:
 			ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Labels: map[string]string{common.LabelKeyCronWorkflow: "my-cwf"}},
 		})
 		if assert.NoError(t, err) && assert.NotNil(t, p) {
-			e := p.(*estimator)
-			if assert.NotNil(t, e) && assert.NotNil(t, e.baselineWF) {
-				assert.Equal(t, "my-cwf-baseline", e.baselineWF.Name)
-			}
+			assert.Equal(t, wfv1.EstimatedDuration(20), p.EstimateWorkflowDuration())
 		}
 	})
 	t.Run("WorkflowArchive", func(t *testing.T) {
-		p, err := f.NewEstimator(&wfv1.Workflow{
-			ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-archived-wftmpl"}},
-		})
-		if assert.NoError(t, err) && assert.NotNil(t, p) {
-			e := p.(*estimator)
-			if assert.NotNil(t, e) && assert.NotNil(t, e.baselineWF) {
-				assert.Equal(t, "my-archived-wftmpl-baseline", e.baselineWF.Name)
+		// the second estimator uses the cached durations, as the archive can only be listed once
+		for i := 0; i < 2; i++ {
+			p, err := f.NewEstimator(&wfv1.Workflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-wf", Namespace: "my-ns", Labels: map[string]string{common.LabelKeyWorkflowTemplate: "my-archived-wftmpl"}},
+			})
+			if assert.NoError(t, err) && assert.NotNil(t, p) {
+				assert.Equal(t, &wfv1.EstimatedDurationPercentiles{P50: 50, P90: 60, Samples: 2}, p.EstimateWorkflowDurationPercentiles())
 			}
 		}
 	})
+	t.Run("DeleteWorkflow", func(t *testing.T) {
+		// workflows of the workflow template are left, so its durations are kept
+		f.DeleteWorkflow(testutil.MustUnmarshalUnstructured(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: my-ns
+  uid: my-uid
+  labels:
+    workflows.argoproj.io/workflow-template: my-wftmpl
+`))
+		assert.Contains(t, f.(*estimatorFactory).durations, "my-ns/workflows.argoproj.io/workflow-template=my-wftmpl")
+		// none of the workflows of the archived workflow template are left
+		f.DeleteWorkflow(testutil.MustUnmarshalUnstructured(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: my-wf
+  namespace: my-ns
+  uid: my-uid
+  labels:
+    workflows.argoproj.io/workflow-template: my-archived-wftmpl
+`))
+		assert.NotContains(t, f.(*estimatorFactory).durations, "my-ns/workflows.argoproj.io/workflow-template=my-archived-wftmpl")
+		assert.False(t, metrics.EstimatedDurationMetric.DeleteLabelValues("my-ns", "WorkflowTemplate", "my-archived-wftmpl", "0.5"))
+		assert.True(t, metrics.EstimatedDurationMetric.DeleteLabelValues("my-ns", "WorkflowTemplate", "my-wftmpl", "0.5"))
+	})
 }
@@ -1,6 +1,7 @@
 package estimation
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,34 +11,61 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
-func Test_estimator(t *testing.T) {
+func newBaselineWF(name string, duration time.Duration) *wfv1.Workflow {
 	a := metav1.Time{}
-	b := metav1.Time{Time: time.Time{}.Add(time.Second)}
-	p := &estimator{
-		&wfv1.Workflow{
-			ObjectMeta: metav1.ObjectMeta{Name: "my-wf"},
-			Status: wfv1.WorkflowStatus{
-				Nodes: map[string]wfv1.NodeStatus{
-					"my-wf":             {StartedAt: a, FinishedAt: a},
-					"my-wy-873244444":   {StartedAt: a, FinishedAt: a},
-					"my-wy-873244444.x": {StartedAt: a, FinishedAt: a},
-				},
-			},
-		},
-		&wfv1.Workflow{
-			ObjectMeta: metav1.ObjectMeta{Name: "my-baseline"},
-			Status: wfv1.WorkflowStatus{
-				StartedAt:  a,
-				FinishedAt: b,
-				Nodes: map[string]wfv1.NodeStatus{
-					"my-baseline":             {StartedAt: a, FinishedAt: b},
-					"my-baseline-873244444":   {StartedAt: a, FinishedAt: b},
-					"my-baseline-873244444.x": {StartedAt: a, FinishedAt: b},
-				},
+	b := metav1.Time{Time: time.Time{}.Add(duration)}
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: wfv1.WorkflowStatus{
+			StartedAt:  a,
+			FinishedAt: b,
+			Nodes: map[string]wfv1.NodeStatus{
+				name:             {Name: name, Type: wfv1.NodeTypeSteps, TemplateName: "main", Phase: wfv1.NodeSucceeded, StartedAt: a, FinishedAt: b},
+				name + "-0":      {Name: name + "[0]", Type: wfv1.NodeTypeStepGroup, TemplateName: "main", Phase: wfv1.NodeSucceeded, StartedAt: a, FinishedAt: b},
+				name + "-1":      {Name: name + "[0].x", Type: wfv1.NodeTypePod, TemplateName: "x", Phase: wfv1.NodeSucceeded, StartedAt: a, FinishedAt: b},
+				name + "-2":      {Name: name + "[0].z", Type: wfv1.NodeTypePod, TemplateRef: &wfv1.TemplateRef{Name: "my-wftmpl", Template: "z"}, Phase: wfv1.NodeSucceeded, StartedAt: a, FinishedAt: b},
+				name + "-failed": {Name: name + "[0].y", Type: wfv1.NodeTypePod, TemplateName: "y", Phase: wfv1.NodeFailed, StartedAt: a, FinishedAt: b},
 			},
 		},
 	}
-	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateWorkflowDuration())
-	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration("my-wf"))
-	assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration("1"))
+}
+
+func Test_estimator(t *testing.T) {
+	var baselineWFs []*wfv1.Workflow
+	for i := 1; i <= 10; i++ {
+		baselineWFs = append(baselineWFs, newBaselineWF(fmt.Sprintf("my-baseline-%d", i), time.Duration(i)*time.Second))
+	}
+	t.Run("NoBaseline", func(t *testing.T) {
+		p := &estimator{wf: &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}}
+		assert.Equal(t, wfv1.EstimatedDuration(0), p.EstimateWorkflowDuration())
+		assert.Equal(t, wfv1.EstimatedDuration(0), p.EstimateNodeDuration("my-wf"))
+		assert.Nil(t, p.EstimateWorkflowDurationPercentiles())
+		assert.Nil(t, p.EstimateTemplateDurationPercentiles())
+	})
+	t.Run("OneBaseline", func(t *testing.T) {
+		p := &estimator{&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}, newDurations(baselineWFs[:1])}
+		assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateWorkflowDuration())
+		assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration("my-wf"))
+		assert.Equal(t, wfv1.EstimatedDuration(1), p.EstimateNodeDuration("my-wf[0].x"))
+		assert.Equal(t, wfv1.EstimatedDuration(0), p.EstimateNodeDuration("my-wf[0].y"))
+		assert.Equal(t, &wfv1.EstimatedDurationPercentiles{P50: 1, P90: 1, Samples: 1}, p.EstimateWorkflowDurationPercentiles())
+	})
+	t.Run("Percentiles", func(t *testing.T) {
+		p := &estimator{&wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: "my-wf"}}, newDurations(baselineWFs)}
+		assert.Equal(t, wfv1.EstimatedDuration(5), p.EstimateWorkflowDuration())
+		assert.Equal(t, &wfv1.EstimatedDurationPercentiles{P50: 5, P90: 9, Samples: 10}, p.EstimateWorkflowDurationPercentiles())
+		assert.Equal(t, map[string]wfv1.EstimatedDurationPercentiles{
+			"main":        {P50: 5, P90: 9, Samples: 10},
+			"x":           {P50: 5, P90: 9, Samples: 10},
+			"my-wftmpl/z": {P50: 5, P90: 9, Samples: 10},
+		}, p.EstimateTemplateDurationPercentiles())
+	})
+}
+
+func Test_percentile(t *testing.T) {
+	values := []time.Duration{1, 2, 3, 4}
+	assert.Equal(t, time.Duration(1), percentile(values, 0))
+	assert.Equal(t, time.Duration(2), percentile(values, 0.5))
+	assert.Equal(t, time.Duration(4), percentile(values, 0.9))
+	assert.Equal(t, time.Duration(4), percentile(values, 1))
 }
@@ -59,6 +59,7 @@ import (
 	argosync "github.com/argoproj/argo-workflows/v3/workflow/sync"
 	"github.com/argoproj/argo-workflows/v3/workflow/templateresolution"
 	wfutil "github.com/argoproj/argo-workflows/v3/workflow/util"
+	wfretry "github.com/argoproj/argo-workflows/v3/workflow/util/retry"
 	"github.com/argoproj/argo-workflows/v3/workflow/validate"
 )
 
@@ -264,6 +265,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 	if woc.wf.Status.Phase == wfv1.WorkflowUnknown {
 		woc.markWorkflowRunning(ctx)
 		setWfPodNamesAnnotation(woc.wf)
+		woc.addArtifactGCFinalizer()
 
 		err := woc.createPDBResource(ctx)
 		if err != nil {
@@ -286,6 +288,8 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		}
 
 		woc.wf.Status.EstimatedDuration = woc.estimateWorkflowDuration()
+		woc.wf.Status.EstimatedDurationPercentiles = woc.getEstimator().EstimateWorkflowDurationPercentiles()
+		woc.wf.Status.TemplateEstimatedDurationPercentiles = woc.getEstimator().EstimateTemplateDurationPercentiles()
 	} else {
 		woc.workflowDeadline = woc.getWorkflowDeadline()
 		woc.taskResultReconciliation()
@@ -683,6 +687,8 @@ func (woc *wfOperationCtx) persistUpdates(ctx context.Context) {
 	// Failing to do so means we can have inconsistent state.
 	// Pods may be be labeled multiple times.
 	woc.queuePodsForCleanup()
+	// Likewise, artifacts must only be deleted once the completed workflow has been persisted.
+	woc.queueArtifactGC()
 }
 
 func (woc *wfOperationCtx) deleteTaskResults(ctx context.Context) error {
@@ -826,6 +832,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 			return nil, false, err
 		}
 		if !shouldContinue {
+			woc.recordRetryDecision(node, false, "retryStrategy.expression evaluated to false")
 			return woc.markNodePhase(node.Name, lastChildNode.Phase, "retryStrategy.expression evaluated to false"), true, nil
 		}
 	}
@@ -853,6 +860,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 			message = fmt.Sprintf("retry exceeded workflow deadline %s", *woc.workflowDeadline)
 		}
 		woc.log.Infoln(message)
+		woc.recordRetryDecision(node, false, message)
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, message), true, nil
 	}
 
@@ -868,6 +876,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 			maxDurationDeadline = firstChildNode.StartedAt.Add(maxDuration)
 			if time.Now().After(maxDurationDeadline) {
 				woc.log.Infoln("Max duration limit exceeded. Failing...")
+				woc.recordRetryDecision(node, false, "Max duration limit exceeded")
 				return woc.markNodePhase(node.Name, lastChildNode.Phase, "Max duration limit exceeded"), true, nil
 			}
 		}
@@ -897,6 +906,7 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		// If the waiting deadline is after the max duration deadline, then it's futile to wait until then. Stop early
 		if !maxDurationDeadline.IsZero() && waitingDeadline.After(maxDurationDeadline) {
 			woc.log.Infoln("Backoff would exceed max duration limit. Failing...")
+			woc.recordRetryDecision(node, false, "Backoff would exceed max duration limit")
 			return woc.markNodePhase(node.Name, lastChildNode.Phase, "Backoff would exceed max duration limit"), true, nil
 		}
 
@@ -913,9 +923,63 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		node = woc.markNodePhase(node.Name, node.Phase, "")
 	}
 
+	retry, reason, err := shouldRetry(retryStrategy, lastChildNode)
+	if err != nil {
+		return nil, false, err
+	}
+	if !retry {
+		woc.log.Infof("Node not set to be retried: %s", reason)
+		woc.recordRetryDecision(node, false, reason)
+		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+	}
+
+	if !lastChildNode.CanRetry() {
+		woc.log.Infof("Node cannot be retried. Marking it failed")
+		woc.recordRetryDecision(node, false, fmt.Sprintf("Node with status %s cannot be retried", lastChildNode.Phase))
+		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+	}
+
+	limit, err := intstr.Int32(retryStrategy.Limit)
+	if err != nil {
+		return nil, false, err
+	}
+	if retryStrategy.Limit != nil && limit != nil && int32(len(node.Children)) > *limit {
+		woc.log.Infoln("No more retries left. Failing...")
+		woc.recordRetryDecision(node, false, "No more retries left")
+		return woc.markNodePhase(node.Name, lastChildNode.Phase, "No more retries left"), true, nil
+	}
+
+	woc.log.Infof("%d child nodes of %s failed. Trying again...", len(node.Children), node.Name)
+	return woc.recordRetryDecision(node, true, reason), true, nil
+}
+
+// shouldRetry returns whether the last attempt of a retry node, which failed or errored, should be retried according
+// to the doNotRetryOn and retryOn matchers, or else the retry policy, of the retry strategy, and why
+func shouldRetry(retryStrategy wfv1.RetryStrategy, lastChildNode *wfv1.NodeStatus) (bool, string, error) {
+	if m := retryStrategy.DoNotRetryOn; m != nil {
+		matched, err := wfretry.Match(m, *lastChildNode)
+		if err != nil {
+			return false, "", fmt.Errorf("invalid retryStrategy.doNotRetryOn: %w", err)
+		}
+		if matched != "" {
+			return false, "doNotRetryOn matches: " + matched, nil
+		}
+	}
+	if m := retryStrategy.RetryOn; m != nil {
+		matched, err := wfretry.Match(m, *lastChildNode)
+		if err != nil {
+			return false, "", fmt.Errorf("invalid retryStrategy.retryOn: %w", err)
+		}
+		if matched == "" {
+			return false, "retryOn does not match", nil
+		}
+		return true, "retryOn matches: " + matched, nil
+	}
+
 	var retryOnFailed bool
 	var retryOnError bool
-	switch retryStrategy.RetryPolicy {
+	retryPolicy := retryStrategy.RetryPolicy
+	switch retryPolicy {
 	case wfv1.RetryPolicyAlways:
 		retryOnFailed = true
 		retryOnError = true
@@ -928,33 +992,28 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 			retryOnError = true
 		}
 	case wfv1.RetryPolicyOnFailure, "":
+		retryPolicy = wfv1.RetryPolicyOnFailure
 		retryOnFailed = true
 		retryOnError = false
 	default:
-		return nil, false, fmt.Errorf("%s is not a valid RetryPolicy", retryStrategy.RetryPolicy)
+		return false, "", fmt.Errorf("%s is not a valid RetryPolicy", retryStrategy.RetryPolicy)
 	}
 
 	if (lastChildNode.Phase == wfv1.NodeFailed && !retryOnFailed) || (lastChildNode.Phase == wfv1.NodeError && !retryOnError) {
-		woc.log.Infof("Node not set to be retried after status: %s", lastChildNode.Phase)
-		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
-	}
-
-	if !lastChildNode.CanRetry() {
-		woc.log.Infof("Node cannot be retried. Marking it failed")
-		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+		return false, fmt.Sprintf("retryPolicy %s does not retry after status %s", retryPolicy, lastChildNode.Phase), nil
 	}
+	return true, fmt.Sprintf("retryPolicy %s retries after status %s", retryPolicy, lastChildNode.Phase), nil
+}
 
-	limit, err := intstr.Int32(retryStrategy.Limit)
-	if err != nil {
-		return nil, false, err
-	}
-	if retryStrategy.Limit != nil && limit != nil && int32(len(node.Children)) > *limit {
-		woc.log.Infoln("No more retries left. Failing...")
-		return woc.markNodePhase(node.Name, lastChildNode.Phase, "No more retries left"), true, nil
+// recordRetryDecision records on the retry node whether its last attempt is retried, and why
+func (woc *wfOperationCtx) recordRetryDecision(node *wfv1.NodeStatus, retried bool, reason string) *wfv1.NodeStatus {
+	decision := &wfv1.RetryDecision{Retried: retried, Reason: reason}
+	if !reflect.DeepEqual(node.RetryDecision, decision) {
+		node.RetryDecision = decision
+		woc.wf.Status.Nodes[node.ID] = *node
+		woc.updated = true
 	}
-
-	woc.log.Infof("%d child nodes of %s failed. Trying again...", len(node.Children), node.Name)
-	return node, true, nil
+	return node
 }
 
 // podReconciliation is the process by which a workflow will examine all its related
@@ -990,8 +1049,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				woc.addOutputsToGlobalScope(newState.Outputs)
 				if newState.MemoizationStatus != nil {
 					if newState.Succeeded() {
-						c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, newState.MemoizationStatus.CacheName)
-						err := c.Save(ctx, newState.MemoizationStatus.Key, newState.ID, newState.Outputs)
+						err := woc.saveToMemoizationCache(ctx, newState)
 						if err != nil {
 							woc.log.WithFields(log.Fields{"nodeID": newState.ID}).WithError(err).Error("Failed to save node outputs to cache")
 							newState.Phase = wfv1.NodeError
@@ -1066,6 +1124,13 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				node.Daemoned = nil
 				woc.updated = true
 			}
+			termination := node.Termination.DeepCopy()
+			if termination == nil {
+				termination = &wfv1.NodeTermination{}
+			}
+			termination.NodeLost = true
+			node.Termination = termination
+			woc.wf.Status.Nodes[nodeID] = node
 			woc.markNodePhase(node.Name, wfv1.NodeError, "pod deleted")
 		}
 	}
@@ -1162,6 +1227,7 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, old *wfv1.NodeStatus
 			new.Phase = wfv1.NodeSucceeded
 		} else {
 			new.Phase, new.Message = woc.inferFailedReason(pod, tmpl)
+			new.Termination = wfretry.GetTermination(pod)
 		}
 		new.Daemoned = nil
 	case apiv1.PodRunning:
@@ -1275,6 +1341,14 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, old *wfv1.NodeStatus
 		new.Message = ""
 	}
 
+	// the scheduler deletes the pods it preempts, so how a pod was disrupted is recorded while it still exists, for the
+	// node to still match `preempted` once the pod is deleted
+	if !new.Fulfilled() {
+		if t := wfretry.GetTermination(pod); t != nil && (t.Preempted || t.NodeLost) {
+			new.Termination = t
+		}
+	}
+
 	if new.Fulfilled() && new.FinishedAt.IsZero() {
 		new.FinishedAt = getLatestFinishedAt(pod)
 		new.ResourcesDuration = resource.DurationForPod(pod)
@@ -1676,10 +1750,11 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 	// If memoization is on, check if node output exists in cache
 	if node == nil && processedTmpl.Memoize != nil {
-		memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
+		cacheType := controllercache.GetCacheType(processedTmpl.Memoize.Cache)
+		memoizationCache := woc.controller.cacheFactory.GetCache(cacheType, processedTmpl.Memoize.Cache.GetName())
 		if memoizationCache == nil {
 			err := fmt.Errorf("cache could not be found or created")
-			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.GetName(), "cacheType": cacheType}).WithError(err)
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
 
@@ -1709,7 +1784,10 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       hit,
 			Key:       processedTmpl.Memoize.Key,
-			CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+			CacheName: processedTmpl.Memoize.Cache.GetName(),
+		}
+		if cacheType != controllercache.ConfigMapCache {
+			memoizationStatus.CacheType = string(cacheType)
 		}
 		if hit {
 			node = woc.initializeCacheHitNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, outputs, memoizationStatus)
@@ -2004,6 +2082,8 @@ func (woc *wfOperationCtx) markWorkflowPhase(ctx context.Context, phase wfv1.Wor
 		woc.updated = true
 		woc.wf.Status.StartedAt = metav1.Time{Time: time.Now().UTC()}
 		woc.wf.Status.EstimatedDuration = woc.estimateWorkflowDuration()
+		woc.wf.Status.EstimatedDurationPercentiles = woc.getEstimator().EstimateWorkflowDurationPercentiles()
+		woc.wf.Status.TemplateEstimatedDurationPercentiles = woc.getEstimator().EstimateTemplateDurationPercentiles()
 	}
 	if woc.wf.Status.Message != message {
 		woc.log.Infof("Updated message %s -> %s", woc.wf.Status.Message, message)
@@ -2159,6 +2239,15 @@ func (woc *wfOperationCtx) initializeCacheNode(nodeName string, resolvedTmpl *wf
 	return node
 }
 
+// saveToMemoizationCache saves the outputs of a memoized node to the cache it was loaded from
+func (woc *wfOperationCtx) saveToMemoizationCache(ctx context.Context, node *wfv1.NodeStatus) error {
+	c := woc.controller.cacheFactory.GetCache(controllercache.GetStatusCacheType(node.MemoizationStatus), node.MemoizationStatus.CacheName)
+	if c == nil {
+		return fmt.Errorf("cache could not be found or created")
+	}
+	return c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
+}
+
 // Creates a node status that has been cached, completely initialized, and marked as finished
 func (woc *wfOperationCtx) initializeCacheHitNode(nodeName string, resolvedTmpl *wfv1.Template, templateScope string, orgTmpl wfv1.TemplateReferenceHolder, boundaryID string, outputs *wfv1.Outputs, memStat *wfv1.MemoizationStatus, messages ...string) *wfv1.NodeStatus {
 	node := woc.initializeCacheNode(nodeName, resolvedTmpl, templateScope, orgTmpl, boundaryID, memStat, messages...)
@@ -224,6 +224,8 @@ status:
 	assert.Equal(t, wfv1.EstimatedDuration(1), woc.wf.Status.EstimatedDuration)
 	assert.Equal(t, wfv1.EstimatedDuration(1), woc.wf.Status.Nodes[woc.wf.Name].EstimatedDuration)
 	assert.Equal(t, wfv1.EstimatedDuration(1), woc.wf.Status.Nodes.FindByDisplayName("pod").EstimatedDuration)
+	assert.Equal(t, &wfv1.EstimatedDurationPercentiles{P50: 1, P90: 1, Samples: 1}, woc.wf.Status.EstimatedDurationPercentiles)
+	assert.Equal(t, map[string]wfv1.EstimatedDurationPercentiles{"pod": {P50: 1, P90: 1, Samples: 1}}, woc.wf.Status.TemplateEstimatedDurationPercentiles)
 }
 
 func TestDefaultProgress(t *testing.T) {
@@ -719,6 +721,54 @@ func TestProcessNodesWithRetriesOnTransientErrors(t *testing.T) {
 	assert.Equal(t, n.Phase, wfv1.NodeError)
 }
 
+// TestProcessNodesWithRetryMatchers tests retrying by how the last attempt failed, and recording why
+func TestProcessNodesWithRetryMatchers(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	woc := newWorkflowOperationCtx(wf, controller)
+
+	nodeName := "test-node"
+	woc.initializeNode(nodeName, wfv1.NodeTypeRetry, "", &wfv1.WorkflowStep{}, "", wfv1.NodeRunning)
+	retries := wfv1.RetryStrategy{
+		Limit:        intstrutil.ParsePtr("3"),
+		RetryOn:      &wfv1.RetryMatcher{Reasons: []string{"OOMKilled"}, NodeLost: true},
+		DoNotRetryOn: &wfv1.RetryMatcher{ExitCodes: []int32{2}},
+	}
+	addFailedChild := func(i int, phase wfv1.NodePhase, termination *wfv1.NodeTermination) *wfv1.NodeStatus {
+		childNode := fmt.Sprintf("child-node-%d", i)
+		child := woc.initializeNode(childNode, wfv1.NodeTypePod, "", &wfv1.WorkflowStep{}, "", phase)
+		child.Termination = termination
+		woc.wf.Status.Nodes[child.ID] = *child
+		woc.addChildNode(nodeName, childNode)
+		return woc.wf.GetNodeByName(nodeName)
+	}
+
+	n := addFailedChild(0, wfv1.NodeFailed, &wfv1.NodeTermination{Containers: []wfv1.ContainerTermination{{Name: "main", ExitCode: 137, Reason: "OOMKilled"}}})
+	n, _, err := woc.processNodeRetries(n, retries, &executeTemplateOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	assert.Equal(t, &wfv1.RetryDecision{Retried: true, Reason: "retryOn matches: container main terminated with reason OOMKilled"}, woc.wf.GetNodeByName(nodeName).RetryDecision)
+
+	n = addFailedChild(1, wfv1.NodeError, &wfv1.NodeTermination{NodeLost: true})
+	n, _, err = woc.processNodeRetries(n, retries, &executeTemplateOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, n.Phase, "retryOn replaces the retry policy, which does not retry errors by default")
+
+	n = addFailedChild(2, wfv1.NodeFailed, &wfv1.NodeTermination{Containers: []wfv1.ContainerTermination{{Name: "main", ExitCode: 2, Reason: "OOMKilled"}}})
+	n, _, err = woc.processNodeRetries(n, retries, &executeTemplateOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, n.Phase)
+	assert.Equal(t, &wfv1.RetryDecision{Reason: "doNotRetryOn matches: container main exited with code 2"}, n.RetryDecision)
+
+	n = woc.markNodePhase(nodeName, wfv1.NodeRunning)
+	n = addFailedChild(3, wfv1.NodeFailed, &wfv1.NodeTermination{Containers: []wfv1.ContainerTermination{{Name: "main", ExitCode: 1, Reason: "Error"}}})
+	n, _, err = woc.processNodeRetries(n, retries, &executeTemplateOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, n.Phase)
+	assert.Equal(t, &wfv1.RetryDecision{Reason: "retryOn does not match"}, n.RetryDecision)
+}
+
 func TestProcessNodesWithRetriesWithBackoff(t *testing.T) {
 	cancel, controller := newController()
 	defer cancel()
@@ -1366,6 +1416,22 @@ func TestAssessNodeStatus(t *testing.T) {
 	}
 }
 
+func TestAssessNodeStatusPreempted(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	pod := &apiv1.Pod{Status: apiv1.PodStatus{
+		Phase:      apiv1.PodRunning,
+		Conditions: []apiv1.PodCondition{{Type: apiv1.DisruptionTarget, Status: apiv1.ConditionTrue, Reason: apiv1.PodReasonPreemptionByScheduler}},
+	}}
+	got := woc.assessNodeStatus(pod, &wfv1.NodeStatus{TemplateName: wf.Spec.Entrypoint})
+	if assert.NotNil(t, got) {
+		assert.Equal(t, wfv1.NodeRunning, got.Phase)
+		assert.Equal(t, &wfv1.NodeTermination{Preempted: true}, got.Termination)
+	}
+}
+
 func getPodTemplate(pod *apiv1.Pod) (*wfv1.Template, error) {
 	tmpl := &wfv1.Template{}
 	for _, c := range pod.Spec.Containers {
@@ -1,7 +1,10 @@
 package controller
 
 import (
+	"math"
+
 	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/env"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -37,3 +40,87 @@ func RetryOnDifferentHost(retryNodeName string) RetryTweak {
 		}
 	}
 }
+
+// findRetryParent locates the retry node that nodeID is an attempt of
+func findRetryParent(nodes wfv1.Nodes, nodeID string) *wfv1.NodeStatus {
+	for _, node := range nodes {
+		if node.Type != wfv1.NodeTypeRetry {
+			continue
+		}
+		for _, childID := range node.Children {
+			if childID == nodeID {
+				return &node
+			}
+		}
+	}
+	return nil
+}
+
+// EscalateResources escalates the resource requests and limits of the main containers of the pod, once for each
+// failed attempt of the retry node that matches retryStrategy.resources.escalateOn
+func EscalateResources(retryNodeID string, isMainContainer func(string) bool) RetryTweak {
+	return func(retryStrategy wfv1.RetryStrategy, nodes wfv1.Nodes, pod *apiv1.Pod) {
+		r := retryStrategy.Resources
+		if r == nil {
+			return
+		}
+		escalations := 0
+		for _, childID := range nodes[retryNodeID].Children {
+			child, ok := nodes[childID]
+			if !ok || !child.FailedOrError() {
+				continue
+			}
+			if r.EscalateOn != nil {
+				// the matcher is validated, so an error is treated as no match
+				if matched, _ := wfretry.Match(r.EscalateOn, child); matched == "" {
+					continue
+				}
+			}
+			escalations++
+		}
+		if escalations == 0 {
+			return
+		}
+		factor := 1.0
+		if r.Factor != nil {
+			if f, err := r.Factor.Float64(); err == nil && f > 0 {
+				factor = f
+			}
+		}
+		for i, c := range pod.Spec.Containers {
+			if !isMainContainer(c.Name) {
+				continue
+			}
+			// the resource lists may be shared with the template
+			c.Resources = *c.Resources.DeepCopy()
+			for _, list := range []apiv1.ResourceList{c.Resources.Requests, c.Resources.Limits} {
+				for name, max := range r.Max {
+					if q, ok := list[name]; ok {
+						increase := r.Increase[name]
+						list[name] = escalateQuantity(q, factor, increase, max, escalations)
+					}
+				}
+			}
+			pod.Spec.Containers[i] = c
+		}
+	}
+}
+
+// escalateQuantity multiplies the quantity by factor and adds increase, n times, up to max. Quantities of whole units,
+// e.g. bytes of memory, are rounded up to whole units. A quantity already at or above max is never lowered.
+func escalateQuantity(q resource.Quantity, factor float64, increase, max resource.Quantity, n int) resource.Quantity {
+	if q.Cmp(max) >= 0 {
+		return q.DeepCopy()
+	}
+	milli := float64(q.MilliValue())
+	for i := 0; i < n; i++ {
+		milli = milli*factor + float64(increase.MilliValue())
+	}
+	if milli >= float64(max.MilliValue()) {
+		return max.DeepCopy()
+	}
+	if q.MilliValue()%1000 == 0 {
+		return *resource.NewQuantity(int64(math.Ceil(milli/1000)), q.Format)
+	}
+	return *resource.NewMilliQuantity(int64(math.Ceil(milli)), q.Format)
+}
@@ -1,11 +1,16 @@
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
 )
 
 func TestFindRetryNode(t *testing.T) {
@@ -69,3 +74,98 @@ func TestFindRetryNode(t *testing.T) {
 		assert.Nil(t, a)
 	})
 }
+
+func TestEscalateQuantity(t *testing.T) {
+	max := resource.MustParse("4Gi")
+	assert.Equal(t, "2Gi", escalateQuantityString(resource.MustParse("1Gi"), 2, resource.Quantity{}, max, 1))
+	assert.Equal(t, "4Gi", escalateQuantityString(resource.MustParse("1Gi"), 2, resource.Quantity{}, max, 2))
+	assert.Equal(t, "4Gi", escalateQuantityString(resource.MustParse("1Gi"), 2, resource.Quantity{}, max, 3), "capped by the maximum")
+	assert.Equal(t, "1536Mi", escalateQuantityString(resource.MustParse("1Gi"), 1, resource.MustParse("512Mi"), max, 1))
+	assert.Equal(t, "750m", escalateQuantityString(resource.MustParse("500m"), 1.5, resource.Quantity{}, resource.MustParse("2"), 1))
+	assert.Equal(t, "4Gi", escalateQuantityString(resource.MustParse("4Gi"), 2, resource.Quantity{}, resource.MustParse("3Gi"), 1), "never lowered to the maximum")
+}
+
+func escalateQuantityString(q resource.Quantity, factor float64, increase, max resource.Quantity, n int) string {
+	escalated := escalateQuantity(q, factor, increase, max, n)
+	return escalated.String()
+}
+
+var retryResourcesWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: retry-resources
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      resources:
+        escalateOn:
+          reasons: [OOMKilled]
+        factor: 2
+        max:
+          memory: 3Gi
+    container:
+      image: my-image
+      command: [etl]
+      resources:
+        requests:
+          cpu: 500m
+          memory: 1Gi
+        limits:
+          memory: 1Gi
+`
+
+func withTerminationReason(reason string) with {
+	return func(pod *apiv1.Pod) {
+		for _, c := range pod.Spec.Containers {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, apiv1.ContainerStatus{
+				Name:  c.Name,
+				State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 137, Reason: reason}},
+			})
+		}
+	}
+}
+
+func TestEscalateResources(t *testing.T) {
+	wf := wfv1.MustUnmarshalWorkflow(retryResourcesWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	ctx := context.Background()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	for _, reason := range []string{"OOMKilled", "Error", "OOMKilled"} {
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		makePodsPhase(ctx, woc, apiv1.PodFailed, withTerminationReason(reason))
+		woc.operate(ctx)
+	}
+
+	memory := make(map[string]string)
+	pods, err := listPods(woc)
+	require.NoError(t, err)
+	for _, pod := range pods.Items {
+		for _, c := range pod.Spec.Containers {
+			if c.Name == common.MainContainerName {
+				assert.Equal(t, c.Resources.Requests.Memory().String(), c.Resources.Limits.Memory().String())
+				assert.Equal(t, "500m", c.Resources.Requests.Cpu().String(), "only the resources with a maximum are escalated")
+				memory[pod.Annotations[common.AnnotationKeyNodeName]] = c.Resources.Requests.Memory().String()
+			}
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"retry-resources(0)": "1Gi",
+		"retry-resources(1)": "2Gi",
+		"retry-resources(2)": "2Gi",
+		"retry-resources(3)": "3Gi",
+	}, memory, "the resources are escalated after each OOMKilled attempt, up to the maximum")
+
+	node := woc.wf.GetNodeByName("retry-resources(3)")
+	if assert.NotNil(t, node) {
+		recorded := node.Resources[common.MainContainerName]
+		assert.Equal(t, "3Gi", recorded.Limits.Memory().String(), "the resources of each attempt are recorded")
+	}
+	assert.Equal(t, "1Gi", woc.execWf.Spec.Templates[0].Container.Resources.Requests.Memory().String(), "the template is not modified")
+}
@@ -109,6 +109,8 @@ func (s *wfScope) resolveArtifact(art *wfv1.Artifact) (*wfv1.Artifact, error) {
 	if art.SubPath != "" {
 		// Copy resolved artifact pointer before adding subpath
 		copyArt := valArt.DeepCopy()
+		// the digest is of the whole artifact, not of the subpath
+		copyArt.Digest, copyArt.SizeBytes = "", 0
 
 		subPathAsJson, err := json.Marshal(art.SubPath)
 		if err != nil {
@@ -300,3 +300,20 @@ func TestResolveParameters(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal("5", result)
 }
+
+func TestResolveArtifactWithDigest(t *testing.T) {
+	scope := createScope(nil)
+	artifact := wfv1.Artifact{Name: "art", Digest: "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385", SizeBytes: 7, ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-pod/art"}}}
+	scope.addArtifactToScope("steps.test.outputs.artifacts.art", artifact)
+
+	resolvedArtifact, err := scope.resolveArtifact(&wfv1.Artifact{From: "{{steps.test.outputs.artifacts.art}}"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, artifact.Digest, resolvedArtifact.Digest)
+		assert.Equal(t, artifact.SizeBytes, resolvedArtifact.SizeBytes)
+	}
+	resolvedArtifact, err = scope.resolveArtifact(&wfv1.Artifact{SubPath: "some/subkey", From: "{{steps.test.outputs.artifacts.art}}"})
+	if assert.NoError(t, err) {
+		assert.Empty(t, resolvedArtifact.Digest, "the digest is not of the subpath")
+		assert.Empty(t, resolvedArtifact.SizeBytes)
+	}
+}
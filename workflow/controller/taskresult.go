@@ -12,6 +12,7 @@ import (
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfextvv1alpha1 "github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions/workflow/v1alpha1"
 	"github.com/argoproj/argo-workflows/v3/workflow/controller/indexes"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
 )
 
 func (wfc *WorkflowController) newWorkflowTaskResultInformer() cache.SharedIndexInformer {
@@ -54,6 +55,13 @@ func (woc *wfOperationCtx) taskResultReconciliation() {
 		if result.Progress.IsValid() {
 			new.Progress = result.Progress
 		}
+		if result.SharedArtifactCache != nil {
+			new.SharedArtifactCache = result.SharedArtifactCache.DeepCopy()
+		}
+		if result.ArtifactTransfers != nil {
+			new.ArtifactTransfers = result.ArtifactTransfers.DeepCopy()
+			observeArtifactTransfers(old.ArtifactTransfers, new.ArtifactTransfers)
+		}
 		if !reflect.DeepEqual(&old, new) {
 			woc.log.
 				WithField("nodeID", nodeID).
@@ -63,3 +71,21 @@ func (woc *wfOperationCtx) taskResultReconciliation() {
 		}
 	}
 }
+
+// observeArtifactTransfers observes the throughput of the transfers that were not already in the node's status, as
+// task results are reconciled many times
+func observeArtifactTransfers(old, new *wfv1.ArtifactTransfers) {
+	if old == nil {
+		old = &wfv1.ArtifactTransfers{}
+	}
+	if len(old.Loads) == 0 {
+		for _, t := range new.Loads {
+			metrics.ArtifactTransferThroughputMetric.WithLabelValues("load").Observe(t.BytesPerSecond())
+		}
+	}
+	if len(old.Saves) == 0 {
+		for _, t := range new.Saves {
+			metrics.ArtifactTransferThroughputMetric.WithLabelValues("save").Observe(t.BytesPerSecond())
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"path/filepath"
 	"strconv"
 	"time"
@@ -71,6 +72,32 @@ func (woc *wfOperationCtx) scheduleOnDifferentHost(node *wfv1.NodeStatus, pod *a
 	return nil
 }
 
+// escalateResources escalates the resources of the main containers of a retried pod when retryStrategy.resources is
+// specified, and records the resources of the attempt on its node
+func (woc *wfOperationCtx) escalateResources(node *wfv1.NodeStatus, pod *apiv1.Pod, tmpl *wfv1.Template) {
+	if node == nil || pod == nil {
+		return
+	}
+	retryStrategy := woc.retryStrategy(tmpl)
+	if retryStrategy == nil || retryStrategy.Resources == nil {
+		return
+	}
+	retryNode := findRetryParent(woc.wf.Status.Nodes, node.ID)
+	if retryNode == nil {
+		return
+	}
+	EscalateResources(retryNode.ID, tmpl.IsMainContainerName)(*retryStrategy, woc.wf.Status.Nodes, pod)
+	resources := make(map[string]apiv1.ResourceRequirements)
+	for _, c := range pod.Spec.Containers {
+		if tmpl.IsMainContainerName(c.Name) {
+			resources[c.Name] = c.Resources
+		}
+	}
+	node.Resources = resources
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.updated = true
+}
+
 type createWorkflowPodOpts struct {
 	includeScriptOutput bool
 	onExitPod           bool
@@ -236,6 +263,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	// script templates (which needs to populate the script)
 	initCtr := woc.newInitContainer(tmpl)
 	pod.Spec.InitContainers = []apiv1.Container{initCtr}
+	woc.addPartialDownloads(pod, nodeID)
 
 	addSchedulingConstraints(pod, wfSpec, tmpl)
 	woc.addMetadata(pod, tmpl)
@@ -281,6 +309,10 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		{Name: common.EnvVarProgressFile, Value: common.ArgoProgressPath},
 	}
 
+	if logSink := woc.controller.Config.LogSink; logSink != nil {
+		envVars = append(envVars, apiv1.EnvVar{Name: common.EnvVarLogSink, Value: wfv1.MustMarshallJSON(logSink)})
+	}
+
 	// only set tick durations if progress is enabled. The EnvVarProgressFile is always set (user convenience) but the
 	// progress is only monitored if the tick durations are >0.
 	if woc.controller.progressPatchTickDuration != 0 && woc.controller.progressFileTickDuration != 0 {
@@ -417,6 +449,8 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		return nil, err
 	}
 
+	woc.escalateResources(node, pod, tmpl)
+
 	if templateDeadline != nil && (pod.Spec.ActiveDeadlineSeconds == nil || time.Since(*templateDeadline).Seconds() < float64(*pod.Spec.ActiveDeadlineSeconds)) {
 		newActiveDeadlineSeconds := int64(time.Until(*templateDeadline).Seconds())
 		if newActiveDeadlineSeconds <= 1 {
@@ -511,15 +545,59 @@ func substitutePodParams(pod *apiv1.Pod, globalParams common.Parameters, tmpl *w
 func (woc *wfOperationCtx) newInitContainer(tmpl *wfv1.Template) apiv1.Container {
 	ctr := woc.newExecContainer(common.InitContainerName, tmpl)
 	ctr.Command = []string{"argoexec", "init", "--loglevel", getExecutorLogLevel()}
+	woc.addSharedArtifactCache(ctr)
 	return *ctr
 }
 
 func (woc *wfOperationCtx) newWaitContainer(tmpl *wfv1.Template) *apiv1.Container {
 	ctr := woc.newExecContainer(common.WaitContainerName, tmpl)
 	ctr.Command = []string{"argoexec", "wait", "--loglevel", getExecutorLogLevel()}
+	woc.addSharedArtifactCache(ctr)
+	if logSink := woc.controller.Config.LogSink; logSink != nil {
+		if logSink.HTTP != nil && logSink.HTTP.AuthorizationSecret != nil {
+			ctr.Env = append(ctr.Env, apiv1.EnvVar{
+				Name:      common.EnvVarLogSinkAuthorization,
+				ValueFrom: &apiv1.EnvVarSource{SecretKeyRef: logSink.HTTP.AuthorizationSecret},
+			})
+		}
+		if logSink.File != nil && logSink.File.Volume != nil {
+			ctr.VolumeMounts = append(ctr.VolumeMounts, apiv1.VolumeMount{Name: logSink.File.Volume.Name, MountPath: logSink.File.Path})
+		}
+	}
 	return ctr
 }
 
+// addSharedArtifactCache mounts the volume of the shared artifact cache on the init or wait container, which load input
+// artifacts from it and copy output artifacts to it
+func (woc *wfOperationCtx) addSharedArtifactCache(ctr *apiv1.Container) {
+	cache := woc.controller.Config.SharedArtifactCache
+	if cache == nil {
+		return
+	}
+	ctr.Env = append(ctr.Env, apiv1.EnvVar{Name: common.EnvVarSharedArtifactCache, Value: common.ExecutorSharedArtifactCacheDir})
+	ctr.VolumeMounts = append(ctr.VolumeMounts, apiv1.VolumeMount{Name: cache.Volume.Name, MountPath: common.ExecutorSharedArtifactCacheDir})
+}
+
+// addPartialDownloads mounts the volume of partial downloads on the init container, with a sub-path that the attempts
+// of a retried node share, so that each attempt resumes the downloads of the one before it
+func (woc *wfOperationCtx) addPartialDownloads(pod *apiv1.Pod, nodeID string) {
+	transfer := woc.controller.Config.ArtifactTransfer
+	if transfer == nil || transfer.PartialDownloads == nil {
+		return
+	}
+	if retryParent := findRetryParent(woc.wf.Status.Nodes, nodeID); retryParent != nil {
+		nodeID = retryParent.ID
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, *transfer.PartialDownloads)
+	ctr := &pod.Spec.InitContainers[0]
+	ctr.Env = append(ctr.Env, apiv1.EnvVar{Name: common.EnvVarArtifactPartialDownloads, Value: common.ExecutorPartialDownloadsDir})
+	ctr.VolumeMounts = append(ctr.VolumeMounts, apiv1.VolumeMount{
+		Name:      transfer.PartialDownloads.Name,
+		MountPath: common.ExecutorPartialDownloadsDir,
+		SubPath:   path.Join(string(woc.wf.UID), nodeID),
+	})
+}
+
 func getExecutorLogLevel() string {
 	return log.GetLevel().String()
 }
@@ -562,6 +640,14 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 			apiv1.EnvVar{Name: common.EnvVarInstanceID, Value: v},
 		)
 	}
+	if transfer := woc.controller.Config.ArtifactTransfer; transfer != nil {
+		if transfer.Parallelism > 0 {
+			execEnvVars = append(execEnvVars, apiv1.EnvVar{Name: common.EnvVarArtifactTransferParallelism, Value: strconv.Itoa(transfer.Parallelism)})
+		}
+		if transfer.PartSize != nil {
+			execEnvVars = append(execEnvVars, apiv1.EnvVar{Name: common.EnvVarArtifactPartSize, Value: strconv.FormatInt(transfer.PartSize.Value(), 10)})
+		}
+	}
 	if woc.controller.Config.Executor != nil {
 		execEnvVars = append(execEnvVars, woc.controller.Config.Executor.Env...)
 	}
@@ -585,6 +671,14 @@ func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
 		})
 	}
 
+	if logSink := woc.controller.Config.LogSink; logSink != nil && logSink.File != nil && logSink.File.Volume != nil {
+		volumes = append(volumes, *logSink.File.Volume)
+	}
+
+	if cache := woc.controller.Config.SharedArtifactCache; cache != nil {
+		volumes = append(volumes, cache.Volume)
+	}
+
 	volumes = append(volumes, volumeVarArgo, volumeTmpDir)
 	volumes = append(volumes, tmpl.Volumes...)
 	return volumes
@@ -1142,6 +1236,9 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 		createSecretVal(volMap, ossRepo.SecretKeySecret, uniqueKeyMap)
 	} else if gcsRepo := tmpl.ArchiveLocation.GCS; gcsRepo != nil {
 		createSecretVal(volMap, gcsRepo.ServiceAccountKeySecret, uniqueKeyMap)
+	} else if azureRepo := tmpl.ArchiveLocation.Azure; azureRepo != nil {
+		createSecretVal(volMap, azureRepo.AccountKeySecret, uniqueKeyMap)
+		createSecretVal(volMap, azureRepo.SASTokenSecret, uniqueKeyMap)
 	}
 }
 
@@ -1164,6 +1261,9 @@ func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMa
 		createSecretVal(volMap, art.OSS.SecretKeySecret, keyMap)
 	} else if art.GCS != nil {
 		createSecretVal(volMap, art.GCS.ServiceAccountKeySecret, keyMap)
+	} else if art.Azure != nil {
+		createSecretVal(volMap, art.Azure.AccountKeySecret, keyMap)
+		createSecretVal(volMap, art.Azure.SASTokenSecret, keyMap)
 	}
 }
 
@@ -1783,3 +1783,117 @@ func TestProgressEnvVars(t *testing.T) {
 		})
 	})
 }
+
+func TestLogSink(t *testing.T) {
+	createPod := func(t *testing.T, logSink *config.LogSinkConfig) *apiv1.Pod {
+		cancel, controller := newController(func(controller *WorkflowController) {
+			controller.Config.LogSink = logSink
+		})
+		defer cancel()
+		wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+		ctx := context.Background()
+		woc := newWorkflowOperationCtx(wf, controller)
+		require.NoError(t, woc.setExecWorkflow(ctx))
+		mainCtr := woc.execWf.Spec.Templates[0].Container
+		pod, err := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+		require.NoError(t, err)
+		return pod
+	}
+	t.Run("None", func(t *testing.T) {
+		pod := createPod(t, nil)
+		for _, c := range pod.Spec.Containers {
+			for _, e := range c.Env {
+				assert.NotEqual(t, common.EnvVarLogSink, e.Name)
+			}
+		}
+	})
+	t.Run("HTTP", func(t *testing.T) {
+		secret := &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: "authorization"}
+		pod := createPod(t, &config.LogSinkConfig{HTTP: &config.HTTPLogSinkConfig{Format: config.HTTPLogSinkLoki, URL: "http://loki:3100", AuthorizationSecret: secret}})
+		waitCtr, mainCtr := pod.Spec.Containers[0], pod.Spec.Containers[1]
+		assert.Equal(t, common.WaitContainerName, waitCtr.Name)
+		logSinkEnv := apiv1.EnvVar{Name: common.EnvVarLogSink, Value: `{"http":{"format":"loki","url":"http://loki:3100","authorizationSecret":{"name":"my-secret","key":"authorization"}}}`}
+		assert.Contains(t, waitCtr.Env, logSinkEnv)
+		assert.Contains(t, mainCtr.Env, logSinkEnv, "the main container captures its output for the wait container")
+		authorizationEnv := apiv1.EnvVar{Name: common.EnvVarLogSinkAuthorization, ValueFrom: &apiv1.EnvVarSource{SecretKeyRef: secret}}
+		assert.Contains(t, waitCtr.Env, authorizationEnv)
+		assert.NotContains(t, mainCtr.Env, authorizationEnv)
+	})
+	t.Run("File", func(t *testing.T) {
+		volume := &apiv1.Volume{Name: "logs", VolumeSource: apiv1.VolumeSource{PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{ClaimName: "logs"}}}
+		pod := createPod(t, &config.LogSinkConfig{File: &config.FileLogSinkConfig{Path: "/logs", Volume: volume}})
+		assert.Contains(t, pod.Spec.Volumes, *volume)
+		assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, apiv1.VolumeMount{Name: "logs", MountPath: "/logs"})
+		assert.NotContains(t, pod.Spec.Containers[1].VolumeMounts, apiv1.VolumeMount{Name: "logs", MountPath: "/logs"})
+	})
+}
+
+func TestSharedArtifactCache(t *testing.T) {
+	volume := apiv1.Volume{Name: "shared-artifact-cache", VolumeSource: apiv1.VolumeSource{HostPath: &apiv1.HostPathVolumeSource{Path: "/var/lib/argo-artifacts"}}}
+	cancel, controller := newController(func(controller *WorkflowController) {
+		controller.Config.SharedArtifactCache = &config.SharedArtifactCacheConfig{Volume: volume}
+	})
+	defer cancel()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	require.NoError(t, woc.setExecWorkflow(ctx))
+	pod, err := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*woc.execWf.Spec.Templates[0].Container}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	assert.Contains(t, pod.Spec.Volumes, volume)
+	env := apiv1.EnvVar{Name: common.EnvVarSharedArtifactCache, Value: common.ExecutorSharedArtifactCacheDir}
+	mount := apiv1.VolumeMount{Name: "shared-artifact-cache", MountPath: common.ExecutorSharedArtifactCacheDir}
+	require.Len(t, pod.Spec.InitContainers, 1)
+	assert.Contains(t, pod.Spec.InitContainers[0].Env, env)
+	assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, mount)
+	waitCtr, mainCtr := pod.Spec.Containers[0], pod.Spec.Containers[1]
+	assert.Contains(t, waitCtr.Env, env)
+	assert.Contains(t, waitCtr.VolumeMounts, mount)
+	assert.NotContains(t, mainCtr.Env, env)
+	assert.NotContains(t, mainCtr.VolumeMounts, mount)
+}
+
+func TestArtifactTransferEnvVars(t *testing.T) {
+	partSize := resource.MustParse("64Mi")
+	cancel, controller := newController(func(controller *WorkflowController) {
+		controller.Config.ArtifactTransfer = &config.ArtifactTransferConfig{Parallelism: 8, PartSize: &partSize}
+	})
+	defer cancel()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	require.NoError(t, woc.setExecWorkflow(ctx))
+	pod, err := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*woc.execWf.Spec.Templates[0].Container}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	for _, ctr := range []apiv1.Container{pod.Spec.InitContainers[0], pod.Spec.Containers[0]} {
+		assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarArtifactTransferParallelism, Value: "8"})
+		assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarArtifactPartSize, Value: "67108864"})
+	}
+}
+
+func TestPartialDownloads(t *testing.T) {
+	volume := apiv1.Volume{Name: "partial-downloads", VolumeSource: apiv1.VolumeSource{HostPath: &apiv1.HostPathVolumeSource{Path: "/var/lib/argo-partial-downloads"}}}
+	cancel, controller := newController(func(controller *WorkflowController) {
+		controller.Config.ArtifactTransfer = &config.ArtifactTransferConfig{PartialDownloads: &volume}
+	})
+	defer cancel()
+	wf := wfv1.MustUnmarshalWorkflow(helloWorldWf)
+	wf.UID = "my-uid"
+	retryNodeID := wf.NodeID(wf.Name)
+	attemptName := wf.Name + "(1)"
+	wf.Status.Nodes = wfv1.Nodes{retryNodeID: {ID: retryNodeID, Name: wf.Name, Type: wfv1.NodeTypeRetry, Children: []string{wf.NodeID(wf.Name + "(0)"), wf.NodeID(attemptName)}}}
+	ctx := context.Background()
+	woc := newWorkflowOperationCtx(wf, controller)
+	require.NoError(t, woc.setExecWorkflow(ctx))
+	pod, err := woc.createWorkflowPod(ctx, attemptName, []apiv1.Container{*woc.execWf.Spec.Templates[0].Container}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	require.NoError(t, err)
+	assert.Contains(t, pod.Spec.Volumes, volume)
+	require.Len(t, pod.Spec.InitContainers, 1)
+	assert.Contains(t, pod.Spec.InitContainers[0].Env, apiv1.EnvVar{Name: common.EnvVarArtifactPartialDownloads, Value: common.ExecutorPartialDownloadsDir})
+	assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, apiv1.VolumeMount{Name: "partial-downloads", MountPath: common.ExecutorPartialDownloadsDir, SubPath: "my-uid/" + retryNodeID}, "the attempts of the node share the sub-path")
+	for _, ctr := range pod.Spec.Containers {
+		for _, mount := range ctr.VolumeMounts {
+			assert.NotEqual(t, "partial-downloads", mount.Name)
+		}
+	}
+}
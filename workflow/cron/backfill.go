@@ -0,0 +1,146 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/util"
+)
+
+// NewBackfillStatus returns the status of a new backfill of a CronWorkflow, whose first scheduled time is the first
+// time the schedule would have run a Workflow at or after the start of the backfill
+func NewBackfillStatus(cronWf *v1alpha1.CronWorkflow, backfill v1alpha1.CronWorkflowBackfill, now time.Time) (*v1alpha1.CronWorkflowBackfillStatus, error) {
+	if b := cronWf.Status.Backfill; b.Running() {
+		return nil, fmt.Errorf("a backfill from %s to %s is already running", b.From.Format(time.RFC3339), b.To.Format(time.RFC3339))
+	}
+	if backfill.From.IsZero() || backfill.To.IsZero() {
+		return nil, fmt.Errorf("the start and end of a backfill must be set")
+	}
+	if backfill.To.Before(&backfill.From) {
+		return nil, fmt.Errorf("the end of a backfill must not be before its start")
+	}
+	if backfill.To.After(now) {
+		return nil, fmt.Errorf("the end of a backfill must not be in the future")
+	}
+	if backfill.Parallelism < 0 {
+		return nil, fmt.Errorf("the parallelism of a backfill must not be negative")
+	}
+	schedule, err := parseSchedules(&cronWf.Spec)
+	if err != nil {
+		return nil, err
+	}
+	// Next returns the first time after the time it is given, which is at least a second later
+	first := schedule.Next(backfill.From.Add(-time.Second))
+	if first.After(backfill.To.Time) {
+		return nil, fmt.Errorf("the schedule '%s' has no scheduled times from %s to %s", cronWf.Spec.GetScheduleString(), backfill.From.Format(time.RFC3339), backfill.To.Format(time.RFC3339))
+	}
+	return &v1alpha1.CronWorkflowBackfillStatus{
+		CronWorkflowBackfill: backfill,
+		NextScheduledTime:    &v1.Time{Time: first},
+		StartedAt:            v1.Time{Time: now},
+	}, nil
+}
+
+// backfill submits the Workflows of the scheduled times of a running backfill, at most its parallelism at a time.
+// Scheduled times that the schedule already ran a Workflow for are skipped, as the Workflow would have the same name,
+// as are scheduled times on excluded dates.
+func (woc *cronWfOperationCtx) backfill(ctx context.Context, workflows []v1alpha1.Workflow) error {
+	b := woc.cronWf.Status.Backfill
+	if !b.Running() {
+		return nil
+	}
+	schedule, err := parseSchedules(&woc.cronWf.Spec)
+	if err != nil {
+		return err
+	}
+	exclusions, err := woc.getExclusions(ctx)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to get exclusions: %s", err))
+		woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"conditions": woc.cronWf.Status.Conditions}})
+		return nil
+	}
+
+	fulfilled := make(map[types.UID]bool)
+	for _, wf := range workflows {
+		fulfilled[wf.UID] = wf.Status.Fulfilled()
+	}
+	var active []corev1.ObjectReference
+	for _, ref := range b.Active {
+		if f, found := fulfilled[ref.UID]; found && !f {
+			active = append(active, ref)
+		}
+	}
+	updated := len(active) != len(b.Active)
+	b.Active = active
+
+	parallelism := int(b.Parallelism)
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	for !woc.cronWf.Spec.Suspend && b.NextScheduledTime != nil && len(b.Active) < parallelism {
+		scheduledTime := b.NextScheduledTime.Time
+		if reason := exclusions.skipReason(&woc.cronWf.Spec, scheduledTime); reason != "" {
+			woc.log.WithField("scheduledTime", scheduledTime).Infof("Skipping the scheduled time: %s", reason)
+		} else if err := woc.submitBackfill(ctx, b, scheduledTime); err != nil {
+			woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to submit backfilled Workflow: %s", err))
+			updated = true
+			break
+		}
+		if next := schedule.Next(scheduledTime); next.After(b.To.Time) {
+			b.NextScheduledTime = nil
+		} else {
+			b.NextScheduledTime = &v1.Time{Time: next}
+		}
+		updated = true
+	}
+	if b.NextScheduledTime == nil && len(b.Active) == 0 {
+		woc.log.WithField("submitted", b.Submitted).Info("Backfill finished")
+		b.FinishedAt = &v1.Time{Time: time.Now()}
+		updated = true
+	}
+	if updated {
+		woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"backfill": BackfillStatusPatch(b), "conditions": woc.cronWf.Status.Conditions}})
+	}
+	return nil
+}
+
+// submitBackfill submits the backfilled Workflow of a scheduled time, unless the schedule already ran one
+func (woc *cronWfOperationCtx) submitBackfill(ctx context.Context, b *v1alpha1.CronWorkflowBackfillStatus, scheduledTime time.Time) error {
+	wf := common.ConvertCronWorkflowToWorkflowWithProperties(woc.cronWf, getChildWorkflowName(woc.cronWf.Name, scheduledTime), scheduledTime)
+	wf.Labels[common.LabelKeyCronWorkflowBackfill] = "true"
+	runWf, err := util.SubmitWorkflow(ctx, woc.wfClient, woc.wfClientset, woc.cronWf.Namespace, wf, &v1alpha1.SubmitOpts{})
+	if errors.IsAlreadyExists(err) {
+		woc.log.WithField("scheduledTime", scheduledTime).Info("A Workflow was already run for the scheduled time, skipping it")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	b.Active = append(b.Active, getWorkflowObjectReference(wf, runWf))
+	b.Submitted++
+	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
+	return nil
+}
+
+// BackfillStatusPatch returns the merge patch that replaces the status of a backfill. Unlike the status itself, fields
+// that are not set are patched to null, so that they are removed from the status of the previous backfill.
+func BackfillStatusPatch(b *v1alpha1.CronWorkflowBackfillStatus) map[string]interface{} {
+	return map[string]interface{}{
+		"from":              b.From,
+		"to":                b.To,
+		"parallelism":       b.Parallelism,
+		"nextScheduledTime": b.NextScheduledTime,
+		"submitted":         b.Submitted,
+		"active":            b.Active,
+		"startedAt":         b.StartedAt,
+		"finishedAt":        b.FinishedAt,
+	}
+}
@@ -0,0 +1,164 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+)
+
+const backfillCronWf = `apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: my-cron
+  namespace: argo
+spec:
+  schedule: 0 0 * * *
+  workflowSpec:
+    entrypoint: main
+    templates:
+    - name: main
+      container:
+        image: alpine
+`
+
+func day(d int) v1.Time {
+	return v1.NewTime(time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC))
+}
+
+func TestNewBackfillStatus(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	now := time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Valid", func(t *testing.T) {
+		b, err := NewBackfillStatus(&cronWf, v1alpha1.CronWorkflowBackfill{From: v1.NewTime(day(1).Add(time.Hour)), To: day(3)}, now)
+		require.NoError(t, err)
+		assert.Equal(t, day(2).Time, b.NextScheduledTime.Time)
+		assert.Equal(t, now, b.StartedAt.Time)
+		assert.True(t, b.Running())
+	})
+	t.Run("InclusiveFrom", func(t *testing.T) {
+		b, err := NewBackfillStatus(&cronWf, v1alpha1.CronWorkflowBackfill{From: day(1), To: day(1)}, now)
+		require.NoError(t, err)
+		assert.Equal(t, day(1).Time, b.NextScheduledTime.Time)
+	})
+	t.Run("EndBeforeStart", func(t *testing.T) {
+		_, err := NewBackfillStatus(&cronWf, v1alpha1.CronWorkflowBackfill{From: day(3), To: day(1)}, now)
+		assert.EqualError(t, err, "the end of a backfill must not be before its start")
+	})
+	t.Run("EndInFuture", func(t *testing.T) {
+		_, err := NewBackfillStatus(&cronWf, v1alpha1.CronWorkflowBackfill{From: day(1), To: v1.NewTime(now.Add(time.Hour))}, now)
+		assert.EqualError(t, err, "the end of a backfill must not be in the future")
+	})
+	t.Run("NoScheduledTimes", func(t *testing.T) {
+		_, err := NewBackfillStatus(&cronWf, v1alpha1.CronWorkflowBackfill{From: v1.NewTime(day(1).Add(time.Hour)), To: v1.NewTime(day(1).Add(2 * time.Hour))}, now)
+		assert.ErrorContains(t, err, "has no scheduled times")
+	})
+	t.Run("AlreadyRunning", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Status.Backfill = &v1alpha1.CronWorkflowBackfillStatus{CronWorkflowBackfill: v1alpha1.CronWorkflowBackfill{From: day(1), To: day(3)}}
+		_, err := NewBackfillStatus(cronWf, v1alpha1.CronWorkflowBackfill{From: day(1), To: day(3)}, now)
+		assert.ErrorContains(t, err, "is already running")
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	b, err := NewBackfillStatus(&cronWf, v1alpha1.CronWorkflowBackfill{From: day(1), To: day(3), Parallelism: 2}, time.Now())
+	require.NoError(t, err)
+	cronWf.Status.Backfill = b
+
+	cs := fake.NewSimpleClientset(&cronWf)
+	// the fake clientset does not set the UIDs of the Workflows it creates
+	cs.PrependReactor("create", "workflows", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		wf := action.(k8stesting.CreateAction).GetObject().(*v1alpha1.Workflow)
+		wf.UID = types.UID(wf.Name)
+		return false, nil, nil
+	})
+	woc := &cronWfOperationCtx{
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+	}
+	ctx := context.Background()
+	listWorkflows := func() []v1alpha1.Workflow {
+		wfs, err := woc.wfClient.List(ctx, v1.ListOptions{})
+		require.NoError(t, err)
+		return wfs.Items
+	}
+
+	require.NoError(t, woc.backfill(ctx, nil))
+	wfs := listWorkflows()
+	require.Len(t, wfs, 2, "the backfill submits as many Workflows as its parallelism")
+	assert.Equal(t, "my-cron-1577836800", wfs[0].Name)
+	assert.Equal(t, "true", wfs[0].Labels[common.LabelKeyCronWorkflowBackfill])
+	assert.Equal(t, int32(2), woc.cronWf.Status.Backfill.Submitted)
+	assert.Len(t, woc.cronWf.Status.Backfill.Active, 2)
+	assert.True(t, day(3).Time.Equal(woc.cronWf.Status.Backfill.NextScheduledTime.Time))
+
+	require.NoError(t, woc.backfill(ctx, wfs))
+	assert.Len(t, listWorkflows(), 2, "the backfill waits for its Workflows")
+
+	for i := range wfs {
+		wfs[i].Status.Phase = v1alpha1.WorkflowSucceeded
+	}
+	require.NoError(t, woc.backfill(ctx, wfs))
+	wfs = listWorkflows()
+	require.Len(t, wfs, 3)
+	assert.Nil(t, woc.cronWf.Status.Backfill.NextScheduledTime)
+	assert.True(t, woc.cronWf.Status.Backfill.Running())
+
+	for i := range wfs {
+		wfs[i].Status.Phase = v1alpha1.WorkflowSucceeded
+	}
+	require.NoError(t, woc.backfill(ctx, wfs))
+	assert.False(t, woc.cronWf.Status.Backfill.Running())
+	assert.Equal(t, int32(3), woc.cronWf.Status.Backfill.Submitted)
+	assert.Empty(t, woc.cronWf.Status.Backfill.Active)
+}
+
+func TestBackfillExclusions(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	cronWf.Spec.Exclusions = &v1alpha1.CronWorkflowExclusions{Dates: []string{"2020-01-02"}}
+	b, err := NewBackfillStatus(&cronWf, v1alpha1.CronWorkflowBackfill{From: day(1), To: day(3), Parallelism: 3}, time.Now())
+	require.NoError(t, err)
+	cronWf.Status.Backfill = b
+
+	cs := fake.NewSimpleClientset(&cronWf)
+	woc := &cronWfOperationCtx{
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:    cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		cronWf:      &cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+		metrics:     metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+	}
+	ctx := context.Background()
+	require.NoError(t, woc.backfill(ctx, nil))
+	wfs, err := woc.wfClient.List(ctx, v1.ListOptions{})
+	require.NoError(t, err)
+	if assert.Len(t, wfs.Items, 2) {
+		assert.Equal(t, "my-cron-1577836800", wfs.Items[0].Name)
+		assert.Equal(t, "my-cron-1578009600", wfs.Items[1].Name)
+	}
+	assert.Equal(t, int32(2), woc.cronWf.Status.Backfill.Submitted)
+	assert.Nil(t, woc.cronWf.Status.Backfill.NextScheduledTime)
+}
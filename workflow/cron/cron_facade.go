@@ -10,11 +10,11 @@ import (
 )
 
 // cronFacade allows the client to operate using key rather than cron.EntryID,
-// as well as providing sync guarantees
+// as well as providing sync guarantees. A key has one entry for each of its schedules.
 type cronFacade struct {
 	mu       sync.Mutex
 	cron     *cron.Cron
-	entryIDs map[string]cron.EntryID
+	entryIDs map[string][]cron.EntryID
 }
 
 type ScheduledTimeFunc func() time.Time
@@ -22,7 +22,7 @@ type ScheduledTimeFunc func() time.Time
 func newCronFacade() *cronFacade {
 	return &cronFacade{
 		cron:     cron.New(),
-		entryIDs: make(map[string]cron.EntryID),
+		entryIDs: make(map[string][]cron.EntryID),
 	}
 }
 
@@ -37,37 +37,53 @@ func (f *cronFacade) Stop() {
 func (f *cronFacade) Delete(key string) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	entryID, ok := f.entryIDs[key]
+	entryIDs, ok := f.entryIDs[key]
 	if !ok {
 		return
 	}
-	f.cron.Remove(entryID)
+	for _, entryID := range entryIDs {
+		f.cron.Remove(entryID)
+	}
 	delete(f.entryIDs, key)
 }
 
-func (f *cronFacade) AddJob(key, schedule string, cwoc *cronWfOperationCtx) (ScheduledTimeFunc, error) {
+// AddJob adds an entry for each of the schedules, that all run the same job
+func (f *cronFacade) AddJob(key string, schedules []string, cwoc *cronWfOperationCtx) (ScheduledTimeFunc, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	entryID, err := f.cron.AddJob(schedule, cwoc)
-	if err != nil {
-		return nil, err
+	var entryIDs []cron.EntryID
+	for _, schedule := range schedules {
+		entryID, err := f.cron.AddJob(schedule, cwoc)
+		if err != nil {
+			for _, entryID := range entryIDs {
+				f.cron.Remove(entryID)
+			}
+			return nil, err
+		}
+		entryIDs = append(entryIDs, entryID)
 	}
-	f.entryIDs[key] = entryID
+	f.entryIDs[key] = entryIDs
 
-	// Return a function to return the last scheduled time
+	// Return a function to return the last scheduled time, i.e. that of the entry that was run last
 	return func() time.Time {
-		return f.cron.Entry(entryID).Prev
+		var prev time.Time
+		for _, entryID := range entryIDs {
+			if p := f.cron.Entry(entryID).Prev; p.After(prev) {
+				prev = p
+			}
+		}
+		return prev
 	}, nil
 }
 
 func (f *cronFacade) Load(key string) (*cronWfOperationCtx, error) {
 	f.mu.Lock()
 	defer f.mu.Unlock()
-	entryID, ok := f.entryIDs[key]
-	if !ok {
+	entryIDs, ok := f.entryIDs[key]
+	if !ok || len(entryIDs) == 0 {
 		return nil, fmt.Errorf("entry ID for %s not found", key)
 	}
-	entry := f.cron.Entry(entryID).Job
+	entry := f.cron.Entry(entryIDs[0]).Job
 	cwoc, ok := entry.(*cronWfOperationCtx)
 	if !ok {
 		return nil, fmt.Errorf("job entry ID for %s was not a *cronWfOperationCtx, was %v", key, reflect.TypeOf(entry))
@@ -5,14 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
-	"github.com/robfig/cron/v3"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -29,24 +30,28 @@ import (
 
 type cronWfOperationCtx struct {
 	// CronWorkflow is the CronWorkflow to be run
-	name        string
-	cronWf      *v1alpha1.CronWorkflow
-	wfClientset versioned.Interface
-	wfClient    typed.WorkflowInterface
-	cronWfIf    typed.CronWorkflowInterface
-	log         *log.Entry
-	metrics     *metrics.Metrics
+	name          string
+	cronWf        *v1alpha1.CronWorkflow
+	wfClientset   versioned.Interface
+	wfClient      typed.WorkflowInterface
+	cronWfIf      typed.CronWorkflowInterface
+	kubeclientset kubernetes.Interface
+	log           *log.Entry
+	metrics       *metrics.Metrics
 	// scheduledTimeFunc returns the last scheduled time when it is called
 	scheduledTimeFunc ScheduledTimeFunc
+	// runMutex stops the schedules of a CronWorkflow that are due at the same time from running at the same time
+	runMutex sync.Mutex
 }
 
-func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
+func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset versioned.Interface, kubeclientset kubernetes.Interface, metrics *metrics.Metrics) *cronWfOperationCtx {
 	return &cronWfOperationCtx{
-		name:        cronWorkflow.ObjectMeta.Name,
-		cronWf:      cronWorkflow,
-		wfClientset: wfClientset,
-		wfClient:    wfClientset.ArgoprojV1alpha1().Workflows(cronWorkflow.Namespace),
-		cronWfIf:    wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
+		name:          cronWorkflow.ObjectMeta.Name,
+		cronWf:        cronWorkflow,
+		wfClientset:   wfClientset,
+		kubeclientset: kubeclientset,
+		wfClient:      wfClientset.ArgoprojV1alpha1().Workflows(cronWorkflow.Namespace),
+		cronWfIf:      wfClientset.ArgoprojV1alpha1().CronWorkflows(cronWorkflow.Namespace),
 		log: log.WithFields(log.Fields{
 			"workflow":  cronWorkflow.ObjectMeta.Name,
 			"namespace": cronWorkflow.ObjectMeta.Namespace,
@@ -64,6 +69,8 @@ func newCronWfOperationCtx(cronWorkflow *v1alpha1.CronWorkflow, wfClientset vers
 // Run handles the running of a cron workflow
 // It fits the github.com/robfig/cron.Job interface
 func (woc *cronWfOperationCtx) Run() {
+	woc.runMutex.Lock()
+	defer woc.runMutex.Unlock()
 	ctx := context.Background()
 	woc.run(ctx, woc.scheduledTimeFunc())
 }
@@ -83,6 +90,32 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 		return
 	}
 
+	stopped, err := woc.enforceStopStrategy(ctx)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSpecError, err.Error())
+		return
+	} else if stopped {
+		return
+	}
+
+	exclusions, err := woc.getExclusions(ctx)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Failed to get exclusions: %s", err))
+		return
+	}
+	if reason := exclusions.skipReason(&woc.cronWf.Spec, scheduledRuntime); reason != "" {
+		woc.skip(scheduledRuntime, reason)
+		return
+	}
+	reason, err := skipReasonWhen(woc.cronWf)
+	if err != nil {
+		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSpecError, err.Error())
+		return
+	} else if reason != "" {
+		woc.skip(scheduledRuntime, reason)
+		return
+	}
+
 	proceed, err := woc.enforceRuntimePolicy(ctx)
 	if err != nil {
 		woc.reportCronWorkflowError(v1alpha1.ConditionTypeSubmissionError, fmt.Sprintf("Concurrency policy error: %s", err))
@@ -108,6 +141,13 @@ func (woc *cronWfOperationCtx) run(ctx context.Context, scheduledRuntime time.Ti
 	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
 }
 
+// skip records that no Workflow is run at the scheduled time
+func (woc *cronWfOperationCtx) skip(scheduledRuntime time.Time, reason string) {
+	woc.log.WithField("scheduledTime", scheduledRuntime).Infof("%s is skipped: %s", woc.name, reason)
+	woc.cronWf.Status.LastSkipped = &v1alpha1.CronWorkflowSkip{ScheduledTime: v1.Time{Time: scheduledRuntime}, Reason: reason}
+	woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeSubmissionError)
+}
+
 func (woc *cronWfOperationCtx) validateCronWorkflow() error {
 	wftmplGetter := templateresolution.WrapWorkflowTemplateInterface(woc.wfClientset.ArgoprojV1alpha1().WorkflowTemplates(woc.cronWf.Namespace))
 	cwftmplGetter := templateresolution.WrapClusterWorkflowTemplateInterface(woc.wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates())
@@ -138,7 +178,13 @@ func (woc *cronWfOperationCtx) persistUpdate(ctx context.Context) {
 }
 
 func (woc *cronWfOperationCtx) persistUpdateActiveWorkflows(ctx context.Context) {
-	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"active": woc.cronWf.Status.Active}})
+	status := woc.cronWf.Status
+	woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{
+		"active":            status.Active,
+		"succeeded":         status.Succeeded,
+		"failed":            status.Failed,
+		"consecutiveFailed": status.ConsecutiveFailed,
+	}})
 }
 
 func (woc *cronWfOperationCtx) patch(ctx context.Context, patch map[string]interface{}) {
@@ -225,27 +271,17 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, err
 	}
 	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
 	if woc.cronWf.Status.LastScheduledTime != nil {
-		var now time.Time
-		var cronSchedule cron.Schedule
+		now := time.Now()
 		if woc.cronWf.Spec.Timezone != "" {
 			loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
 			if err != nil {
 				return time.Time{}, fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
 			}
-			now = time.Now().In(loc)
-
-			cronScheduleString := woc.cronWf.Spec.GetScheduleString()
-			cronSchedule, err = cron.ParseStandard(cronScheduleString)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("unable to form timezone schedule '%s': %s", cronScheduleString, err)
-			}
-		} else {
-			var err error
-			now = time.Now()
-			cronSchedule, err = cron.ParseStandard(woc.cronWf.Spec.Schedule)
-			if err != nil {
-				return time.Time{}, err
-			}
+			now = now.In(loc)
+		}
+		cronSchedule, err := parseSchedules(&woc.cronWf.Spec)
+		if err != nil {
+			return time.Time{}, err
 		}
 
 		var missedExecutionTime time.Time
@@ -270,9 +306,13 @@ func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, err
 
 func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows []v1alpha1.Workflow) error {
 	updated := false
-	currentWfsFulfilled := make(map[types.UID]bool)
+	currentWfPhases := make(map[types.UID]v1alpha1.WorkflowPhase)
 	for _, wf := range workflows {
-		currentWfsFulfilled[wf.UID] = wf.Status.Fulfilled()
+		// the Workflows of a backfill are reconciled by the backfill
+		if wf.Labels[common.LabelKeyCronWorkflowBackfill] == "true" {
+			continue
+		}
+		currentWfPhases[wf.UID] = wf.Status.Phase
 
 		if !woc.cronWf.Status.HasActiveUID(wf.UID) && !wf.Status.Fulfilled() {
 			updated = true
@@ -280,10 +320,17 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 		}
 	}
 
+	completed := false
 	for _, objectRef := range woc.cronWf.Status.Active {
-		if fulfilled, found := currentWfsFulfilled[objectRef.UID]; !found || fulfilled {
+		phase, found := currentWfPhases[objectRef.UID]
+		if !found || phase.Completed() {
 			updated = true
 			woc.removeFromActiveList(objectRef.UID)
+			// Workflows that were deleted before they completed are not counted
+			if found {
+				completed = true
+				woc.countCompleted(phase)
+			}
 		}
 	}
 
@@ -291,6 +338,14 @@ func (woc *cronWfOperationCtx) reconcileActiveWfs(ctx context.Context, workflows
 		woc.persistUpdateActiveWorkflows(ctx)
 	}
 
+	// stop as soon as a scheduled Workflow that meets the stop strategy completes, rather than at the next schedule;
+	// this is done once the counters are persisted, as the patch replaces the CronWorkflow with the stored one
+	if completed {
+		if _, err := woc.enforceStopStrategy(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,119 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// exclusionDateLayout is the layout of the dates of CronWorkflow exclusions
+const exclusionDateLayout = "2006-01-02"
+
+// schedules is the union of the schedules of a CronWorkflow
+type schedules []cron.Schedule
+
+var _ cron.Schedule = schedules{}
+
+func parseSchedules(spec *v1alpha1.CronWorkflowSpec) (schedules, error) {
+	var s schedules
+	for _, scheduleString := range spec.GetSchedulesWithTimezone() {
+		schedule, err := cron.ParseStandard(scheduleString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule '%s': %w", scheduleString, err)
+		}
+		s = append(s, schedule)
+	}
+	if len(s) == 0 {
+		return nil, fmt.Errorf("no schedule")
+	}
+	return s, nil
+}
+
+// Next returns the earliest time after t that any of the schedules is due
+func (s schedules) Next(t time.Time) time.Time {
+	var next time.Time
+	for _, schedule := range s {
+		if n := schedule.Next(t); next.IsZero() || n.Before(next) {
+			next = n
+		}
+	}
+	return next
+}
+
+// ParseExclusionDates parses a list of excluded dates, one per line. Empty lines and lines starting with '#' are
+// ignored.
+func ParseExclusionDates(text string) ([]string, error) {
+	var dates []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, err := time.Parse(exclusionDateLayout, line); err != nil {
+			return nil, fmt.Errorf("invalid excluded date '%s', must be in the format YYYY-MM-DD", line)
+		}
+		dates = append(dates, line)
+	}
+	return dates, nil
+}
+
+// exclusions is the set of excluded dates of a CronWorkflow
+type exclusions map[string]bool
+
+// getExclusions returns the excluded dates of the CronWorkflow, reading its ConfigMap of dates if it has one
+func (woc *cronWfOperationCtx) getExclusions(ctx context.Context) (exclusions, error) {
+	x := woc.cronWf.Spec.Exclusions
+	e := exclusions{}
+	if x == nil {
+		return e, nil
+	}
+	for _, date := range x.Dates {
+		e[date] = true
+	}
+	if ref := x.ConfigMapKeyRef; ref != nil {
+		optional := ref.Optional != nil && *ref.Optional
+		cm, err := woc.kubeclientset.CoreV1().ConfigMaps(woc.cronWf.Namespace).Get(ctx, ref.Name, v1.GetOptions{})
+		if optional && apierr.IsNotFound(err) {
+			return e, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the ConfigMap of excluded dates: %w", err)
+		}
+		text, ok := cm.Data[ref.Key]
+		if !ok {
+			if optional {
+				return e, nil
+			}
+			return nil, fmt.Errorf("ConfigMap '%s' does not have the key '%s'", ref.Name, ref.Key)
+		}
+		dates, err := ParseExclusionDates(text)
+		if err != nil {
+			return nil, fmt.Errorf("ConfigMap '%s' key '%s': %w", ref.Name, ref.Key, err)
+		}
+		for _, date := range dates {
+			e[date] = true
+		}
+	}
+	return e, nil
+}
+
+// skipReason returns why no Workflow is run at the scheduled time, or an empty string if one is. The date of the
+// scheduled time is its date in the timezone of the CronWorkflow.
+func (e exclusions) skipReason(spec *v1alpha1.CronWorkflowSpec, scheduledTime time.Time) string {
+	if spec.Timezone != "" {
+		if loc, err := time.LoadLocation(spec.Timezone); err == nil {
+			scheduledTime = scheduledTime.In(loc)
+		}
+	}
+	if date := scheduledTime.Format(exclusionDateLayout); e[date] {
+		return fmt.Sprintf("%s is an excluded date", date)
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+)
+
+func TestSchedules_Next(t *testing.T) {
+	s, err := parseSchedules(&v1alpha1.CronWorkflowSpec{Schedules: []string{"0 9 * * 1-5", "0 12 * * 0,6"}})
+	require.NoError(t, err)
+	// Friday 5 January 2024
+	friday := time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC)
+	saturday := s.Next(friday)
+	assert.Equal(t, time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC), saturday)
+	assert.Equal(t, time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC), s.Next(saturday))
+
+	_, err = parseSchedules(&v1alpha1.CronWorkflowSpec{})
+	assert.EqualError(t, err, "no schedule")
+}
+
+func TestParseExclusionDates(t *testing.T) {
+	dates, err := ParseExclusionDates("# holidays\n2024-12-25\n\n 2024-12-26 \n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2024-12-25", "2024-12-26"}, dates)
+
+	_, err = ParseExclusionDates("25/12/2024")
+	assert.EqualError(t, err, "invalid excluded date '25/12/2024', must be in the format YYYY-MM-DD")
+}
+
+func TestExclusions_SkipReason(t *testing.T) {
+	e := exclusions{"2024-12-25": true}
+	spec := &v1alpha1.CronWorkflowSpec{}
+	assert.Equal(t, "2024-12-25 is an excluded date", e.skipReason(spec, time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC)))
+	assert.Empty(t, e.skipReason(spec, time.Date(2024, 12, 24, 23, 0, 0, 0, time.UTC)))
+
+	spec.Timezone = "Asia/Tokyo"
+	assert.Equal(t, "2024-12-25 is an excluded date", e.skipReason(spec, time.Date(2024, 12, 24, 23, 0, 0, 0, time.UTC)), "the date is the date in the timezone of the CronWorkflow")
+}
+
+func TestRunExcluded(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	cronWf.Spec.Exclusions = &v1alpha1.CronWorkflowExclusions{
+		Dates:           []string{"2024-01-01"},
+		ConfigMapKeyRef: &apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "holidays"}, Key: "dates"},
+	}
+	cs := fake.NewSimpleClientset(&cronWf)
+	kubeclientset := kubefake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{Name: "holidays", Namespace: "argo"},
+		Data:       map[string]string{"dates": "2024-12-25\n"},
+	})
+	woc := &cronWfOperationCtx{
+		wfClientset:   cs,
+		wfClient:      cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:      cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		kubeclientset: kubeclientset,
+		cronWf:        &cronWf,
+		log:           logrus.WithFields(logrus.Fields{}),
+		metrics:       metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+	}
+	ctx := context.Background()
+	listWorkflows := func() []v1alpha1.Workflow {
+		wfs, err := woc.wfClient.List(ctx, v1.ListOptions{})
+		require.NoError(t, err)
+		return wfs.Items
+	}
+
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+	woc.run(ctx, christmas)
+	assert.Empty(t, listWorkflows(), "dates of the ConfigMap are excluded")
+	if assert.NotNil(t, woc.cronWf.Status.LastSkipped) {
+		assert.True(t, christmas.Equal(woc.cronWf.Status.LastSkipped.ScheduledTime.Time))
+		assert.Equal(t, "2024-12-25 is an excluded date", woc.cronWf.Status.LastSkipped.Reason)
+	}
+
+	woc.run(ctx, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Empty(t, listWorkflows(), "dates of the CronWorkflow are excluded")
+
+	woc.run(ctx, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, listWorkflows(), 1)
+
+	require.NoError(t, kubeclientset.CoreV1().ConfigMaps("argo").Delete(ctx, "holidays", v1.DeleteOptions{}))
+	woc.run(ctx, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, listWorkflows(), 1, "no Workflow is run when the exclusions are unknown")
+	if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+		assert.Equal(t, v1alpha1.ConditionTypeSubmissionError, woc.cronWf.Status.Conditions[0].Type)
+		assert.Contains(t, woc.cronWf.Status.Conditions[0].Message, "Failed to get exclusions")
+	}
+}
@@ -0,0 +1,114 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/expr/argoexpr"
+	"github.com/argoproj/argo-workflows/v3/util/expr/env"
+)
+
+// exprEnv returns the environment that the `when` and stop strategy expressions of the CronWorkflow are evaluated in
+func exprEnv(cronWf *v1alpha1.CronWorkflow) map[string]interface{} {
+	return env.GetFuncMap(map[string]interface{}{
+		"cronworkflow": map[string]interface{}{
+			"name":              cronWf.Name,
+			"namespace":         cronWf.Namespace,
+			"labels":            cronWf.Labels,
+			"annotations":       cronWf.Annotations,
+			"succeeded":         cronWf.Status.Succeeded,
+			"failed":            cronWf.Status.Failed,
+			"consecutiveFailed": cronWf.Status.ConsecutiveFailed,
+		},
+	})
+}
+
+// countCompleted counts a scheduled Workflow that completed in the run counters of the CronWorkflow
+func (woc *cronWfOperationCtx) countCompleted(phase v1alpha1.WorkflowPhase) {
+	status := &woc.cronWf.Status
+	if phase == v1alpha1.WorkflowSucceeded {
+		status.Succeeded++
+		status.ConsecutiveFailed = 0
+	} else {
+		status.Failed++
+		status.ConsecutiveFailed++
+	}
+}
+
+// stopReason returns why the CronWorkflow must stop, or an empty string if it must not
+func stopReason(cronWf *v1alpha1.CronWorkflow, now time.Time) (string, error) {
+	s := cronWf.Spec.StopStrategy
+	if s == nil {
+		return "", nil
+	}
+	status := cronWf.Status
+	switch {
+	case s.Succeeded > 0 && status.Succeeded >= s.Succeeded:
+		return fmt.Sprintf("%d scheduled Workflows succeeded", status.Succeeded), nil
+	case s.Failed > 0 && status.Failed >= s.Failed:
+		return fmt.Sprintf("%d scheduled Workflows failed", status.Failed), nil
+	case s.EndTime != nil && !now.Before(s.EndTime.Time):
+		return fmt.Sprintf("the end time %s has passed", s.EndTime.Format(time.RFC3339)), nil
+	case s.Expression != "":
+		stop, err := argoexpr.EvalBool(s.Expression, exprEnv(cronWf))
+		if err != nil {
+			return "", fmt.Errorf("invalid stop strategy expression: %w", err)
+		}
+		if stop {
+			return fmt.Sprintf("the stop strategy expression '%s' is true", s.Expression), nil
+		}
+	}
+	return "", nil
+}
+
+// enforceStopStrategy suspends the CronWorkflow if its stop strategy is met, and returns whether it did. Resuming a
+// CronWorkflow whose stop strategy is no longer met removes its Stopped condition.
+func (woc *cronWfOperationCtx) enforceStopStrategy(ctx context.Context) (bool, error) {
+	if woc.cronWf.Spec.Suspend {
+		return false, nil
+	}
+	reason, err := stopReason(woc.cronWf, time.Now())
+	if err != nil {
+		return false, err
+	}
+	if reason == "" {
+		n := len(woc.cronWf.Status.Conditions)
+		woc.cronWf.Status.Conditions.RemoveCondition(v1alpha1.ConditionTypeStopped)
+		if len(woc.cronWf.Status.Conditions) != n {
+			woc.patch(ctx, map[string]interface{}{"status": map[string]interface{}{"conditions": woc.cronWf.Status.Conditions}})
+		}
+		return false, nil
+	}
+	woc.log.Infof("%s is stopped: %s", woc.name, reason)
+	woc.cronWf.Spec.Suspend = true
+	woc.cronWf.Status.Conditions.UpsertCondition(v1alpha1.Condition{
+		Type:    v1alpha1.ConditionTypeStopped,
+		Status:  v1.ConditionTrue,
+		Message: "Suspended because " + reason,
+	})
+	woc.patch(ctx, map[string]interface{}{
+		"spec":   map[string]interface{}{"suspend": true},
+		"status": map[string]interface{}{"conditions": woc.cronWf.Status.Conditions},
+	})
+	return true, nil
+}
+
+// skipReasonWhen returns why no Workflow is run because the `when` expression of the CronWorkflow is false, or an
+// empty string if it is true
+func skipReasonWhen(cronWf *v1alpha1.CronWorkflow) (string, error) {
+	if cronWf.Spec.When == "" {
+		return "", nil
+	}
+	run, err := argoexpr.EvalBool(cronWf.Spec.When, exprEnv(cronWf))
+	if err != nil {
+		return "", fmt.Errorf("invalid when expression: %w", err)
+	}
+	if !run {
+		return fmt.Sprintf("the when expression '%s' is false", cronWf.Spec.When), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,186 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/workflow/metrics"
+)
+
+func newStopTestWoc(cronWf *v1alpha1.CronWorkflow) *cronWfOperationCtx {
+	cs := fake.NewSimpleClientset(cronWf)
+	return &cronWfOperationCtx{
+		wfClientset:   cs,
+		wfClient:      cs.ArgoprojV1alpha1().Workflows("argo"),
+		cronWfIf:      cs.ArgoprojV1alpha1().CronWorkflows("argo"),
+		kubeclientset: kubefake.NewSimpleClientset(),
+		cronWf:        cronWf,
+		log:           logrus.WithFields(logrus.Fields{}),
+		metrics:       metrics.New(metrics.ServerConfig{}, metrics.ServerConfig{}),
+	}
+}
+
+func TestCountCompleted(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	cronWf.Status.Active = []corev1.ObjectReference{{UID: "1"}, {UID: "2"}, {UID: "3"}, {UID: "4"}}
+	woc := newStopTestWoc(&cronWf)
+
+	workflows := []v1alpha1.Workflow{
+		{ObjectMeta: v1.ObjectMeta{UID: "1"}, Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowFailed}},
+		{ObjectMeta: v1.ObjectMeta{UID: "2"}, Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowError}},
+		{ObjectMeta: v1.ObjectMeta{UID: "3"}, Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowRunning}},
+	}
+	require.NoError(t, woc.reconcileActiveWfs(context.Background(), workflows))
+	assert.Equal(t, int64(0), woc.cronWf.Status.Succeeded)
+	assert.Equal(t, int64(2), woc.cronWf.Status.Failed, "deleted Workflows are not counted")
+	assert.Equal(t, int64(2), woc.cronWf.Status.ConsecutiveFailed)
+	if assert.Len(t, woc.cronWf.Status.Active, 1) {
+		assert.Equal(t, types.UID("3"), woc.cronWf.Status.Active[0].UID)
+	}
+
+	workflows[2].Status.Phase = v1alpha1.WorkflowSucceeded
+	require.NoError(t, woc.reconcileActiveWfs(context.Background(), workflows))
+	assert.Equal(t, int64(1), woc.cronWf.Status.Succeeded)
+	assert.Equal(t, int64(2), woc.cronWf.Status.Failed)
+	assert.Equal(t, int64(0), woc.cronWf.Status.ConsecutiveFailed)
+	assert.Empty(t, woc.cronWf.Status.Active)
+}
+
+func TestReconcileActiveWfsStopStrategy(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Failed: 1}
+	cronWf.Status.Active = []corev1.ObjectReference{{UID: "1"}}
+	woc := newStopTestWoc(&cronWf)
+	ctx := context.Background()
+
+	workflows := []v1alpha1.Workflow{{ObjectMeta: v1.ObjectMeta{UID: "1"}, Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowFailed}}}
+	require.NoError(t, woc.reconcileActiveWfs(ctx, workflows))
+	assert.True(t, woc.cronWf.Spec.Suspend, "the CronWorkflow is stopped as soon as the Workflow completes")
+	if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+		assert.Equal(t, v1alpha1.ConditionTypeStopped, woc.cronWf.Status.Conditions[0].Type)
+	}
+	stored, err := woc.cronWfIf.Get(ctx, "my-cron", v1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, stored.Spec.Suspend)
+	assert.Equal(t, int64(1), stored.Status.Failed)
+	assert.Empty(t, stored.Status.Active)
+}
+
+func TestStopReason(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	cronWf := &v1alpha1.CronWorkflow{
+		ObjectMeta: v1.ObjectMeta{Name: "my-cron", Labels: map[string]string{"env": "prod"}},
+		Status:     v1alpha1.CronWorkflowStatus{Succeeded: 2, Failed: 1, ConsecutiveFailed: 1},
+	}
+	reason := func(s v1alpha1.StopStrategy) string {
+		cronWf.Spec.StopStrategy = &s
+		r, err := stopReason(cronWf, now)
+		require.NoError(t, err)
+		return r
+	}
+	assert.Empty(t, reason(v1alpha1.StopStrategy{}))
+	assert.Empty(t, reason(v1alpha1.StopStrategy{Succeeded: 3, Failed: 2}))
+	assert.Equal(t, "2 scheduled Workflows succeeded", reason(v1alpha1.StopStrategy{Succeeded: 2}))
+	assert.Equal(t, "1 scheduled Workflows failed", reason(v1alpha1.StopStrategy{Failed: 1}))
+	assert.Equal(t, "the end time 2024-01-02T00:00:00Z has passed", reason(v1alpha1.StopStrategy{EndTime: &v1.Time{Time: now}}))
+	assert.Empty(t, reason(v1alpha1.StopStrategy{EndTime: &v1.Time{Time: now.Add(time.Second)}}))
+	assert.Equal(t, "the stop strategy expression 'cronworkflow.consecutiveFailed >= 1' is true", reason(v1alpha1.StopStrategy{Expression: "cronworkflow.consecutiveFailed >= 1"}))
+	assert.Empty(t, reason(v1alpha1.StopStrategy{Expression: "cronworkflow.labels.env != 'prod'"}))
+
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Expression: "cronworkflow.failed"}
+	_, err := stopReason(cronWf, now)
+	assert.ErrorContains(t, err, "invalid stop strategy expression")
+}
+
+func TestSkipReasonWhen(t *testing.T) {
+	cronWf := &v1alpha1.CronWorkflow{ObjectMeta: v1.ObjectMeta{Name: "my-cron", Annotations: map[string]string{"enabled": "false"}}}
+	reason, err := skipReasonWhen(cronWf)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+
+	cronWf.Spec.When = "cronworkflow.annotations.enabled == 'true'"
+	reason, err = skipReasonWhen(cronWf)
+	require.NoError(t, err)
+	assert.Equal(t, "the when expression 'cronworkflow.annotations.enabled == 'true'' is false", reason)
+
+	cronWf.Annotations["enabled"] = "true"
+	reason, err = skipReasonWhen(cronWf)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+}
+
+func TestRunStopStrategy(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	cronWf.Spec.StopStrategy = &v1alpha1.StopStrategy{Failed: 2}
+	cronWf.Status.Failed = 1
+	woc := newStopTestWoc(&cronWf)
+	ctx := context.Background()
+	listWorkflows := func() []v1alpha1.Workflow {
+		wfs, err := woc.wfClient.List(ctx, v1.ListOptions{})
+		require.NoError(t, err)
+		return wfs.Items
+	}
+
+	woc.run(ctx, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, listWorkflows(), 1)
+
+	woc.cronWf.Status.Failed = 2
+	woc.run(ctx, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, listWorkflows(), 1, "no Workflow is run once the stop strategy is met")
+	assert.True(t, woc.cronWf.Spec.Suspend)
+	if assert.Len(t, woc.cronWf.Status.Conditions, 1) {
+		assert.Equal(t, v1alpha1.ConditionTypeStopped, woc.cronWf.Status.Conditions[0].Type)
+		assert.Equal(t, "Suspended because 2 scheduled Workflows failed", woc.cronWf.Status.Conditions[0].Message)
+	}
+	stored, err := woc.cronWfIf.Get(ctx, "my-cron", v1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, stored.Spec.Suspend, "the CronWorkflow is suspended")
+
+	// resuming after raising the limit removes the Stopped condition
+	stored.Spec.Suspend = false
+	stored.Spec.StopStrategy.Failed = 3
+	woc.cronWf, err = woc.cronWfIf.Update(ctx, stored, v1.UpdateOptions{})
+	require.NoError(t, err)
+	woc.run(ctx, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, listWorkflows(), 2)
+	assert.Empty(t, woc.cronWf.Status.Conditions)
+}
+
+func TestRunWhen(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	v1alpha1.MustUnmarshal([]byte(backfillCronWf), &cronWf)
+	cronWf.Spec.When = "cronworkflow.succeeded < 1"
+	woc := newStopTestWoc(&cronWf)
+	ctx := context.Background()
+	listWorkflows := func() []v1alpha1.Workflow {
+		wfs, err := woc.wfClient.List(ctx, v1.ListOptions{})
+		require.NoError(t, err)
+		return wfs.Items
+	}
+
+	woc.run(ctx, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Len(t, listWorkflows(), 1)
+
+	woc.cronWf.Status.Succeeded = 1
+	skipped := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	woc.run(ctx, skipped)
+	assert.Len(t, listWorkflows(), 1)
+	if assert.NotNil(t, woc.cronWf.Status.LastSkipped) {
+		assert.True(t, skipped.Equal(woc.cronWf.Status.LastSkipped.ScheduledTime.Time))
+		assert.Equal(t, "the when expression 'cronworkflow.succeeded < 1' is false", woc.cronWf.Status.LastSkipped.Reason)
+	}
+}
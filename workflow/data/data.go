@@ -1,9 +1,11 @@
 package data
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/antonmedv/expr"
+	"gopkg.in/yaml.v3"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -29,6 +31,34 @@ func processSource(source wfv1.DataSource, processor wfv1.DataSourceProcessor) (
 		if err != nil {
 			return nil, fmt.Errorf("unable to source artifact paths: %w", err)
 		}
+	case source.ConfigMapKeyRef != nil:
+		value, err := processor.ProcessConfigMapKeyRef(source.ConfigMapKeyRef)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source config map key: %w", err)
+		}
+		data = parseValue(value)
+	case source.SecretKeyRef != nil:
+		value, err := processor.ProcessSecretKeyRef(source.SecretKeyRef)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source secret key: %w", err)
+		}
+		data = parseValue(value)
+	case source.HTTP != nil:
+		value, err := processor.ProcessHTTP(source.HTTP)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source http: %w", err)
+		}
+		data = parseValue(value)
+	case source.Parameters != nil:
+		data, err = processParameters(source.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source parameters: %w", err)
+		}
+	case source.Resources != nil:
+		data, err = processor.ProcessResources(source.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("unable to source resources: %w", err)
+		}
 	default:
 		return nil, fmt.Errorf("no valid source is used for data template")
 	}
@@ -36,6 +66,88 @@ func processSource(source wfv1.DataSource, processor wfv1.DataSourceProcessor) (
 	return data, nil
 }
 
+func processParameters(parameters []wfv1.Parameter) (interface{}, error) {
+	data := make(map[string]interface{}, len(parameters))
+	for _, param := range parameters {
+		if param.Value == nil {
+			return nil, fmt.Errorf("parameter '%s' has no value", param.Name)
+		}
+		data[param.Name] = parseValue(param.Value.String())
+	}
+	return data, nil
+}
+
+// parseValue parses JSON or YAML into an object, falling back to the value itself. Values that parse into
+// a string (e.g. CSV, which YAML folds into a single line) are returned as they are.
+func parseValue(value string) interface{} {
+	data, err := parseYAML(value)
+	if err != nil || data == nil {
+		return value
+	}
+	if _, ok := data.(string); ok {
+		return value
+	}
+	return data
+}
+
+// parseYAML parses YAML, or JSON, under the JSON schema of YAML 1.2: only true, false, null (or ~) and JSON numbers
+// are resolved from plain scalars, so that e.g. "on" and "0755" stay strings rather than becoming true and 493 as in YAML 1.1
+func parseYAML(value string) (interface{}, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(value), &node); err != nil {
+		return nil, err
+	}
+	return fromNode(&node)
+}
+
+func fromNode(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return fromNode(node.Content[0])
+	case yaml.AliasNode:
+		return fromNode(node.Alias)
+	case yaml.SequenceNode:
+		items := make([]interface{}, len(node.Content))
+		for i, item := range node.Content {
+			value, err := fromNode(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = value
+		}
+		return items, nil
+	case yaml.MappingNode:
+		items := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line %d: keys must be scalars", key.Line)
+			}
+			value, err := fromNode(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			items[key.Value] = value
+		}
+		return items, nil
+	}
+	switch {
+	case node.Style&^yaml.TaggedStyle != 0 || node.Tag == "!!str":
+		// quoted and block scalars are strings
+		return node.Value, nil
+	case node.Tag == "!!null":
+		return nil, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(node.Value), &value); err != nil {
+		return node.Value, nil
+	}
+	return value, nil
+}
+
 func processTransformation(data interface{}, transformation *wfv1.Transformation) (interface{}, error) {
 	if transformation == nil {
 		return data, nil
@@ -46,6 +158,20 @@ func processTransformation(data interface{}, transformation *wfv1.Transformation
 		switch {
 		case step.Expression != "":
 			data, err = processExpression(step.Expression, data)
+		case step.JQ != "":
+			data, err = processJQ(step.JQ, data)
+		case step.JSONPath != "":
+			data, err = processJSONPath(step.JSONPath, data)
+		case step.Parse != nil:
+			data, err = processParse(step.Parse, data)
+		case step.Sort != nil:
+			data, err = processSort(step.Sort, data)
+		case step.Unique != nil:
+			data, err = processUnique(step.Unique, data)
+		case step.GroupBy != nil:
+			data, err = processGroupBy(step.GroupBy, data)
+		case step.Chunk != nil:
+			data, err = processChunk(step.Chunk, data)
 		}
 		if err != nil {
 			return nil, fmt.Errorf("error processing data step %d: %w", i, err)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
@@ -17,6 +18,22 @@ func (t testDataSourceProcessor) ProcessArtifactPaths(*v1alpha1.ArtifactPaths) (
 	return []interface{}{"foo.py", "bar.pdf", "goo/foo.py", "moo/bar.pdf"}, nil
 }
 
+func (t testDataSourceProcessor) ProcessConfigMapKeyRef(*apiv1.ConfigMapKeySelector) (string, error) {
+	return `{"foo": ["bar", "baz"]}`, nil
+}
+
+func (t testDataSourceProcessor) ProcessSecretKeyRef(*apiv1.SecretKeySelector) (string, error) {
+	return "my-secret", nil
+}
+
+func (t testDataSourceProcessor) ProcessHTTP(*v1alpha1.HTTP) (string, error) {
+	return "- foo\n- bar\n", nil
+}
+
+func (t testDataSourceProcessor) ProcessResources(*v1alpha1.ResourcesSource) (interface{}, error) {
+	return []interface{}{map[string]interface{}{"kind": "Pod"}}, nil
+}
+
 type nullTestDataSourceProcessor struct{}
 
 var _ v1alpha1.DataSourceProcessor = &nullTestDataSourceProcessor{}
@@ -25,6 +42,22 @@ func (t nullTestDataSourceProcessor) ProcessArtifactPaths(*v1alpha1.ArtifactPath
 	return nil, fmt.Errorf("cannot get artifacts")
 }
 
+func (t nullTestDataSourceProcessor) ProcessConfigMapKeyRef(*apiv1.ConfigMapKeySelector) (string, error) {
+	return "", fmt.Errorf("cannot get config map")
+}
+
+func (t nullTestDataSourceProcessor) ProcessSecretKeyRef(*apiv1.SecretKeySelector) (string, error) {
+	return "", fmt.Errorf("cannot get secret")
+}
+
+func (t nullTestDataSourceProcessor) ProcessHTTP(*v1alpha1.HTTP) (string, error) {
+	return "", fmt.Errorf("cannot get http")
+}
+
+func (t nullTestDataSourceProcessor) ProcessResources(*v1alpha1.ResourcesSource) (interface{}, error) {
+	return nil, fmt.Errorf("cannot get resources")
+}
+
 func TestProcessSource(t *testing.T) {
 	artifactPathsSource := v1alpha1.DataSource{ArtifactPaths: &v1alpha1.ArtifactPaths{}}
 	data, err := processSource(artifactPathsSource, &testDataSourceProcessor{})
@@ -39,6 +72,60 @@ func TestProcessSource(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestProcessSourceKinds(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		source v1alpha1.DataSource
+		want   interface{}
+	}{
+		{"ConfigMapKeyRef", v1alpha1.DataSource{ConfigMapKeyRef: &apiv1.ConfigMapKeySelector{}}, map[string]interface{}{"foo": []interface{}{"bar", "baz"}}},
+		{"SecretKeyRef", v1alpha1.DataSource{SecretKeyRef: &apiv1.SecretKeySelector{}}, "my-secret"},
+		{"HTTP", v1alpha1.DataSource{HTTP: &v1alpha1.HTTP{}}, []interface{}{"foo", "bar"}},
+		{"Resources", v1alpha1.DataSource{Resources: &v1alpha1.ResourcesSource{}}, []interface{}{map[string]interface{}{"kind": "Pod"}}},
+		{"Parameters", v1alpha1.DataSource{Parameters: []v1alpha1.Parameter{
+			{Name: "files", Value: v1alpha1.AnyStringPtr(`["a.txt", "b.txt"]`)},
+			{Name: "message", Value: v1alpha1.AnyStringPtr("hello world")},
+		}}, map[string]interface{}{"files": []interface{}{"a.txt", "b.txt"}, "message": "hello world"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := processSource(tt.source, &testDataSourceProcessor{})
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, data)
+			}
+			if tt.source.Parameters == nil {
+				_, err = processSource(tt.source, &nullTestDataSourceProcessor{})
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	_, err := processSource(v1alpha1.DataSource{Parameters: []v1alpha1.Parameter{{Name: "empty"}}}, &testDataSourceProcessor{})
+	assert.EqualError(t, err, "unable to source parameters: parameter 'empty' has no value")
+}
+
+func TestParseValue(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		value string
+		want  interface{}
+	}{
+		{"JSON", `{"foo": [1, true, null]}`, map[string]interface{}{"foo": []interface{}{float64(1), true, nil}}},
+		{"YAML", "foo:\n- 1.5\n- false\n- ~\n", map[string]interface{}{"foo": []interface{}{1.5, false, nil}}},
+		{"YAML11Booleans", "switch: on\nanswer: yes\n", map[string]interface{}{"switch": "on", "answer": "yes"}},
+		{"LeadingZeros", "mode: 0755\nzip: 01234\n", map[string]interface{}{"mode": "0755", "zip": "01234"}},
+		{"QuotedNumber", `id: "1"`, map[string]interface{}{"id": "1"}},
+		{"Anchors", "a: &x [1]\nb: *x\n", map[string]interface{}{"a": []interface{}{float64(1)}, "b": []interface{}{float64(1)}}},
+		{"String", "hello world", "hello world"},
+		{"CSV", "name,team\nbob,b\n", "name,team\nbob,b\n"},
+		{"Invalid", "foo: [", "foo: ["},
+		{"Empty", "", ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseValue(tt.value))
+		})
+	}
+}
+
 func TestProcessTransformation(t *testing.T) {
 	files := []interface{}{"foo.py", "bar.pdf", "goo/foo.py", "moo/bar.pdf"}
 
@@ -101,3 +188,74 @@ func TestProcessTransformation(t *testing.T) {
 	_, err = processTransformation(files, filterFiles)
 	assert.Error(t, err)
 }
+
+func TestProcessTransformationSteps(t *testing.T) {
+	people := []interface{}{
+		map[string]interface{}{"name": "bob", "team": "b", "age": 30},
+		map[string]interface{}{"name": "alice", "team": "a", "age": 40},
+		map[string]interface{}{"name": "carol", "team": "b", "age": 20},
+	}
+	for _, tt := range []struct {
+		name           string
+		data           interface{}
+		transformation v1alpha1.Transformation
+		want           interface{}
+	}{
+		{"JQ", people, v1alpha1.Transformation{{JQ: `.[] | select(.age > 25) | .name`}}, []interface{}{"bob", "alice"}},
+		{"JQSingleResult", people, v1alpha1.Transformation{{JQ: `length`}}, []interface{}{float64(3)}},
+		{"JQNoResults", people, v1alpha1.Transformation{{JQ: `.[] | select(.age > 50)`}}, []interface{}{}},
+		{"JSONPath", people, v1alpha1.Transformation{{JSONPath: `{[*].name}`}}, []interface{}{"bob", "alice", "carol"}},
+		{"JSONPathWithoutBraces", people, v1alpha1.Transformation{{JSONPath: `[0].team`}}, []interface{}{"b"}},
+		{"ParseJSON", `{"foo": 1}`, v1alpha1.Transformation{{Parse: &v1alpha1.ParseTransformation{Format: v1alpha1.ParseFormatJSON}}}, map[string]interface{}{"foo": float64(1)}},
+		{"ParseYAML", "- foo\n- bar\n", v1alpha1.Transformation{{Parse: &v1alpha1.ParseTransformation{Format: v1alpha1.ParseFormatYAML}}}, []interface{}{"foo", "bar"}},
+		{"ParseYAML12", "- on\n- 0755\n- 10\n", v1alpha1.Transformation{{Parse: &v1alpha1.ParseTransformation{Format: v1alpha1.ParseFormatYAML}}}, []interface{}{"on", "0755", float64(10)}},
+		{"ParseCSV", "name,team\nbob,b\nalice,a\n", v1alpha1.Transformation{{Parse: &v1alpha1.ParseTransformation{Format: v1alpha1.ParseFormatCSV}}}, []interface{}{
+			map[string]interface{}{"name": "bob", "team": "b"},
+			map[string]interface{}{"name": "alice", "team": "a"},
+		}},
+		{"Sort", []interface{}{"b", "c", "a"}, v1alpha1.Transformation{{Sort: &v1alpha1.SortTransformation{}}}, []interface{}{"a", "b", "c"}},
+		{"SortByKeyDescending", people, v1alpha1.Transformation{{Sort: &v1alpha1.SortTransformation{Key: "item.age", Descending: true}}, {Expression: `map(data, {#.name})`}}, []interface{}{"alice", "bob", "carol"}},
+		{"Unique", []interface{}{"a", "b", "a", "c", "b"}, v1alpha1.Transformation{{Unique: &v1alpha1.UniqueTransformation{}}}, []interface{}{"a", "b", "c"}},
+		{"UniqueByKey", people, v1alpha1.Transformation{{Unique: &v1alpha1.UniqueTransformation{Key: "item.team"}}, {Expression: `map(data, {#.name})`}}, []interface{}{"bob", "alice"}},
+		{"GroupBy", people, v1alpha1.Transformation{{GroupBy: &v1alpha1.GroupByTransformation{Key: "item.team"}}}, []interface{}{
+			map[string]interface{}{"key": "b", "items": []interface{}{people[0], people[2]}},
+			map[string]interface{}{"key": "a", "items": []interface{}{people[1]}},
+		}},
+		{"Chunk", []interface{}{1, 2, 3, 4, 5}, v1alpha1.Transformation{{Chunk: &v1alpha1.ChunkTransformation{ChunkSize: 2}}}, []interface{}{
+			[]interface{}{1, 2}, []interface{}{3, 4}, []interface{}{5},
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := processTransformation(tt.data, &tt.transformation)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, data)
+			}
+		})
+	}
+}
+
+func TestProcessTransformationStepErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		data           interface{}
+		transformation v1alpha1.Transformation
+		wantErr        string
+	}{
+		{"ParseNotString", 1, v1alpha1.Transformation{{Parse: &v1alpha1.ParseTransformation{Format: v1alpha1.ParseFormatJSON}}}, "error processing data step 0: parse expects a string, got int"},
+		{"UnknownParseFormat", "foo", v1alpha1.Transformation{{Parse: &v1alpha1.ParseTransformation{Format: "xml"}}}, "error processing data step 0: unknown parse format 'xml'"},
+		{"SortNotList", "foo", v1alpha1.Transformation{{Expression: "data"}, {Sort: &v1alpha1.SortTransformation{}}}, "error processing data step 1: expected a list, got string"},
+		{"SortMixedTypes", []interface{}{"a", 1}, v1alpha1.Transformation{{Sort: &v1alpha1.SortTransformation{}}}, "error processing data step 0: cannot compare int and string"},
+		{"GroupByWithoutKey", []interface{}{"a"}, v1alpha1.Transformation{{GroupBy: &v1alpha1.GroupByTransformation{}}}, "error processing data step 0: groupBy requires a key"},
+		{"ChunkSize", []interface{}{"a"}, v1alpha1.Transformation{{Expression: "data"}, {Expression: "data"}, {Chunk: &v1alpha1.ChunkTransformation{}}}, "error processing data step 2: chunk size must be greater than zero, got 0"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := processTransformation(tt.data, &tt.transformation)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := processTransformation([]interface{}{}, &v1alpha1.Transformation{{JQ: `.[`}})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "error processing data step 0: jq failed")
+	}
+}
@@ -0,0 +1,290 @@
+package data
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/antonmedv/expr"
+	"k8s.io/client-go/util/jsonpath"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// processJQ applies a jq filter using the jq binary, which is installed in the executor image. The results are always
+// returned as a list, however many the filter produces.
+func processJQ(filter string, data interface{}) (interface{}, error) {
+	input, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command("jq", "-c", "["+filter+"]")
+	cmd.Stdin = bytes.NewReader(input)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("jq failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	results := make([]interface{}, 0)
+	if err := json.Unmarshal(output, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// processJSONPath applies a JSONPath template. Templates without braces (e.g. ".items[0]") are wrapped in them. Like
+// jq filters, the results are always returned as a list.
+func processJSONPath(template string, data interface{}) (interface{}, error) {
+	if !strings.Contains(template, "{") {
+		template = "{" + template + "}"
+	}
+	j := jsonpath.New("data")
+	if err := j.Parse(template); err != nil {
+		return nil, err
+	}
+	data, err := normalize(data)
+	if err != nil {
+		return nil, err
+	}
+	values, err := j.FindResults(data)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]interface{}, 0)
+	for _, v := range values {
+		for _, r := range v {
+			results = append(results, r.Interface())
+		}
+	}
+	return results, nil
+}
+
+func processParse(parse *wfv1.ParseTransformation, data interface{}) (interface{}, error) {
+	value, ok := data.(string)
+	if !ok {
+		return nil, fmt.Errorf("parse expects a string, got %T", data)
+	}
+	var parsed interface{}
+	switch parse.Format {
+	case wfv1.ParseFormatJSON:
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			return nil, err
+		}
+	case wfv1.ParseFormatYAML:
+		var err error
+		if parsed, err = parseYAML(value); err != nil {
+			return nil, err
+		}
+	case wfv1.ParseFormatCSV:
+		records, err := csv.NewReader(strings.NewReader(value)).ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]interface{}, 0)
+		for i := 1; i < len(records); i++ {
+			row := make(map[string]interface{}, len(records[0]))
+			for j, column := range records[0] {
+				row[column] = records[i][j]
+			}
+			rows = append(rows, row)
+		}
+		parsed = rows
+	default:
+		return nil, fmt.Errorf("unknown parse format '%s'", parse.Format)
+	}
+	return parsed, nil
+}
+
+func processSort(s *wfv1.SortTransformation, data interface{}) (interface{}, error) {
+	items, keys, err := itemsAndKeys(s.Key, data)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]int, len(items))
+	for i := range indices {
+		indices[i] = i
+	}
+	var compareErr error
+	sort.SliceStable(indices, func(a, b int) bool {
+		c, err := compare(keys[indices[a]], keys[indices[b]])
+		if err != nil && compareErr == nil {
+			compareErr = err
+		}
+		if s.Descending {
+			return c > 0
+		}
+		return c < 0
+	})
+	if compareErr != nil {
+		return nil, compareErr
+	}
+	sorted := make([]interface{}, len(items))
+	for i, index := range indices {
+		sorted[i] = items[index]
+	}
+	return sorted, nil
+}
+
+func processUnique(u *wfv1.UniqueTransformation, data interface{}) (interface{}, error) {
+	items, keys, err := itemsAndKeys(u.Key, data)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	unique := make([]interface{}, 0)
+	for i, item := range items {
+		id, err := json.Marshal(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		if seen[string(id)] {
+			continue
+		}
+		seen[string(id)] = true
+		unique = append(unique, item)
+	}
+	return unique, nil
+}
+
+func processGroupBy(g *wfv1.GroupByTransformation, data interface{}) (interface{}, error) {
+	if g.Key == "" {
+		return nil, fmt.Errorf("groupBy requires a key")
+	}
+	items, keys, err := itemsAndKeys(g.Key, data)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]interface{}, 0)
+	index := make(map[string]int)
+	for i, item := range items {
+		id, err := json.Marshal(keys[i])
+		if err != nil {
+			return nil, err
+		}
+		j, ok := index[string(id)]
+		if !ok {
+			j = len(groups)
+			index[string(id)] = j
+			groups = append(groups, map[string]interface{}{"key": keys[i], "items": []interface{}{}})
+		}
+		group := groups[j].(map[string]interface{})
+		group["items"] = append(group["items"].([]interface{}), item)
+	}
+	return groups, nil
+}
+
+func processChunk(c *wfv1.ChunkTransformation, data interface{}) (interface{}, error) {
+	if c.ChunkSize <= 0 {
+		return nil, fmt.Errorf("chunk size must be greater than zero, got %d", c.ChunkSize)
+	}
+	items, err := toList(data)
+	if err != nil {
+		return nil, err
+	}
+	size := int(c.ChunkSize)
+	chunks := make([]interface{}, 0)
+	for i := 0; i < len(items); i += size {
+		end := i + size
+		if end > len(items) {
+			end = len(items)
+		}
+		chunks = append(chunks, items[i:end])
+	}
+	return chunks, nil
+}
+
+// itemsAndKeys returns the items of a list, and the result of evaluating the key expression against each of them
+func itemsAndKeys(key string, data interface{}) ([]interface{}, []interface{}, error) {
+	items, err := toList(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]interface{}, len(items))
+	for i, item := range items {
+		if key == "" {
+			keys[i] = item
+			continue
+		}
+		keys[i], err = expr.Eval(key, map[string]interface{}{"item": item})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to evaluate key of item %d: %w", i, err)
+		}
+	}
+	return items, keys, nil
+}
+
+func toList(data interface{}) ([]interface{}, error) {
+	if items, ok := data.([]interface{}); ok {
+		return items, nil
+	}
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("expected a list, got %T", data)
+	}
+	items := make([]interface{}, v.Len())
+	for i := range items {
+		items[i] = v.Index(i).Interface()
+	}
+	return items, nil
+}
+
+// compare compares two numbers, strings or booleans
+func compare(a, b interface{}) (int, error) {
+	if x, ok := toFloat(a); ok {
+		if y, ok := toFloat(b); ok {
+			switch {
+			case x < y:
+				return -1, nil
+			case x > y:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			switch {
+			case x == y:
+				return 0, nil
+			case y:
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %T and %T", a, b)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	r := reflect.ValueOf(v)
+	switch r.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(r.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(r.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return r.Float(), true
+	}
+	return 0, false
+}
+
+// normalize converts data into the types produced by unmarshalling JSON
+func normalize(data interface{}) (interface{}, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	return normalized, json.Unmarshal(b, &normalized)
+}
@@ -306,6 +306,11 @@ var httpClients = map[bool]*http.Client{
 }
 
 func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTemplate *wfv1.HTTP) (*http.Response, error) {
+	return executeHTTPRequest(ctx, ae.ClientSet, ae.Namespace, httpTemplate)
+}
+
+// executeHTTPRequest sends the request described by httpTemplate, resolving any headers sourced from secrets
+func executeHTTPRequest(ctx context.Context, clientSet kubernetes.Interface, namespace string, httpTemplate *wfv1.HTTP) (*http.Response, error) {
 	request, err := http.NewRequest(httpTemplate.Method, httpTemplate.URL, bytes.NewBufferString(httpTemplate.Body))
 	if err != nil {
 		return nil, err
@@ -322,7 +327,7 @@ func (ae *AgentExecutor) executeHTTPTemplateRequest(ctx context.Context, httpTem
 	for _, header := range httpTemplate.Headers {
 		value := header.Value
 		if header.ValueFrom != nil && header.ValueFrom.SecretKeyRef != nil {
-			secret, err := util.GetSecrets(ctx, ae.ClientSet, ae.Namespace, header.ValueFrom.SecretKeyRef.Name, header.ValueFrom.SecretKeyRef.Key)
+			secret, err := util.GetSecrets(ctx, clientSet, namespace, header.ValueFrom.SecretKeyRef.Name, header.ValueFrom.SecretKeyRef.Key)
 			if err != nil {
 				return nil, err
 			}
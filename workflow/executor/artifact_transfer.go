@@ -0,0 +1,38 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// newArtifactTransfer returns the transfer of the artifact at a path that started at a time, logging its throughput
+func newArtifactTransfer(name, path string, start time.Time) wfv1.ArtifactTransfer {
+	t := wfv1.ArtifactTransfer{Name: name, Duration: metav1.Duration{Duration: time.Since(start)}}
+	size, err := pathSize(path)
+	if err != nil {
+		// the artifact was transferred, only its throughput is unknown
+		log.WithError(err).WithField("artifactName", name).Warn("failed to get the size of the artifact")
+	}
+	t.SizeBytes = size
+	log.WithFields(log.Fields{"artifactName": name, "sizeBytes": t.SizeBytes, "duration": t.Duration.Duration, "bytesPerSecond": int64(t.BytesPerSecond())}).
+		Info("Transferred artifact")
+	return t
+}
+
+// pathSize returns the size of a file, or the total size of the files of a directory
+func pathSize(path string) (int64, error) {
+	var size int64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return err
+	})
+	return size, err
+}
@@ -0,0 +1,47 @@
+package executor
+
+import (
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// deduplicate sets the key of a deduplicated artifact to the key of the saved artifact with the same digest, returning
+// true if there is one, so that the file does not need to be uploaded. Otherwise, it sets the key to the
+// content-addressed key the file must be uploaded to.
+func deduplicate(driver artifactcommon.ArtifactDriver, art, driverArt *wfv1.Artifact, fileName string) (bool, error) {
+	if !art.Deduplicate || art.Digest == "" {
+		return false, nil
+	}
+	logger := log.WithFields(log.Fields{"artifactName": art.Name, "digest": art.Digest})
+	if driverArt.S3 == nil && driverArt.GCS == nil && driverArt.OSS == nil && driverArt.Azure == nil {
+		logger.Warn("Artifacts can only be deduplicated in S3, GCS, OSS and Azure, saving it without deduplication")
+		return false, nil
+	}
+	dir := path.Join(common.ContentAddressedKeyPrefix, strings.TrimPrefix(art.Digest, "sha256:"))
+	dirArt := driverArt.DeepCopy()
+	if err := dirArt.SetKey(dir); err != nil {
+		return false, err
+	}
+	key := path.Join(dir, fileName)
+	keys, err := driver.ListObjects(dirArt)
+	found := err == nil && len(keys) > 0
+	if err != nil {
+		// uploading it again is harmless
+		logger.WithError(err).Warn("Failed to list artifacts with the same digest")
+	} else if found {
+		key = keys[0]
+	}
+	for _, a := range []*wfv1.Artifact{art, driverArt} {
+		if err := a.SetKey(key); err != nil {
+			return false, err
+		}
+	}
+	logger.WithFields(log.Fields{"key": key, "found": found}).Info("Deduplicated artifact")
+	return found, nil
+}
@@ -0,0 +1,87 @@
+package executor
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	artifactcommon "github.com/argoproj/argo-workflows/v3/workflow/artifacts/common"
+)
+
+// listingArtifactDriver lists the keys it has with the prefix of the artifact's key
+type listingArtifactDriver struct {
+	artifactcommon.ArtifactDriver
+	keys []string
+	err  error
+}
+
+func (d *listingArtifactDriver) ListObjects(a *wfv1.Artifact) ([]string, error) {
+	prefix, _ := a.GetKey()
+	var keys []string
+	for _, key := range d.keys {
+		if filepath.Dir(key) == prefix {
+			keys = append(keys, key)
+		}
+	}
+	return keys, d.err
+}
+
+func TestDeduplicate(t *testing.T) {
+	const digest = "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385"
+	const dir = "argo-content/sha256/c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385"
+	newArtifacts := func(deduplicate bool) (*wfv1.Artifact, *wfv1.Artifact) {
+		art := &wfv1.Artifact{Name: "my-art", Deduplicate: deduplicate, Digest: digest, ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{Key: "my-wf/my-pod/my-art.tgz"}}}
+		driverArt := art.DeepCopy()
+		driverArt.S3.Bucket = "my-bucket"
+		return art, driverArt
+	}
+	key := func(a *wfv1.Artifact) string {
+		k, _ := a.GetKey()
+		return k
+	}
+	t.Run("NotDeduplicated", func(t *testing.T) {
+		art, driverArt := newArtifacts(false)
+		found, err := deduplicate(&listingArtifactDriver{keys: []string{dir + "/other.tgz"}}, art, driverArt, "my-art.tgz")
+		if assert.NoError(t, err) {
+			assert.False(t, found)
+			assert.Equal(t, "my-wf/my-pod/my-art.tgz", key(art))
+		}
+	})
+	t.Run("New", func(t *testing.T) {
+		art, driverArt := newArtifacts(true)
+		found, err := deduplicate(&listingArtifactDriver{}, art, driverArt, "my-art.tgz")
+		if assert.NoError(t, err) {
+			assert.False(t, found)
+			assert.Equal(t, dir+"/my-art.tgz", key(art))
+			assert.Equal(t, dir+"/my-art.tgz", key(driverArt))
+			assert.Equal(t, "my-bucket", driverArt.S3.Bucket)
+		}
+	})
+	t.Run("Found", func(t *testing.T) {
+		art, driverArt := newArtifacts(true)
+		found, err := deduplicate(&listingArtifactDriver{keys: []string{dir + "/other.tgz"}}, art, driverArt, "my-art.tgz")
+		if assert.NoError(t, err) {
+			assert.True(t, found)
+			assert.Equal(t, dir+"/other.tgz", key(art), "artifacts with the same digest are shared whatever their names")
+		}
+	})
+	t.Run("ListError", func(t *testing.T) {
+		art, driverArt := newArtifacts(true)
+		found, err := deduplicate(&listingArtifactDriver{keys: []string{dir + "/other.tgz"}, err: fmt.Errorf("failed")}, art, driverArt, "my-art.tgz")
+		if assert.NoError(t, err) {
+			assert.False(t, found, "the file is uploaded again")
+			assert.Equal(t, dir+"/my-art.tgz", key(art))
+		}
+	})
+	t.Run("Unsupported", func(t *testing.T) {
+		art := &wfv1.Artifact{Name: "my-art", Deduplicate: true, Digest: digest, ArtifactLocation: wfv1.ArtifactLocation{HDFS: &wfv1.HDFSArtifact{Path: "/my-wf/my-art.tgz"}}}
+		found, err := deduplicate(&listingArtifactDriver{}, art, art.DeepCopy(), "my-art.tgz")
+		if assert.NoError(t, err) {
+			assert.False(t, found)
+			assert.Equal(t, "/my-wf/my-art.tgz", key(art))
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// digestWriter computes the digest and size of what is written to it, so that a file can be digested as it is written
+type digestWriter struct {
+	hash hash.Hash
+	size int64
+}
+
+func newDigestWriter() *digestWriter {
+	return &digestWriter{hash: sha256.New()}
+}
+
+func (w *digestWriter) Write(p []byte) (int, error) {
+	w.size += int64(len(p))
+	return w.hash.Write(p)
+}
+
+// digest returns the digest, as "sha256:<hex>", of what has been written
+func (w *digestWriter) digest() string {
+	return "sha256:" + hex.EncodeToString(w.hash.Sum(nil))
+}
+
+// fileDigest returns the digest, as "sha256:<hex>", and the size of a file
+func fileDigest(filePath string) (string, int64, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = f.Close() }()
+	w := newDigestWriter()
+	if _, err := io.Copy(w, f); err != nil {
+		return "", 0, fmt.Errorf("failed to compute the digest of %s: %w", filePath, err)
+	}
+	return w.digest(), w.size, nil
+}
+
+// setDigest records the digest and size of an artifact file before it is saved. Directories, which are only saved
+// when they are not archived, do not have digests.
+func setDigest(art *wfv1.Artifact, localArtPath string) error {
+	info, err := os.Stat(localArtPath)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	art.Digest, art.SizeBytes, err = fileDigest(localArtPath)
+	return err
+}
+
+// verifyDigest checks that a loaded artifact file has the digest and size it was saved with, if it was saved with one
+func verifyDigest(art *wfv1.Artifact, filePath string) error {
+	if art.Digest == "" {
+		return nil
+	}
+	digest, size, err := fileDigest(filePath)
+	if err != nil {
+		return err
+	}
+	if digest != art.Digest || size != art.SizeBytes {
+		return fmt.Errorf("artifact %s is corrupt: it was saved with digest %s and size %d, but was loaded with digest %s and size %d", art.Name, art.Digest, art.SizeBytes, digest, size)
+	}
+	return nil
+}
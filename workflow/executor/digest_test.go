@@ -0,0 +1,35 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestSetDigest(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "my-art.txt")
+	assert.NoError(t, os.WriteFile(file, []byte("my-data"), 0o600))
+	art := &wfv1.Artifact{}
+	if assert.NoError(t, setDigest(art, file)) {
+		assert.Equal(t, "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385", art.Digest)
+		assert.Equal(t, int64(7), art.SizeBytes)
+	}
+	art = &wfv1.Artifact{}
+	if assert.NoError(t, setDigest(art, t.TempDir())) {
+		assert.Empty(t, art.Digest, "directories do not have digests")
+	}
+}
+
+func TestVerifyDigest(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "my-art.txt")
+	assert.NoError(t, os.WriteFile(file, []byte("my-data"), 0o600))
+	const digest = "sha256:c0b8114a809d94b548e3f098b4b76b1589e8ea6297dc795b1377df2c99055385"
+	assert.NoError(t, verifyDigest(&wfv1.Artifact{Name: "my-art"}, file), "artifacts saved without digests are not verified")
+	assert.NoError(t, verifyDigest(&wfv1.Artifact{Name: "my-art", Digest: digest, SizeBytes: 7}, file))
+	assert.EqualError(t, verifyDigest(&wfv1.Artifact{Name: "my-art", Digest: digest, SizeBytes: 8}, file), "artifact my-art is corrupt: it was saved with digest "+digest+" and size 8, but was loaded with digest "+digest+" and size 7")
+	assert.Error(t, verifyDigest(&wfv1.Artifact{Name: "my-art", Digest: "sha256:0000", SizeBytes: 7}, file))
+}
@@ -38,7 +38,7 @@ In the main container, the emissary creates these files:
 If the container is named `main` it also copies base-layer artifacts to the shared volume:
 
 * `/var/run/argo/outputs/parameters/${path}` All output parameters are copied here, e.g. `/tmp/message` is moved to `/var/run/argo/outputs/parameters/tmp/message`.
-* `/var/run/argo/outputs/artifacts/${path}.tgz` All output artifacts are copied here, e.g. `/tmp/message` is moved to /var/run/argo/outputs/artifacts/tmp/message.tgz`.
+* `/var/run/argo/outputs/artifacts/${path}.tgz` All output artifacts are copied here, e.g. `/tmp/message` is moved to /var/run/argo/outputs/artifacts/tmp/message.tgz`. Despite the extension, the tarball is compressed as the artifact's archive strategy specifies.
 
 The wait container can create one file itself, used for terminating the sub-process:
 
@@ -77,8 +77,7 @@ func (e emissary) GetFileContents(_ string, sourcePath string) (string, error) {
 
 func (e emissary) CopyFile(_ string, sourcePath string, destPath string, _ int) error {
 	// this implementation is very different, because we expect the emissary binary has already compressed the file
-	// so no compression can or needs to be implemented here
-	// TODO - warn the user we ignored compression?
+	// as the artifact's archive strategy specifies, so no compression can or needs to be implemented here
 	sourceFile := filepath.Join(common.VarRunArgoPath, "outputs", "artifacts", strings.TrimSuffix(sourcePath, "/")+".tgz")
 	log.Infof("%s -> %s", sourceFile, destPath)
 	src, err := os.Open(filepath.Clean(sourceFile))
@@ -4,7 +4,6 @@ import (
 	"archive/tar"
 	"archive/zip"
 	"bufio"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -22,8 +21,6 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/argoproj/argo-workflows/v3/util/file"
-
 	argofile "github.com/argoproj/pkg/file"
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
@@ -79,6 +76,8 @@ type WorkflowExecutor struct {
 
 	// current progress which is synced every `annotationPatchTickDuration` to the pods annotations.
 	progress wfv1.Progress
+	// output artifacts that were uploaded, reported with the outputs
+	artifactSaves []wfv1.ArtifactTransfer
 
 	annotationPatchTickDuration  time.Duration
 	readProgressFileTickDuration time.Duration
@@ -160,6 +159,8 @@ func (we *WorkflowExecutor) HandleError(ctx context.Context) {
 // LoadArtifacts loads artifacts from location to a container path
 func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 	log.Infof("Start loading input artifacts...")
+	var cacheStatus *wfv1.SharedArtifactCacheStatus
+	var loads []wfv1.ArtifactTransfer
 	for _, art := range we.Template.Inputs.Artifacts {
 
 		log.Infof("Downloading artifact: %s", art.Name)
@@ -203,13 +204,31 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 		// the file is a tarball or not. If it is, it is first extracted then renamed to
 		// the desired location. If not, it is simply renamed to the location.
 		tempArtPath := artPath + ".tmp"
-		err = artDriver.Load(driverArt, tempArtPath)
-		if err != nil {
-			if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
-				log.Infof("Skipping optional input artifact that was not found: %s", art.Name)
-				continue
+		_, cacheable := we.sharedArtifactCachePath(driverArt)
+		if cacheable && cacheStatus == nil {
+			cacheStatus = &wfv1.SharedArtifactCacheStatus{}
+		}
+		if cacheable && we.loadCachedArtifact(driverArt, tempArtPath) {
+			cacheStatus.Hits = append(cacheStatus.Hits, art.Name)
+		} else {
+			start := time.Now()
+			err = artDriver.Load(driverArt, tempArtPath)
+			if err != nil {
+				if art.Optional && argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+					log.Infof("Skipping optional input artifact that was not found: %s", art.Name)
+					continue
+				}
+				return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
+			}
+			loads = append(loads, newArtifactTransfer(art.Name, tempArtPath, start))
+			if err := verifyDigest(&art, tempArtPath); err != nil {
+				return err
+			}
+			if cacheable {
+				cacheStatus.Misses = append(cacheStatus.Misses, art.Name)
+				// later steps on this node can read it from the cache
+				we.cacheArtifact(driverArt, tempArtPath)
 			}
-			return fmt.Errorf("artifact %s failed to load: %w", art.Name, err)
 		}
 
 		isTar := false
@@ -218,8 +237,8 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			// explicitly not a tar
 			isTar = false
 			isZip = false
-		} else if art.GetArchive().Tar != nil {
-			// explicitly a tar
+		} else if art.GetArchive().Tar != nil || art.GetArchive().Zstd != nil {
+			// explicitly a tar, its compression is detected when untarring it
 			isTar = true
 		} else if art.GetArchive().Zip != nil {
 			// explicitly a zip
@@ -254,6 +273,16 @@ func (we *WorkflowExecutor) LoadArtifacts(ctx context.Context) error {
 			}
 		}
 	}
+	if cacheStatus != nil || len(loads) > 0 {
+		var transfers *wfv1.ArtifactTransfers
+		if len(loads) > 0 {
+			transfers = &wfv1.ArtifactTransfers{Loads: loads}
+		}
+		// the status is informational, so failing to report it does not fail the step
+		if err := we.reportResult(ctx, wfv1.NodeResult{SharedArtifactCache: cacheStatus, ArtifactTransfers: transfers}); err != nil {
+			log.WithError(err).Warn("failed to report the shared artifact cache status and artifact transfers")
+		}
+	}
 	return nil
 }
 
@@ -321,6 +350,12 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, containerName stri
 
 // fileBase is probably path.Base(filePath), but can be something else
 func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.Artifact, fileName, localArtPath string) error {
+	// staged artifacts are digested when they are staged
+	if art.Digest == "" {
+		if err := setDigest(art, localArtPath); err != nil {
+			return err
+		}
+	}
 	if !art.HasKey() {
 		key, err := we.Template.ArchiveLocation.GetKey()
 		if err != nil {
@@ -345,10 +380,21 @@ func (we *WorkflowExecutor) saveArtifactFromFile(ctx context.Context, art *wfv1.
 	if err != nil {
 		return err
 	}
-	err = artDriver.Save(localArtPath, driverArt)
+	found, err := deduplicate(artDriver, art, driverArt, fileName)
 	if err != nil {
 		return err
 	}
+	if !found {
+		start := time.Now()
+		err = artDriver.Save(localArtPath, driverArt)
+		if err != nil {
+			return err
+		}
+		we.artifactSaves = append(we.artifactSaves, newArtifactTransfer(art.Name, localArtPath, start))
+	}
+	if art.Name != wfv1.MainLogsArtifactName {
+		we.cacheArtifact(driverArt, localArtPath)
+	}
 	we.maybeDeleteLocalArtPath(localArtPath)
 	log.Infof("Successfully saved file: %s", localArtPath)
 	return nil
@@ -372,7 +418,7 @@ func (we *WorkflowExecutor) maybeDeleteLocalArtPath(localArtPath string) {
 // Returns a filename and a local path for the upload.
 // The filename is incorporated into the final path when uploading it to the artifact repo.
 // The local path is the final staging location of the file (or directory) which we will pass
-// to the SaveArtifacts call and may be a directory or file.
+// to the SaveArtifacts call and may be a directory or file. The digest of a file is recorded in the artifact.
 func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Artifact) (string, string, error) {
 	log.Infof("Staging artifact: %s", art.Name)
 	strategy := art.Archive
@@ -382,14 +428,7 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 			Tar: &wfv1.TarStrategy{},
 		}
 	}
-	compressionLevel := gzip.NoCompression
-	if strategy.Tar != nil {
-		if l := strategy.Tar.CompressionLevel; l != nil {
-			compressionLevel = int(*l)
-		} else {
-			compressionLevel = gzip.DefaultCompression
-		}
-	}
+	compression, compressionLevel := archive.StrategyCompression(strategy)
 
 	if !we.isBaseImagePath(art.Path) {
 		// If we get here, we are uploading an artifact from a mirrored volume mount which the wait
@@ -403,24 +442,31 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 			if !argofile.Exists(mountedArtPath) {
 				return "", "", argoerrs.Errorf(argoerrs.CodeNotFound, "%s no such file or directory", art.Path)
 			}
+			if err := setDigest(art, mountedArtPath); err != nil {
+				return "", "", err
+			}
 			return fileName, mountedArtPath, nil
 		}
-		fileName := fmt.Sprintf("%s.tgz", art.Name)
+		fileName := art.Name + compression.Extension()
 		localArtPath := filepath.Join(tempOutArtDir, fileName)
 		f, err := os.Create(localArtPath)
 		if err != nil {
 			return "", "", argoerrs.InternalWrapError(err)
 		}
-		w := bufio.NewWriter(f)
-		err = archive.TarGzToWriter(mountedArtPath, compressionLevel, w)
+		defer func() { _ = f.Close() }()
+		// the archive is digested as it is written, rather than read again
+		digest := newDigestWriter()
+		w := bufio.NewWriter(io.MultiWriter(f, digest))
+		err = archive.TarToWriter(mountedArtPath, compression, compressionLevel, w)
 		if err != nil {
 			return "", "", err
 		}
+		art.Digest, art.SizeBytes = digest.digest(), digest.size
 		log.Infof("Successfully staged %s from mirrored volume mount %s", art.Path, mountedArtPath)
 		return fileName, localArtPath, nil
 	}
 
-	fileName := fmt.Sprintf("%s.tgz", art.Name)
+	fileName := art.Name + compression.Extension()
 	localArtPath := filepath.Join(tempOutArtDir, fileName)
 	log.Infof("Copying %s from container base image layer to %s", art.Path, localArtPath)
 
@@ -428,11 +474,14 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 	if err != nil {
 		return "", "", err
 	}
-	if strategy.Tar != nil {
-		// NOTE we already tar gzip the file in the executor. So this is a noop.
+	if strategy.Tar != nil || strategy.Zstd != nil {
+		// NOTE we already tar and compress the file in the executor. So this is a noop.
+		if err := setDigest(art, localArtPath); err != nil {
+			return "", "", err
+		}
 		return fileName, localArtPath, nil
 	}
-	// localArtPath now points to a .tgz file, and the archive strategy is *not* tar. We need to untar it
+	// localArtPath now points to a tarball, and the archive strategy is *not* tar. We need to untar it
 	log.Infof("Untaring %s archive before upload", localArtPath)
 	unarchivedArtPath := path.Join(filepath.Dir(localArtPath), art.Name)
 	err = untar(localArtPath, unarchivedArtPath)
@@ -460,9 +509,10 @@ func (we *WorkflowExecutor) stageArchiveFile(containerName string, art *wfv1.Art
 		if err != nil {
 			return "", "", argoerrs.InternalWrapError(err)
 		}
+		if err := setDigest(art, localArtPath); err != nil {
+			return "", "", err
+		}
 	}
-	// In the future, if we were to support other compression formats (e.g. bzip2) or options
-	// the logic would go here, and compression would be moved out of the executors
 	return fileName, localArtPath, nil
 }
 
@@ -721,11 +771,15 @@ func (we *WorkflowExecutor) ReportOutputs(ctx context.Context, logArt *wfv1.Arti
 	if logArt != nil {
 		outputs.Artifacts = append(outputs.Artifacts, *logArt)
 	}
-	return we.reportResult(ctx, wfv1.NodeResult{Outputs: outputs})
+	result := wfv1.NodeResult{Outputs: outputs}
+	if len(we.artifactSaves) > 0 {
+		result.ArtifactTransfers = &wfv1.ArtifactTransfers{Saves: we.artifactSaves}
+	}
+	return we.reportResult(ctx, result)
 }
 
 func (we *WorkflowExecutor) reportResult(ctx context.Context, result wfv1.NodeResult) error {
-	if !result.Outputs.HasOutputs() && !result.Progress.IsValid() {
+	if !result.Outputs.HasOutputs() && !result.Progress.IsValid() && result.SharedArtifactCache == nil && result.ArtifactTransfers == nil {
 		return nil
 	}
 	return retryutil.OnError(wait.Backoff{
@@ -794,12 +848,14 @@ func isTarball(filePath string) (bool, error) {
 			log.Fatalf("Error closing file[%s]: %v", filePath, err)
 		}
 	}()
-	gzr, err := gzip.NewReader(f)
+	// uncompressed tarballs are detected by their header, e.g. those archived with `tar: {noCompression: true}` that
+	// are input by key only
+	r, err := archive.NewDecompressor(f)
 	if err != nil {
 		return false, nil
 	}
-	defer gzr.Close()
-	tarr := tar.NewReader(gzr)
+	defer r.Close()
+	tarr := tar.NewReader(r)
 	_, err = tarr.Next()
 	return err == nil, nil
 }
@@ -813,12 +869,12 @@ func untar(tarPath string, destPath string) error {
 			return err
 		}
 		defer f.Close()
-		gzr, err := file.GetGzipReader(f)
+		r, err := archive.NewDecompressor(f)
 		if err != nil {
 			return err
 		}
-		defer gzr.Close()
-		tr := tar.NewReader(gzr)
+		defer r.Close()
+		tr := tar.NewReader(r)
 		for {
 			header, err := tr.Next()
 			switch {
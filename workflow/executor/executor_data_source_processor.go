@@ -2,7 +2,16 @@ package executor
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strings"
 
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-workflows/v3/errors"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 )
 
@@ -36,3 +45,62 @@ func (ep *executorDataSourceProcessor) ProcessArtifactPaths(artifacts *wfv1.Arti
 
 	return files, nil
 }
+
+func (ep *executorDataSourceProcessor) ProcessConfigMapKeyRef(selector *apiv1.ConfigMapKeySelector) (string, error) {
+	return ep.we.GetConfigMapKey(ep.ctx, selector.Name, selector.Key)
+}
+
+func (ep *executorDataSourceProcessor) ProcessSecretKeyRef(selector *apiv1.SecretKeySelector) (string, error) {
+	value, err := ep.we.GetSecrets(ep.ctx, ep.we.Namespace, selector.Name, selector.Key)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func (ep *executorDataSourceProcessor) ProcessHTTP(httpSource *wfv1.HTTP) (string, error) {
+	response, err := executeHTTPRequest(ep.ctx, ep.we.ClientSet, ep.we.Namespace, httpSource)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return "", fmt.Errorf("received non-2xx response code: %d", response.StatusCode)
+	}
+	return string(bodyBytes), nil
+}
+
+func (ep *executorDataSourceProcessor) ProcessResources(resources *wfv1.ResourcesSource) (interface{}, error) {
+	namespace := resources.Namespace
+	if namespace == "" {
+		namespace = ep.we.Namespace
+	}
+	args := []string{"get", resources.Resource, "-n", namespace, "-o", "json"}
+	if resources.LabelSelector != "" {
+		args = append(args, "-l", resources.LabelSelector)
+	}
+	cmd := exec.Command("kubectl", args...)
+	log.Info(strings.Join(cmd.Args, " "))
+	out, err := cmd.Output()
+	if err != nil {
+		if exErr, ok := err.(*exec.ExitError); ok {
+			return nil, errors.New(errors.CodeBadRequest, strings.TrimSpace(string(exErr.Stderr)))
+		}
+		return nil, err
+	}
+	var list struct {
+		Items []interface{} `json:"items"`
+	}
+	if err := json.Unmarshal(out, &list); err != nil {
+		return nil, err
+	}
+	if list.Items == nil {
+		return []interface{}{}, nil
+	}
+	return list.Items, nil
+}
@@ -0,0 +1,74 @@
+package executor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestProcessHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(r.Header.Get("Accept")))
+	}))
+	defer server.Close()
+	ep := newExecutorDataSourceProcessor(context.Background(), &WorkflowExecutor{ClientSet: fake.NewSimpleClientset(), Namespace: fakeNamespace})
+
+	body, err := ep.ProcessHTTP(&wfv1.HTTP{URL: server.URL, Headers: wfv1.HTTPHeaders{{Name: "Accept", Value: "application/json"}}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "application/json", body)
+	}
+
+	_, err = ep.ProcessHTTP(&wfv1.HTTP{URL: server.URL + "/missing"})
+	assert.EqualError(t, err, "received non-2xx response code: 404")
+}
+
+func TestProcessResources(t *testing.T) {
+	dir := t.TempDir()
+	// the fake kubectl lists a single object named after its arguments
+	kubectl := `#!/bin/sh
+case "$2" in
+missing) echo 'error: the server does not have a resource type "missing"' >&2; exit 1 ;;
+none) echo '{"kind": "List"}' ;;
+*) echo "{\"kind\": \"List\", \"items\": [{\"metadata\": {\"name\": \"$*\"}}]}" ;;
+esac
+`
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "kubectl"), []byte(kubectl), 0o755))
+	t.Setenv("PATH", dir)
+	ep := newExecutorDataSourceProcessor(context.Background(), &WorkflowExecutor{Namespace: fakeNamespace})
+
+	for _, tt := range []struct {
+		name      string
+		resources wfv1.ResourcesSource
+		want      string
+	}{
+		{"Namespace", wfv1.ResourcesSource{Resource: "pods"}, "get pods -n " + fakeNamespace + " -o json"},
+		{"LabelSelector", wfv1.ResourcesSource{Resource: "deployments.apps", Namespace: "other", LabelSelector: "app=my-app"}, "get deployments.apps -n other -o json -l app=my-app"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := ep.ProcessResources(&tt.resources)
+			if assert.NoError(t, err) {
+				assert.Equal(t, []interface{}{map[string]interface{}{"metadata": map[string]interface{}{"name": tt.want}}}, items)
+			}
+		})
+	}
+
+	items, err := ep.ProcessResources(&wfv1.ResourcesSource{Resource: "none"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []interface{}{}, items)
+	}
+
+	_, err = ep.ProcessResources(&wfv1.ResourcesSource{Resource: "missing"})
+	assert.EqualError(t, err, `error: the server does not have a resource type "missing"`)
+}
@@ -5,16 +5,19 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	argofake "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-workflows/v3/util/archive"
 	"github.com/argoproj/argo-workflows/v3/workflow/common"
 	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
 )
@@ -235,7 +238,7 @@ func TestIsTarball(t *testing.T) {
 	}{
 		{"testdata/file", false, false},
 		{"testdata/file.zip", false, false},
-		{"testdata/file.tar", false, false},
+		{"testdata/file.tar", true, false},
 		{"testdata/file.gz", false, false},
 		{"testdata/file.tar.gz", true, false},
 		{"testdata/file.tgz", true, false},
@@ -289,6 +292,29 @@ func TestUntar(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUntarCompressions(t *testing.T) {
+	for _, compression := range []archive.Compression{archive.Zstd, archive.None} {
+		t.Run(string(compression), func(t *testing.T) {
+			dir := t.TempDir()
+			tarPath := filepath.Join(dir, "file"+compression.Extension())
+			f, err := os.Create(tarPath)
+			require.NoError(t, err)
+			require.NoError(t, archive.TarToWriter("testdata/file", compression, archive.DefaultZstdCompression, f))
+			require.NoError(t, f.Close())
+
+			ok, err := isTarball(tarPath)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			destPath := filepath.Join(dir, "untarredFile")
+			require.NoError(t, untar(tarPath, destPath))
+			fileInfo, err := os.Stat(destPath)
+			require.NoError(t, err)
+			assert.True(t, fileInfo.Mode().IsRegular())
+		})
+	}
+}
+
 func TestChmod(t *testing.T) {
 	type perm struct {
 		dir  string
@@ -0,0 +1,139 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+)
+
+const (
+	// how often the logs of the containers are read
+	logSinkReadInterval = time.Second
+	// the maximum number of entries kept while the sink cannot be written to, the oldest are dropped
+	maxUnwrittenLogEntries = 10000
+)
+
+// logTail reads the lines written to the combined output of a container since it was last read
+type logTail struct {
+	containerName string
+	stream        io.ReadCloser
+	partial       []byte
+}
+
+// logClock times log entries, each strictly after the last, so the order of the lines is kept and sinks that page by
+// time, e.g. Loki, do not skip lines read at the same time
+type logClock struct {
+	last time.Time
+}
+
+func (c *logClock) next(now time.Time) time.Time {
+	if !now.After(c.last) {
+		now = c.last.Add(time.Nanosecond)
+	}
+	c.last = now
+	return now
+}
+
+// StreamLogs tails the combined output of the main containers while they run, writing the lines to the sink every
+// flush interval. The lines are timed when they are read, each after the last. It returns a function that stops
+// tailing, after writing the remaining lines.
+func (we *WorkflowExecutor) StreamLogs(ctx context.Context, logSink sink.Sink, flushInterval time.Duration) (stop func()) {
+	var tails []*logTail
+	for _, containerName := range we.Template.GetMainContainerNames() {
+		tails = append(tails, &logTail{containerName: containerName})
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		readTicker := time.NewTicker(logSinkReadInterval)
+		defer readTicker.Stop()
+		flushTicker := time.NewTicker(flushInterval)
+		defer flushTicker.Stop()
+		var entries []sink.Entry
+		clock := &logClock{}
+		write := func() {
+			if len(entries) == 0 {
+				return
+			}
+			if err := logSink.Write(ctx, entries); err != nil {
+				log.WithError(err).Warn("failed to write logs to the log sink")
+				if n := len(entries) - maxUnwrittenLogEntries; n > 0 {
+					log.WithField("entries", n).Warn("dropping the oldest unwritten log entries")
+					entries = entries[n:]
+				}
+				return
+			}
+			entries = nil
+		}
+		for {
+			select {
+			case <-done:
+				entries = append(entries, we.readLogTails(ctx, clock, tails, true)...)
+				write()
+				for _, t := range tails {
+					if t.stream != nil {
+						_ = t.stream.Close()
+					}
+				}
+				return
+			case <-readTicker.C:
+				entries = append(entries, we.readLogTails(ctx, clock, tails, false)...)
+			case <-flushTicker.C:
+				write()
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// readLogTails returns the complete lines written since the last read, and if final, any incomplete last line
+func (we *WorkflowExecutor) readLogTails(ctx context.Context, clock *logClock, tails []*logTail, final bool) []sink.Entry {
+	var entries []sink.Entry
+	now := time.Now()
+	for _, t := range tails {
+		if t.stream == nil {
+			stream, err := we.RuntimeExecutor.GetOutputStream(ctx, t.containerName, true)
+			if err != nil {
+				// the output is created when the container starts
+				if !errors.Is(err, fs.ErrNotExist) {
+					log.WithError(err).WithField("containerName", t.containerName).Warn("failed to open container output")
+				}
+				continue
+			}
+			t.stream = stream
+		}
+		data, err := io.ReadAll(t.stream)
+		if err != nil {
+			log.WithError(err).WithField("containerName", t.containerName).Warn("failed to read container output")
+		}
+		t.partial = append(t.partial, data...)
+		for {
+			i := bytes.IndexByte(t.partial, '\n')
+			if i < 0 {
+				break
+			}
+			entries = append(entries, we.newLogEntry(clock.next(now), t.containerName, string(t.partial[:i])))
+			t.partial = t.partial[i+1:]
+		}
+		if final && len(t.partial) > 0 {
+			entries = append(entries, we.newLogEntry(clock.next(now), t.containerName, string(t.partial)))
+			t.partial = nil
+		}
+	}
+	return entries
+}
+
+func (we *WorkflowExecutor) newLogEntry(t time.Time, containerName, content string) sink.Entry {
+	return sink.Entry{Time: t, Namespace: we.Namespace, Workflow: we.workflow, PodName: we.PodName, Container: containerName, Content: content}
+}
@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/logs/sink"
+	"github.com/argoproj/argo-workflows/v3/workflow/executor/mocks"
+)
+
+// memorySink keeps the entries written to it in memory
+type memorySink struct {
+	entries []sink.Entry
+}
+
+func (s *memorySink) Write(_ context.Context, entries []sink.Entry) error {
+	s.entries = append(s.entries, entries...)
+	return nil
+}
+
+func (s *memorySink) Read(context.Context, sink.Query) ([]sink.Entry, error) {
+	return s.entries, nil
+}
+
+func TestStreamLogs(t *testing.T) {
+	combined := filepath.Join(t.TempDir(), "combined")
+	assert.NoError(t, os.WriteFile(combined, []byte("one\ntwo\nthree without a newline"), 0o600))
+	stream, err := os.Open(combined)
+	if !assert.NoError(t, err) {
+		return
+	}
+	mockRuntimeExecutor := mocks.ContainerRuntimeExecutor{}
+	mockRuntimeExecutor.On("GetOutputStream", mock.Anything, "main", true).Return(stream, nil)
+	mockRuntimeExecutor.On("GetOutputStream", mock.Anything, "sidecar", true).Return(nil, fmt.Errorf("open combined: %w", fs.ErrNotExist))
+	we := WorkflowExecutor{
+		PodName:         fakePodName,
+		workflow:        fakeWorkflow,
+		Namespace:       fakeNamespace,
+		RuntimeExecutor: &mockRuntimeExecutor,
+		Template: wfv1.Template{ContainerSet: &wfv1.ContainerSetTemplate{Containers: []wfv1.ContainerNode{
+			{Container: corev1.Container{Name: "main"}},
+			{Container: corev1.Container{Name: "sidecar"}},
+		}}},
+	}
+	logSink := &memorySink{}
+	stop := we.StreamLogs(context.Background(), logSink, time.Hour)
+	stop()
+	if assert.Len(t, logSink.entries, 3) {
+		assert.Equal(t, []string{"one", "two", "three without a newline"}, []string{logSink.entries[0].Content, logSink.entries[1].Content, logSink.entries[2].Content})
+		e := logSink.entries[0]
+		assert.Equal(t, sink.Entry{Time: e.Time, Namespace: fakeNamespace, Workflow: fakeWorkflow, PodName: fakePodName, Container: "main", Content: "one"}, e)
+		assert.True(t, logSink.entries[1].Time.After(e.Time), "lines read at the same time are timed in order")
+		assert.True(t, logSink.entries[2].Time.After(logSink.entries[1].Time))
+	}
+}
@@ -0,0 +1,138 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+// sharedArtifactCachePath returns the path of an artifact in the shared artifact cache, or false if there is no cache
+// or the artifact does not have a key and digest. Artifacts are keyed by their namespace, repository and key, so that
+// pods cannot read the artifacts of other namespaces by using the same key, and artifacts of different repositories
+// with the same key are not mixed up. Only artifacts with digests are cached, so that an entry can be verified.
+func (we *WorkflowExecutor) sharedArtifactCachePath(art *wfv1.Artifact) (string, bool) {
+	dir := os.Getenv(common.EnvVarSharedArtifactCache)
+	if dir == "" || art.Digest == "" {
+		return "", false
+	}
+	key, err := art.GetKey()
+	if err != nil || key == "" {
+		return "", false
+	}
+	repository, ok := artifactRepositoryID(art)
+	if !ok {
+		return "", false
+	}
+	repositoryDir := filepath.Join(dir, we.Namespace, repository)
+	cachePath := filepath.Join(repositoryDir, key)
+	if !strings.HasPrefix(cachePath, repositoryDir+string(filepath.Separator)) {
+		return "", false
+	}
+	return cachePath, true
+}
+
+// artifactRepositoryID returns a hash of the type and location of the repository an artifact is stored in, e.g. the
+// endpoint and bucket of an S3 artifact, or false if the repository is not known
+func artifactRepositoryID(art *wfv1.Artifact) (string, bool) {
+	var location string
+	switch {
+	case art.S3 != nil:
+		location = "s3/" + art.S3.Endpoint + "/" + art.S3.Bucket
+	case art.GCS != nil:
+		location = "gcs/" + art.GCS.Bucket
+	case art.OSS != nil:
+		location = "oss/" + art.OSS.Endpoint + "/" + art.OSS.Bucket
+	case art.Azure != nil:
+		location = "azure/" + art.Azure.Endpoint + "/" + art.Azure.Container
+	case art.HDFS != nil:
+		location = "hdfs/" + strings.Join(art.HDFS.Addresses, ",")
+	case art.Artifactory != nil:
+		// the key is the URL, which includes the host
+		location = "artifactory"
+	case art.HTTP != nil:
+		location = "http"
+	default:
+		return "", false
+	}
+	sum := sha256.Sum256([]byte(location))
+	return hex.EncodeToString(sum[:8]), true
+}
+
+// cacheArtifact copies the file of an artifact to the shared artifact cache. Directories are not cached. Failing to
+// cache an artifact does not fail the step, as the artifact can still be downloaded from the repository.
+func (we *WorkflowExecutor) cacheArtifact(art *wfv1.Artifact, localArtPath string) {
+	cachePath, ok := we.sharedArtifactCachePath(art)
+	if !ok {
+		return
+	}
+	if info, err := os.Stat(localArtPath); err != nil || !info.Mode().IsRegular() {
+		return
+	}
+	logger := log.WithFields(log.Fields{"artifactName": art.Name, "cachePath": cachePath})
+	if err := copyFile(localArtPath, cachePath); err != nil {
+		logger.WithError(err).Warn("failed to copy artifact to the shared artifact cache")
+		return
+	}
+	logger.Info("Copied artifact to the shared artifact cache")
+}
+
+// loadCachedArtifact copies an artifact from the shared artifact cache to a path, returning false if it is not in
+// the cache or is corrupt, in which case it must be downloaded from the repository
+func (we *WorkflowExecutor) loadCachedArtifact(art *wfv1.Artifact, path string) bool {
+	cachePath, ok := we.sharedArtifactCachePath(art)
+	if !ok {
+		return false
+	}
+	if info, err := os.Stat(cachePath); err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	logger := log.WithFields(log.Fields{"artifactName": art.Name, "cachePath": cachePath})
+	if err := copyFile(cachePath, path); err != nil {
+		logger.WithError(err).Warn("failed to copy artifact from the shared artifact cache")
+		_ = os.Remove(path)
+		return false
+	}
+	if err := verifyDigest(art, path); err != nil {
+		logger.WithError(err).Warn("artifact in the shared artifact cache is corrupt")
+		_ = os.Remove(path)
+		return false
+	}
+	logger.Info("Loaded artifact from the shared artifact cache")
+	return true
+}
+
+// copyFile copies a file via a temporary file that is renamed, so that readers of dst never see a partial file
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	out, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(out.Name()) }()
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(out.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(out.Name(), dst)
+}
@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/workflow/common"
+)
+
+func TestSharedArtifactCache(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "my-art.tgz")
+	assert.NoError(t, os.WriteFile(src, []byte("my-data"), 0o600))
+	digest, size, err := fileDigest(src)
+	assert.NoError(t, err)
+	bucketArtifact := func(bucket, key string) *wfv1.Artifact {
+		return &wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Bucket: bucket}, Key: key}}, Digest: digest, SizeBytes: size}
+	}
+	s3Artifact := func(key string) *wfv1.Artifact {
+		return bucketArtifact("my-bucket", key)
+	}
+	producer := WorkflowExecutor{Namespace: fakeNamespace}
+
+	t.Run("Disabled", func(t *testing.T) {
+		_, ok := producer.sharedArtifactCachePath(s3Artifact("my-wf/my-pod/my-art.tgz"))
+		assert.False(t, ok)
+	})
+	cacheDir := t.TempDir()
+	t.Setenv(common.EnvVarSharedArtifactCache, cacheDir)
+	t.Run("Path", func(t *testing.T) {
+		cachePath, ok := producer.sharedArtifactCachePath(s3Artifact("my-wf/my-pod/my-art.tgz"))
+		if assert.True(t, ok) {
+			repository, _ := artifactRepositoryID(s3Artifact(""))
+			assert.Equal(t, filepath.Join(cacheDir, fakeNamespace, repository, "my-wf/my-pod/my-art.tgz"), cachePath)
+		}
+		otherBucketPath, _ := producer.sharedArtifactCachePath(bucketArtifact("other-bucket", "my-wf/my-pod/my-art.tgz"))
+		assert.NotEqual(t, cachePath, otherBucketPath, "artifacts of different repositories are kept apart")
+		_, ok = producer.sharedArtifactCachePath(s3Artifact("../other-ns/my-art.tgz"))
+		assert.False(t, ok, "keys cannot escape the namespace's directory")
+		_, ok = producer.sharedArtifactCachePath(&wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{Raw: &wfv1.RawArtifact{Data: "my-data"}}})
+		assert.False(t, ok, "artifacts without keys are not cached")
+		noDigest := s3Artifact("my-wf/my-pod/my-art.tgz")
+		noDigest.Digest = ""
+		_, ok = producer.sharedArtifactCachePath(noDigest)
+		assert.False(t, ok, "artifacts without digests cannot be verified, so are not cached")
+	})
+	t.Run("Miss", func(t *testing.T) {
+		dst := filepath.Join(t.TempDir(), "my-art.tmp")
+		assert.False(t, producer.loadCachedArtifact(s3Artifact("my-wf/my-pod/missing.tgz"), dst))
+		assert.NoFileExists(t, dst)
+	})
+	t.Run("Hit", func(t *testing.T) {
+		producer.cacheArtifact(s3Artifact("my-wf/my-pod/my-art.tgz"), src)
+		dst := filepath.Join(t.TempDir(), "my-art.tmp")
+		consumer := WorkflowExecutor{Namespace: fakeNamespace}
+		if assert.True(t, consumer.loadCachedArtifact(s3Artifact("my-wf/my-pod/my-art.tgz"), dst)) {
+			data, err := os.ReadFile(dst)
+			assert.NoError(t, err)
+			assert.Equal(t, "my-data", string(data))
+		}
+		corrupt := s3Artifact("my-wf/my-pod/my-art.tgz")
+		corrupt.Digest, corrupt.SizeBytes = "sha256:0000", 7
+		assert.False(t, consumer.loadCachedArtifact(corrupt, dst), "corrupt artifacts are downloaded again")
+		assert.NoFileExists(t, dst)
+		otherNamespace := WorkflowExecutor{Namespace: "other-ns"}
+		assert.False(t, otherNamespace.loadCachedArtifact(s3Artifact("my-wf/my-pod/my-art.tgz"), dst), "artifacts are not shared between namespaces")
+		assert.False(t, consumer.loadCachedArtifact(bucketArtifact("other-bucket", "my-wf/my-pod/my-art.tgz"), dst), "artifacts are not shared between repositories")
+	})
+	t.Run("Directory", func(t *testing.T) {
+		producer.cacheArtifact(s3Artifact("my-wf/my-pod/my-dir"), t.TempDir())
+		cachePath, _ := producer.sharedArtifactCachePath(s3Artifact("my-wf/my-pod/my-dir"))
+		assert.NoDirExists(t, cachePath, "directories are not cached")
+	})
+}
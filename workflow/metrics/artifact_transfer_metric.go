@@ -0,0 +1,14 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var ArtifactTransferThroughputMetric = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: argoNamespace,
+		Subsystem: workflowsSubsystem,
+		Name:      "artifact_transfer_throughput_bytes_per_second",
+		Help:      "Throughput of the transfers of input and output artifacts. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_artifact_transfer_throughput_bytes_per_second",
+		Buckets:   prometheus.ExponentialBuckets(1<<20, 2, 12),
+	},
+	[]string{"direction"},
+)
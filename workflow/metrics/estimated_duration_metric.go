@@ -0,0 +1,13 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var EstimatedDurationMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Namespace: argoNamespace,
+		Subsystem: workflowsSubsystem,
+		Name:      "estimated_duration_seconds",
+		Help:      "Percentiles of the durations of previous runs of workflow templates and cron workflows. https://argoproj.github.io/argo-workflows/metrics/#argo_workflows_estimated_duration_seconds",
+	},
+	[]string{"namespace", "kind", "name", "quantile"},
+)
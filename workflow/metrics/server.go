@@ -99,7 +99,9 @@ func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
 	m.logMetric.Describe(ch)
 	K8sRequestTotalMetric.Describe(ch)
 	PodMissingMetric.Describe(ch)
+	EstimatedDurationMetric.Describe(ch)
 	WorkflowConditionMetric.Describe(ch)
+	ArtifactTransferThroughputMetric.Describe(ch)
 }
 
 func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
@@ -109,7 +111,9 @@ func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
 	m.logMetric.Collect(ch)
 	K8sRequestTotalMetric.Collect(ch)
 	PodMissingMetric.Collect(ch)
+	EstimatedDurationMetric.Collect(ch)
 	WorkflowConditionMetric.Collect(ch)
+	ArtifactTransferThroughputMetric.Collect(ch)
 }
 
 func (m *Metrics) garbageCollector(ctx context.Context) {
@@ -3,10 +3,10 @@ package sync
 import "time"
 
 type Semaphore interface {
-	acquire(holderKey string) bool
+	acquire(holderKey string, permits int) bool
 	tryAcquire(holderKey string) (bool, string)
 	release(key string) bool
-	addToQueue(holderKey string, priority int32, creationTime time.Time)
+	addToQueue(holderKey string, priority int32, creationTime time.Time, permits int)
 	removeFromQueue(holderKey string)
 	getCurrentHolders() []string
 	getCurrentPending() []string
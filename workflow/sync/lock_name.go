@@ -12,6 +12,8 @@ type LockKind string
 
 const (
 	LockKindConfigMap LockKind = "ConfigMap"
+	LockKindDatabase  LockKind = "Database"
+	LockKindHTTP      LockKind = "HTTP"
 	LockKindMutex     LockKind = "Mutex"
 )
 
@@ -34,10 +36,23 @@ func NewLockName(namespace, resourceName, lockKey string, kind LockKind) *LockNa
 func GetLockName(sync *v1alpha1.Synchronization, namespace string) (*LockName, error) {
 	switch sync.GetType() {
 	case v1alpha1.SynchronizationTypeSemaphore:
-		if sync.Semaphore.ConfigMapKeyRef != nil {
+		switch {
+		case sync.Semaphore.ConfigMapKeyRef != nil:
 			return NewLockName(namespace, sync.Semaphore.ConfigMapKeyRef.Name, sync.Semaphore.ConfigMapKeyRef.Key, LockKindConfigMap), nil
+		case sync.Semaphore.Database != nil:
+			key := sync.Semaphore.Database.Key
+			if key == "" || strings.Contains(key, "/") {
+				return nil, fmt.Errorf("invalid database semaphore key %q: it must not be empty or contain '/'", key)
+			}
+			return NewLockName(namespace, key, "", LockKindDatabase), nil
+		case sync.Semaphore.HTTP != nil:
+			name := sync.Semaphore.HTTP.Name
+			if name == "" || strings.Contains(name, "/") {
+				return nil, fmt.Errorf("invalid HTTP semaphore name %q: it must not be empty or contain '/'", name)
+			}
+			return NewLockName(namespace, name, "", LockKindHTTP), nil
 		}
-		return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef")
+		return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef, database or HTTP reference")
 	case v1alpha1.SynchronizationTypeMutex:
 		return NewLockName(namespace, sync.Mutex.Name, "", LockKindMutex), nil
 	default:
@@ -54,7 +69,7 @@ func DecodeLockName(lockName string) (*LockName, error) {
 	var lock LockName
 	lockKind := LockKind(items[1])
 	switch lockKind {
-	case LockKindMutex:
+	case LockKindMutex, LockKindDatabase, LockKindHTTP:
 		lock = LockName{Namespace: items[0], Kind: LockKind(items[1]), ResourceName: items[2]}
 	case LockKindConfigMap:
 		lock = LockName{Namespace: items[0], Kind: LockKind(items[1]), ResourceName: items[2], Key: items[3]}
@@ -70,7 +85,7 @@ func DecodeLockName(lockName string) (*LockName, error) {
 }
 
 func (ln *LockName) EncodeName() string {
-	if ln.Kind == LockKindMutex {
+	if ln.Kind == LockKindMutex || ln.Kind == LockKindDatabase || ln.Kind == LockKindHTTP {
 		return ln.ValidateEncoding(fmt.Sprintf("%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName))
 	}
 	return ln.ValidateEncoding(fmt.Sprintf("%s/%s/%s/%s", ln.Namespace, ln.Kind, ln.ResourceName, ln.Key))
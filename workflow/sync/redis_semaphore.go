@@ -0,0 +1,474 @@
+package sync
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	"github.com/argoproj/argo-workflows/v3/util"
+)
+
+// queueFront is a Lua function that returns the members at the front of the queue whose permits are free, removing
+// expired members. Members are ordered by priority (highest first) and then by creation time (oldest first), and a
+// member that needs more permits than are free blocks the members behind it, so that it is not starved. Queue entries
+// are stored as "<priority> <creation time> <lease expiry> <permits>" in a hash, keyed by member. It also returns the
+// permits that are left, and whether every member of the queue fits in the free permits.
+const queueFront = `
+local function front(queueKey, now, free)
+	local waiting = {}
+	local entries = redis.call('HGETALL', queueKey)
+	for i = 1, #entries, 2 do
+		local priority, created, expiry, permits = string.match(entries[i + 1], '^(-?%d+) (%d+) (%d+) ?(%d*)$')
+		if expiry == nil or tonumber(expiry) <= now then
+			redis.call('HDEL', queueKey, entries[i])
+		else
+			table.insert(waiting, {member = entries[i], priority = tonumber(priority), created = tonumber(created), permits = tonumber(permits) or 1})
+		end
+	end
+	table.sort(waiting, function(a, b)
+		if a.priority ~= b.priority then
+			return a.priority > b.priority
+		end
+		if a.created ~= b.created then
+			return a.created < b.created
+		end
+		return a.member < b.member
+	end)
+	local members = {}
+	for _, w in ipairs(waiting) do
+		if w.permits > free then
+			break
+		end
+		free = free - w.permits
+		table.insert(members, w.member)
+	end
+	return members, free, #members == #waiting
+end
+
+local function available(limitKey, holdersKey, permitsKey, now)
+	for _, m in ipairs(redis.call('ZRANGEBYSCORE', holdersKey, '-inf', now)) do
+		redis.call('HDEL', permitsKey, m)
+	end
+	redis.call('ZREMRANGEBYSCORE', holdersKey, '-inf', now)
+	local used = 0
+	for _, m in ipairs(redis.call('ZRANGE', holdersKey, 0, -1)) do
+		used = used + tonumber(redis.call('HGET', permitsKey, m) or '1')
+	end
+	return tonumber(redis.call('GET', limitKey) or '0') - used
+end
+`
+
+// acquireScript acquires the permits if the member already holds them, or they are free and the member is at the
+// front of the queue (or forced). It returns 1 if the permits were acquired.
+var acquireScript = redis.NewScript(queueFront + `
+local limitKey, holdersKey, queueKey, permitsKey = KEYS[1], KEYS[2], KEYS[3], KEYS[4]
+local member, now, expiry, force, permits = ARGV[1], tonumber(ARGV[2]), ARGV[3], ARGV[4] == '1', tonumber(ARGV[5])
+local free = available(limitKey, holdersKey, permitsKey, now)
+if redis.call('ZSCORE', holdersKey, member) then
+	redis.call('ZADD', holdersKey, expiry, member)
+	redis.call('HDEL', queueKey, member)
+	return 1
+end
+if free < permits then
+	return 0
+end
+if not force then
+	local members, rest, all = front(queueKey, now, free)
+	local allowed = all and rest >= permits
+	for _, m in ipairs(members) do
+		if m == member then
+			allowed = true
+		end
+	end
+	if not allowed then
+		return 0
+	end
+end
+redis.call('ZADD', holdersKey, expiry, member)
+if permits > 1 then
+	redis.call('HSET', permitsKey, member, permits)
+end
+redis.call('HDEL', queueKey, member)
+return 1
+`)
+
+// nextScript returns the members at the front of the queue that may acquire the free permits
+var nextScript = redis.NewScript(queueFront + `
+local limitKey, holdersKey, queueKey, permitsKey = KEYS[1], KEYS[2], KEYS[3], KEYS[4]
+local now = tonumber(ARGV[1])
+local members = front(queueKey, now, available(limitKey, holdersKey, permitsKey, now))
+return members
+`)
+
+// setLimitScript sets the limit if it is not set, or is the limit this controller expects, i.e. its previous limit. It
+// returns the limit, so that a controller whose limit differs from the one set by another controller can report the
+// conflict rather than overwrite it.
+var setLimitScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current == false or current == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[2])
+	return tonumber(ARGV[2])
+end
+return tonumber(current)
+`)
+
+// availableScript returns the number of free permits
+var availableScript = redis.NewScript(queueFront + `
+return available(KEYS[1], KEYS[2], KEYS[4], tonumber(ARGV[1]))
+`)
+
+type pendingItem struct {
+	priority     int32
+	creationTime time.Time
+	permits      int
+}
+
+// RedisSemaphore is a semaphore whose holders and queue are kept in Redis, so that it can be shared by controllers in
+// different clusters. Holders and queue entries are leased, and the controller renews the leases of its own holders
+// and queue entries, so that the permits of a controller that stops are eventually released.
+type RedisSemaphore struct {
+	name          string
+	limit         int
+	client        redis.UniversalClient
+	keyPrefix     string
+	clusterName   string
+	leaseDuration time.Duration
+	// holders and pending are the holders and queue entries of this controller, whose leases it renews
+	holders      map[string]bool
+	pending      map[string]pendingItem
+	lock         *sync.Mutex
+	nextWorkflow NextWorkflow
+	now          func() time.Time
+	log          *log.Entry
+}
+
+var _ Semaphore = &RedisSemaphore{}
+
+func NewRedisSemaphore(client redis.UniversalClient, cfg *config.RedisConfig, name string, limit int, nextWorkflow NextWorkflow, lockType string) *RedisSemaphore {
+	s := &RedisSemaphore{
+		name:          name,
+		limit:         limit,
+		client:        client,
+		keyPrefix:     cfg.GetKeyPrefix(),
+		clusterName:   cfg.GetClusterName(),
+		leaseDuration: cfg.GetLeaseDuration(),
+		holders:       make(map[string]bool),
+		pending:       make(map[string]pendingItem),
+		lock:          &sync.Mutex{},
+		nextWorkflow:  nextWorkflow,
+		now:           time.Now,
+		log: log.WithFields(log.Fields{
+			lockType: name,
+			"store":  "redis",
+		}),
+	}
+	s.setLimit(limit, limit)
+	return s
+}
+
+// NewRedisClient creates a client for the Redis in the configuration, reading its password from the secret in the
+// controller's namespace
+func NewRedisClient(ctx context.Context, kubeClient kubernetes.Interface, namespace string, cfg *config.RedisConfig) (redis.UniversalClient, error) {
+	opts := &redis.Options{Addr: cfg.Address, DB: cfg.DB}
+	if cfg.PasswordSecret != nil {
+		password, err := util.GetSecrets(ctx, kubeClient, namespace, cfg.PasswordSecret.Name, cfg.PasswordSecret.Key)
+		if err != nil {
+			return nil, err
+		}
+		opts.Password = string(password)
+	}
+	if cfg.TLS {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	client := redis.NewClient(opts)
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis at %s: %w", cfg.Address, err)
+	}
+	return client, nil
+}
+
+// keys are hash-tagged, so that the keys of a lock are in the same slot of a Redis cluster
+func (s *RedisSemaphore) keys() []string {
+	tag := fmt.Sprintf("%s:{%s}", s.keyPrefix, s.name)
+	return []string{tag + ":limit", tag + ":holders", tag + ":queue", tag + ":permits"}
+}
+
+// member is how this controller's holder keys are stored in Redis
+func (s *RedisSemaphore) member(holderKey string) string {
+	return s.clusterName + "/" + holderKey
+}
+
+// holderKey returns the holder key of a member, or false if it is a member of another controller
+func (s *RedisSemaphore) holderKey(member string) (string, bool) {
+	key := strings.TrimPrefix(member, s.clusterName+"/")
+	return key, key != member
+}
+
+func (s *RedisSemaphore) expiry() string {
+	return strconv.FormatInt(s.now().Add(s.leaseDuration).UnixMilli(), 10)
+}
+
+func (s *RedisSemaphore) getName() string {
+	return s.name
+}
+
+func (s *RedisSemaphore) getLimit() int {
+	return s.limit
+}
+
+func (s *RedisSemaphore) getCurrentPending() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var keys []string
+	for k := range s.pending {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// getCurrentHolders returns the holders of this controller, the holders of other controllers are theirs to release
+func (s *RedisSemaphore) getCurrentHolders() []string {
+	var holders []string
+	for _, member := range s.getMembers() {
+		if key, ok := s.holderKey(member); ok {
+			holders = append(holders, key)
+		}
+	}
+	return holders
+}
+
+// getAllHolders returns the holders of all the controllers sharing the semaphore, for the status of waiting workflows,
+// those of other controllers prefixed with their cluster name
+func (s *RedisSemaphore) getAllHolders() []string {
+	var holders []string
+	for _, member := range s.getMembers() {
+		if key, ok := s.holderKey(member); ok {
+			holders = append(holders, key)
+		} else {
+			holders = append(holders, member)
+		}
+	}
+	return holders
+}
+
+// getMembers returns the unexpired members of the holders set
+func (s *RedisSemaphore) getMembers() []string {
+	members, err := s.client.ZRangeByScore(context.Background(), s.keys()[1], &redis.ZRangeBy{
+		Min: "(" + strconv.FormatInt(s.now().UnixMilli(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		s.log.WithError(err).Error("failed to get the holders")
+		return nil
+	}
+	return members
+}
+
+// setLimit changes the limit in Redis from the expected limit to n, unless another controller has set a different
+// limit, in which case that limit is kept and the conflict is reported
+func (s *RedisSemaphore) setLimit(expected, n int) bool {
+	limit, err := setLimitScript.Run(context.Background(), s.client, s.keys()[:1], strconv.Itoa(expected), strconv.Itoa(n)).Int()
+	if err != nil {
+		s.log.WithError(err).Error("failed to set the limit")
+		return false
+	}
+	if limit != n {
+		s.log.Warnf("The limit of %s is %d, set by another controller, rather than %d. The config maps of the controllers sharing it should be the same.", s.name, limit, n)
+	}
+	return true
+}
+
+func (s *RedisSemaphore) resize(n int) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.setLimit(s.limit, n) {
+		return false
+	}
+	s.log.Infof("%s semaphore resized from %d to %d", s.name, s.limit, n)
+	s.limit = n
+	s.notifyNext()
+	return true
+}
+
+func (s *RedisSemaphore) release(key string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.holders[key] {
+		return true
+	}
+	keys := s.keys()
+	_, err := s.client.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.ZRem(context.Background(), keys[1], s.member(key))
+		pipe.HDel(context.Background(), keys[3], s.member(key))
+		return nil
+	})
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to release the lock held by %s", key)
+		return false
+	}
+	delete(s.holders, key)
+	s.log.Infof("Lock has been released by %s", key)
+	s.notifyNext()
+	return true
+}
+
+// notifyNext enqueues this controller's workflows that are at the front of the queue, as there are free permits
+func (s *RedisSemaphore) notifyNext() {
+	result, err := nextScript.Run(context.Background(), s.client, s.keys(), s.now().UnixMilli()).StringSlice()
+	if err != nil {
+		s.log.WithError(err).Error("failed to get the front of the queue")
+		return
+	}
+	for _, member := range result {
+		key, ok := s.holderKey(member)
+		if _, pending := s.pending[key]; !ok || !pending {
+			continue
+		}
+		workflowKey := getWorkflowKey(key)
+		s.log.Debugf("Enqueue the workflow %s", workflowKey)
+		s.nextWorkflow(workflowKey)
+	}
+}
+
+func (s *RedisSemaphore) enqueue(holderKey string, item pendingItem) error {
+	value := fmt.Sprintf("%d %d %s %d", item.priority, item.creationTime.UnixMilli(), s.expiry(), item.permits)
+	return s.client.HSet(context.Background(), s.keys()[2], s.member(holderKey), value).Err()
+}
+
+func (s *RedisSemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time, permits int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.holders[holderKey] {
+		s.log.Debugf("Lock is already acquired by %s", holderKey)
+		return
+	}
+
+	item := pendingItem{priority: priority, creationTime: creationTime, permits: permits}
+	if err := s.enqueue(holderKey, item); err != nil {
+		s.log.WithError(err).Errorf("failed to add %s into queue", holderKey)
+		return
+	}
+	s.pending[holderKey] = item
+	s.log.Debugf("Added into queue: %s", holderKey)
+}
+
+func (s *RedisSemaphore) removeFromQueue(holderKey string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if err := s.client.HDel(context.Background(), s.keys()[2], s.member(holderKey)).Err(); err != nil {
+		s.log.WithError(err).Errorf("failed to remove %s from queue", holderKey)
+		return
+	}
+	delete(s.pending, holderKey)
+	s.log.Debugf("Removed from queue: %s", holderKey)
+}
+
+func (s *RedisSemaphore) runAcquire(holderKey string, permits int, force bool) (bool, error) {
+	forceArg := "0"
+	if force {
+		forceArg = "1"
+	}
+	acquired, err := acquireScript.Run(context.Background(), s.client, s.keys(), s.member(holderKey), s.now().UnixMilli(), s.expiry(), forceArg, permits).Int()
+	if err != nil {
+		return false, err
+	}
+	if acquired == 1 {
+		s.holders[holderKey] = true
+		delete(s.pending, holderKey)
+		return true, nil
+	}
+	return false, nil
+}
+
+// acquire acquires the permits if they are free, regardless of the queue, it is used to restore the holders when the
+// controller starts
+func (s *RedisSemaphore) acquire(holderKey string, permits int) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	acquired, err := s.runAcquire(holderKey, permits, true)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to acquire lock for %s", holderKey)
+	}
+	return acquired
+}
+
+func (s *RedisSemaphore) tryAcquire(holderKey string) (bool, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	permits := 1
+	if item, ok := s.pending[holderKey]; ok {
+		permits = item.permits
+	}
+	acquired, err := s.runAcquire(holderKey, permits, false)
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to acquire lock for %s", holderKey)
+		return false, fmt.Sprintf("Waiting for %s lock. Failed to acquire lock: %v", s.name, err)
+	}
+	if acquired {
+		s.log.Infof("%s acquired by %s ", s.name, holderKey)
+		return true, ""
+	}
+	available, err := availableScript.Run(context.Background(), s.client, s.keys(), s.now().UnixMilli()).Int()
+	if err != nil {
+		s.log.WithError(err).Error("failed to get the available permits")
+	}
+	if permits > 1 {
+		return false, fmt.Sprintf("Waiting for %d permits of %s lock. Lock status: %d/%d ", permits, s.name, available, s.limit)
+	}
+	return false, fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, available, s.limit)
+}
+
+// renew renews the leases of this controller's holders and queue entries, and enqueues its workflows that may acquire
+// permits released by other controllers
+func (s *RedisSemaphore) renew() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ctx := context.Background()
+	keys := s.keys()
+	for key := range s.holders {
+		member := s.member(key)
+		expiry, err := s.client.ZScore(ctx, keys[1], member).Result()
+		if err == redis.Nil || (err == nil && expiry <= float64(s.now().UnixMilli())) {
+			s.log.Warnf("The lease of %s has expired, it no longer holds the lock", key)
+			delete(s.holders, key)
+			continue
+		}
+		if err == nil {
+			// XX only updates existing holders, so a holder whose lease has just expired is not resurrected
+			err = s.client.ZAddXX(ctx, keys[1], &redis.Z{Score: float64(s.now().Add(s.leaseDuration).UnixMilli()), Member: member}).Err()
+		}
+		if err != nil {
+			s.log.WithError(err).Errorf("failed to renew the lease of %s", key)
+		}
+	}
+	for key, item := range s.pending {
+		if err := s.enqueue(key, item); err != nil {
+			s.log.WithError(err).Errorf("failed to renew the lease of %s in queue", key)
+		}
+	}
+	if len(s.pending) > 0 {
+		s.notifyNext()
+	}
+}
+
+// getWorkflowKey returns the "namespace/name" key of the workflow of a holder key
+func getWorkflowKey(holderKey string) string {
+	items := strings.Split(holderKey, "/")
+	if len(items) == 3 {
+		return fmt.Sprintf("%s/%s", items[0], items[1])
+	}
+	return holderKey
+}
@@ -0,0 +1,182 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func newRedisClient(t *testing.T) redis.UniversalClient {
+	s := miniredis.RunT(t)
+	return redis.NewClient(&redis.Options{Addr: s.Addr()})
+}
+
+func TestRedisSemaphore(t *testing.T) {
+	client := newRedisClient(t)
+	now := time.Now()
+	clock := func() time.Time { return now }
+	newSemaphore := func(clusterName string, limit int, nextWorkflow NextWorkflow) *RedisSemaphore {
+		s := NewRedisSemaphore(client, &config.RedisConfig{ClusterName: clusterName, LeaseDuration: &metav1.Duration{Duration: time.Minute}}, "default/ConfigMap/my-config/db", limit, nextWorkflow, "semaphore")
+		s.now = clock
+		return s
+	}
+	var nextA, nextB []string
+	a := newSemaphore("cluster-a", 2, func(key string) { nextA = append(nextA, key) })
+	b := newSemaphore("cluster-b", 2, func(key string) { nextB = append(nextB, key) })
+
+	t.Run("Acquire", func(t *testing.T) {
+		a.addToQueue("default/wf-1", 0, now, 1)
+		acquired, msg := a.tryAcquire("default/wf-1")
+		assert.True(t, acquired)
+		assert.Empty(t, msg)
+		b.addToQueue("default/wf-2", 0, now, 1)
+		acquired, _ = b.tryAcquire("default/wf-2")
+		assert.True(t, acquired)
+		assert.Equal(t, []string{"default/wf-1"}, a.getCurrentHolders(), "the holders of other clusters are theirs to release")
+		assert.Equal(t, []string{"default/wf-2"}, b.getCurrentHolders())
+		// acquiring again is idempotent
+		acquired, _ = a.tryAcquire("default/wf-1")
+		assert.True(t, acquired)
+	})
+	t.Run("QueueByPriority", func(t *testing.T) {
+		a.addToQueue("default/wf-low", 0, now.Add(-time.Hour), 1)
+		b.addToQueue("default/wf-high", 10, now, 1)
+		acquired, msg := a.tryAcquire("default/wf-low")
+		assert.False(t, acquired)
+		assert.Equal(t, "Waiting for default/ConfigMap/my-config/db lock. Lock status: 0/2 ", msg)
+		assert.Equal(t, []string{"default/wf-low"}, a.getCurrentPending())
+
+		a.release("default/wf-1")
+		assert.Empty(t, nextA, "the workflow of the other cluster is first in the queue")
+		b.renew()
+		assert.Equal(t, []string{"default/wf-high"}, nextB)
+		acquired, _ = a.tryAcquire("default/wf-low")
+		assert.False(t, acquired)
+		acquired, _ = b.tryAcquire("default/wf-high")
+		assert.True(t, acquired)
+		assert.Empty(t, b.getCurrentPending())
+	})
+	t.Run("LeaseExpiry", func(t *testing.T) {
+		// cluster-b stops renewing its leases, so its permits are released after the lease duration
+		now = now.Add(30 * time.Second)
+		a.renew()
+		now = now.Add(45 * time.Second)
+		a.renew()
+		assert.Equal(t, []string{"default/wf-low"}, nextA)
+		acquired, _ := a.tryAcquire("default/wf-low")
+		assert.True(t, acquired)
+		assert.Equal(t, []string{"default/wf-low"}, a.getCurrentHolders())
+		// cluster-b finds out it has lost its permits when it renews them
+		b.renew()
+		assert.Empty(t, b.holders)
+	})
+	t.Run("Resize", func(t *testing.T) {
+		assert.True(t, a.resize(1))
+		a.addToQueue("default/wf-3", 0, now, 1)
+		acquired, _ := a.tryAcquire("default/wf-3")
+		assert.False(t, acquired)
+		assert.True(t, a.resize(3))
+		acquired, _ = a.tryAcquire("default/wf-3")
+		assert.True(t, acquired)
+	})
+}
+
+func TestRedisSemaphoreLimit(t *testing.T) {
+	client := newRedisClient(t)
+	newSemaphore := func(clusterName string, limit int) *RedisSemaphore {
+		return NewRedisSemaphore(client, &config.RedisConfig{ClusterName: clusterName}, "default/ConfigMap/my-config/db", limit, func(string) {}, "semaphore")
+	}
+	a := newSemaphore("cluster-a", 2)
+	limit := func() string {
+		return client.Get(context.Background(), a.keys()[0]).Val()
+	}
+	b := newSemaphore("cluster-b", 5)
+	assert.Equal(t, "2", limit(), "a controller that starts later does not overwrite a different limit")
+	assert.True(t, a.resize(3))
+	assert.Equal(t, "3", limit())
+	assert.True(t, b.resize(3))
+	assert.Equal(t, "3", limit())
+	assert.True(t, b.resize(4), "the controllers agree on the limit, so either can change it")
+	assert.Equal(t, "4", limit())
+	assert.True(t, a.resize(1))
+	assert.Equal(t, "4", limit(), "the limit is not the one the controller last agreed on")
+}
+
+func TestRedisWeightedSemaphore(t *testing.T) {
+	client := newRedisClient(t)
+	now := time.Now()
+	var next []string
+	s := NewRedisSemaphore(client, &config.RedisConfig{}, "default/ConfigMap/my-config/gpu", 4, func(key string) { next = append(next, key) }, "semaphore")
+	s.addToQueue("default/wf-1", 0, now, 3)
+	acquired, _ := s.tryAcquire("default/wf-1")
+	assert.True(t, acquired)
+
+	s.addToQueue("default/wf-2", 0, now, 2)
+	s.addToQueue("default/wf-3", 0, now.Add(time.Second), 1)
+	// wf-3 would fit in the free permit, but it has to wait for wf-2, which is in front of it
+	acquired, msg := s.tryAcquire("default/wf-3")
+	assert.False(t, acquired)
+	assert.Equal(t, "Waiting for default/ConfigMap/my-config/gpu lock. Lock status: 1/4 ", msg)
+	acquired, msg = s.tryAcquire("default/wf-2")
+	assert.False(t, acquired)
+	assert.Equal(t, "Waiting for 2 permits of default/ConfigMap/my-config/gpu lock. Lock status: 1/4 ", msg)
+
+	s.release("default/wf-1")
+	assert.Equal(t, []string{"default/wf-2", "default/wf-3"}, next)
+	for _, key := range []string{"default/wf-2", "default/wf-3"} {
+		acquired, _ := s.tryAcquire(key)
+		assert.True(t, acquired)
+	}
+	assert.False(t, s.acquire("default/wf-4", 2), "only one permit is free")
+	assert.True(t, s.acquire("default/wf-4", 1))
+}
+
+func TestRedisMutex(t *testing.T) {
+	kube := fake.NewSimpleClientset()
+	var cm v1.ConfigMap
+	wfv1.MustUnmarshal([]byte(configMap), &cm)
+	_, err := kube.CoreV1().ConfigMaps("default").Create(context.Background(), &cm, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	client := newRedisClient(t)
+	newManager := func(clusterName string) *Manager {
+		m := NewLockManager(GetSyncLimitFunc(kube), func(key string) {}, WorkflowExistenceFunc)
+		m.UseRedis(client, &config.RedisConfig{ClusterName: clusterName})
+		return m
+	}
+	a := newManager("cluster-a")
+	b := newManager("cluster-b")
+
+	wf := wfv1.MustUnmarshalWorkflow(wfWithMutex)
+	acquired, updated, msg, err := a.TryAcquire(wf, "", wf.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	assert.True(t, updated)
+	assert.Empty(t, msg)
+
+	// the same workflow in another cluster has to wait, and its status shows the holder in the other cluster
+	other := wf.DeepCopy()
+	other.Status = wfv1.WorkflowStatus{}
+	acquired, updated, msg, err = b.TryAcquire(other, "", other.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+	assert.True(t, updated)
+	assert.NotEmpty(t, msg)
+	if assert.NotNil(t, other.Status.Synchronization) && assert.NotNil(t, other.Status.Synchronization.Mutex) {
+		assert.Equal(t, "cluster-a/"+wf.Namespace+"/"+wf.Name, other.Status.Synchronization.Mutex.Waiting[0].Holder)
+	}
+
+	a.ReleaseAll(wf)
+	acquired, _, _, err = b.TryAcquire(other, "", other.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+}
@@ -11,11 +11,12 @@ import (
 )
 
 type PrioritySemaphore struct {
-	name         string
-	limit        int
-	pending      *priorityQueue
-	semaphore    *sema.Weighted
-	lockHolder   map[string]bool
+	name      string
+	limit     int
+	pending   *priorityQueue
+	semaphore *sema.Weighted
+	// lockHolder is the number of permits held by each holder
+	lockHolder   map[string]int
 	lock         *sync.Mutex
 	nextWorkflow NextWorkflow
 	log          *log.Entry
@@ -29,7 +30,7 @@ func NewSemaphore(name string, limit int, nextWorkflow NextWorkflow, lockType st
 		limit:        limit,
 		pending:      &priorityQueue{itemByKey: make(map[string]*item)},
 		semaphore:    sema.NewWeighted(int64(limit)),
-		lockHolder:   make(map[string]bool),
+		lockHolder:   make(map[string]int),
 		lock:         &sync.Mutex{},
 		nextWorkflow: nextWorkflow,
 		log: log.WithFields(log.Fields{
@@ -62,22 +63,45 @@ func (s *PrioritySemaphore) getCurrentHolders() []string {
 	return keys
 }
 
+// usedPermits returns the number of permits held, which is more than the limit if the semaphore was resized downward
+func (s *PrioritySemaphore) usedPermits() int {
+	used := 0
+	for _, permits := range s.lockHolder {
+		used += permits
+	}
+	return used
+}
+
+// heldPermits returns the number of permits of the underlying semaphore that are acquired when the holders hold used
+// permits, as it cannot acquire more than its limit
+func (s *PrioritySemaphore) heldPermits(used int) int {
+	if used > s.limit {
+		return s.limit
+	}
+	return used
+}
+
 func (s *PrioritySemaphore) resize(n int) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	cur := len(s.lockHolder)
+	cur := s.usedPermits()
 	// downward case, acquired n locks
 	if cur > n {
 		cur = n
 	}
 
+	// the current holders keep their locks, even if there are more of them than the new limit
 	semaphore := sema.NewWeighted(int64(n))
 	status := semaphore.TryAcquire(int64(cur))
 	if status {
-		s.log.Infof("%s semaphore resized from %d to %d", s.name, cur, n)
+		s.log.Infof("%s semaphore resized from %d to %d", s.name, s.limit, n)
+		grown := n > s.limit
 		s.semaphore = semaphore
 		s.limit = n
+		if grown {
+			s.notifyPending()
+		}
 	}
 	return status
 }
@@ -85,40 +109,47 @@ func (s *PrioritySemaphore) resize(n int) bool {
 func (s *PrioritySemaphore) release(key string) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	if _, ok := s.lockHolder[key]; ok {
+	if permits, ok := s.lockHolder[key]; ok {
+		used := s.usedPermits()
 		delete(s.lockHolder, key)
 		// When semaphore resized downward
 		// Remove the excess holders from map once the done.
-		if len(s.lockHolder) >= s.limit {
+		released := s.heldPermits(used) - s.heldPermits(used-permits)
+		if released == 0 {
 			return true
 		}
 
-		s.semaphore.Release(1)
-		availableLocks := s.limit - len(s.lockHolder)
-		s.log.Infof("Lock has been released by %s. Available locks: %d", key, availableLocks)
-		if s.pending.Len() > 0 {
-			triggerCount := availableLocks
-			if s.pending.Len() < triggerCount {
-				triggerCount = s.pending.Len()
+		s.semaphore.Release(int64(released))
+		s.log.Infof("Lock has been released by %s. Available locks: %d", key, s.limit-s.usedPermits())
+		s.notifyPending()
+	}
+	return true
+}
+
+// notifyPending enqueues the workflows at the front of the queue that may acquire the available locks
+func (s *PrioritySemaphore) notifyPending() {
+	availableLocks := s.limit - s.usedPermits()
+	if s.pending.Len() > 0 {
+		for _, item := range s.pending.sorted() {
+			// the workflows behind one that needs more permits than are available wait for it, so it is not starved
+			if item.permits > availableLocks {
+				break
 			}
-			for idx := 0; idx < triggerCount; idx++ {
-				item := s.pending.items[idx]
-				keyStr := fmt.Sprint(item.key)
-				items := strings.Split(keyStr, "/")
-				workflowKey := keyStr
-				if len(items) == 3 {
-					workflowKey = fmt.Sprintf("%s/%s", items[0], items[1])
-				}
-				s.log.Debugf("Enqueue the workflow %s", workflowKey)
-				s.nextWorkflow(workflowKey)
+			availableLocks -= item.permits
+			keyStr := fmt.Sprint(item.key)
+			items := strings.Split(keyStr, "/")
+			workflowKey := keyStr
+			if len(items) == 3 {
+				workflowKey = fmt.Sprintf("%s/%s", items[0], items[1])
 			}
+			s.log.Debugf("Enqueue the workflow %s", workflowKey)
+			s.nextWorkflow(workflowKey)
 		}
 	}
-	return true
 }
 
 // addToQueue adds the holderkey into priority queue that maintains the priority order to acquire the lock.
-func (s *PrioritySemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time) {
+func (s *PrioritySemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time, permits int) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -128,6 +159,7 @@ func (s *PrioritySemaphore) addToQueue(holderKey string, priority int32, creatio
 	}
 
 	s.pending.add(holderKey, priority, creationTime)
+	s.pending.itemByKey[holderKey].permits = permits
 	s.log.Debugf("Added into queue: %s", holderKey)
 }
 
@@ -139,9 +171,9 @@ func (s *PrioritySemaphore) removeFromQueue(holderKey string) {
 	s.log.Debugf("Removed from queue: %s", holderKey)
 }
 
-func (s *PrioritySemaphore) acquire(holderKey string) bool {
-	if s.semaphore.TryAcquire(1) {
-		s.lockHolder[holderKey] = true
+func (s *PrioritySemaphore) acquire(holderKey string, permits int) bool {
+	if s.semaphore.TryAcquire(int64(permits)) {
+		s.lockHolder[holderKey] = permits
 		return true
 	}
 	return false
@@ -167,25 +199,33 @@ func (s *PrioritySemaphore) tryAcquire(holderKey string) (bool, string) {
 		return true, ""
 	}
 	var nextKey string
+	permits := 1
+	if item, ok := s.pending.itemByKey[holderKey]; ok {
+		permits = item.permits
+	}
 
-	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-len(s.lockHolder), s.limit)
+	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-s.usedPermits(), s.limit)
+	if permits > 1 {
+		waitingMsg = fmt.Sprintf("Waiting for %d permits of %s lock. Lock status: %d/%d ", permits, s.name, s.limit-s.usedPermits(), s.limit)
+	}
 
 	// Check whether requested holdkey is in front of priority queue.
 	// If it is in front position, it will allow to acquire lock.
-	// If it is not a front key, it needs to wait for its turn.
+	// If it is not a front key, it needs to wait for its turn, even if there are enough permits for it, so that
+	// a holder that needs more permits is not starved by the holders that need fewer.
 	if s.pending.Len() > 0 {
 		item := s.pending.peek()
 		nextKey = fmt.Sprintf("%v", item.key)
 		if holderKey != nextKey && !isSameWorkflowNodeKeys(holderKey, nextKey) {
 			// Enqueue the front workflow if lock is available
-			if len(s.lockHolder) < s.limit {
+			if s.usedPermits()+item.permits <= s.limit {
 				s.nextWorkflow(nextKey)
 			}
 			return false, waitingMsg
 		}
 	}
 
-	if s.acquire(holderKey) {
+	if s.acquire(holderKey, permits) {
 		s.pending.pop()
 		s.log.Infof("%s acquired by %s ", s.name, nextKey)
 		return true, ""
@@ -1,7 +1,9 @@
 package sync
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -19,3 +21,93 @@ func TestIsSameWorkflowNodeKeys(t *testing.T) {
 	assert.False(t, isSameWorkflowNodeKeys(wfkey1, wfkey2))
 	assert.True(t, isSameWorkflowNodeKeys(nodeWf2key1, nodeWf2key2))
 }
+
+func TestResizeSemaphore(t *testing.T) {
+	var next []string
+	s := NewSemaphore("foo", 2, func(key string) { next = append(next, key) }, "semaphore")
+	now := time.Now()
+	for _, key := range []string{"default/wf-1", "default/wf-2", "default/wf-3"} {
+		s.addToQueue(key, 0, now, 1)
+		now = now.Add(time.Second)
+	}
+	for _, key := range []string{"default/wf-1", "default/wf-2"} {
+		acquired, _ := s.tryAcquire(key)
+		assert.True(t, acquired)
+	}
+	acquired, _ := s.tryAcquire("default/wf-3")
+	assert.False(t, acquired)
+
+	t.Run("Shrink", func(t *testing.T) {
+		assert.True(t, s.resize(1))
+		// the current holders are not evicted
+		assert.ElementsMatch(t, []string{"default/wf-1", "default/wf-2"}, s.getCurrentHolders())
+		s.release("default/wf-1")
+		assert.Empty(t, next)
+		acquired, _ := s.tryAcquire("default/wf-3")
+		assert.False(t, acquired)
+	})
+	t.Run("Grow", func(t *testing.T) {
+		assert.True(t, s.resize(3))
+		assert.Equal(t, []string{"default/wf-3"}, next)
+		acquired, _ := s.tryAcquire("default/wf-3")
+		assert.True(t, acquired)
+		assert.ElementsMatch(t, []string{"default/wf-2", "default/wf-3"}, s.getCurrentHolders())
+	})
+}
+
+func TestWeightedSemaphore(t *testing.T) {
+	var next []string
+	s := NewSemaphore("foo", 4, func(key string) { next = append(next, key) }, "semaphore")
+	now := time.Now()
+	for key, permits := range map[string]int{"default/wf-1": 3, "default/wf-2": 2, "default/wf-3": 1} {
+		s.addToQueue(key, 0, now.Add(time.Duration(permits)*-time.Second), permits)
+	}
+	acquired, _ := s.tryAcquire("default/wf-1")
+	assert.True(t, acquired)
+	assert.Equal(t, 3, s.usedPermits())
+
+	// wf-3 would fit in the free permit, but it has to wait for wf-2, which is in front of it
+	acquired, msg := s.tryAcquire("default/wf-3")
+	assert.False(t, acquired)
+	assert.Equal(t, "Waiting for foo lock. Lock status: 1/4 ", msg)
+	acquired, msg = s.tryAcquire("default/wf-2")
+	assert.False(t, acquired)
+	assert.Equal(t, "Waiting for 2 permits of foo lock. Lock status: 1/4 ", msg)
+	assert.Empty(t, next)
+
+	s.release("default/wf-1")
+	assert.Equal(t, []string{"default/wf-2", "default/wf-3"}, next)
+	for _, key := range []string{"default/wf-2", "default/wf-3"} {
+		acquired, _ := s.tryAcquire(key)
+		assert.True(t, acquired)
+	}
+	assert.Equal(t, 3, s.usedPermits())
+
+	t.Run("Shrink", func(t *testing.T) {
+		assert.True(t, s.resize(2))
+		s.addToQueue("default/wf-4", 0, now, 1)
+		s.release("default/wf-3")
+		acquired, _ := s.tryAcquire("default/wf-4")
+		assert.False(t, acquired, "wf-2 still holds all the permits")
+		s.release("default/wf-2")
+		acquired, _ = s.tryAcquire("default/wf-4")
+		assert.True(t, acquired)
+		assert.Equal(t, 1, s.usedPermits())
+	})
+}
+
+func TestNotifyPendingInPriorityOrder(t *testing.T) {
+	var next []string
+	s := NewSemaphore("foo", 2, func(key string) { next = append(next, key) }, "semaphore")
+	now := time.Now()
+	s.addToQueue("default/holder", 0, now, 2)
+	acquired, _ := s.tryAcquire("default/holder")
+	assert.True(t, acquired)
+	// the items of the heap are in the order 5, 3, 4, 1
+	for _, priority := range []int32{5, 1, 4, 3} {
+		s.addToQueue(fmt.Sprintf("default/wf-%d", priority), priority, now, 1)
+	}
+
+	s.release("default/holder")
+	assert.Equal(t, []string{"default/wf-5", "default/wf-4"}, next)
+}
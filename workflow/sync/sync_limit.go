@@ -0,0 +1,108 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+)
+
+const syncLimitTableName = "argo_sync_limit"
+
+// GetDatabaseSyncLimit returns the limit of a database semaphore, which is stored in the row named
+// "<namespace>/<key>" of the sync limit table
+func GetDatabaseSyncLimit(session sqlbuilder.Database, lockName *LockName) (int, error) {
+	if session == nil {
+		return 0, fmt.Errorf("persistence must be configured to use database semaphores")
+	}
+	var record struct {
+		SizeLimit int `db:"sizelimit"`
+	}
+	err := session.
+		Select("sizelimit").
+		From(syncLimitTableName).
+		Where("name", lockName.Namespace+"/"+lockName.ResourceName).
+		One(&record)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the limit of database semaphore %q: %w", lockName.ResourceName, err)
+	}
+	return record.SizeLimit, nil
+}
+
+// GetHTTPSyncLimit returns the limit of an HTTP semaphore, by requesting the URL of the limit endpoint it names, which
+// must return a JSON number. Only the endpoints declared in the controller's config are requested.
+func GetHTTPSyncLimit(ctx context.Context, client *http.Client, syncConfig *config.SyncConfig, lockName *LockName) (int, error) {
+	endpoint, ok := syncConfig.GetLimitEndpoint(lockName.ResourceName)
+	if !ok {
+		return 0, fmt.Errorf("HTTP semaphore endpoint %q is not declared in the synchronization.limitEndpoints of the controller's ConfigMap", lockName.ResourceName)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return 0, fmt.Errorf("invalid URL of HTTP semaphore endpoint %q: it must be an absolute http or https URL", lockName.ResourceName)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the limit of HTTP semaphore %q: %w", lockName.ResourceName, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("failed to get the limit of HTTP semaphore %q: %s", lockName.ResourceName, resp.Status)
+	}
+	var limit json.Number
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1024)).Decode(&limit); err != nil {
+		return 0, fmt.Errorf("limit of HTTP semaphore %q is not a JSON number: %w", lockName.ResourceName, err)
+	}
+	n, err := limit.Int64()
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("limit of HTTP semaphore %q must be a non-negative integer, got %s", lockName.ResourceName, limit)
+	}
+	return int(n), nil
+}
+
+type cachedSyncLimit struct {
+	limit     int
+	fetchedAt time.Time
+}
+
+// NewCachedSyncLimit caches the limits returned by getSyncLimit for the ttl, so that limits that are expensive to get
+// are not fetched every time a lock is acquired. If getting a limit fails, the last limit is used until it is got
+// again.
+func NewCachedSyncLimit(getSyncLimit GetSyncLimit, ttl time.Duration) GetSyncLimit {
+	var lock sync.Mutex
+	cache := make(map[string]cachedSyncLimit)
+	return func(lockKey string) (int, error) {
+		lock.Lock()
+		cached, ok := cache[lockKey]
+		lock.Unlock()
+		if ok && time.Since(cached.fetchedAt) < ttl {
+			return cached.limit, nil
+		}
+		limit, err := getSyncLimit(lockKey)
+		if err != nil {
+			if !ok {
+				return 0, err
+			}
+			log.WithError(err).WithField("lockKey", lockKey).Warn("failed to get the semaphore limit, using the last limit")
+			// try again after the ttl, rather than every time the limit is needed
+			limit = cached.limit
+		}
+		lock.Lock()
+		cache[lockKey] = cachedSyncLimit{limit: limit, fetchedAt: time.Now()}
+		lock.Unlock()
+		return limit, nil
+	}
+}
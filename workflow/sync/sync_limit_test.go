@@ -0,0 +1,146 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-workflows/v3/config"
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestGetLockNameExternal(t *testing.T) {
+	t.Run("Database", func(t *testing.T) {
+		lockName, err := GetLockName(&wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{Database: &wfv1.SyncDatabaseRef{Key: "my-db"}}}, "default")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "default/Database/my-db", lockName.EncodeName())
+		}
+		_, err = GetLockName(&wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{Database: &wfv1.SyncDatabaseRef{Key: "my/db"}}}, "default")
+		assert.Error(t, err)
+	})
+	t.Run("HTTP", func(t *testing.T) {
+		lockName, err := GetLockName(&wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{HTTP: &wfv1.SyncHTTPRef{Name: "capacity"}}}, "default")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "default/HTTP/capacity", lockName.EncodeName())
+		}
+		for _, name := range []string{"", "https://capacity/limits/my-db"} {
+			_, err = GetLockName(&wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{HTTP: &wfv1.SyncHTTPRef{Name: name}}}, "default")
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestGetHTTPSyncLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			_, _ = w.Write([]byte("3\n"))
+		case "/float":
+			_, _ = w.Write([]byte("2.5"))
+		case "/negative":
+			_, _ = w.Write([]byte("-1"))
+		case "/text":
+			_, _ = w.Write([]byte(`"three"`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	syncConfig := &config.SyncConfig{LimitEndpoints: map[string]string{"file": "file:///etc/limit"}}
+	for _, name := range []string{"ok", "float", "negative", "text", "error"} {
+		syncConfig.LimitEndpoints[name] = server.URL + "/" + name
+	}
+	getLimit := func(name string) (int, error) {
+		return GetHTTPSyncLimit(context.Background(), server.Client(), syncConfig, NewLockName("default", name, "", LockKindHTTP))
+	}
+	limit, err := getLimit("ok")
+	if assert.NoError(t, err) {
+		assert.Equal(t, 3, limit)
+	}
+	for _, name := range []string{"float", "negative", "text", "error"} {
+		_, err = getLimit(name)
+		assert.Error(t, err, name)
+	}
+	_, err = getLimit("file")
+	assert.EqualError(t, err, `invalid URL of HTTP semaphore endpoint "file": it must be an absolute http or https URL`)
+	_, err = getLimit("undeclared")
+	assert.EqualError(t, err, `HTTP semaphore endpoint "undeclared" is not declared in the synchronization.limitEndpoints of the controller's ConfigMap`)
+	_, err = GetHTTPSyncLimit(context.Background(), server.Client(), nil, NewLockName("default", "ok", "", LockKindHTTP))
+	assert.Error(t, err, "no endpoints are declared without a synchronization config")
+}
+
+func TestGetDatabaseSyncLimitWithoutPersistence(t *testing.T) {
+	_, err := GetDatabaseSyncLimit(nil, NewLockName("default", "my-db", "", LockKindDatabase))
+	assert.EqualError(t, err, "persistence must be configured to use database semaphores")
+}
+
+func TestCachedSyncLimit(t *testing.T) {
+	calls := 0
+	var err error
+	getSyncLimit := NewCachedSyncLimit(func(string) (int, error) {
+		calls++
+		return calls, err
+	}, 0)
+	limit, _ := getSyncLimit("foo")
+	assert.Equal(t, 1, limit)
+	limit, _ = getSyncLimit("foo")
+	assert.Equal(t, 2, limit, "the limit is got again once the ttl has passed")
+	err = fmt.Errorf("unavailable")
+	limit, getErr := getSyncLimit("foo")
+	if assert.NoError(t, getErr) {
+		assert.Equal(t, 2, limit, "the last limit is used when getting it fails")
+	}
+	_, getErr = getSyncLimit("bar")
+	assert.Error(t, getErr)
+
+	cached := NewCachedSyncLimit(func(string) (int, error) {
+		calls++
+		return calls, nil
+	}, time.Hour)
+	first, _ := cached("foo")
+	second, _ := cached("foo")
+	assert.Equal(t, first, second)
+}
+
+func TestRefreshLimits(t *testing.T) {
+	limit := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, limit)
+	}))
+	defer server.Close()
+	syncConfig := &config.SyncConfig{LimitEndpoints: map[string]string{"capacity": server.URL + "/limit"}}
+	getSyncLimit := func(lockKey string) (int, error) {
+		lockName, err := DecodeLockName(lockKey)
+		if err != nil {
+			return 0, err
+		}
+		return GetHTTPSyncLimit(context.Background(), server.Client(), syncConfig, lockName)
+	}
+	var next []string
+	m := NewLockManager(getSyncLimit, func(key string) { next = append(next, key) }, WorkflowExistenceFunc)
+	syncRef := &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{HTTP: &wfv1.SyncHTTPRef{Name: "capacity"}}}
+	wf := wfv1.MustUnmarshalWorkflow(wfWithSemaphore)
+	wf.Spec.Synchronization = syncRef
+	other := wf.DeepCopy()
+	other.Name = "other"
+
+	acquired, _, _, err := m.TryAcquire(wf, "", syncRef)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	acquired, _, msg, err := m.TryAcquire(other, "", syncRef)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+	assert.Contains(t, msg, "Lock status: 0/1")
+
+	limit = 2
+	m.RefreshLimits()
+	assert.Equal(t, []string{other.Namespace + "/" + other.Name}, next)
+	acquired, _, _, err = m.TryAcquire(other, "", syncRef)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+}
@@ -1,14 +1,19 @@
 package sync
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
 
+	"github.com/go-redis/redis/v8"
 	log "github.com/sirupsen/logrus"
 	runtimeutil "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/argoproj/argo-workflows/v3/config"
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo-workflows/v3/util/intstr"
 )
 
 type (
@@ -23,6 +28,9 @@ type Manager struct {
 	nextWorkflow NextWorkflow
 	getSyncLimit GetSyncLimit
 	isWFDeleted  IsWorkflowDeleted
+	// redis and redisConfig are set when semaphores and mutexes are kept in Redis
+	redis       redis.UniversalClient
+	redisConfig *config.RedisConfig
 }
 
 func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDeleted IsWorkflowDeleted) *Manager {
@@ -35,6 +43,54 @@ func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, isWFDe
 	}
 }
 
+// UseRedis makes the manager keep the state of the semaphores and mutexes it creates in Redis, so that they are shared
+// with the other controllers that use the same Redis. It must be called before the manager is initialized.
+func (cm *Manager) UseRedis(client redis.UniversalClient, cfg *config.RedisConfig) {
+	cm.redis = client
+	cm.redisConfig = cfg
+}
+
+// Run renews the leases of the locks kept in Redis, and enqueues the workflows waiting for permits released by other
+// controllers, until the context is done. It returns immediately if locks are kept in memory.
+func (cm *Manager) Run(ctx context.Context) {
+	if cm.redis == nil {
+		return
+	}
+	wait.UntilWithContext(ctx, func(context.Context) {
+		cm.lock.Lock()
+		var semaphores []*RedisSemaphore
+		for _, lock := range cm.syncLockMap {
+			if s, ok := lock.(*RedisSemaphore); ok {
+				semaphores = append(semaphores, s)
+			}
+		}
+		cm.lock.Unlock()
+		for _, s := range semaphores {
+			s.renew()
+		}
+	}, cm.redisConfig.GetLeaseDuration()/3)
+}
+
+// RefreshLimits resizes the semaphores whose limits are stored in the database or returned by HTTP endpoints, as,
+// unlike config maps, changes to them are not watched
+func (cm *Manager) RefreshLimits() {
+	cm.lock.Lock()
+	var semaphores []Semaphore
+	for key, lock := range cm.syncLockMap {
+		lockName, err := DecodeLockName(key)
+		if err != nil || (lockName.Kind != LockKindDatabase && lockName.Kind != LockKindHTTP) {
+			continue
+		}
+		semaphores = append(semaphores, lock)
+	}
+	cm.lock.Unlock()
+	for _, semaphore := range semaphores {
+		if err := cm.checkAndUpdateSemaphoreSize(semaphore); err != nil {
+			log.WithError(err).WithField("semaphore", semaphore.getName()).Warn("failed to refresh the semaphore limit")
+		}
+	}
+}
+
 func (cm *Manager) getWorkflowKey(key string) (string, error) {
 	if key == "" {
 		return "", fmt.Errorf("holderkey is empty")
@@ -77,7 +133,8 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 				semaphore := cm.syncLockMap[holding.Semaphore]
 				if semaphore == nil {
-					semaphore, err := cm.initializeSemaphore(holding.Semaphore)
+					var err error
+					semaphore, err = cm.initializeSemaphore(holding.Semaphore)
 					if err != nil {
 						log.Warnf("cannot initialize semaphore '%s': %v", holding.Semaphore, err)
 						continue
@@ -87,7 +144,7 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 				for _, holders := range holding.Holders {
 					resourceKey := getResourceKey(wf.Namespace, wf.Name, holders)
-					if semaphore != nil && semaphore.acquire(resourceKey) {
+					if semaphore != nil && semaphore.acquire(resourceKey, int(holding.GetPermits(holders))) {
 						log.Infof("Lock acquired by %s from %s", resourceKey, holding.Semaphore)
 					}
 				}
@@ -102,7 +159,7 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 					mutex := cm.initializeMutex(holding.Mutex)
 					if holding.Holder != "" {
 						resourceKey := getResourceKey(wf.Namespace, wf.Name, holding.Holder)
-						mutex.acquire(resourceKey)
+						mutex.acquire(resourceKey, 1)
 					}
 					cm.syncLockMap[holding.Mutex] = mutex
 				}
@@ -126,6 +183,10 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 	if err != nil {
 		return false, false, "", fmt.Errorf("requested configuration is invalid: %w", err)
 	}
+	permits, err := getPermits(syncLockRef)
+	if err != nil {
+		return false, false, "", fmt.Errorf("requested configuration is invalid: %w", err)
+	}
 
 	lockKey := syncLockName.EncodeName()
 	lock, found := cm.syncLockMap[lockKey]
@@ -149,6 +210,10 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		if err != nil {
 			return false, false, "", err
 		}
+		// the holder would never acquire the permits, and would block the holders queued behind it
+		if permits > lock.getLimit() {
+			return false, false, "", fmt.Errorf("requested %d permits of semaphore %s, which is more than its limit of %d", permits, lockKey, lock.getLimit())
+		}
 	}
 
 	holderKey := getHolderKey(wf, nodeName)
@@ -159,13 +224,16 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		priority = 0
 	}
 	creationTime := wf.CreationTimestamp
-	lock.addToQueue(holderKey, priority, creationTime.Time)
+	lock.addToQueue(holderKey, priority, creationTime.Time, permits)
 
 	ensureInit(wf, syncLockRef.GetType())
 	currentHolders := cm.getCurrentLockHolders(lockKey)
 	acquired, msg := lock.tryAcquire(holderKey)
 	if acquired {
 		updated := wf.Status.Synchronization.GetStatus(syncLockRef.GetType()).LockAcquired(holderKey, lockKey, currentHolders)
+		if syncLockRef.GetType() == wfv1.SynchronizationTypeSemaphore {
+			updated = wf.Status.Synchronization.Semaphore.LockPermits(holderKey, lockKey, int32(permits)) || updated
+		}
 		return true, updated, "", nil
 	}
 
@@ -273,6 +341,21 @@ func (cm *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
 	return true
 }
 
+// getPermits returns the number of permits of the semaphore to acquire, whose expression must have been resolved
+func getPermits(syncLockRef *wfv1.Synchronization) (int, error) {
+	if syncLockRef.Semaphore == nil || syncLockRef.Semaphore.Permits == nil {
+		return 1, nil
+	}
+	permits, err := intstr.Int(syncLockRef.Semaphore.Permits)
+	if err != nil {
+		return 0, fmt.Errorf("semaphore permits must be an integer: %w", err)
+	}
+	if *permits < 1 {
+		return 0, fmt.Errorf("semaphore permits must be a positive integer, got %d", *permits)
+	}
+	return *permits, nil
+}
+
 func ensureInit(wf *wfv1.Workflow, lockType wfv1.SynchronizationType) {
 	if wf.Status.Synchronization == nil {
 		wf.Status.Synchronization = &wfv1.SynchronizationStatus{}
@@ -306,6 +389,9 @@ func getResourceKey(namespace, wfName, resourceName string) string {
 
 func (cm *Manager) getCurrentLockHolders(lockName string) []string {
 	if concurrency, ok := cm.syncLockMap[lockName]; ok {
+		if redisSemaphore, ok := concurrency.(*RedisSemaphore); ok {
+			return redisSemaphore.getAllHolders()
+		}
 		return concurrency.getCurrentHolders()
 	}
 	return nil
@@ -316,10 +402,16 @@ func (cm *Manager) initializeSemaphore(semaphoreName string) (Semaphore, error)
 	if err != nil {
 		return nil, err
 	}
+	if cm.redis != nil {
+		return NewRedisSemaphore(cm.redis, cm.redisConfig, semaphoreName, limit, cm.nextWorkflow, "semaphore"), nil
+	}
 	return NewSemaphore(semaphoreName, limit, cm.nextWorkflow, "semaphore"), nil
 }
 
 func (cm *Manager) initializeMutex(mutexName string) Semaphore {
+	if cm.redis != nil {
+		return NewRedisSemaphore(cm.redis, cm.redisConfig, mutexName, 1, cm.nextWorkflow, "mutex")
+	}
 	return NewMutex(mutexName, cm.nextWorkflow)
 }
 
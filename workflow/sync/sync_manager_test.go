@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/pointer"
 
@@ -871,3 +872,55 @@ status:
 	})
 
 }
+
+func TestWeightedSemaphoreTryAcquire(t *testing.T) {
+	concurrenyMgr := NewLockManager(func(string) (int, error) { return 4, nil }, func(key string) {}, WorkflowExistenceFunc)
+	newWorkflow := func(name string, permits intstr.IntOrString) *wfv1.Workflow {
+		wf := wfv1.MustUnmarshalWorkflow(wfWithSemaphore)
+		wf.Name = name
+		wf.Spec.Synchronization.Semaphore.Permits = &permits
+		return wf
+	}
+
+	wf := newWorkflow("three", intstr.FromInt(3))
+	status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.Empty(t, msg)
+	assert.True(t, status)
+	assert.True(t, wfUpdate)
+	if assert.Len(t, wf.Status.Synchronization.Semaphore.Holding, 1) {
+		holding := wf.Status.Synchronization.Semaphore.Holding[0]
+		assert.Equal(t, map[string]int32{"three": 3}, holding.Permits)
+		assert.Equal(t, int32(3), holding.GetPermits("three"))
+	}
+
+	wf2 := newWorkflow("two", intstr.FromString("2"))
+	status, _, msg, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.False(t, status)
+	assert.Equal(t, "Waiting for 2 permits of default/ConfigMap/my-config/workflow lock. Lock status: 1/4 ", msg)
+
+	concurrenyMgr.Release(wf, "", wf.Spec.Synchronization)
+	assert.Empty(t, wf.Status.Synchronization.Semaphore.Holding[0].Permits)
+	status, _, _, err = concurrenyMgr.TryAcquire(wf2, "", wf2.Spec.Synchronization)
+	assert.NoError(t, err)
+	assert.True(t, status)
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, permits := range []intstr.IntOrString{intstr.FromInt(0), intstr.FromString("{{inputs.parameters.gpus}}"), intstr.FromInt(5)} {
+			wf := newWorkflow("invalid", permits)
+			_, _, _, err := concurrenyMgr.TryAcquire(wf, "", wf.Spec.Synchronization)
+			assert.Error(t, err, permits.String())
+		}
+	})
+	t.Run("Initialize", func(t *testing.T) {
+		mgr := NewLockManager(func(string) (int, error) { return 4, nil }, func(key string) {}, WorkflowExistenceFunc)
+		wf := wf2.DeepCopy()
+		wf.Status.Synchronization.Semaphore.Holding[0].Permits = map[string]int32{"two": 4}
+		mgr.Initialize([]wfv1.Workflow{*wf})
+		other := newWorkflow("other", intstr.FromInt(1))
+		status, _, _, err := mgr.TryAcquire(other, "", other.Spec.Synchronization)
+		assert.NoError(t, err)
+		assert.False(t, status, "the restored holder holds all the permits")
+	})
+}
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"container/heap"
+	"sort"
 	"sync"
 	"time"
 
@@ -144,6 +145,8 @@ type item struct {
 	creationTime time.Time
 	priority     int32
 	index        int
+	// permits is the number of permits of a semaphore the item is waiting for
+	permits int
 }
 
 type priorityQueue struct {
@@ -180,10 +183,23 @@ func (pq *priorityQueue) remove(key Key) {
 func (pq priorityQueue) Len() int { return len(pq.items) }
 
 func (pq priorityQueue) Less(i, j int) bool {
-	if pq.items[i].priority == pq.items[j].priority {
-		return pq.items[i].creationTime.Before(pq.items[j].creationTime)
+	return pq.items[i].before(pq.items[j])
+}
+
+// before returns whether the item is ahead of another in the queue, by priority and then by creation time
+func (i *item) before(other *item) bool {
+	if i.priority == other.priority {
+		return i.creationTime.Before(other.creationTime)
 	}
-	return pq.items[i].priority > pq.items[j].priority
+	return i.priority > other.priority
+}
+
+// sorted returns the items in the order they are popped, as the items of the heap are only partially ordered
+func (pq *priorityQueue) sorted() []*item {
+	items := make([]*item, len(pq.items))
+	copy(items, pq.items)
+	sort.Slice(items, func(i, j int) bool { return items[i].before(items[j]) })
+	return items
 }
 
 func (pq priorityQueue) Swap(i, j int) {
@@ -0,0 +1,95 @@
+package retry
+
+import (
+	"fmt"
+	"regexp"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// podReasonNodeLost is the reason of a pod whose Kubernetes node stopped reporting
+	podReasonNodeLost = "NodeLost"
+	// podReasonDeletionByTaintManager is the reason of the DisruptionTarget condition of a pod that was deleted
+	// because its Kubernetes node is tainted, e.g. unreachable
+	podReasonDeletionByTaintManager = "DeletionByTaintManager"
+)
+
+// GetTermination returns how the pod failed, or nil if nothing is known about it
+func GetTermination(pod *apiv1.Pod) *wfv1.NodeTermination {
+	t := &wfv1.NodeTermination{Reason: pod.Status.Reason}
+	var ctrs []apiv1.ContainerStatus
+	ctrs = append(ctrs, pod.Status.InitContainerStatuses...)
+	ctrs = append(ctrs, pod.Status.ContainerStatuses...)
+	for _, ctr := range ctrs {
+		if s := ctr.State.Terminated; s != nil && s.ExitCode != 0 {
+			t.Containers = append(t.Containers, wfv1.ContainerTermination{Name: ctr.Name, ExitCode: s.ExitCode, Reason: s.Reason})
+		}
+	}
+	if pod.Status.Reason == podReasonNodeLost {
+		t.NodeLost = true
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type != apiv1.DisruptionTarget || c.Status != apiv1.ConditionTrue {
+			continue
+		}
+		switch c.Reason {
+		case apiv1.PodReasonPreemptionByScheduler:
+			t.Preempted = true
+		case podReasonDeletionByTaintManager:
+			t.NodeLost = true
+		}
+	}
+	if len(t.Containers) == 0 && t.Reason == "" && !t.NodeLost && !t.Preempted {
+		return nil
+	}
+	return t
+}
+
+// Match returns how the node failed if the matcher matches it, or an empty string if it does not
+func Match(m *wfv1.RetryMatcher, node wfv1.NodeStatus) (string, error) {
+	t := node.Termination
+	if t == nil {
+		t = &wfv1.NodeTermination{}
+	}
+	for _, ctr := range t.Containers {
+		for _, exitCode := range m.ExitCodes {
+			if ctr.ExitCode == exitCode {
+				return fmt.Sprintf("container %s exited with code %d", ctr.Name, exitCode), nil
+			}
+		}
+		if ctr.Reason != "" && contains(m.Reasons, ctr.Reason) {
+			return fmt.Sprintf("container %s terminated with reason %s", ctr.Name, ctr.Reason), nil
+		}
+	}
+	if t.Reason != "" && contains(m.Reasons, t.Reason) {
+		return fmt.Sprintf("pod failed with reason %s", t.Reason), nil
+	}
+	if m.NodeLost && t.NodeLost {
+		return "pod was deleted or its node was lost", nil
+	}
+	if m.Preempted && t.Preempted {
+		return "pod was preempted", nil
+	}
+	if m.Message != "" {
+		re, err := regexp.Compile(m.Message)
+		if err != nil {
+			return "", fmt.Errorf("invalid message regular expression '%s': %w", m.Message, err)
+		}
+		if re.MatchString(node.Message) {
+			return fmt.Sprintf("message matches '%s'", m.Message), nil
+		}
+	}
+	return "", nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
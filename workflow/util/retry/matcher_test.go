@@ -0,0 +1,73 @@
+package retry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+func TestGetTermination(t *testing.T) {
+	t.Run("Running", func(t *testing.T) {
+		assert.Nil(t, GetTermination(&apiv1.Pod{}))
+	})
+	t.Run("OOMKilled", func(t *testing.T) {
+		pod := &apiv1.Pod{Status: apiv1.PodStatus{
+			InitContainerStatuses: []apiv1.ContainerStatus{{Name: "init", State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 0}}}},
+			ContainerStatuses: []apiv1.ContainerStatus{
+				{Name: "wait", State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "main", State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}}},
+			},
+		}}
+		assert.Equal(t, &wfv1.NodeTermination{Containers: []wfv1.ContainerTermination{{Name: "main", ExitCode: 137, Reason: "OOMKilled"}}}, GetTermination(pod))
+	})
+	t.Run("Evicted", func(t *testing.T) {
+		pod := &apiv1.Pod{Status: apiv1.PodStatus{Reason: "Evicted"}}
+		assert.Equal(t, &wfv1.NodeTermination{Reason: "Evicted"}, GetTermination(pod))
+	})
+	t.Run("Preempted", func(t *testing.T) {
+		pod := &apiv1.Pod{Status: apiv1.PodStatus{Conditions: []apiv1.PodCondition{
+			{Type: apiv1.DisruptionTarget, Status: apiv1.ConditionTrue, Reason: apiv1.PodReasonPreemptionByScheduler},
+		}}}
+		assert.Equal(t, &wfv1.NodeTermination{Preempted: true}, GetTermination(pod))
+	})
+	t.Run("NodeLost", func(t *testing.T) {
+		pod := &apiv1.Pod{Status: apiv1.PodStatus{Conditions: []apiv1.PodCondition{
+			{Type: apiv1.DisruptionTarget, Status: apiv1.ConditionTrue, Reason: "DeletionByTaintManager"},
+		}}}
+		assert.Equal(t, &wfv1.NodeTermination{NodeLost: true}, GetTermination(pod))
+	})
+}
+
+func TestMatch(t *testing.T) {
+	node := wfv1.NodeStatus{
+		Message:     "OOMKilled (exit code 137)",
+		Termination: &wfv1.NodeTermination{Containers: []wfv1.ContainerTermination{{Name: "main", ExitCode: 137, Reason: "OOMKilled"}}},
+	}
+	match := func(m wfv1.RetryMatcher, node wfv1.NodeStatus) string {
+		matched, err := Match(&m, node)
+		require.NoError(t, err)
+		return matched
+	}
+	assert.Empty(t, match(wfv1.RetryMatcher{}, node))
+	assert.Equal(t, "container main exited with code 137", match(wfv1.RetryMatcher{ExitCodes: []int32{1, 137}}, node))
+	assert.Empty(t, match(wfv1.RetryMatcher{ExitCodes: []int32{1}}, node))
+	assert.Equal(t, "container main terminated with reason OOMKilled", match(wfv1.RetryMatcher{Reasons: []string{"OOMKilled"}}, node))
+	assert.Equal(t, "message matches 'exit code 13[0-9]'", match(wfv1.RetryMatcher{Message: "exit code 13[0-9]"}, node))
+	assert.Empty(t, match(wfv1.RetryMatcher{NodeLost: true, Preempted: true}, node))
+
+	evicted := wfv1.NodeStatus{Termination: &wfv1.NodeTermination{Reason: "Evicted"}}
+	assert.Equal(t, "pod failed with reason Evicted", match(wfv1.RetryMatcher{Reasons: []string{"Evicted"}}, evicted))
+
+	deleted := wfv1.NodeStatus{Message: "pod deleted", Termination: &wfv1.NodeTermination{NodeLost: true}}
+	assert.Equal(t, "pod was deleted or its node was lost", match(wfv1.RetryMatcher{NodeLost: true}, deleted))
+
+	preempted := wfv1.NodeStatus{Termination: &wfv1.NodeTermination{Preempted: true}}
+	assert.Equal(t, "pod was preempted", match(wfv1.RetryMatcher{Preempted: true}, preempted))
+
+	_, err := Match(&wfv1.RetryMatcher{Message: "("}, node)
+	assert.ErrorContains(t, err, "invalid message regular expression")
+}
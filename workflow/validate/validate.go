@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/antonmedv/expr"
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -220,6 +221,9 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if _, err := wf.Spec.PodGC.GetLabelSelector(); err != nil {
 		return nil, errors.Errorf(errors.CodeBadRequest, "podGC.labelSelector invalid: %v", err)
 	}
+	if err := validateSynchronization("", wf.Spec.Synchronization); err != nil {
+		return nil, err
+	}
 
 	// Check if all templates can be resolved.
 	for _, template := range wf.Spec.Templates {
@@ -283,8 +287,44 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 		return fmt.Errorf("cron workflow name %q must not be more than 52 characters long (currently %d)", cronWf.Name, len(cronWf.Name))
 	}
 
-	if _, err := cron.ParseStandard(cronWf.Spec.Schedule); err != nil {
-		return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
+	if len(cronWf.Spec.GetSchedules()) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "cron workflow must have a schedule or schedules")
+	}
+	if cronWf.Spec.Schedule != "" && len(cronWf.Spec.Schedules) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "cron workflow cannot have both a schedule and schedules")
+	}
+	for _, schedule := range cronWf.Spec.GetSchedules() {
+		if _, err := cron.ParseStandard(schedule); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
+		}
+	}
+
+	if x := cronWf.Spec.Exclusions; x != nil {
+		for _, date := range x.Dates {
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "exclusions.dates: '%s' must be in the format YYYY-MM-DD", date)
+			}
+		}
+		if x.ConfigMapKeyRef != nil && (x.ConfigMapKeyRef.Name == "" || x.ConfigMapKeyRef.Key == "") {
+			return errors.Errorf(errors.CodeBadRequest, "exclusions.configMapKeyRef must have a name and a key")
+		}
+	}
+
+	if s := cronWf.Spec.StopStrategy; s != nil {
+		if s.Succeeded < 0 || s.Failed < 0 {
+			return errors.Errorf(errors.CodeBadRequest, "stopStrategy.succeeded and stopStrategy.failed must not be negative")
+		}
+		if s.Expression != "" {
+			if _, err := expr.Compile(s.Expression); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "stopStrategy.expression is invalid: %s", err)
+			}
+		}
+	}
+
+	if cronWf.Spec.When != "" {
+		if _, err := expr.Compile(cronWf.Spec.When); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "when is invalid: %s", err)
+		}
 	}
 
 	switch cronWf.Spec.ConcurrencyPolicy {
@@ -330,6 +370,14 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 		return err
 	}
 
+	if err := validateSynchronization(fmt.Sprintf("templates.%s.", tmpl.Name), tmpl.Synchronization); err != nil {
+		return err
+	}
+
+	if err := validateMemoize(tmpl); err != nil {
+		return err
+	}
+
 	localParams := make(map[string]string)
 	if tmpl.IsPodType() {
 		localParams[common.LocalVarPodName] = placeholderGenerator.NextPlaceholder()
@@ -471,11 +519,108 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 		default:
 			return nil, fmt.Errorf("%s is not a valid RetryPolicy", resolvedTmpl.RetryStrategy.RetryPolicy)
 		}
+		if err := validateRetryMatchers(resolvedTmpl.RetryStrategy); err != nil {
+			return nil, err
+		}
+		if err := validateRetryResources(resolvedTmpl.RetryStrategy.Resources); err != nil {
+			return nil, err
+		}
 	}
 
 	return resolvedTmpl, ctx.validateTemplate(resolvedTmpl, tmplCtx, args)
 }
 
+// validateRetryMatchers checks that retryOn does not conflict with retryPolicy, and that the messages of the matchers
+// are valid regular expressions
+func validateRetryMatchers(retryStrategy *wfv1.RetryStrategy) error {
+	if retryStrategy.RetryOn != nil && retryStrategy.RetryPolicy != "" {
+		return fmt.Errorf("retryStrategy.retryOn and retryStrategy.retryPolicy cannot both be set")
+	}
+	for name, m := range map[string]*wfv1.RetryMatcher{"retryOn": retryStrategy.RetryOn, "doNotRetryOn": retryStrategy.DoNotRetryOn} {
+		if m == nil || m.Message == "" {
+			continue
+		}
+		if _, err := regexp.Compile(m.Message); err != nil {
+			return fmt.Errorf("retryStrategy.%s.message is not a valid regular expression: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// validateRetryResources checks that the escalated resources have a maximum, and that they escalate by a factor greater
+// than 1 or an increase
+func validateRetryResources(r *wfv1.RetryResources) error {
+	if r == nil {
+		return nil
+	}
+	if len(r.Max) == 0 {
+		return fmt.Errorf("retryStrategy.resources.max must have the maximum of each escalated resource")
+	}
+	for name := range r.Increase {
+		if _, ok := r.Max[name]; !ok {
+			return fmt.Errorf("retryStrategy.resources.increase.%s must have a maximum in retryStrategy.resources.max", name)
+		}
+	}
+	factor := 1.0
+	if r.Factor != nil {
+		var err error
+		factor, err = r.Factor.Float64()
+		if err != nil || factor < 1 {
+			return fmt.Errorf("retryStrategy.resources.factor must be a number of at least 1")
+		}
+	}
+	if factor == 1 && len(r.Increase) == 0 {
+		return fmt.Errorf("retryStrategy.resources must escalate the resources by a factor greater than 1 or an increase")
+	}
+	if r.EscalateOn != nil && r.EscalateOn.Message != "" {
+		if _, err := regexp.Compile(r.EscalateOn.Message); err != nil {
+			return fmt.Errorf("retryStrategy.resources.escalateOn.message is not a valid regular expression: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateSynchronization checks the semaphore permits are a positive integer, or an argo variable that is resolved
+// when the lock is acquired, and that an HTTP semaphore names a limit endpoint rather than giving a URL
+func validateSynchronization(prefix string, synchronization *wfv1.Synchronization) error {
+	if synchronization == nil || synchronization.Semaphore == nil {
+		return nil
+	}
+	if http := synchronization.Semaphore.HTTP; http != nil && (http.Name == "" || strings.Contains(http.Name, "/")) {
+		return errors.Errorf(errors.CodeBadRequest, "%ssynchronization.semaphore.http.name must be the name of one of the synchronization.limitEndpoints of the controller's ConfigMap", prefix)
+	}
+	permits := synchronization.Semaphore.Permits
+	if permits == nil {
+		return nil
+	}
+	if !intstr.IsValidIntOrArgoVariable(permits) && !placeholderGenerator.IsPlaceholder(permits.StrVal) {
+		return errors.Errorf(errors.CodeBadRequest, "%ssynchronization.semaphore.permits must be a positive integer > 0 or an argo variable", prefix)
+	}
+	if i, err := intstr.Int(permits); err == nil && *i < 1 {
+		return errors.Errorf(errors.CodeBadRequest, "%ssynchronization.semaphore.permits must be a positive integer > 0 or an argo variable", prefix)
+	}
+	return nil
+}
+
+// validateMemoize validates that the cache of a memoized template defines exactly one backend
+func validateMemoize(tmpl *wfv1.Template) error {
+	if tmpl.Memoize == nil {
+		return nil
+	}
+	numBackends := 0
+	if c := tmpl.Memoize.Cache; c != nil {
+		for _, defined := range []bool{c.ConfigMap != nil, c.SQL != nil, c.Artifact != nil} {
+			if defined {
+				numBackends++
+			}
+		}
+	}
+	if numBackends != 1 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache must define exactly one of: configMap, sql, artifact", tmpl.Name)
+	}
+	return nil
+}
+
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
@@ -668,6 +813,16 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.image may not be empty", tmpl.Name)
 		}
 	}
+	if tmpl.Data != nil {
+		if err := validateDataSource(tmpl.Data.Source); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.source %s", tmpl.Name, err.Error())
+		}
+		for i, step := range tmpl.Data.Transformation {
+			if err := validateTransformationStep(step); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.data.transformation[%d] %s", tmpl.Name, i, err.Error())
+			}
+		}
+	}
 	// we don't validate tmpl.Plugin, because this is done by Plugin.UnmarshallJSON
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
@@ -683,6 +838,34 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	return nil
 }
 
+// validateDataSource validates that exactly one source is defined
+func validateDataSource(source wfv1.DataSource) error {
+	numSources := 0
+	for _, defined := range []bool{source.ArtifactPaths != nil, source.ConfigMapKeyRef != nil, source.SecretKeyRef != nil, source.HTTP != nil, source.Parameters != nil, source.Resources != nil} {
+		if defined {
+			numSources++
+		}
+	}
+	if numSources != 1 {
+		return fmt.Errorf("must define exactly one of: artifactPaths, configMapKeyRef, secretKeyRef, http, parameters, resources")
+	}
+	return nil
+}
+
+// validateTransformationStep validates that exactly one transformation is defined
+func validateTransformationStep(step wfv1.TransformationStep) error {
+	numTypes := 0
+	for _, defined := range []bool{step.Expression != "", step.JQ != "", step.JSONPath != "", step.Parse != nil, step.Sort != nil, step.Unique != nil, step.GroupBy != nil, step.Chunk != nil} {
+		if defined {
+			numTypes++
+		}
+	}
+	if numTypes != 1 {
+		return fmt.Errorf("must define exactly one of: expression, jq, jsonPath, parse, sort, unique, groupBy, chunk")
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments, allowEmptyValues bool) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -909,6 +1092,22 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 	}
 }
 
+// validateArchiveStrategy validates the compression options of an archive strategy
+func validateArchiveStrategy(strategy *wfv1.ArchiveStrategy) error {
+	if strategy == nil {
+		return nil
+	}
+	if strategy.Tar != nil && strategy.Tar.NoCompression && strategy.Tar.CompressionLevel != nil {
+		return fmt.Errorf("tar.compressionLevel cannot be used with noCompression")
+	}
+	if strategy.Zstd != nil && strategy.Zstd.CompressionLevel != nil {
+		if l := *strategy.Zstd.CompressionLevel; l < 1 || l > 22 {
+			return fmt.Errorf("zstd.compressionLevel must be between 1 and 22, not %d", l)
+		}
+	}
+	return nil
+}
+
 func validateOutputs(scope map[string]interface{}, globalParams map[string]string, tmpl *wfv1.Template) error {
 	err := validateWorkflowFieldNames(tmpl.Outputs.Parameters)
 	if err != nil {
@@ -945,6 +1144,12 @@ func validateOutputs(scope map[string]interface{}, globalParams map[string]strin
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globalName: %s", tmpl.Name, artRef, errs[0])
 			}
 		}
+		if art.Deduplicate && art.HasKey() {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.deduplicate cannot be used with a key, as the key is derived from the artifact's digest", tmpl.Name, artRef)
+		}
+		if err := validateArchiveStrategy(art.Archive); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.archive.%s", tmpl.Name, artRef, err.Error())
+		}
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
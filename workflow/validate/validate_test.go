@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	wfv1 "github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned/fake"
@@ -866,6 +869,58 @@ func TestInvalidOutputArtName(t *testing.T) {
 	}
 }
 
+var deduplicatedOutputArt = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: output-artifact-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    container:
+      image: docker/whalesay:latest
+      command: [sh, -c]
+      args: ["cowsay hello world | tee /tmp/hello_world.txt"]
+    outputs:
+      artifacts:
+      - name: hello
+        path: /tmp/hello_world.txt
+        deduplicate: true
+`
+
+func TestDeduplicatedOutputArt(t *testing.T) {
+	_, err := validate(deduplicatedOutputArt)
+	assert.NoError(t, err)
+	wf := unmarshalWf(deduplicatedOutputArt)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].S3 = &wfv1.S3Artifact{Key: "my-key"}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "deduplicate cannot be used with a key")
+	}
+}
+
+func TestArchiveStrategyOutputArt(t *testing.T) {
+	level := int32(19)
+	wf := unmarshalWf(deduplicatedOutputArt)
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Archive = &wfv1.ArchiveStrategy{Zstd: &wfv1.ZstdStrategy{CompressionLevel: &level}}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	level = 23
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "zstd.compressionLevel must be between 1 and 22")
+	}
+
+	level = 1
+	wf.Spec.Templates[0].Outputs.Artifacts[0].Archive = &wfv1.ArchiveStrategy{Tar: &wfv1.TarStrategy{NoCompression: true, CompressionLevel: &level}}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "tar.compressionLevel cannot be used with noCompression")
+	}
+}
+
 var invalidOutputParamNames = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -1165,6 +1220,61 @@ func TestValidActiveDeadlineSeconds(t *testing.T) {
 	}
 }
 
+var semaphorePermits = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: semaphore-permits-
+spec:
+  entrypoint: train
+  arguments:
+    parameters:
+      - name: gpus
+        value: "4"
+  templates:
+  - name: train
+    inputs:
+      parameters:
+        - name: gpus
+          value: "{{workflow.parameters.gpus}}"
+    synchronization:
+      semaphore:
+        configMapKeyRef:
+          name: my-config
+          key: gpu
+        permits: "{{inputs.parameters.gpus}}"
+    container:
+      image: alpine:latest
+`
+
+func TestSemaphorePermits(t *testing.T) {
+	_, err := validate(semaphorePermits)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(semaphorePermits)
+	wf.Spec.Templates[0].Synchronization.Semaphore.Permits = &intstr.IntOrString{Type: intstr.Int, IntVal: 0}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.train.synchronization.semaphore.permits must be a positive integer > 0 or an argo variable")
+
+	wf = unmarshalWf(semaphorePermits)
+	wf.Spec.Synchronization = &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{Permits: &intstr.IntOrString{Type: intstr.String, StrVal: "four"}}}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "synchronization.semaphore.permits must be a positive integer > 0 or an argo variable")
+}
+
+func TestHTTPSemaphore(t *testing.T) {
+	wf := unmarshalWf(semaphorePermits)
+	wf.Spec.Templates[0].Synchronization.Semaphore = &wfv1.SemaphoreRef{HTTP: &wfv1.SyncHTTPRef{Name: "capacity"}}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	for _, name := range []string{"", "https://capacity.example.com/limits/gpu"} {
+		wf.Spec.Templates[0].Synchronization.Semaphore.HTTP.Name = name
+		_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.train.synchronization.semaphore.http.name must be the name of one of the synchronization.limitEndpoints of the controller's ConfigMap", name)
+	}
+}
+
 var leafWithParallelism = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2951,3 +3061,238 @@ func TestInitContainerHasName(t *testing.T) {
 	_, err := validate(testInitContainerHasName)
 	assert.EqualError(t, err, "templates.main.tasks.spurious initContainers must all have container name")
 }
+
+func TestCronWorkflowSchedulesAndExclusions(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			WorkflowSpec: wfv1.WorkflowSpec{
+				Entrypoint: "main",
+				Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+			},
+		},
+	}
+	err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.EqualError(t, err, "cron workflow must have a schedule or schedules")
+
+	cwf.Spec.Schedules = []string{"0 9 * * 1-5", "0 12 * * 0,6"}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf))
+
+	cwf.Spec.Schedule = "0 9 * * *"
+	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.EqualError(t, err, "cron workflow cannot have both a schedule and schedules")
+	cwf.Spec.Schedule = ""
+
+	cwf.Spec.Schedules = append(cwf.Spec.Schedules, "invalid")
+	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.ErrorContains(t, err, "cron schedule is malformed")
+
+	cwf.Spec.Schedules = []string{"0 9 * * *"}
+	cwf.Spec.Exclusions = &wfv1.CronWorkflowExclusions{Dates: []string{"2024-12-25", "26/12/2024"}}
+	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.EqualError(t, err, "exclusions.dates: '26/12/2024' must be in the format YYYY-MM-DD")
+
+	cwf.Spec.Exclusions = &wfv1.CronWorkflowExclusions{Dates: []string{"2024-12-25"}, ConfigMapKeyRef: &apiv1.ConfigMapKeySelector{Key: "holidays"}}
+	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.EqualError(t, err, "exclusions.configMapKeyRef must have a name and a key")
+}
+
+func TestCronWorkflowStopStrategyAndWhen(t *testing.T) {
+	cwf := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cwf"},
+		Spec: wfv1.CronWorkflowSpec{
+			Schedule: "0 9 * * *",
+			WorkflowSpec: wfv1.WorkflowSpec{
+				Entrypoint: "main",
+				Templates:  []wfv1.Template{{Name: "main", Container: &apiv1.Container{Image: "alpine"}}},
+			},
+			StopStrategy: &wfv1.StopStrategy{Succeeded: 3, Expression: "cronworkflow.consecutiveFailed >= 2"},
+			When:         "cronworkflow.labels.env == 'prod'",
+		},
+	}
+	assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf))
+
+	cwf.Spec.When = "cronworkflow.labels.env =="
+	err := ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.ErrorContains(t, err, "when is invalid")
+
+	cwf.Spec.When = ""
+	cwf.Spec.StopStrategy.Expression = "cronworkflow.failed >"
+	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.ErrorContains(t, err, "stopStrategy.expression is invalid")
+
+	cwf.Spec.StopStrategy = &wfv1.StopStrategy{Failed: -1}
+	err = ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cwf)
+	assert.EqualError(t, err, "stopStrategy.succeeded and stopStrategy.failed must not be negative")
+}
+
+var testRetryMatchers = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: retry-matchers-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      retryOn:
+        reasons: [OOMKilled]
+        nodeLost: true
+      doNotRetryOn:
+        exitCodes: [2]
+        message: "invalid argument"
+    container:
+      image: alpine:latest
+`
+
+func TestRetryMatchers(t *testing.T) {
+	_, err := validate(testRetryMatchers)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(testRetryMatchers)
+	wf.Spec.Templates[0].RetryStrategy.RetryPolicy = wfv1.RetryPolicyAlways
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "retryStrategy.retryOn and retryStrategy.retryPolicy cannot both be set")
+
+	wf = unmarshalWf(testRetryMatchers)
+	wf.Spec.Templates[0].RetryStrategy.DoNotRetryOn.Message = "("
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.ErrorContains(t, err, "retryStrategy.doNotRetryOn.message is not a valid regular expression")
+}
+
+var testRetryResources = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: retry-resources-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    retryStrategy:
+      limit: 3
+      resources:
+        escalateOn:
+          reasons: [OOMKilled]
+        factor: 1.5
+        increase:
+          memory: 512Mi
+        max:
+          memory: 16Gi
+    container:
+      image: alpine:latest
+`
+
+func TestRetryResources(t *testing.T) {
+	_, err := validate(testRetryResources)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(testRetryResources)
+	wf.Spec.Templates[0].RetryStrategy.Resources.Increase[apiv1.ResourceCPU] = resource.MustParse("1")
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "retryStrategy.resources.increase.cpu must have a maximum in retryStrategy.resources.max")
+
+	wf = unmarshalWf(testRetryResources)
+	wf.Spec.Templates[0].RetryStrategy.Resources.Max = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "retryStrategy.resources.max must have the maximum of each escalated resource")
+
+	wf = unmarshalWf(testRetryResources)
+	wf.Spec.Templates[0].RetryStrategy.Resources.Factor = &wfv1.Amount{Value: "0.5"}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "retryStrategy.resources.factor must be a number of at least 1")
+
+	wf = unmarshalWf(testRetryResources)
+	wf.Spec.Templates[0].RetryStrategy.Resources.Increase = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err, "a factor greater than 1 escalates the resources")
+
+	wf.Spec.Templates[0].RetryStrategy.Resources.Factor = &wfv1.Amount{Value: "1"}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "retryStrategy.resources must escalate the resources by a factor greater than 1 or an increase")
+
+	wf.Spec.Templates[0].RetryStrategy.Resources.Factor = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "retryStrategy.resources must escalate the resources by a factor greater than 1 or an increase")
+}
+
+var testDataTransformation = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: data-transformation-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    data:
+      source:
+        parameters:
+        - name: regions
+          value: '["eu", "us"]'
+      transformation:
+      - jq: ".regions[]"
+      - sort: {}
+`
+
+func TestDataTransformation(t *testing.T) {
+	_, err := validate(testDataTransformation)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(testDataTransformation)
+	wf.Spec.Templates[0].Data.Transformation[1].Expression = "data"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.data.transformation[1] must define exactly one of: expression, jq, jsonPath, parse, sort, unique, groupBy, chunk")
+
+	wf = unmarshalWf(testDataTransformation)
+	wf.Spec.Templates[0].Data.Transformation = append(wf.Spec.Templates[0].Data.Transformation, wfv1.TransformationStep{})
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.data.transformation[2] must define exactly one of: expression, jq, jsonPath, parse, sort, unique, groupBy, chunk")
+}
+
+func TestDataSource(t *testing.T) {
+	wf := unmarshalWf(testDataTransformation)
+	wf.Spec.Templates[0].Data.Source.SecretKeyRef = &apiv1.SecretKeySelector{Key: "regions"}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.data.source must define exactly one of: artifactPaths, configMapKeyRef, secretKeyRef, http, parameters, resources")
+
+	wf = unmarshalWf(testDataTransformation)
+	wf.Spec.Templates[0].Data.Source = wfv1.DataSource{}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.data.source must define exactly one of: artifactPaths, configMapKeyRef, secretKeyRef, http, parameters, resources")
+}
+
+var testMemoizeCache = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoize-cache-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    memoize:
+      key: my-key
+      cache:
+        sql:
+          name: my-cache
+    container:
+      image: alpine:latest
+`
+
+func TestMemoizeCache(t *testing.T) {
+	_, err := validate(testMemoizeCache)
+	assert.NoError(t, err)
+
+	wf := unmarshalWf(testMemoizeCache)
+	wf.Spec.Templates[0].Memoize.Cache.Artifact = &wfv1.ArtifactCache{Name: "my-cache"}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.memoize.cache must define exactly one of: configMap, sql, artifact")
+
+	wf = unmarshalWf(testMemoizeCache)
+	wf.Spec.Templates[0].Memoize.Cache = &wfv1.Cache{}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.main.memoize.cache must define exactly one of: configMap, sql, artifact")
+}